@@ -0,0 +1,208 @@
+package chaoskit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+)
+
+// Artifact file names written into an iteration artifacts directory
+const (
+	ArtifactError      = "error.txt"
+	ArtifactGoroutines = "goroutines.txt"
+	ArtifactHeap       = "heap.pprof"
+	ArtifactCPU        = "cpu.pprof"
+	ArtifactEvents     = "events.json"
+	ArtifactOutput     = "output.txt"
+)
+
+var unsafePathChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// ArtifactConfig configures post-mortem artifact capture for failed iterations.
+// Artifacts of a failed iteration are written to
+// <Dir>/<scenario>/iteration-<n>/ and listed in ExecutionResult.Artifacts.
+type ArtifactConfig struct {
+	// Dir is the root artifacts directory
+	Dir string
+
+	// Goroutines captures a full goroutine dump
+	Goroutines bool
+
+	// HeapProfile captures a heap pprof profile
+	HeapProfile bool
+
+	// CPUProfile profiles every iteration and keeps the profile of failed ones.
+	// Only one CPU profile can be active per process, so it is skipped
+	// if profiling is already running (e.g. go test -cpuprofile).
+	CPUProfile bool
+
+	// EventLog captures the injection events of the iteration as JSON
+	EventLog bool
+}
+
+// DefaultArtifactConfig captures goroutine dumps, heap profiles and the event log into dir.
+// CPU profiling is disabled as it adds overhead to every iteration.
+func DefaultArtifactConfig(dir string) ArtifactConfig {
+	return ArtifactConfig{
+		Dir:         dir,
+		Goroutines:  true,
+		HeapProfile: true,
+		EventLog:    true,
+	}
+}
+
+// WithArtifacts enables artifact capture on iteration failure
+func WithArtifacts(config ArtifactConfig) ExecutorOption {
+	return func(e *Executor) {
+		e.artifacts = &config
+	}
+}
+
+// FailureArtifacts references the artifacts captured for a failed iteration
+type FailureArtifacts struct {
+	Scenario  string   `json:"scenario"`
+	Iteration int      `json:"iteration"`
+	Error     string   `json:"error"`
+	Files     []string `json:"files"`
+}
+
+// artifactCapture captures artifacts of a single iteration
+type artifactCapture struct {
+	config   *ArtifactConfig
+	logger   *slog.Logger
+	redactor *Redactor
+	cpu      *bytes.Buffer
+}
+
+// startArtifactCapture starts per-iteration capture (CPU profiling, if enabled)
+func startArtifactCapture(config *ArtifactConfig, logger *slog.Logger, redactor *Redactor) *artifactCapture {
+	capture := &artifactCapture{config: config, logger: logger, redactor: redactor}
+
+	if config.CPUProfile {
+		buf := &bytes.Buffer{}
+		if err := pprof.StartCPUProfile(buf); err != nil {
+			capture.warn("failed to start CPU profile", err)
+		} else {
+			capture.cpu = buf
+		}
+	}
+
+	return capture
+}
+
+// finish stops capture and, if the iteration failed, writes artifacts
+// and records their paths in the result. Error text, output and events
+// are redacted; goroutine dumps and profiles are written as is.
+func (c *artifactCapture) finish(result *ExecutionResult, events []InjectionEvent) {
+	if c.cpu != nil {
+		pprof.StopCPUProfile()
+	}
+
+	if result.Success {
+		return
+	}
+	*result = c.redactor.RedactResult(*result)
+
+	dir := filepath.Join(c.config.Dir,
+		unsafePathChars.ReplaceAllString(result.ScenarioName, "_"),
+		"iteration-"+strconv.Itoa(result.Iteration))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		c.warn("failed to create artifacts directory", err)
+
+		return
+	}
+
+	write := func(name string, data []byte) {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			c.warn("failed to write artifact "+name, err)
+
+			return
+		}
+		result.Artifacts = append(result.Artifacts, path)
+	}
+
+	var errText bytes.Buffer
+	if result.Error != nil {
+		errText.WriteString(result.Error.Error())
+		errText.WriteString("\n")
+	}
+	if result.PanicStack != "" {
+		_, _ = fmt.Fprintf(&errText, "\npanic stack:\n%s", result.PanicStack)
+	}
+	write(ArtifactError, errText.Bytes())
+
+	if result.Output != "" {
+		write(ArtifactOutput, []byte(result.Output))
+	}
+
+	if c.config.Goroutines {
+		var buf bytes.Buffer
+		if err := pprof.Lookup("goroutine").WriteTo(&buf, 2); err != nil {
+			c.warn("failed to capture goroutine dump", err)
+		} else {
+			write(ArtifactGoroutines, buf.Bytes())
+		}
+	}
+
+	if c.config.HeapProfile {
+		var buf bytes.Buffer
+		runtime.GC() // up-to-date allocation statistics
+		if err := pprof.Lookup("heap").WriteTo(&buf, 0); err != nil {
+			c.warn("failed to capture heap profile", err)
+		} else {
+			write(ArtifactHeap, buf.Bytes())
+		}
+	}
+
+	if c.cpu != nil {
+		write(ArtifactCPU, c.cpu.Bytes())
+	}
+
+	if c.config.EventLog {
+		if events == nil {
+			events = []InjectionEvent{}
+		}
+		data, err := json.MarshalIndent(events, "", "  ")
+		if err != nil {
+			c.warn("failed to encode event log", err)
+		} else {
+			write(ArtifactEvents, data)
+		}
+	}
+}
+
+func (c *artifactCapture) warn(msg string, err error) {
+	if c.logger != nil {
+		c.logger.Warn(msg, slog.String("error", err.Error()))
+	}
+}
+
+// failureArtifacts collects artifact references of failed results
+func failureArtifacts(results []ExecutionResult) []FailureArtifacts {
+	var artifacts []FailureArtifacts
+	for _, result := range results {
+		if len(result.Artifacts) == 0 {
+			continue
+		}
+
+		entry := FailureArtifacts{
+			Scenario:  result.ScenarioName,
+			Iteration: result.Iteration,
+			Files:     result.Artifacts,
+		}
+		if result.Error != nil {
+			entry.Error = result.Error.Error()
+		}
+		artifacts = append(artifacts, entry)
+	}
+
+	return artifacts
+}
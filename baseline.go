@@ -0,0 +1,94 @@
+package chaoskit
+
+import (
+	"fmt"
+)
+
+// BaselineTolerances defines how far a run may regress relative to a baseline report
+type BaselineTolerances struct {
+	// MaxSuccessRateDrop is the maximum absolute success rate drop
+	// Example: 0.02 = the success rate may drop by 2 percentage points
+	MaxSuccessRateDrop float64 `json:"max_success_rate_drop" yaml:"max_success_rate_drop"`
+
+	// MaxP99Increase is the maximum relative p99 iteration duration growth
+	// Example: 0.20 = p99 may grow by 20%
+	MaxP99Increase float64 `json:"max_p99_increase" yaml:"max_p99_increase"`
+}
+
+// DefaultBaselineTolerances allows a 2 percentage point success rate drop and 20% p99 growth
+func DefaultBaselineTolerances() BaselineTolerances {
+	return BaselineTolerances{
+		MaxSuccessRateDrop: 0.02,
+		MaxP99Increase:     0.20,
+	}
+}
+
+// Validate checks if tolerances are valid
+func (t BaselineTolerances) Validate() error {
+	if t.MaxSuccessRateDrop < 0.0 || t.MaxSuccessRateDrop > 1.0 {
+		return fmt.Errorf("max_success_rate_drop must be between 0.0 and 1.0")
+	}
+	if t.MaxP99Increase < 0.0 {
+		return fmt.Errorf("max_p99_increase must be >= 0")
+	}
+
+	return nil
+}
+
+// GetVerdictAgainstBaseline calculates the verdict like GetVerdict and additionally
+// fails it when the run regressed beyond tolerances relative to a baseline report,
+// e.g. one stored with SaveReport and read back with LoadReport.
+// The run is evaluated with the thresholds of the baseline
+// (or DefaultThresholds if it has none). Detected regressions are listed in
+// Report.Regressions. Registered verdict listeners are notified.
+func (r *Reporter) GetVerdictAgainstBaseline(baseline *Report, tolerances BaselineTolerances) (*Report, error) {
+	if baseline == nil {
+		return nil, fmt.Errorf("baseline report is nil")
+	}
+	if err := tolerances.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid baseline tolerances: %w", err)
+	}
+
+	thresholds := baseline.Thresholds
+	if thresholds == nil {
+		thresholds = DefaultThresholds()
+	}
+
+	report, err := r.calculateVerdict(thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Regressions = baselineRegressions(baseline, report, tolerances)
+	if len(report.Regressions) > 0 {
+		report.Verdict = VerdictFail
+		report.Summary = r.generateSummary(report)
+	}
+
+	r.notifyVerdict(report)
+
+	return report, nil
+}
+
+// baselineRegressions lists regressions of current relative to baseline beyond tolerances
+func baselineRegressions(baseline, current *Report, tolerances BaselineTolerances) []string {
+	var regressions []string
+
+	if drop := baseline.SuccessRate - current.SuccessRate; drop > tolerances.MaxSuccessRateDrop {
+		regressions = append(regressions, fmt.Sprintf(
+			"success rate dropped from %.2f%% to %.2f%% (tolerance %.2f%%)",
+			baseline.SuccessRate*100, current.SuccessRate*100, tolerances.MaxSuccessRateDrop*100))
+	}
+
+	// Baselines recorded before percentiles were reported have no p99
+	if baseline.P99Duration > 0 {
+		growth := float64(current.P99Duration-baseline.P99Duration) / float64(baseline.P99Duration)
+		if growth > tolerances.MaxP99Increase {
+			regressions = append(regressions, fmt.Sprintf(
+				"p99 duration grew from %s to %s (%+.1f%%, tolerance %.1f%%)",
+				baseline.P99Duration, current.P99Duration, growth*100, tolerances.MaxP99Increase*100))
+		}
+	}
+
+	return regressions
+}
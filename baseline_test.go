@@ -0,0 +1,65 @@
+package chaoskit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func baselineTestReporter(failures int, duration time.Duration) *Reporter {
+	reporter := NewReporter()
+	for i := 0; i < 100; i++ {
+		reporter.AddResult(ExecutionResult{
+			ScenarioName: "baseline",
+			Success:      i >= failures,
+			Error:        errorIf(i < failures, fmt.Sprintf("some error %d", i)),
+			Duration:     duration,
+			Timestamp:    time.Now(),
+		})
+	}
+
+	return reporter
+}
+
+func TestReporter_GetVerdictAgainstBaseline(t *testing.T) {
+	baseline, err := baselineTestReporter(0, 10*time.Millisecond).GetVerdict(RelaxedThresholds())
+	require.NoError(t, err)
+	require.Equal(t, VerdictPass, baseline.Verdict)
+
+	tolerances := DefaultBaselineTolerances()
+
+	// Within tolerances
+	report, err := baselineTestReporter(1, 11*time.Millisecond).GetVerdictAgainstBaseline(baseline, tolerances)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictPass, report.Verdict)
+	assert.Empty(t, report.Regressions)
+
+	// Success rate drop still passes the absolute thresholds but fails the gate
+	report, err = baselineTestReporter(5, 10*time.Millisecond).GetVerdictAgainstBaseline(baseline, tolerances)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictFail, report.Verdict)
+	require.Len(t, report.Regressions, 1)
+	assert.Contains(t, report.Regressions[0], "success rate dropped")
+	assert.Contains(t, report.Summary, "success rate dropped")
+
+	// p99 growth
+	report, err = baselineTestReporter(0, 15*time.Millisecond).GetVerdictAgainstBaseline(baseline, tolerances)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictFail, report.Verdict)
+	require.Len(t, report.Regressions, 1)
+	assert.Contains(t, report.Regressions[0], "p99 duration grew")
+	assert.Contains(t, NewReporter().GenerateTextReport(report), "Baseline Regressions:")
+}
+
+func TestReporter_GetVerdictAgainstBaseline_Invalid(t *testing.T) {
+	reporter := baselineTestReporter(0, time.Millisecond)
+
+	_, err := reporter.GetVerdictAgainstBaseline(nil, DefaultBaselineTolerances())
+	assert.Error(t, err)
+
+	_, err = reporter.GetVerdictAgainstBaseline(&Report{}, BaselineTolerances{MaxP99Increase: -1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,159 @@
+package chaoskit
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// BenchStats summarizes the results of one run of a bench comparison
+type BenchStats struct {
+	Iterations  int     `json:"iterations"`
+	Failures    int     `json:"failures"`
+	SuccessRate float64 `json:"success_rate"`
+
+	// Elapsed is the wall time from the start of the first iteration
+	// to the end of the last one
+	Elapsed time.Duration `json:"elapsed"`
+
+	// Throughput is the number of iterations per second of Elapsed
+	Throughput float64 `json:"throughput"`
+
+	AvgDuration time.Duration `json:"avg_duration"`
+	P50Duration time.Duration `json:"p50_duration"`
+	P99Duration time.Duration `json:"p99_duration"`
+
+	// Recoveries is the number of failure streaks followed by a successful iteration
+	Recoveries int `json:"recoveries"`
+
+	// AvgRecovery and MaxRecovery measure the time from the start of the first
+	// failed iteration of a streak to the end of the next successful iteration
+	AvgRecovery time.Duration `json:"avg_recovery"`
+	MaxRecovery time.Duration `json:"max_recovery"`
+
+	// Unrecovered is set when the run ended with failed iterations
+	Unrecovered bool `json:"unrecovered,omitempty"`
+}
+
+// NewBenchStats summarizes results in iteration order
+func NewBenchStats(results []ExecutionResult) BenchStats {
+	var stats BenchStats
+	if len(results) == 0 {
+		return stats
+	}
+
+	durations := make([]time.Duration, 0, len(results))
+	var total, totalRecovery time.Duration
+	var streakStart time.Time
+	start, end := results[0].Timestamp, results[0].Timestamp
+	for _, result := range results {
+		durations = append(durations, result.Duration)
+		total += result.Duration
+		if result.Timestamp.Before(start) {
+			start = result.Timestamp
+		}
+		if finished := result.Timestamp.Add(result.Duration); finished.After(end) {
+			end = finished
+		}
+
+		if !result.Success {
+			stats.Failures++
+			if streakStart.IsZero() {
+				streakStart = result.Timestamp
+			}
+			continue
+		}
+		if !streakStart.IsZero() {
+			recovery := result.Timestamp.Add(result.Duration).Sub(streakStart)
+			stats.Recoveries++
+			totalRecovery += recovery
+			stats.MaxRecovery = max(stats.MaxRecovery, recovery)
+			streakStart = time.Time{}
+		}
+	}
+	slices.Sort(durations)
+
+	stats.Iterations = len(results)
+	stats.SuccessRate = float64(len(results)-stats.Failures) / float64(len(results))
+	stats.Elapsed = end.Sub(start)
+	if stats.Elapsed > 0 {
+		stats.Throughput = float64(len(results)) / stats.Elapsed.Seconds()
+	}
+	stats.AvgDuration = total / time.Duration(len(results))
+	stats.P50Duration = percentile(durations, 50)
+	stats.P99Duration = percentile(durations, 99)
+	if stats.Recoveries > 0 {
+		stats.AvgRecovery = totalRecovery / time.Duration(stats.Recoveries)
+	}
+	stats.Unrecovered = !streakStart.IsZero()
+
+	return stats
+}
+
+// BenchComparison compares a run of a scenario without injectors (Baseline)
+// to a run with them (Chaos), showing how much chaos degrades the target
+type BenchComparison struct {
+	Scenario string     `json:"scenario"`
+	Baseline BenchStats `json:"baseline"`
+	Chaos    BenchStats `json:"chaos"`
+}
+
+// LatencyOverhead is the relative change of the average iteration duration
+// (0.25 = 25% slower under chaos)
+func (c *BenchComparison) LatencyOverhead() float64 {
+	return relativeChange(float64(c.Baseline.AvgDuration), float64(c.Chaos.AvgDuration))
+}
+
+// ThroughputChange is the relative change of throughput
+// (-0.2 = 20% fewer iterations per second under chaos)
+func (c *BenchComparison) ThroughputChange() float64 {
+	return relativeChange(c.Baseline.Throughput, c.Chaos.Throughput)
+}
+
+// String renders the comparison as a side-by-side table
+func (c *BenchComparison) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Bench: %s\n", c.Scenario)
+	fmt.Fprintf(&b, "%-16s %14s %14s %10s\n", "", "baseline", "chaos", "delta")
+
+	row := func(name, baseline, chaos, delta string) {
+		fmt.Fprintf(&b, "%-16s %14s %14s %10s\n", name, baseline, chaos, delta)
+	}
+	durationRow := func(name string, baseline, chaos time.Duration) {
+		row(name, baseline.Round(time.Microsecond).String(), chaos.Round(time.Microsecond).String(),
+			formatChange(relativeChange(float64(baseline), float64(chaos))))
+	}
+
+	row("iterations", fmt.Sprint(c.Baseline.Iterations), fmt.Sprint(c.Chaos.Iterations), "")
+	row("success rate", fmt.Sprintf("%.2f%%", c.Baseline.SuccessRate*100), fmt.Sprintf("%.2f%%", c.Chaos.SuccessRate*100),
+		fmt.Sprintf("%+.2fpp", (c.Chaos.SuccessRate-c.Baseline.SuccessRate)*100))
+	row("throughput", fmt.Sprintf("%.1f/s", c.Baseline.Throughput), fmt.Sprintf("%.1f/s", c.Chaos.Throughput),
+		formatChange(c.ThroughputChange()))
+	durationRow("avg latency", c.Baseline.AvgDuration, c.Chaos.AvgDuration)
+	durationRow("p50 latency", c.Baseline.P50Duration, c.Chaos.P50Duration)
+	durationRow("p99 latency", c.Baseline.P99Duration, c.Chaos.P99Duration)
+	row("recoveries", fmt.Sprint(c.Baseline.Recoveries), fmt.Sprint(c.Chaos.Recoveries), "")
+	row("avg recovery", c.Baseline.AvgRecovery.Round(time.Microsecond).String(),
+		c.Chaos.AvgRecovery.Round(time.Microsecond).String(), "")
+	row("max recovery", c.Baseline.MaxRecovery.Round(time.Microsecond).String(),
+		c.Chaos.MaxRecovery.Round(time.Microsecond).String(), "")
+	if c.Chaos.Unrecovered {
+		b.WriteString("The chaos run ended with failed iterations (not recovered)\n")
+	}
+
+	return b.String()
+}
+
+// relativeChange returns (current-base)/base, or 0 without a base
+func relativeChange(base, current float64) float64 {
+	if base == 0 {
+		return 0
+	}
+
+	return (current - base) / base
+}
+
+func formatChange(change float64) string {
+	return fmt.Sprintf("%+.1f%%", change*100)
+}
@@ -0,0 +1,59 @@
+package chaoskit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func benchResults(start time.Time, step time.Duration, success ...bool) []ExecutionResult {
+	results := make([]ExecutionResult, len(success))
+	for i, ok := range success {
+		results[i] = ExecutionResult{
+			Iteration: i + 1,
+			Success:   ok,
+			Timestamp: start.Add(time.Duration(i) * step),
+			Duration:  step,
+		}
+	}
+
+	return results
+}
+
+func TestNewBenchStats(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	stats := NewBenchStats(benchResults(start, 100*time.Millisecond, true, false, false, true, false, true, false))
+
+	assert.Equal(t, 7, stats.Iterations)
+	assert.Equal(t, 4, stats.Failures)
+	assert.Equal(t, 700*time.Millisecond, stats.Elapsed)
+	assert.InDelta(t, 10.0, stats.Throughput, 0.001)
+	assert.Equal(t, 100*time.Millisecond, stats.AvgDuration)
+
+	// Streaks recover after 300ms (iterations 2-4) and 200ms (5-6); iteration 7 never recovers
+	assert.Equal(t, 2, stats.Recoveries)
+	assert.Equal(t, 250*time.Millisecond, stats.AvgRecovery)
+	assert.Equal(t, 300*time.Millisecond, stats.MaxRecovery)
+	assert.True(t, stats.Unrecovered)
+
+	assert.Equal(t, BenchStats{}, NewBenchStats(nil))
+}
+
+func TestBenchComparison(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	comparison := &BenchComparison{
+		Scenario: "bench",
+		Baseline: NewBenchStats(benchResults(start, 100*time.Millisecond, true, true, true, true)),
+		Chaos:    NewBenchStats(benchResults(start, 125*time.Millisecond, true, false, true, true)),
+	}
+
+	assert.InDelta(t, 0.25, comparison.LatencyOverhead(), 0.001)
+	assert.InDelta(t, -0.2, comparison.ThroughputChange(), 0.001)
+
+	text := comparison.String()
+	assert.Contains(t, text, "Bench: bench")
+	assert.Contains(t, text, "-25.00pp")
+	assert.Contains(t, text, "+25.0%")
+	assert.NotContains(t, text, "not recovered")
+}
@@ -0,0 +1,86 @@
+package chaoskit
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// CancelOptions are the options of a MaybeCancelContext call. Cancellation
+// injectors implementing ChaosCancellationOptionsProvider honour them.
+type CancelOptions struct {
+	// Probability overrides the injector probability when not negative
+	Probability float64
+
+	// After is how long after its creation the context is cancelled (0 = injector default)
+	After time.Duration
+
+	// Deadline makes the context expire with context.DeadlineExceeded
+	// instead of being cancelled with context.Canceled
+	Deadline bool
+
+	// Site names the call site in injection events and logs: the name given
+	// with CancelSite, the chaos point of the context or the caller file:line
+	Site string
+}
+
+// CancelOption configures a MaybeCancelContext call
+type CancelOption func(*CancelOptions)
+
+// CancelProbability overrides the injector probability at the call site
+func CancelProbability(p float64) CancelOption {
+	return func(o *CancelOptions) {
+		o.Probability = p
+	}
+}
+
+// CancelAfter cancels the context d after its creation
+func CancelAfter(d time.Duration) CancelOption {
+	return func(o *CancelOptions) {
+		o.After = d
+	}
+}
+
+// CancelAsDeadline makes the context expire with context.DeadlineExceeded,
+// as if a deadline was missed, instead of being cancelled
+func CancelAsDeadline() CancelOption {
+	return func(o *CancelOptions) {
+		o.Deadline = true
+	}
+}
+
+// CancelSite names the call site in injection events and logs
+func CancelSite(name string) CancelOption {
+	return func(o *CancelOptions) {
+		o.Site = name
+	}
+}
+
+// ChaosCancellationOptionsProvider is implemented by cancellation injectors
+// honouring the options of MaybeCancelContext calls
+type ChaosCancellationOptionsProvider interface {
+	ChaosContextCancellationProvider
+	GetChaosContextWithOptions(parent context.Context, opts CancelOptions) (context.Context, context.CancelFunc)
+}
+
+// newCancelOptions applies the options of a MaybeCancelContext call
+func newCancelOptions(ctx context.Context, opts []CancelOption) CancelOptions {
+	options := CancelOptions{Probability: -1}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.Site == "" {
+		options.Site = ChaosPointFromContext(ctx)
+	}
+	if options.Site == "" {
+		// Skip newCancelOptions and MaybeCancelContext
+		if _, file, line, ok := runtime.Caller(2); ok {
+			options.Site = fmt.Sprintf("%s:%d", filepath.Base(file), line)
+		}
+	}
+
+	return options
+}
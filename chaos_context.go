@@ -2,8 +2,11 @@ package chaoskit
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"sync"
+	"time"
 )
 
 // randKey is a private type for context key
@@ -15,12 +18,19 @@ type chaosKey struct{}
 // ChaosContext provides chaos injection capabilities to user code
 type ChaosContext struct {
 	mu               sync.RWMutex
-	delayFunc        func() bool
-	errorFunc        func() error
-	panicFunc        func() bool
-	networkFunc      func(host string, port int) bool
-	cancellationFunc func(context.Context) (context.Context, context.CancelFunc)
+	delayFunc        func(ctx context.Context) bool
+	errorFunc        func(ctx context.Context) error
+	panicFunc        func(ctx context.Context) *ChaosError
+	networkFunc      func(ctx context.Context, host string, port int) *ChaosError
+	messagingFunc    func(ctx context.Context, op MessagingOp, subject string) (MessagingFault, *ChaosError)
+	corruptFunc      func(ctx context.Context) bool
+	contextValueFunc func(ctx context.Context, key, value any) (any, bool)
+	cancellationFunc func(context.Context, CancelOptions) (context.Context, context.CancelFunc)
 	providers        map[string]ChaosProvider
+	points           map[string]*ChaosPointConfig
+	scopes           map[string]*ChaosContext
+	injectors        []Injector
+	limiter          *injectionLimiter
 }
 
 // AttachChaos attaches chaos capabilities to context
@@ -28,10 +38,19 @@ func AttachChaos(ctx context.Context, chaos *ChaosContext) context.Context {
 	return context.WithValue(ctx, chaosKey{}, chaos)
 }
 
-// GetChaos retrieves chaos context
+// GetChaos retrieves chaos context. Within WithChaosDisabled it is nil, within
+// WithChaosScope it only holds the injectors of the scope.
 func GetChaos(ctx context.Context) *ChaosContext {
 	if v := ctx.Value(chaosKey{}); v != nil {
 		if chaos, ok := v.(*ChaosContext); ok {
+			region, _ := ctx.Value(chaosRegionKey{}).(chaosRegion)
+			switch {
+			case region.disabled:
+				return nil
+			case region.scope != "":
+				return chaos.scopes[region.scope]
+			}
+
 			return chaos
 		}
 	}
@@ -39,6 +58,37 @@ func GetChaos(ctx context.Context) *ChaosContext {
 	return nil
 }
 
+// chaosRegionKey is a private type for context key
+type chaosRegionKey struct{}
+
+// chaosRegion restricts chaos within a block of user code
+type chaosRegion struct {
+	disabled bool
+	scope    string
+}
+
+// WithChaosDisabled returns a context under which no chaos fires, for critical
+// sections such as a final commit. Maybe* helpers and ShouldFail called with it
+// (or contexts derived from it) do nothing.
+func WithChaosDisabled(ctx context.Context) context.Context {
+	region, _ := ctx.Value(chaosRegionKey{}).(chaosRegion)
+	region.disabled = true
+
+	return context.WithValue(ctx, chaosRegionKey{}, region)
+}
+
+// WithChaosScope returns a context under which only the injectors of the named
+// scenario scope (see ScenarioBuilder.Scope) apply. No injector applies for a
+// scope the scenario does not define. Chaos stays off within WithChaosDisabled.
+func WithChaosScope(ctx context.Context, scope string) context.Context {
+	region, _ := ctx.Value(chaosRegionKey{}).(chaosRegion)
+	region.scope = scope
+
+	return context.WithValue(ctx, chaosRegionKey{}, region)
+}
+
+// MaybeError returns an injected error (a *ChaosError) based on the configured injector
+// User code should return it as if the operation at this point failed
 func MaybeError(ctx context.Context) error {
 	chaos := GetChaos(ctx)
 	if chaos == nil {
@@ -50,13 +100,13 @@ func MaybeError(ctx context.Context) error {
 	chaos.mu.RUnlock()
 
 	if errorFunc != nil {
-		return errorFunc()
+		return errorFunc(ctx)
 	}
 
 	return nil
 }
 
-// MaybePanic triggers a panic based on configured probability
+// MaybePanic triggers a panic based on configured probability; the panic value is a *ChaosError
 // User code should call this at critical points in their logic
 func MaybePanic(ctx context.Context) {
 	chaos := GetChaos(ctx)
@@ -68,11 +118,76 @@ func MaybePanic(ctx context.Context) {
 	panicFunc := chaos.panicFunc
 	chaos.mu.RUnlock()
 
-	if panicFunc != nil && panicFunc() {
-		panic("chaos: injected panic")
+	if panicFunc == nil {
+		return
+	}
+	if err := panicFunc(ctx); err != nil {
+		panic(err)
 	}
 }
 
+// MaybeCorrupt returns corrupt(value) when the configured corruption injector
+// decides to corrupt this call, and value otherwise. Unlike monkey patching it
+// works at any call site and needs no build flags:
+//
+//	balance = chaoskit.MaybeCorrupt(ctx, balance, func(v int64) int64 { return -v })
+func MaybeCorrupt[T any](ctx context.Context, value T, corrupt func(T) T) T {
+	chaos := GetChaos(ctx)
+	if chaos == nil {
+		return value
+	}
+
+	chaos.mu.RLock()
+	corruptFunc := chaos.corruptFunc
+	chaos.mu.RUnlock()
+
+	if corruptFunc == nil || !corruptFunc(ctx) {
+		return value
+	}
+
+	return corrupt(value)
+}
+
+// MaybeCorruptContextValue returns ctx with the value of key replaced (by a
+// zero or garbage value) when the configured context value injector decides
+// to corrupt it, and ctx otherwise. It flushes out hidden assumptions about
+// context contents such as tenant IDs, auth principals or trace IDs:
+//
+//	ctx = chaoskit.MaybeCorruptContextValue(ctx, tenantKey{})
+//	tenant := ctx.Value(tenantKey{}).(string)
+//
+// Contexts without a value for key are returned as is.
+func MaybeCorruptContextValue(ctx context.Context, key any) context.Context {
+	chaos := GetChaos(ctx)
+	if chaos == nil {
+		return ctx
+	}
+
+	chaos.mu.RLock()
+	contextValueFunc := chaos.contextValueFunc
+	chaos.mu.RUnlock()
+
+	value := ctx.Value(key)
+	if contextValueFunc == nil || value == nil {
+		return ctx
+	}
+	corrupted, ok := contextValueFunc(ctx, key, value)
+	if !ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, key, corrupted)
+}
+
+// contextKeyName names a context key in logs and events
+func contextKeyName(key any) string {
+	if name, ok := key.(string); ok {
+		return name
+	}
+
+	return fmt.Sprintf("%T", key)
+}
+
 // MaybeDelay applies a delay based on configured injector
 // User code can call this at critical points
 func MaybeDelay(ctx context.Context) {
@@ -86,31 +201,72 @@ func MaybeDelay(ctx context.Context) {
 	chaos.mu.RUnlock()
 
 	if delayFunc != nil {
-		delayFunc()
+		delayFunc(ctx)
+	}
+}
+
+// delayBoundsKey is a private type for context key
+type delayBoundsKey struct{}
+
+// delayBounds are the bounds of a MaybeDelayWithin call
+type delayBounds struct {
+	min, max time.Duration
+}
+
+// MaybeDelayWithin is MaybeDelay with the injected delay clamped to [min, max],
+// so call sites can cap the latency imposed at sensitive points
+// (e.g. never more than 50ms while holding a lock). A max below min is raised to min.
+func MaybeDelayWithin(ctx context.Context, min, max time.Duration) {
+	if GetChaos(ctx) == nil {
+		return
+	}
+
+	MaybeDelay(context.WithValue(ctx, delayBoundsKey{}, delayBounds{min: min, max: max}))
+}
+
+// boundDelay clamps an injected delay to the bounds of a MaybeDelayWithin call, if any
+func boundDelay(ctx context.Context, delay time.Duration) time.Duration {
+	bounds, ok := ctx.Value(delayBoundsKey{}).(delayBounds)
+	if !ok {
+		return delay
 	}
+
+	return min(max(delay, bounds.min), max(bounds.min, bounds.max))
 }
 
 // MaybeNetworkChaos applies network chaos (latency, drops) based on configured injector
 // User code should call this before network operations
 func MaybeNetworkChaos(ctx context.Context, host string, port int) {
+	applyNetworkChaos(ctx, host, port)
+}
+
+// applyNetworkChaos applies network chaos and returns the fault of a dropped
+// connection, if any
+func applyNetworkChaos(ctx context.Context, host string, port int) *ChaosError {
 	chaos := GetChaos(ctx)
 	if chaos == nil {
-		return
+		return nil
 	}
 
 	chaos.mu.RLock()
 	networkFunc := chaos.networkFunc
 	chaos.mu.RUnlock()
 
-	if networkFunc != nil && networkFunc(host, port) {
-		// Network chaos was applied (latency injected, connection dropped, etc.)
-		return
+	if networkFunc == nil {
+		return nil
 	}
+
+	return networkFunc(ctx, host, port)
 }
 
 // MaybeCancelContext creates a child context with possible cancellation
-// User code should use this to wrap contexts that should be subject to cancellation chaos
-func MaybeCancelContext(ctx context.Context) (context.Context, context.CancelFunc) {
+// User code should use this to wrap contexts that should be subject to cancellation chaos.
+// Options tune the cancellation at the call site:
+//
+//	ctx, cancel := chaoskit.MaybeCancelContext(ctx,
+//		chaoskit.CancelProbability(0.3), chaoskit.CancelAfter(20*time.Millisecond), chaoskit.CancelAsDeadline())
+//	defer cancel()
+func MaybeCancelContext(ctx context.Context, opts ...CancelOption) (context.Context, context.CancelFunc) {
 	chaos := GetChaos(ctx)
 	if chaos == nil {
 		// No chaos context, just return parent context with no-op cancel
@@ -122,7 +278,7 @@ func MaybeCancelContext(ctx context.Context) (context.Context, context.CancelFun
 	chaos.mu.RUnlock()
 
 	if cancellationFunc != nil {
-		return cancellationFunc(ctx)
+		return cancellationFunc(ctx, newCancelOptions(ctx, opts))
 	}
 
 	// No cancellation provider, return parent context
@@ -168,7 +324,15 @@ func (c *ChaosContext) GetProvider(name string) (ChaosProvider, bool) {
 	return provider, ok
 }
 
-// AttachRand attaches a deterministic random number generator to context
+// Injectors returns the injectors applying to the chaos context, for
+// wrappers of clients finding their injector types among them
+func (c *ChaosContext) Injectors() []Injector {
+	return c.injectors
+}
+
+// AttachRand attaches a deterministic random number generator to context.
+// The generator is shared by every goroutine of the run, so it should be
+// safe for concurrent use (see NewRand).
 func AttachRand(ctx context.Context, rng *rand.Rand) context.Context {
 	return context.WithValue(ctx, randKey{}, rng)
 }
@@ -176,12 +340,103 @@ func AttachRand(ctx context.Context, rng *rand.Rand) context.Context {
 // GetRand retrieves the random number generator from context, or creates a new one if not found
 // If seed was set in scenario, the generator will be deterministic
 func GetRand(ctx context.Context) *rand.Rand {
-	if v := ctx.Value(randKey{}); v != nil {
-		if rng, ok := v.(*rand.Rand); ok {
-			return rng
-		}
+	switch v := ctx.Value(randKey{}).(type) {
+	case *rand.Rand:
+		return v
+	case *seededRand:
+		return v.rng
 	}
 
-	// Fallback to global random if no generator in context
+	// Fresh generator if no generator in context (not shared, so no locking needed)
 	return rand.New(rand.NewSource(rand.Int63()))
 }
+
+// HasRand reports whether a random number generator is attached to ctx
+func HasRand(ctx context.Context) bool {
+	return ctx.Value(randKey{}) != nil
+}
+
+// seededRand is a generator attached with the seed it was created with
+type seededRand struct {
+	rng  *rand.Rand
+	seed int64
+}
+
+// attachSeededRand attaches a NewRand(seed) generator that ForkRand derives streams from
+func attachSeededRand(ctx context.Context, seed int64) context.Context {
+	return context.WithValue(ctx, randKey{}, &seededRand{rng: NewRand(seed), seed: seed})
+}
+
+// ForkRand attaches a generator of its own for key, e.g. per worker goroutine
+// or parallel step, seeded from the generator of ctx and key. Goroutines
+// sharing the generator of a run draw values in whatever order they are
+// scheduled; a goroutine drawing from its own fork gets the same values for
+// a seed however it interleaves with the others. Keys must be unique among
+// the goroutines sharing ctx and should not depend on scheduling (use a
+// worker index, not the arrival order).
+//
+//	for i := 0; i < workers; i++ {
+//		go worker(chaoskit.ForkRand(ctx, fmt.Sprintf("worker-%d", i)))
+//	}
+func ForkRand(ctx context.Context, key string) context.Context {
+	var seed int64
+	if parent, ok := ctx.Value(randKey{}).(*seededRand); ok {
+		seed = parent.seed
+	} else {
+		// Deterministic as long as the forks are made in a deterministic order
+		seed = GetRand(ctx).Int63()
+	}
+
+	return attachSeededRand(ctx, forkSeed(seed, key))
+}
+
+// forkSeed derives the seed of a fork from its parent seed and key
+func forkSeed(seed int64, key string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+
+	// splitmix64 finalizer, so that nearby seeds and keys give unrelated streams
+	x := uint64(seed) ^ h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return int64(x)
+}
+
+// NewRand returns a seeded random number generator safe for concurrent use.
+// Values drawn from one goroutine are reproducible for a seed; the
+// interleaving of concurrent goroutines decides who draws which value.
+// Read must not be used concurrently.
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(&lockedSource{src: rand.NewSource(seed).(rand.Source64)})
+}
+
+// lockedSource serializes access to a random source
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Uint64()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.src.Seed(seed)
+}
@@ -0,0 +1,166 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scopedErrorInjector always fails MaybeError with its name
+type scopedErrorInjector struct {
+	testMetricsInjector
+	name string
+}
+
+func (i *scopedErrorInjector) Name() string             { return i.name }
+func (i *scopedErrorInjector) ShouldReturnError() error { return errors.New(i.name) }
+
+func TestChaosRegions(t *testing.T) {
+	results := make(map[string]error)
+	var shouldFail bool
+	scenario := NewScenario("regions").
+		WithTarget(&testTarget{}).
+		Scope("db", func(s *ScopeBuilder) {
+			s.Inject("db-errors", &scopedErrorInjector{name: "db"})
+		}).
+		Scope("api", func(s *ScopeBuilder) {
+			s.Inject("api-errors", &scopedErrorInjector{name: "api"})
+		}).
+		Step("step", func(ctx context.Context, target Target) error {
+			results["all"] = MaybeError(ctx)
+			results["db"] = MaybeError(WithChaosScope(ctx, "db"))
+			results["api"] = MaybeError(WithChaosScope(ctx, "api"))
+			results["unknown"] = MaybeError(WithChaosScope(ctx, "cache"))
+
+			disabled := WithChaosDisabled(ctx)
+			results["disabled"] = MaybeError(disabled)
+			results["disabled-db"] = MaybeError(WithChaosScope(disabled, "db"))
+			shouldFail = ShouldFail(disabled, 1)
+
+			return nil
+		}).
+		Build()
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+
+	assert.EqualError(t, results["all"], "api", "the last provider wins without a scope")
+	assert.EqualError(t, results["db"], "db")
+	assert.EqualError(t, results["api"], "api")
+	assert.NoError(t, results["unknown"])
+	assert.NoError(t, results["disabled"])
+	assert.NoError(t, results["disabled-db"])
+	assert.False(t, shouldFail)
+}
+
+func TestChaosInfo(t *testing.T) {
+	assert.Equal(t, ChaosState{}, ChaosInfo(context.Background()))
+
+	var before, after, scoped, disabled ChaosState
+	scenario := NewScenario("info").
+		WithTarget(&testTarget{}).
+		Inject("panics", &alwaysPanicInjector{}).
+		Scope("db", func(s *ScopeBuilder) {
+			s.Inject("db-errors", &scopedErrorInjector{name: "db"})
+		}).
+		Step("step", func(ctx context.Context, target Target) error {
+			before = ChaosInfo(ctx)
+			_ = MaybeError(ctx)
+			after = ChaosInfo(ctx)
+			scoped = ChaosInfo(WithChaosScope(ctx, "db"))
+			disabled = ChaosInfo(WithChaosDisabled(ctx))
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+
+	assert.Equal(t, "info", before.Scenario)
+	assert.Equal(t, 2, before.Iteration)
+	assert.Equal(t, []string{"always-panic", "db"}, before.InjectorNames())
+	assert.Equal(t, ActiveInjector{Name: "always-panic", Probability: 1}, before.Injectors[0])
+	assert.Equal(t, ActiveInjector{Name: "db", Injections: 1, TotalInjections: 2}, after.Injectors[1])
+
+	assert.Equal(t, "db", scoped.Scope)
+	assert.Equal(t, []string{"db"}, scoped.InjectorNames())
+	assert.True(t, disabled.Disabled)
+	assert.Empty(t, disabled.Injectors)
+}
+
+func TestMaybeDelayWithin(t *testing.T) {
+	executor := NewExecutor()
+	scenario := NewScenario("bounded-delays").
+		WithTarget(&testTarget{}).
+		Inject("delays", &alwaysDelayInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			MaybeDelayWithin(ctx, 2*time.Millisecond, 5*time.Millisecond)
+			MaybeDelayWithin(ctx, 0, 500*time.Nanosecond)
+			MaybeDelayWithin(ctx, 0, time.Second)
+
+			return nil
+		}).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	timeline := executor.Reporter().Timeline()
+	require.Len(t, timeline, 3)
+	assert.Equal(t, 2*time.Millisecond, timeline[0].Delay)
+	assert.Equal(t, "1µs", timeline[0].Attributes["unbounded_delay"])
+	assert.Equal(t, 500*time.Nanosecond, timeline[1].Delay)
+	assert.Equal(t, time.Microsecond, timeline[2].Delay)
+	assert.Nil(t, timeline[2].Attributes)
+
+	// No-op outside of a run
+	MaybeDelayWithin(context.Background(), time.Hour, time.Hour)
+}
+
+func TestForkRand(t *testing.T) {
+	draws := func(ctx context.Context) []int64 {
+		rng := GetRand(ctx)
+		out := make([]int64, 5)
+		for i := range out {
+			out[i] = rng.Int63()
+		}
+
+		return out
+	}
+
+	run := func() map[string][]int64 {
+		ctx := attachSeededRand(context.Background(), 42)
+		results := make(map[string][]int64)
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for _, key := range []string{"worker-0", "worker-1", "worker-2"} {
+			wg.Add(1)
+			go func(ctx context.Context, key string) {
+				defer wg.Done()
+				values := draws(ctx)
+				mu.Lock()
+				results[key] = values
+				mu.Unlock()
+			}(ForkRand(ctx, key), key)
+		}
+		wg.Wait()
+
+		return results
+	}
+
+	// Same values per worker whatever the scheduling
+	first := run()
+	assert.Equal(t, first, run())
+	assert.NotEqual(t, first["worker-0"], first["worker-1"])
+
+	// Forks of a fork are derived from its seed
+	ctx := ForkRand(attachSeededRand(context.Background(), 42), "worker-0")
+	assert.Equal(t, first["worker-0"], draws(ctx))
+	assert.Equal(t, draws(ForkRand(ctx, "sub")), draws(ForkRand(ctx, "sub")))
+
+	// Without a seeded generator forks are seeded from the generator of ctx
+	plain := AttachRand(context.Background(), NewRand(7))
+	other := AttachRand(context.Background(), NewRand(7))
+	assert.Equal(t, draws(ForkRand(plain, "a")), draws(ForkRand(other, "a")))
+}
@@ -0,0 +1,115 @@
+package chaoskit
+
+import "context"
+
+// ChaosState is the chaos in effect for a context (see ChaosInfo)
+type ChaosState struct {
+	// Scenario and Iteration identify the running iteration
+	Scenario  string
+	Iteration int
+
+	// Scope is the scope set with WithChaosScope, if any
+	Scope string
+
+	// Disabled is set within WithChaosDisabled
+	Disabled bool
+
+	// Injectors lists the injectors applying to the context
+	Injectors []ActiveInjector
+}
+
+// ActiveInjector describes an injector applying to a context
+type ActiveInjector struct {
+	Name string
+
+	// Probability is the fault probability per call (0 when the injector has none)
+	Probability float64
+
+	// Injections is the number of faults applied during the iteration
+	Injections int
+
+	// TotalInjections is the number of faults applied during the run
+	TotalInjections int
+}
+
+// ChaosInfo returns the chaos in effect for ctx, so targets can annotate their
+// own logs and telemetry with it. Outside of a scenario run it is empty.
+func ChaosInfo(ctx context.Context) ChaosState {
+	var state ChaosState
+
+	region, _ := ctx.Value(chaosRegionKey{}).(chaosRegion)
+	state.Scope = region.scope
+	state.Disabled = region.disabled
+
+	recorder, _ := ctx.Value(injectionRecorderKey{}).(*injectionRecorder)
+	if recorder != nil {
+		state.Scenario = recorder.scenario
+		state.Iteration = recorder.iteration
+	}
+
+	chaos := GetChaos(ctx)
+	if chaos == nil {
+		return state
+	}
+
+	for _, inj := range chaos.injectors {
+		active := ActiveInjector{Name: inj.Name(), Probability: injectorProbability(inj)}
+		if recorder != nil {
+			active.Injections = recorder.count(active.Name)
+			if recorder.reporter != nil {
+				active.TotalInjections = recorder.reporter.injectionCount(active.Name)
+			}
+		}
+		state.Injectors = append(state.Injectors, active)
+	}
+
+	return state
+}
+
+// InjectorNames returns the names of the injectors applying to the context
+func (s ChaosState) InjectorNames() []string {
+	names := make([]string, 0, len(s.Injectors))
+	for _, inj := range s.Injectors {
+		names = append(names, inj.Name)
+	}
+
+	return names
+}
+
+// injectorProbability returns the fault probability of an injector, if it has one
+func injectorProbability(inj Injector) float64 {
+	switch provider := inj.(type) {
+	case ChaosPanicProvider:
+		return provider.GetPanicProbability()
+	case ChaosCorruptionProvider:
+		return provider.GetCorruptionProbability()
+	case ChaosContextCancellationProvider:
+		return provider.GetCancellationProbability()
+	case MetricsProvider:
+		if probability, ok := provider.GetMetrics()["probability"].(float64); ok {
+			return probability
+		}
+	}
+
+	return 0
+}
+
+// count returns the number of faults an injector applied during the iteration
+func (r *injectionRecorder) count(injector string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.counts[injector]
+}
+
+// injectionCount returns the number of faults an injector applied during the run
+func (r *Reporter) injectionCount(injector string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stats, ok := r.injectorStats[injector]; ok {
+		return stats.Injections
+	}
+
+	return 0
+}
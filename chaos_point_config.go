@@ -0,0 +1,167 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// ChaosPointInjector is the injector name of faults configured per chaos point
+const ChaosPointInjector = "chaos-point"
+
+// ChaosPointConfig sets the faults of a named chaos point in the scenario,
+// so call sites don't hardcode probabilities:
+//
+//	scenario := chaoskit.NewScenario("checkout").
+//		WithChaosPoints(
+//			chaoskit.ChaosPoint("inventory.reserve").FailWith(ErrTimeout, 0.2).Delay(50*time.Millisecond, 0.3),
+//		).
+//		Build()
+//
+// MaybeErrorAt, ShouldFailAt, MaybePanicAt and MaybeDelayAt use the point
+// configuration; fault types not configured for a point fall back to the
+// scenario injectors (or, for ShouldFailAt, to the probability of the call).
+type ChaosPointConfig struct {
+	name             string
+	err              error
+	errorProbability float64
+	panicProbability float64
+	delay            time.Duration
+	delayProbability float64
+	limit            InjectionLimit
+}
+
+// ChaosPoint starts the configuration of a named chaos point
+func ChaosPoint(name string) *ChaosPointConfig {
+	return &ChaosPointConfig{name: name}
+}
+
+// FailWith makes the point fail with err with probability p
+func (c *ChaosPointConfig) FailWith(err error, p float64) *ChaosPointConfig {
+	c.err = err
+	c.errorProbability = p
+
+	return c
+}
+
+// Panic makes the point panic with probability p
+func (c *ChaosPointConfig) Panic(p float64) *ChaosPointConfig {
+	c.panicProbability = p
+
+	return c
+}
+
+// Delay makes the point sleep for d with probability p
+func (c *ChaosPointConfig) Delay(d time.Duration, p float64) *ChaosPointConfig {
+	c.delay = d
+	c.delayProbability = p
+
+	return c
+}
+
+// Name returns the chaos point name
+func (c *ChaosPointConfig) Name() string {
+	return c.name
+}
+
+// WithChaosPoints configures named chaos points. Configured points are
+// registered (see RegisterChaosPoints), so they are listed in reports even
+// when never hit.
+func (b *ScenarioBuilder) WithChaosPoints(points ...*ChaosPointConfig) *ScenarioBuilder {
+	if b.scenario.points == nil {
+		b.scenario.points = make(map[string]*ChaosPointConfig, len(points))
+	}
+	for _, point := range points {
+		b.scenario.points[point.name] = point
+		RegisterChaosPoints(point.name)
+	}
+
+	return b
+}
+
+// configuredChaosPoint returns the configuration of a point in the running scenario
+func configuredChaosPoint(ctx context.Context, point string) *ChaosPointConfig {
+	chaos := GetChaos(ctx)
+	if chaos == nil {
+		return nil
+	}
+
+	return chaos.points[point]
+}
+
+// roll reports whether a fault with probability p happens within the injection limits
+func (c *ChaosPointConfig) roll(ctx context.Context, p float64) bool {
+	return p > 0 && GetRand(ctx).Float64() < p && allowInjection(ctx, ChaosPointInjector)
+}
+
+// maybeError returns the configured error with its probability
+func (c *ChaosPointConfig) maybeError(ctx context.Context) error {
+	if !c.roll(ctx, c.errorProbability) {
+		return nil
+	}
+
+	err := c.err
+	if err == nil {
+		err = errors.New("chaos point " + c.name + " failed")
+	}
+	injected := NewChaosError(ctx, ChaosPointInjector, InjectionTypeError, err)
+	GetLogger(ctx).Debug("error returned at chaos point",
+		slog.String("point", c.name),
+		slog.String("error", err.Error()),
+		slog.String("fault_id", injected.FaultID))
+	RecordInjection(ctx, InjectionEvent{
+		Injector:   ChaosPointInjector,
+		Type:       InjectionTypeError,
+		FaultID:    injected.FaultID,
+		Attributes: map[string]any{"error": err.Error(), "probability": c.errorProbability},
+	})
+
+	return injected
+}
+
+// maybePanic panics with the configured probability
+func (c *ChaosPointConfig) maybePanic(ctx context.Context) {
+	if !c.roll(ctx, c.panicProbability) {
+		return
+	}
+
+	injected := NewChaosError(ctx, ChaosPointInjector, InjectionTypePanic, errors.New(injectedPanicMessage))
+	GetLogger(ctx).Debug("panic triggered at chaos point",
+		slog.String("point", c.name),
+		slog.Float64("probability", c.panicProbability),
+		slog.String("fault_id", injected.FaultID))
+	RecordInjection(ctx, InjectionEvent{
+		Injector:   ChaosPointInjector,
+		Type:       InjectionTypePanic,
+		FaultID:    injected.FaultID,
+		Attributes: map[string]any{"probability": c.panicProbability},
+	})
+
+	panic(injected)
+}
+
+// maybeDelay sleeps for the configured delay with its probability,
+// returning early when ctx is done
+func (c *ChaosPointConfig) maybeDelay(ctx context.Context) {
+	if c.delay <= 0 || !c.roll(ctx, c.delayProbability) {
+		return
+	}
+
+	GetLogger(ctx).Debug("delay injected at chaos point",
+		slog.String("point", c.name),
+		slog.Duration("delay", c.delay))
+	RecordInjection(ctx, InjectionEvent{
+		Injector:   ChaosPointInjector,
+		Type:       InjectionTypeDelay,
+		Delay:      c.delay,
+		Attributes: map[string]any{"probability": c.delayProbability},
+	})
+
+	timer := time.NewTimer(c.delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
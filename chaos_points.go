@@ -0,0 +1,255 @@
+package chaoskit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// chaosPointKey is a private type for context key
+type chaosPointKey struct{}
+
+// ShouldFailInjector is the injector name of faults decided by ShouldFail
+const ShouldFailInjector = "should-fail"
+
+// chaosPoints holds the centrally registered chaos points
+var chaosPoints = struct {
+	mu    sync.RWMutex
+	names map[string]struct{}
+}{names: make(map[string]struct{})}
+
+// RegisterChaosPoints declares named chaos points, typically from package
+// init functions next to the code calling MaybeErrorAt, ShouldFailAt etc.
+// Registered points are listed in reports even when never hit, which makes
+// chaos coverage measurable. Points hit without registration are reported too.
+func RegisterChaosPoints(names ...string) {
+	chaosPoints.mu.Lock()
+	defer chaosPoints.mu.Unlock()
+
+	for _, name := range names {
+		chaosPoints.names[name] = struct{}{}
+	}
+}
+
+// RegisteredChaosPoints returns the registered chaos points sorted by name
+func RegisteredChaosPoints() []string {
+	chaosPoints.mu.RLock()
+	defer chaosPoints.mu.RUnlock()
+
+	return sortedKeys(chaosPoints.names)
+}
+
+// ChaosPointSummary is the coverage of one named chaos point
+type ChaosPointSummary struct {
+	Name string `json:"name"`
+
+	// Hits is the number of times execution reached the point
+	Hits int `json:"hits"`
+
+	// Triggered is the number of faults injected at the point
+	Triggered int `json:"triggered"`
+
+	// ByType counts injected faults per type (see InjectionType* constants)
+	ByType map[string]int `json:"by_type,omitempty"`
+}
+
+// MaybeErrorAt is MaybeError at a named chaos point, using the point
+// configuration of the scenario when it sets an error (see ChaosPoint)
+func MaybeErrorAt(ctx context.Context, point string) error {
+	ctx = enterChaosPoint(ctx, point)
+	if config := configuredChaosPoint(ctx, point); config != nil && config.errorProbability > 0 {
+		return config.maybeError(ctx)
+	}
+
+	return MaybeError(ctx)
+}
+
+// MaybePanicAt is MaybePanic at a named chaos point, using the point
+// configuration of the scenario when it sets a panic probability
+func MaybePanicAt(ctx context.Context, point string) {
+	ctx = enterChaosPoint(ctx, point)
+	if config := configuredChaosPoint(ctx, point); config != nil && config.panicProbability > 0 {
+		config.maybePanic(ctx)
+
+		return
+	}
+
+	MaybePanic(ctx)
+}
+
+// MaybeDelayAt is MaybeDelay at a named chaos point, using the point
+// configuration of the scenario when it sets a delay
+func MaybeDelayAt(ctx context.Context, point string) {
+	ctx = enterChaosPoint(ctx, point)
+	if config := configuredChaosPoint(ctx, point); config != nil && config.delay > 0 {
+		config.maybeDelay(ctx)
+
+		return
+	}
+
+	MaybeDelay(ctx)
+}
+
+// MaybeCorruptAt is MaybeCorrupt at a named chaos point
+func MaybeCorruptAt[T any](ctx context.Context, point string, value T, corrupt func(T) T) T {
+	return MaybeCorrupt(enterChaosPoint(ctx, point), value, corrupt)
+}
+
+// MaybeNetworkChaosAt is MaybeNetworkChaos at a named chaos point
+func MaybeNetworkChaosAt(ctx context.Context, point, host string, port int) {
+	MaybeNetworkChaos(enterChaosPoint(ctx, point), host, port)
+}
+
+// ShouldFail reports whether user code should simulate a failure, with
+// probability p. It is always false outside of a scenario run, so it can
+// guard fault paths in regular code:
+//
+//	if chaoskit.ShouldFail(ctx, 0.1) {
+//		return ErrTimeout
+//	}
+func ShouldFail(ctx context.Context, p float64) bool {
+	if GetChaos(ctx) == nil || p <= 0 {
+		return false
+	}
+	if GetRand(ctx).Float64() >= p || !allowInjection(ctx, ShouldFailInjector) {
+		return false
+	}
+
+	RecordInjection(ctx, InjectionEvent{
+		Injector:   ShouldFailInjector,
+		Type:       InjectionTypeFailure,
+		Attributes: map[string]any{"probability": p},
+	})
+
+	return true
+}
+
+// ShouldFailAt is ShouldFail at a named chaos point. The error probability
+// configured for the point in the scenario, if any, overrides p.
+func ShouldFailAt(ctx context.Context, point string, p float64) bool {
+	ctx = enterChaosPoint(ctx, point)
+	if config := configuredChaosPoint(ctx, point); config != nil && config.errorProbability > 0 {
+		p = config.errorProbability
+	}
+
+	return ShouldFail(ctx, p)
+}
+
+// ChaosPointFromContext returns the chaos point a helper was called at, if any
+func ChaosPointFromContext(ctx context.Context) string {
+	point, _ := ctx.Value(chaosPointKey{}).(string)
+
+	return point
+}
+
+// enterChaosPoint counts a hit of the point and tags the injection events
+// recorded with the returned context
+func enterChaosPoint(ctx context.Context, point string) context.Context {
+	if v := ctx.Value(injectionRecorderKey{}); v != nil {
+		if r, ok := v.(*injectionRecorder); ok && r.reporter != nil {
+			r.reporter.addChaosPointHit(point)
+		}
+	}
+
+	return context.WithValue(ctx, chaosPointKey{}, point)
+}
+
+// addChaosPointHit counts a hit of a chaos point
+func (r *Reporter) addChaosPointHit(point string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.chaosPoint(point).Hits++
+}
+
+// chaosPoint returns the stats of a point, creating them (caller must hold r.mu)
+func (r *Reporter) chaosPoint(point string) *ChaosPointSummary {
+	if r.pointStats == nil {
+		r.pointStats = make(map[string]*ChaosPointSummary)
+	}
+	stats, ok := r.pointStats[point]
+	if !ok {
+		stats = &ChaosPointSummary{Name: point, ByType: make(map[string]int)}
+		r.pointStats[point] = stats
+	}
+
+	return stats
+}
+
+// chaosPointSummaries returns hit and registered points sorted by name,
+// or nil without chaos points (caller must hold r.mu)
+func (r *Reporter) chaosPointSummaries() []ChaosPointSummary {
+	names := make(map[string]struct{}, len(r.pointStats))
+	for name := range r.pointStats {
+		names[name] = struct{}{}
+	}
+	for _, name := range RegisteredChaosPoints() {
+		names[name] = struct{}{}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	summaries := make([]ChaosPointSummary, 0, len(names))
+	for _, name := range sortedKeys(names) {
+		summary := ChaosPointSummary{Name: name}
+		if stats, ok := r.pointStats[name]; ok {
+			summary.Hits = stats.Hits
+			summary.Triggered = stats.Triggered
+			if len(stats.ByType) > 0 {
+				summary.ByType = make(map[string]int, len(stats.ByType))
+				for t, count := range stats.ByType {
+					summary.ByType[t] = count
+				}
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries
+}
+
+// ChaosPointCoverage returns how many chaos points of the report were hit
+// and triggered at least once, out of all listed points
+func (r *Report) ChaosPointCoverage() (hit, triggered, total int) {
+	for _, point := range r.ChaosPoints {
+		if point.Hits > 0 {
+			hit++
+		}
+		if point.Triggered > 0 {
+			triggered++
+		}
+	}
+
+	return hit, triggered, len(r.ChaosPoints)
+}
+
+// formatChaosPoint returns a one-line description of a chaos point
+func formatChaosPoint(point ChaosPointSummary) string {
+	if point.Hits == 0 {
+		return point.Name + ": never hit"
+	}
+
+	var buf bytes.Buffer
+	_, _ = fmt.Fprintf(&buf, "%s: %d hits, %d triggered", point.Name, point.Hits, point.Triggered)
+	if len(point.ByType) > 0 {
+		types := make([]string, 0, len(point.ByType))
+		for t := range point.ByType {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+
+		buf.WriteString(" (")
+		for i, t := range types {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			_, _ = fmt.Fprintf(&buf, "%s: %d", t, point.ByType[t])
+		}
+		buf.WriteString(")")
+	}
+
+	return buf.String()
+}
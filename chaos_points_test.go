@@ -0,0 +1,201 @@
+package chaoskit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChaosPoints_Coverage(t *testing.T) {
+	RegisterChaosPoints("test.points.commit", "test.points.reserve", "test.points.unused")
+
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+	scenario := NewScenario("points").
+		WithTarget(&testTarget{}).
+		Inject("errors", &alwaysErrorInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			if ShouldFailAt(ctx, "test.points.reserve", 0) {
+				return errors.New("never")
+			}
+
+			return MaybeErrorAt(ctx, "test.points.commit")
+		}).
+		Repeat(3).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	report, err := executor.Reporter().GetVerdict(RelaxedThresholds())
+	require.NoError(t, err)
+
+	points := make(map[string]ChaosPointSummary)
+	for _, point := range report.ChaosPoints {
+		points[point.Name] = point
+	}
+	assert.Equal(t, ChaosPointSummary{Name: "test.points.commit", Hits: 3, Triggered: 3,
+		ByType: map[string]int{InjectionTypeError: 3}}, points["test.points.commit"])
+	assert.Equal(t, ChaosPointSummary{Name: "test.points.reserve", Hits: 3}, points["test.points.reserve"])
+	assert.Equal(t, ChaosPointSummary{Name: "test.points.unused"}, points["test.points.unused"])
+	assert.Equal(t, "test.points.commit", report.Timeline[0].Point)
+
+	text := executor.Reporter().GenerateTextReport(report)
+	assert.Contains(t, text, "test.points.commit: 3 hits, 3 triggered (error: 3)")
+	assert.Contains(t, text, "test.points.unused: never hit")
+
+	// Hits survive the JSON round trip
+	doc, err := executor.Reporter().GenerateJSON()
+	require.NoError(t, err)
+	decoded, err := DecodeReporter([]byte(doc))
+	require.NoError(t, err)
+	decodedReport, err := decoded.GetVerdict(RelaxedThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, report.ChaosPoints, decodedReport.ChaosPoints)
+}
+
+func TestShouldFail(t *testing.T) {
+	assert.False(t, ShouldFail(context.Background(), 1), "no chaos outside of scenario runs")
+
+	executor := NewExecutor()
+	var failed bool
+	scenario := NewScenario("should-fail").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			failed = ShouldFail(ctx, 1)
+			return nil
+		}).
+		Repeat(1).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+	assert.True(t, failed)
+
+	timeline := executor.Reporter().Timeline()
+	require.Len(t, timeline, 1)
+	assert.Equal(t, ShouldFailInjector, timeline[0].Injector)
+	assert.Equal(t, InjectionTypeFailure, timeline[0].Type)
+
+	data, err := json.Marshal(timeline[0])
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"point"`)
+}
+
+func TestChaosPointConfig(t *testing.T) {
+	errTimeout := errors.New("timeout")
+
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+	var shouldFail, panicked bool
+	scenario := NewScenario("configured-points").
+		WithTarget(&testTarget{}).
+		WithChaosPoints(
+			ChaosPoint("test.config.reserve").FailWith(errTimeout, 1).Delay(time.Millisecond, 1),
+			ChaosPoint("test.config.commit").Panic(1),
+		).
+		Step("step", func(ctx context.Context, target Target) error {
+			// The configured probability overrides the call site
+			shouldFail = ShouldFailAt(ctx, "test.config.reserve", 0)
+			MaybeDelayAt(ctx, "test.config.reserve")
+			func() {
+				defer func() { panicked = recover() != nil }()
+				MaybePanicAt(ctx, "test.config.commit")
+			}()
+
+			return MaybeErrorAt(ctx, "test.config.reserve")
+		}).
+		Repeat(1).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+	assert.True(t, shouldFail)
+	assert.True(t, panicked)
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 1)
+	var injected *ChaosError
+	require.ErrorAs(t, results[0].Error, &injected)
+	assert.ErrorIs(t, results[0].Error, errTimeout)
+	assert.Equal(t, ChaosPointInjector, injected.Injector)
+
+	report, err := executor.Reporter().GetVerdict(RelaxedThresholds())
+	require.NoError(t, err)
+	points := make(map[string]ChaosPointSummary)
+	for _, point := range report.ChaosPoints {
+		points[point.Name] = point
+	}
+	assert.Equal(t, map[string]int{InjectionTypeFailure: 1, InjectionTypeDelay: 1, InjectionTypeError: 1},
+		points["test.config.reserve"].ByType)
+	assert.Equal(t, map[string]int{InjectionTypePanic: 1}, points["test.config.commit"].ByType)
+	assert.Contains(t, RegisteredChaosPoints(), "test.config.commit")
+}
+
+func TestShouldFail_Seeded(t *testing.T) {
+	run := func() []bool {
+		var decisions []bool
+		scenario := NewScenario("seeded").
+			WithTarget(&testTarget{}).
+			Step("step", func(ctx context.Context, target Target) error {
+				for i := 0; i < 10; i++ {
+					decisions = append(decisions, ShouldFail(ctx, 0.5))
+				}
+				return nil
+			}).
+			WithSeed(42).
+			Repeat(5).
+			Build()
+		require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+
+		return decisions
+	}
+
+	first := run()
+	assert.Len(t, first, 50)
+	assert.Contains(t, first, true)
+	assert.Contains(t, first, false)
+	assert.Equal(t, first, run())
+}
+
+func TestShouldFail_Concurrent(t *testing.T) {
+	var failures atomic.Int64
+	executor := NewExecutor()
+	scenario := NewScenario("concurrent").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 100; j++ {
+						if ShouldFail(ctx, 0.5) {
+							failures.Add(1)
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			return nil
+		}).
+		WithSeed(1).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	// The shared seeded generator draws the same values whatever the interleaving
+	assert.Len(t, executor.Reporter().Timeline(), int(failures.Load()))
+	assert.Equal(t, int64(countFailures(NewRand(1), 800, 0.5)), failures.Load())
+}
+
+// countFailures counts draws of rng below p
+func countFailures(rng *rand.Rand, draws int, p float64) int {
+	count := 0
+	for i := 0; i < draws; i++ {
+		if rng.Float64() < p {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,254 @@
+package chaoskit
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// clusterSimilarityThreshold is the minimum token Jaccard similarity
+	// for two normalized error messages to share a cluster
+	clusterSimilarityThreshold = 0.75
+
+	// stackSignatureFrames is the number of user frames identifying a panic site
+	stackSignatureFrames = 3
+
+	// maxClusterExamples limits the raw messages kept per cluster
+	maxClusterExamples = 3
+)
+
+var (
+	uuidPattern     = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	hexPattern      = regexp.MustCompile(`(?i)\b0x[0-9a-f]+\b`)
+	addrPattern     = regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}(:\d+)?\b`)
+	durationPattern = regexp.MustCompile(`\b\d+(\.\d+)?(ns|us|µs|ms|s|m|h)\b`)
+	quotedPattern   = regexp.MustCompile(`"[^"]*"|'[^']*'`)
+	numberPattern   = regexp.MustCompile(`\d+`)
+)
+
+// ErrorCluster groups similar failures
+type ErrorCluster struct {
+	// Pattern is the normalized message representing the cluster
+	Pattern string `json:"pattern"`
+
+	// Count is the number of failures in the cluster
+	Count int `json:"count"`
+
+	// Variants is the number of distinct normalized messages merged into the cluster
+	Variants int `json:"variants"`
+
+	// StackSignature identifies the panic site for panic clusters
+	StackSignature string `json:"stack_signature,omitempty"`
+
+	// Validators lists validators reporting failures of the cluster
+	Validators []string `json:"validators,omitempty"`
+
+	// Examples holds a few raw error messages
+	Examples []string `json:"examples,omitempty"`
+}
+
+// failureGroup is a set of failures sharing a normalized message or panic site
+type failureGroup struct {
+	pattern    string
+	signature  string
+	tokens     map[string]struct{}
+	count      int
+	variants   map[string]struct{}
+	validators map[string]struct{}
+	examples   []string
+}
+
+func (g *failureGroup) add(pattern, validator, message string) {
+	g.count++
+	g.variants[pattern] = struct{}{}
+	g.validators[validator] = struct{}{}
+	if len(g.examples) < maxClusterExamples && !slices.Contains(g.examples, message) {
+		g.examples = append(g.examples, message)
+	}
+}
+
+func (g *failureGroup) merge(other *failureGroup) {
+	g.count += other.count
+	for pattern := range other.variants {
+		g.variants[pattern] = struct{}{}
+	}
+	for validator := range other.validators {
+		g.validators[validator] = struct{}{}
+	}
+	for _, example := range other.examples {
+		if len(g.examples) < maxClusterExamples && !slices.Contains(g.examples, example) {
+			g.examples = append(g.examples, example)
+		}
+	}
+}
+
+func newFailureGroup(pattern, signature string) *failureGroup {
+	return &failureGroup{
+		pattern:    pattern,
+		signature:  signature,
+		tokens:     tokenSet(pattern),
+		variants:   make(map[string]struct{}),
+		validators: make(map[string]struct{}),
+	}
+}
+
+// clusterFailures collapses failed results into clusters of similar errors.
+// Panics are grouped by their stack signature; other errors by normalized
+// message and then merged when their token similarity is high enough.
+func clusterFailures(results []ExecutionResult) []ErrorCluster {
+	stackGroups := make(map[string]*failureGroup)
+	messageGroups := make(map[string]*failureGroup)
+
+	for _, result := range results {
+		if result.Error == nil {
+			continue
+		}
+
+		message := result.Error.Error()
+		pattern := normalizeErrorMessage(message)
+		validator := extractValidatorName(result.Error)
+
+		if signature := stackSignature(result.PanicStack); signature != "" {
+			group, ok := stackGroups[signature]
+			if !ok {
+				group = newFailureGroup(pattern, signature)
+				stackGroups[signature] = group
+			}
+			group.add(pattern, validator, message)
+
+			continue
+		}
+
+		group, ok := messageGroups[pattern]
+		if !ok {
+			group = newFailureGroup(pattern, "")
+			messageGroups[pattern] = group
+		}
+		group.add(pattern, validator, message)
+	}
+
+	// Merge similar message groups, largest first so they become representatives
+	candidates := make([]*failureGroup, 0, len(messageGroups))
+	for _, group := range messageGroups {
+		candidates = append(candidates, group)
+	}
+	sortFailureGroups(candidates)
+
+	clusters := make([]*failureGroup, 0, len(candidates)+len(stackGroups))
+	for _, candidate := range candidates {
+		merged := false
+		for _, cluster := range clusters {
+			if jaccard(cluster.tokens, candidate.tokens) >= clusterSimilarityThreshold {
+				cluster.merge(candidate)
+				merged = true
+
+				break
+			}
+		}
+		if !merged {
+			clusters = append(clusters, candidate)
+		}
+	}
+	for _, group := range stackGroups {
+		clusters = append(clusters, group)
+	}
+	sortFailureGroups(clusters)
+
+	if len(clusters) == 0 {
+		return nil
+	}
+
+	out := make([]ErrorCluster, 0, len(clusters))
+	for _, group := range clusters {
+		out = append(out, ErrorCluster{
+			Pattern:        group.pattern,
+			Count:          group.count,
+			Variants:       len(group.variants),
+			StackSignature: group.signature,
+			Validators:     sortedKeys(group.validators),
+			Examples:       group.examples,
+		})
+	}
+
+	return out
+}
+
+func sortFailureGroups(groups []*failureGroup) {
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].count != groups[j].count {
+			return groups[i].count > groups[j].count
+		}
+
+		return groups[i].pattern < groups[j].pattern
+	})
+}
+
+// normalizeErrorMessage replaces volatile values (ids, addresses, durations,
+// quoted values and numbers) with placeholders
+func normalizeErrorMessage(msg string) string {
+	msg = uuidPattern.ReplaceAllString(msg, "<uuid>")
+	msg = hexPattern.ReplaceAllString(msg, "<hex>")
+	msg = addrPattern.ReplaceAllString(msg, "<addr>")
+	msg = durationPattern.ReplaceAllString(msg, "<duration>")
+	msg = quotedPattern.ReplaceAllString(msg, "<str>")
+
+	return numberPattern.ReplaceAllString(msg, "N")
+}
+
+// stackSignature extracts the innermost user frames from a debug.Stack() dump
+func stackSignature(stack string) string {
+	if stack == "" {
+		return ""
+	}
+
+	frames := make([]string, 0, stackSignatureFrames)
+	for _, line := range strings.Split(stack, "\n") {
+		if line == "" || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "goroutine ") {
+			continue
+		}
+
+		// Strip call arguments
+		if idx := strings.LastIndex(line, "("); idx > 0 {
+			line = line[:idx]
+		}
+		if strings.HasPrefix(line, "runtime.") || strings.HasPrefix(line, "runtime/debug.") ||
+			line == "panic" || strings.Contains(line, ".(*Executor).") {
+			continue
+		}
+
+		frames = append(frames, line)
+		if len(frames) == stackSignatureFrames {
+			break
+		}
+	}
+
+	return strings.Join(frames, " <- ")
+}
+
+func tokenSet(s string) map[string]struct{} {
+	tokens := make(map[string]struct{})
+	for _, token := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ':' || r == ',' || r == ';' || r == '\t' || r == '\n'
+	}) {
+		tokens[token] = struct{}{}
+	}
+
+	return tokens
+}
+
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	intersection := 0
+	for token := range a {
+		if _, ok := b[token]; ok {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
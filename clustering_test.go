@@ -0,0 +1,65 @@
+package chaoskit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterFailures_SimilarMessages(t *testing.T) {
+	var results []ExecutionResult
+	for i := 0; i < 50; i++ {
+		results = append(results, ExecutionResult{
+			Error: fmt.Errorf("step call failed: dial tcp 10.0.0.%d:5432: timeout after %dms", i%5, 100+i),
+		})
+	}
+	for i := 0; i < 20; i++ {
+		results = append(results, ExecutionResult{
+			Error: fmt.Errorf(`step call failed: order "ord-%d" not found`, i),
+		})
+	}
+	// Same message with one extra word is merged into the first cluster
+	results = append(results, ExecutionResult{
+		Error: fmt.Errorf("step call failed: dial tcp 10.0.0.1:5432: i/o timeout after 5ms"),
+	})
+	results = append(results, ExecutionResult{Success: true})
+
+	clusters := clusterFailures(results)
+	require.Len(t, clusters, 2)
+
+	assert.Equal(t, "step call failed: dial tcp <addr>: timeout after <duration>", clusters[0].Pattern)
+	assert.Equal(t, 51, clusters[0].Count)
+	assert.Equal(t, 2, clusters[0].Variants)
+	assert.Len(t, clusters[0].Examples, maxClusterExamples)
+
+	assert.Equal(t, "step call failed: order <str> not found", clusters[1].Pattern)
+	assert.Equal(t, 20, clusters[1].Count)
+}
+
+func TestClusterFailures_PanicStacks(t *testing.T) {
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+
+	iteration := 0
+	scenario := NewScenario("panics").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			// Different messages, same panic site
+			panic(fmt.Sprintf("unexpected state %d at %p", iteration, &iteration))
+		}).
+		Repeat(5).
+		Build()
+
+	_ = executor.Run(context.Background(), scenario)
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	require.Len(t, report.Analysis.Clusters, 1)
+
+	cluster := report.Analysis.Clusters[0]
+	assert.Equal(t, 5, cluster.Count)
+	assert.Contains(t, cluster.StackSignature, "TestClusterFailures_PanicStacks")
+}
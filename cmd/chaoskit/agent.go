@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/rom8726/chaoskit/distributed"
+	"github.com/rom8726/chaoskit/distributed/agentpb"
+)
+
+// agentTokenEnv is the environment variable of the token shared by agents
+// and coordinators
+const agentTokenEnv = "CHAOSKIT_AGENT_TOKEN"
+
+func runAgent(args []string) int {
+	flags := flag.NewFlagSet("agent", flag.ExitOnError)
+	addr := flags.String("addr", "127.0.0.1:9090", "Listen address; agents run any scenario they receive, expose them only to coordinators")
+	grpcAddr := flags.String("grpc-addr", "", "Listen address of the gRPC agent service (disabled when empty)")
+	token := flags.String("token", os.Getenv(agentTokenEnv), "Bearer token required from coordinators (default $"+agentTokenEnv+")")
+	_ = flags.Parse(args)
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	agent := distributed.NewAgent(distributed.WithAgentLogger(logger), distributed.WithAgentToken(*token))
+	if *token == "" && (!isLoopback(*addr) || (*grpcAddr != "" && !isLoopback(*grpcAddr))) {
+		logger.Warn("agent listens beyond localhost without a token: anyone reaching it can run scenarios",
+			slog.String("hint", "set -token or $"+agentTokenEnv))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Shard responses last as long as the shard runs: no write timeout
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           agent.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 2)
+	go func() {
+		logger.Info("agent listening", slog.String("addr", *addr))
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	var grpcServer *grpc.Server
+	if *grpcAddr != "" {
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		grpcServer = grpc.NewServer()
+		agentpb.RegisterAgentServer(grpcServer, agent.GRPCServer())
+		go func() {
+			logger.Info("grpc agent listening", slog.String("addr", *grpcAddr))
+			errCh <- grpcServer.Serve(listener)
+		}()
+	}
+
+	select {
+	case err := <-errCh:
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	case <-ctx.Done():
+	}
+
+	// Closing cancels running shards through their request context; their
+	// coordinators record the interrupted streams as worker failures
+	logger.Info("shutting down")
+	if grpcServer != nil {
+		grpcServer.Stop()
+	}
+	if err := httpServer.Close(); err != nil {
+		logger.Error("http close failed", slog.Any("error", err))
+		return 1
+	}
+
+	return 0
+}
+
+// isLoopback reports whether a listen address only accepts local connections
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+func runBench(args []string) int {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	jsonPath := flags.String("json", "", "Write the comparison (chaoskit.BenchComparison) as JSON to this path")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: chaoskit bench [flags] <scenario.yaml>\n\n"+
+			"Runs the scenario without injectors, then with them, and compares latency,\n"+
+			"throughput and recovery time side by side.\n\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	cfg, err := config.Load(flags.Arg(0))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	baselineCfg := *cfg
+	baselineCfg.Injectors = nil
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	comparison := &chaoskit.BenchComparison{Scenario: cfg.Name}
+	runs := []struct {
+		name  string
+		cfg   *config.Scenario
+		stats *chaoskit.BenchStats
+	}{
+		{name: "baseline (injectors disabled)", cfg: &baselineCfg, stats: &comparison.Baseline},
+		{name: "chaos (injectors enabled)", cfg: cfg, stats: &comparison.Chaos},
+	}
+	for _, run := range runs {
+		_, _ = fmt.Fprintf(os.Stderr, "Running %s...\n", run.name)
+		results, err := benchRun(ctx, run.cfg)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", run.name, err)
+			return 1
+		}
+		*run.stats = chaoskit.NewBenchStats(results)
+	}
+
+	fmt.Print(comparison.String())
+
+	if *jsonPath != "" {
+		data, err := json.MarshalIndent(comparison, "", "  ")
+		if err == nil {
+			err = os.WriteFile(*jsonPath, data, 0644)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// benchRun runs a scenario and returns its results
+func benchRun(ctx context.Context, cfg *config.Scenario) ([]chaoskit.ExecutionResult, error) {
+	scenario, err := cfg.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	executor := chaoskit.NewExecutor(chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure))
+	runErr := executor.Run(ctx, scenario)
+
+	results := executor.Reporter().Results()
+	if len(results) == 0 {
+		if runErr == nil || ctx.Err() != nil {
+			return nil, fmt.Errorf("no iterations executed")
+		}
+
+		return nil, runErr
+	}
+
+	return results, nil
+}
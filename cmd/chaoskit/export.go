@@ -0,0 +1,108 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rom8726/chaoskit/config"
+	"github.com/rom8726/chaoskit/exporters"
+)
+
+// labelList is a repeatable key=value flag of pod labels
+type labelList map[string]string
+
+func (l labelList) String() string {
+	pairs := make([]string, 0, len(l))
+	for key, value := range l {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func (l labelList) Set(value string) error {
+	key, label, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	l[key] = label
+
+	return nil
+}
+
+func runExport(args []string) int {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	format := flags.String("format", "chaos-mesh", "Manifest format: chaos-mesh or litmus")
+	namespace := flags.String("namespace", "default", "Namespace of the experiments and target pods")
+	labels := labelList{}
+	flags.Var(labels, "selector", "Label of the target pods as key=value (repeatable)")
+	mode := flags.String("mode", "all", "Pods affected: one, all, fixed, fixed-percent or random-max-percent (chaos-mesh)")
+	value := flags.String("value", "", "Value of -mode fixed, fixed-percent and random-max-percent (chaos-mesh)")
+	appKind := flags.String("app-kind", "deployment", "Kind of the target application (litmus)")
+	serviceAccount := flags.String("service-account", "litmus-admin", "Service account running the experiments (litmus)")
+	duration := flags.Duration("duration", 0, "Experiment duration (default: the scenario duration)")
+	output := flags.String("o", "", "Write the experiments to this file instead of stdout")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: chaoskit export [flags] <scenario.yaml>\n\n")
+		_, _ = fmt.Fprintf(flags.Output(), "Translates network, stress and panic injectors into Chaos Mesh experiments\nor LitmusChaos engines.\n\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	scenario, err := config.Load(flags.Arg(0))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var data []byte
+	switch *format {
+	case "chaos-mesh":
+		opts := []exporters.ChaosMeshOption{
+			exporters.WithChaosMeshNamespace(*namespace),
+			exporters.WithChaosMeshSelector(labels),
+			exporters.WithChaosMeshMode(*mode, *value),
+		}
+		if *duration > 0 {
+			opts = append(opts, exporters.WithChaosMeshDuration(*duration))
+		}
+		data, err = exporters.ChaosMeshExport(scenario, opts...)
+	case "litmus":
+		opts := []exporters.LitmusOption{
+			exporters.WithLitmusNamespace(*namespace),
+			exporters.WithLitmusApp(labels.String(), *appKind),
+			exporters.WithLitmusServiceAccount(*serviceAccount),
+		}
+		if *duration > 0 {
+			opts = append(opts, exporters.WithLitmusDuration(*duration))
+		}
+		data, err = exporters.LitmusExport(scenario, opts...)
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "Error: unknown format %q\n", *format)
+		return 2
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *output == "" {
+		_, _ = os.Stdout.Write(data)
+		return 0
+	}
+	if err := os.WriteFile(*output, data, 0o644); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// gateErrorExitCode is the exit code of gate for usage, I/O and parse
+// errors, apart from the FAIL verdict (1 by default)
+const gateErrorExitCode = 2
+
+func runGate(args []string) int {
+	flags := flag.NewFlagSet("gate", flag.ExitOnError)
+	reportPath := flags.String("report", "", "JSON results (chaoskit run -json, Reporter.SaveJSON) or saved report (Reporter.SaveReport); required")
+	thresholdsPath := flags.String("thresholds", "", "Thresholds file (chaoskit.LoadThresholds) evaluated against JSON results; default thresholds when empty")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), `Usage: chaoskit gate -report <report.json> [-thresholds <thresholds.yaml>]
+
+-thresholds only applies to JSON results: a saved report keeps the verdict of
+the thresholds it was generated with and is rejected with -thresholds.
+Exits with the verdict exit code (PASS and UNSTABLE 0, FAIL 1 unless the
+thresholds set exit_codes), or %d on usage, I/O and parse errors.
+
+`, gateErrorExitCode)
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if *reportPath == "" || flags.NArg() != 0 {
+		flags.Usage()
+		return gateErrorExitCode
+	}
+
+	var thresholds *chaoskit.SuccessThresholds
+	if *thresholdsPath != "" {
+		var err error
+		if thresholds, err = chaoskit.LoadThresholds(*thresholdsPath); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return gateErrorExitCode
+		}
+	}
+
+	report, err := gateReport(*reportPath, thresholds)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return gateErrorExitCode
+	}
+
+	code := report.ExitCode()
+	printGateSummary(report, code)
+
+	return code
+}
+
+// gateReport evaluates JSON results against thresholds, or returns a saved
+// report as is. Saved reports keep the verdict of their own thresholds.
+func gateReport(path string, thresholds *chaoskit.SuccessThresholds) (*chaoskit.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if _, ok := fields["executions"]; !ok {
+		if thresholds != nil {
+			return nil, errors.New(path + " is a saved report, which keeps its own verdict; -thresholds needs the JSON results (chaoskit run -json)")
+		}
+
+		return chaoskit.DecodeReport(data)
+	}
+
+	reporter, err := chaoskit.DecodeReporter(data)
+	if err != nil {
+		return nil, err
+	}
+	if thresholds == nil {
+		thresholds = chaoskit.DefaultThresholds()
+	}
+
+	return reporter.GetVerdict(thresholds)
+}
+
+func printGateSummary(report *chaoskit.Report, code int) {
+	fmt.Printf("Verdict: %s (exit code %d)\n", report.Verdict, code)
+	if report.ScenarioName != "" {
+		fmt.Printf("Scenario: %s\n", report.ScenarioName)
+	}
+	fmt.Printf("Iterations: %d (%d failed, %.2f%% success", report.TotalIterations, report.FailureCount, report.SuccessRate*100)
+	if report.Thresholds != nil {
+		fmt.Printf(", min %.2f%%", report.Thresholds.MinSuccessRate*100)
+	}
+	fmt.Println(")")
+
+	for _, scenario := range report.Scenarios {
+		fmt.Printf("  %-30s %s (%d/%d)\n", scenario.ScenarioName, scenario.Verdict, scenario.SuccessCount, scenario.TotalIterations)
+	}
+	for _, failure := range report.CriticalFailures {
+		fmt.Printf("Critical: %s: %s (%d occurrences)\n", failure.ValidatorName, failure.Message, failure.Occurrences)
+	}
+	for _, failure := range report.Warnings {
+		fmt.Printf("Warning: %s: %s (%d occurrences)\n", failure.ValidatorName, failure.Message, failure.Occurrences)
+	}
+	for _, regression := range report.Regressions {
+		fmt.Printf("Regression: %s\n", regression)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// writeGateFiles writes JSON results with a 90% success rate, a report saved
+// from them and the given thresholds files to a temporary directory
+func writeGateFiles(t *testing.T, thresholds map[string]string) (results, report string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	reporter := chaoskit.NewReporter()
+	for i := 1; i <= 10; i++ {
+		result := chaoskit.ExecutionResult{
+			ScenarioName: "checkout",
+			Iteration:    i,
+			Success:      i != 5,
+			Duration:     time.Millisecond,
+			Timestamp:    time.Unix(int64(i), 0).UTC(),
+		}
+		if !result.Success {
+			result.Error = errors.New("step failed: connection refused")
+		}
+		reporter.AddResult(result)
+	}
+
+	results = filepath.Join(dir, "results.json")
+	require.NoError(t, reporter.SaveJSON(results))
+	verdict, err := reporter.GetVerdict(&chaoskit.SuccessThresholds{MinSuccessRate: 0.8})
+	require.NoError(t, err)
+	report = filepath.Join(dir, "report.json")
+	require.NoError(t, reporter.SaveReport(verdict, report))
+
+	for name, content := range thresholds {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	return results, report
+}
+
+func TestRunGate_ExitCodes(t *testing.T) {
+	results, report := writeGateFiles(t, map[string]string{
+		"pass.yaml":    "min_success_rate: 0.8\n",
+		"fail.yaml":    "min_success_rate: 0.95\n",
+		"custom.yaml":  "min_success_rate: 0.95\nexit_codes: {pass: 0, unstable: 0, fail: 3}\n",
+		"invalid.yaml": "min_success_rate: [\n",
+	})
+	dir := filepath.Dir(results)
+	garbage := filepath.Join(dir, "garbage.json")
+	require.NoError(t, os.WriteFile(garbage, []byte("not json"), 0o600))
+	empty := filepath.Join(dir, "empty.json")
+	require.NoError(t, os.WriteFile(empty, []byte("{}"), 0o600))
+	versionOnly := filepath.Join(dir, "version.json")
+	require.NoError(t, os.WriteFile(versionOnly, []byte(`{"schema_version":2}`), 0o600))
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"pass", []string{"-report", results, "-thresholds", filepath.Join(dir, "pass.yaml")}, 0},
+		{"fail", []string{"-report", results, "-thresholds", filepath.Join(dir, "fail.yaml")}, 1},
+		{"custom exit codes", []string{"-report", results, "-thresholds", filepath.Join(dir, "custom.yaml")}, 3},
+		{"saved report keeps its verdict", []string{"-report", report}, 0},
+		{"saved report with thresholds", []string{"-report", report, "-thresholds", filepath.Join(dir, "fail.yaml")}, gateErrorExitCode},
+		{"missing report", []string{"-report", filepath.Join(dir, "missing.json")}, gateErrorExitCode},
+		{"unparsable report", []string{"-report", garbage}, gateErrorExitCode},
+		{"empty document", []string{"-report", empty}, gateErrorExitCode},
+		{"schema version only", []string{"-report", versionOnly}, gateErrorExitCode},
+		{"missing thresholds", []string{"-report", results, "-thresholds", filepath.Join(dir, "missing.yaml")}, gateErrorExitCode},
+		{"invalid thresholds", []string{"-report", results, "-thresholds", filepath.Join(dir, "invalid.yaml")}, gateErrorExitCode},
+		{"no report", nil, gateErrorExitCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, runGate(tt.args))
+		})
+	}
+}
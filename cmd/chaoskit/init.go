@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// initTemplates lists the supported -target values
+var initTemplates = []string{"http", "grpc", "workflow"}
+
+// initFiles maps template files to generated files
+var initFiles = map[string]string{
+	"main.go.tmpl":       "main.go",
+	"chaos_test.go.tmpl": "chaos_test.go",
+	"scenario.yaml.tmpl": "scenario.yaml",
+}
+
+func runInit(args []string) int {
+	flags := flag.NewFlagSet("init", flag.ExitOnError)
+	target := flags.String("target", "http", "Template: "+strings.Join(initTemplates, ", "))
+	dir := flags.String("dir", ".", "Output directory")
+	name := flags.String("name", "", "Scenario name (default: output directory name)")
+	force := flags.Bool("force", false, "Overwrite existing files")
+	_ = flags.Parse(args)
+
+	if !slices.Contains(initTemplates, *target) {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: unknown target %q (supported: %s)\n", *target, strings.Join(initTemplates, ", "))
+		return 2
+	}
+
+	if *name == "" {
+		abs, err := filepath.Abs(*dir)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		*name = filepath.Base(abs)
+	}
+
+	files, err := renderInit(*target, *name)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if !*force {
+		for file := range files {
+			if _, err := os.Stat(filepath.Join(*dir, file)); err == nil {
+				_, _ = fmt.Fprintf(os.Stderr, "Error: %s already exists (use -force to overwrite)\n", filepath.Join(*dir, file))
+				return 1
+			}
+		}
+	}
+
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	for _, file := range sortedFiles(files) {
+		path := filepath.Join(*dir, file)
+		if err := os.WriteFile(path, files[file], 0o644); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		fmt.Printf("created %s\n", path)
+	}
+
+	fmt.Println("\nNext steps:")
+	if *dir != "." {
+		fmt.Printf("  cd %s\n", *dir)
+	}
+	fmt.Println("  go get github.com/rom8726/chaoskit")
+	if *target == "grpc" {
+		fmt.Println("  go get google.golang.org/grpc")
+	}
+	fmt.Println("  chaoskit validate scenario.yaml")
+	fmt.Println("  go run .")
+
+	return 0
+}
+
+// renderInit renders the files of a template; Go files are gofmt-ed
+func renderInit(target, name string) (map[string][]byte, error) {
+	files := make(map[string][]byte, len(initFiles))
+	for src, dst := range initFiles {
+		tmpl, err := template.ParseFS(templates, "templates/"+target+"/"+src)
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, struct{ Name string }{Name: name}); err != nil {
+			return nil, err
+		}
+
+		data := buf.Bytes()
+		if strings.HasSuffix(dst, ".go") {
+			if data, err = format.Source(data); err != nil {
+				return nil, fmt.Errorf("%s: %w", src, err)
+			}
+		}
+		files[dst] = data
+	}
+
+	return files, nil
+}
+
+func sortedFiles(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rom8726/chaoskit/config"
+)
+
+// exporterInfos describes the exporters package. Exporters are wired in code
+// (executor options, observers and verdict listeners), not in scenario files.
+var exporterInfos = []config.ComponentInfo{
+	{
+		Type:        "prometheus",
+		Description: "Prometheus metrics of executions and injections: exporters.NewPrometheusExporter",
+		Params: []config.ParamInfo{
+			{Name: "namespace", Description: "metric namespace"},
+			{Name: "subsystem", Description: "metric subsystem"},
+			{Name: "WithBuckets", Description: "histogram buckets of iteration durations"},
+		},
+	},
+	{
+		Type:        "prometheus-collector",
+		Description: "Prometheus collector registered on a custom registry: exporters.NewPrometheusCollector",
+		Params: []config.ParamInfo{
+			{Name: "reg", Description: "Prometheus registerer"},
+			{Name: "namespace", Description: "metric namespace"},
+			{Name: "subsystem", Description: "metric subsystem"},
+		},
+	},
+	{
+		Type:        "pushgateway",
+		Description: "Pushes Prometheus metrics of batch runs: PrometheusExporter.Push",
+		Params: []config.ParamInfo{
+			{Name: "gatewayURL", Description: "Pushgateway URL"},
+			{Name: "jobName", Description: "job label of pushed metrics"},
+		},
+	},
+	{
+		Type:        "otel-metrics",
+		Description: "OpenTelemetry metrics: exporters.NewOTelMetricsExporter",
+		Params:      []config.ParamInfo{{Name: "meterProvider", Description: "OpenTelemetry meter provider"}},
+	},
+	{
+		Type:        "otel-trace",
+		Description: "OpenTelemetry spans of iterations, steps and injections: exporters.NewOTelTraceExporter",
+		Params:      []config.ParamInfo{{Name: "tracerProvider", Description: "OpenTelemetry tracer provider"}},
+	},
+	{
+		Type:        "webhook",
+		Description: "Verdict notifications to Slack or any webhook: exporters.WebhookNotifier",
+		Params: []config.ParamInfo{
+			{Name: "url", Description: "webhook URL"},
+			{Name: "tmpl", Description: "text/template of the request body (DefaultWebhookTemplate when empty)"},
+			{Name: "WithNotifyVerdicts", Description: "verdicts to notify about"},
+		},
+	},
+	{
+		Type:        "cloudevents",
+		Description: "CloudEvents of scenarios, injections, validator failures and verdicts: exporters.NewCloudEventsExporter",
+		Params: []config.ParamInfo{
+			{Name: "sink", Description: "exporters.HTTPCloudEventSink or exporters.KafkaCloudEventSink"},
+			{Name: "WithCloudEventSource", Description: "source attribute of events"},
+			{Name: "WithCloudEventTypes", Description: "event types to emit"},
+		},
+	},
+	{
+		Type:        "sqlite-history",
+		Description: "Run history in SQLite: exporters.SQLiteStore",
+		Params:      []config.ParamInfo{{Name: "path", Description: "database file"}},
+	},
+	{
+		Type:        "chaos-mesh",
+		Description: "Chaos Mesh experiments of a scenario file: exporters.ChaosMeshExport, chaoskit export",
+		Params: []config.ParamInfo{
+			{Name: "scenario", Description: "scenario to translate"},
+			{Name: "WithChaosMeshNamespace", Description: "namespace of the experiments and target pods"},
+			{Name: "WithChaosMeshSelector", Description: "labels of the target pods"},
+		},
+	},
+	{
+		Type:        "litmus",
+		Description: "LitmusChaos engines of a scenario file: exporters.LitmusExport, chaoskit export -format litmus",
+		Params: []config.ParamInfo{
+			{Name: "scenario", Description: "scenario to translate"},
+			{Name: "WithLitmusNamespace", Description: "namespace of the engines and target application"},
+			{Name: "WithLitmusApp", Description: "label and kind of the target application"},
+		},
+	},
+}
+
+func runList(args []string) int {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	asJSON := flags.Bool("json", false, "Print JSON instead of text")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: chaoskit list [flags] [injectors|validators|exporters]\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	kinds := map[string][]config.ComponentInfo{
+		"injectors":  config.DescribeInjectors(),
+		"validators": config.DescribeValidators(),
+		"exporters":  exporterInfos,
+	}
+	names := []string{"injectors", "validators", "exporters"}
+
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		if _, ok := kinds[flags.Arg(0)]; !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: unknown component kind %q (%s)\n", flags.Arg(0), strings.Join(names, ", "))
+			return 2
+		}
+		names = []string{flags.Arg(0)}
+	default:
+		flags.Usage()
+		return 2
+	}
+
+	if *asJSON {
+		out := make(map[string][]config.ComponentInfo, len(names))
+		for _, name := range names {
+			out[name] = kinds[name]
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(out); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+
+		return 0
+	}
+
+	for i, name := range names {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s:\n", strings.ToUpper(name[:1])+name[1:])
+		if name == "exporters" {
+			fmt.Println("  (configured in code, not in scenario files)")
+		}
+		for _, info := range kinds[name] {
+			fmt.Printf("  %-22s %s\n", info.Type, info.Description)
+			for _, param := range info.Params {
+				fmt.Printf("      %-18s %s\n", param.Name, param.Description)
+			}
+		}
+	}
+
+	return 0
+}
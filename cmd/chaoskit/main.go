@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// command is a chaoskit subcommand
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) int
+}
+
+var commands = []command{
+	{name: "init", summary: "Generate a starter scenario, main.go and test", run: runInit},
+	{name: "run", summary: "Run a scenario file and print its report", run: runRun},
+	{name: "bench", summary: "Compare a scenario with injectors disabled and enabled", run: runBench},
+	{name: "replay", summary: "Re-run a scenario from a recorded decision trace", run: runReplay},
+	{name: "gate", summary: "Decide pass/fail of saved results against thresholds", run: runGate},
+	{name: "validate", summary: "Check scenario files before running them", run: runValidate},
+	{name: "list", summary: "List injector, validator and exporter types with their parameters", run: runList},
+	{name: "proxy", summary: "Inspect and clean up ToxiProxy proxies and toxics", run: runProxy},
+	{name: "serve", summary: "Run scenarios behind a REST API", run: runServe},
+	{name: "agent", summary: "Run scenario shards for a distributed run (run -workers)", run: runAgent},
+	{name: "operator", summary: "Run ChaosKitScenario resources on a schedule in Kubernetes", run: runOperator},
+	{name: "export", summary: "Export a scenario as Chaos Mesh or LitmusChaos manifests", run: runExport},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	for _, cmd := range commands {
+		if cmd.name == name {
+			os.Exit(cmd.run(os.Args[2:]))
+		}
+	}
+
+	if name != "-h" && name != "-help" && name != "help" {
+		_, _ = fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", name)
+	}
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	_, _ = fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags]\n\nCommands:\n", os.Args[0])
+	for _, cmd := range commands {
+		_, _ = fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "\nRun '%s <command> -h' for command flags.\n", os.Args[0])
+}
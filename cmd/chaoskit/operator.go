@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rom8726/chaoskit/distributed"
+	"github.com/rom8726/chaoskit/kube"
+	"github.com/rom8726/chaoskit/operator"
+)
+
+func runOperator(args []string) int {
+	flags := flag.NewFlagSet("operator", flag.ExitOnError)
+	namespace := flags.String("namespace", "", "Namespace to watch (default: all namespaces)")
+	resync := flags.Duration("resync", operator.DefaultResyncInterval, "How often scenarios are reconciled")
+	server := flags.String("server", "", "Kubernetes API URL, e.g. of kubectl proxy, with the KUBE_TOKEN bearer token (default: in-cluster config)")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: chaoskit operator [flags]\n\n")
+		_, _ = fmt.Fprintf(flags.Output(), "Runs ChaosKitScenario resources on their schedule (see deploy/operator).\n")
+		_, _ = fmt.Fprintf(flags.Output(), "Agents of spec.workers are sent the $%s bearer token.\n\n", agentTokenEnv)
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	var client *kube.Client
+	if *server != "" {
+		client = kube.NewClient(*server, os.Getenv("KUBE_TOKEN"), nil)
+	} else {
+		var err error
+		if client, err = kube.InClusterClient(); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	controller := operator.NewController(client,
+		operator.WithNamespace(*namespace),
+		operator.WithResyncInterval(*resync),
+		operator.WithLogger(logger),
+		operator.WithCoordinatorOptions(distributed.WithToken(os.Getenv(agentTokenEnv))))
+	if err := controller.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
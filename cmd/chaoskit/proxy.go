@@ -0,0 +1,279 @@
+//go:build !no_toxiproxy
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rom8726/chaoskit/injectors"
+)
+
+// defaultToxiProxyHost is the ToxiProxy API used when neither -host nor TOXIPROXY_URL is set
+const defaultToxiProxyHost = "http://localhost:8474"
+
+// attrList is a repeatable key=value flag of toxic attributes
+type attrList map[string]any
+
+func (a attrList) String() string {
+	pairs := make([]string, 0, len(a))
+	for key, value := range a {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, value))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// Set parses key=value; integer values are sent as numbers, as ToxiProxy expects
+func (a attrList) Set(value string) error {
+	key, raw, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	if n, err := strconv.Atoi(raw); err == nil {
+		a[key] = n
+	} else if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		a[key] = f
+	} else {
+		a[key] = raw
+	}
+
+	return nil
+}
+
+func runProxy(args []string) int {
+	flags := flag.NewFlagSet("proxy", flag.ExitOnError)
+	host := flags.String("host", toxiProxyHost(), "ToxiProxy API address (TOXIPROXY_URL)")
+	flags.Usage = func() {
+		out := flags.Output()
+		_, _ = fmt.Fprintf(out, "Usage: chaoskit proxy [-host URL] <command>\n\nCommands:\n")
+		_, _ = fmt.Fprintf(out, "  list                                            proxies and their toxics\n")
+		_, _ = fmt.Fprintf(out, "  create -name N -listen ADDR -upstream ADDR      create a proxy\n")
+		_, _ = fmt.Fprintf(out, "  delete [-all] [name...]                         delete proxies\n")
+		_, _ = fmt.Fprintf(out, "  toxic add -type T [-name N] [-stream S] [-toxicity P] [-attr k=v...] <proxy>\n")
+		_, _ = fmt.Fprintf(out, "  toxic remove <proxy> <toxic>...                 remove toxics\n")
+		_, _ = fmt.Fprintf(out, "  toxic clear [-all] [proxy...]                   remove all toxics of proxies\n\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	// The manager logs every change; the command prints its own summary
+	slog.SetDefault(slog.New(slog.DiscardHandler))
+	manager := injectors.NewToxiProxyManager(injectors.NewToxiProxyClient(*host))
+
+	rest := flags.Args()[1:]
+	switch flags.Arg(0) {
+	case "list":
+		return proxyList(manager)
+	case "create":
+		return proxyCreate(manager, rest)
+	case "delete":
+		return proxyDelete(manager, rest)
+	case "toxic":
+		return proxyToxic(manager, rest)
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "Unknown proxy command %q\n\n", flags.Arg(0))
+		flags.Usage()
+		return 2
+	}
+}
+
+func toxiProxyHost() string {
+	if host := os.Getenv("TOXIPROXY_URL"); host != "" {
+		return host
+	}
+
+	return defaultToxiProxyHost
+}
+
+func proxyList(manager *injectors.ToxiProxyManager) int {
+	proxies, err := manager.Proxies()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if len(proxies) == 0 {
+		fmt.Println("No proxies")
+		return 0
+	}
+
+	for _, proxy := range proxies {
+		state := "enabled"
+		if !proxy.Enabled {
+			state = "disabled"
+		}
+		fmt.Printf("%s  %s -> %s  (%s)\n", proxy.Name, proxy.Listen, proxy.Upstream, state)
+		for _, toxic := range proxy.ActiveToxics {
+			fmt.Printf("  toxic %s: %s %s toxicity=%.2f %s\n",
+				toxic.Name, toxic.Type, toxic.Stream, toxic.Toxicity, attrList(toxic.Attributes))
+		}
+	}
+
+	return 0
+}
+
+func proxyCreate(manager *injectors.ToxiProxyManager, args []string) int {
+	flags := flag.NewFlagSet("proxy create", flag.ExitOnError)
+	name := flags.String("name", "", "Proxy name")
+	listen := flags.String("listen", "", "Listen address (e.g. 127.0.0.1:15432)")
+	upstream := flags.String("upstream", "", "Upstream address (e.g. localhost:5432)")
+	disabled := flags.Bool("disabled", false, "Create the proxy disabled")
+	_ = flags.Parse(args)
+
+	if *name == "" || *listen == "" || *upstream == "" {
+		_, _ = fmt.Fprintln(os.Stderr, "Error: -name, -listen and -upstream are required")
+		return 2
+	}
+
+	cfg := injectors.ProxyConfig{Name: *name, Listen: *listen, Upstream: *upstream, Enabled: !*disabled}
+	if err := manager.CreateProxy(cfg); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Printf("created proxy %s (%s -> %s)\n", *name, *listen, *upstream)
+
+	return 0
+}
+
+func proxyDelete(manager *injectors.ToxiProxyManager, args []string) int {
+	flags := flag.NewFlagSet("proxy delete", flag.ExitOnError)
+	all := flags.Bool("all", false, "Delete all proxies on the server")
+	_ = flags.Parse(args)
+
+	names, code := proxyNames(manager, flags.Args(), *all)
+	if code != 0 {
+		return code
+	}
+
+	for _, name := range names {
+		if err := manager.DeleteProxy(name); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			code = 1
+			continue
+		}
+		fmt.Printf("deleted proxy %s\n", name)
+	}
+
+	return code
+}
+
+func proxyToxic(manager *injectors.ToxiProxyManager, args []string) int {
+	if len(args) == 0 {
+		_, _ = fmt.Fprintln(os.Stderr, "Error: expected toxic add, remove or clear")
+		return 2
+	}
+
+	switch args[0] {
+	case "add":
+		return toxicAdd(manager, args[1:])
+	case "remove":
+		if len(args) < 3 {
+			_, _ = fmt.Fprintln(os.Stderr, "Error: expected toxic remove <proxy> <toxic>...")
+			return 2
+		}
+		code := 0
+		for _, toxic := range args[2:] {
+			if err := manager.RemoveToxic(args[1], toxic); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				code = 1
+				continue
+			}
+			fmt.Printf("removed toxic %s from %s\n", toxic, args[1])
+		}
+
+		return code
+	case "clear":
+		return toxicClear(manager, args[1:])
+	default:
+		_, _ = fmt.Fprintf(os.Stderr, "Error: unknown toxic command %q (add, remove, clear)\n", args[0])
+		return 2
+	}
+}
+
+func toxicAdd(manager *injectors.ToxiProxyManager, args []string) int {
+	flags := flag.NewFlagSet("proxy toxic add", flag.ExitOnError)
+	toxicType := flags.String("type", "", "Toxic type: latency, bandwidth, timeout, slicer, limit_data, reset_peer, slow_close")
+	name := flags.String("name", "", "Toxic name (default <type>_<stream>)")
+	stream := flags.String("stream", "downstream", "Stream: upstream or downstream")
+	toxicity := flags.Float64("toxicity", 1, "Probability of the toxic applying to a connection")
+	attrs := attrList{}
+	flags.Var(attrs, "attr", "Toxic attribute key=value (e.g. latency=100); repeatable")
+	_ = flags.Parse(args)
+
+	if *toxicType == "" || flags.NArg() != 1 {
+		_, _ = fmt.Fprintln(os.Stderr, "Error: expected toxic add -type T [flags] <proxy>")
+		return 2
+	}
+
+	toxic, err := manager.AddToxic(flags.Arg(0), injectors.ToxicConfig{
+		Name:       *name,
+		Type:       *toxicType,
+		Stream:     *stream,
+		Toxicity:   float32(*toxicity),
+		Attributes: attrs,
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Printf("added toxic %s (%s %s) to %s\n", toxic.Name, toxic.Type, toxic.Stream, flags.Arg(0))
+
+	return 0
+}
+
+func toxicClear(manager *injectors.ToxiProxyManager, args []string) int {
+	flags := flag.NewFlagSet("proxy toxic clear", flag.ExitOnError)
+	all := flags.Bool("all", false, "Clear the toxics of all proxies on the server")
+	_ = flags.Parse(args)
+
+	names, code := proxyNames(manager, flags.Args(), *all)
+	if code != 0 {
+		return code
+	}
+
+	for _, name := range names {
+		removed, err := manager.ClearToxics(name)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			code = 1
+		}
+		if len(removed) > 0 {
+			fmt.Printf("removed %d toxic(s) from %s: %s\n", len(removed), name, strings.Join(removed, ", "))
+		}
+	}
+
+	return code
+}
+
+// proxyNames returns the named proxies, or all server proxies with -all
+func proxyNames(manager *injectors.ToxiProxyManager, names []string, all bool) ([]string, int) {
+	if all == (len(names) > 0) {
+		_, _ = fmt.Fprintln(os.Stderr, "Error: pass proxy names or -all")
+		return nil, 2
+	}
+	if !all {
+		return names, 0
+	}
+
+	proxies, err := manager.Proxies()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, 1
+	}
+	for _, proxy := range proxies {
+		names = append(names, proxy.Name)
+	}
+
+	return names, 0
+}
@@ -0,0 +1,14 @@
+//go:build no_toxiproxy
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func runProxy([]string) int {
+	_, _ = fmt.Fprintln(os.Stderr, "chaoskit was built with -tags no_toxiproxy; the proxy command is not available")
+
+	return 1
+}
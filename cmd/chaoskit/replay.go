@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+func runReplay(args []string) int {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	f := addRunFlags(flags)
+	tracePath := flags.String("trace", "", "Decision trace recorded by 'chaoskit run -trace' (required)")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: chaoskit replay -trace <run.trace> [flags] <scenario.yaml>\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 || *tracePath == "" {
+		flags.Usage()
+		return 2
+	}
+
+	trace, err := chaoskit.LoadDecisionTrace(*tracePath)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	cfg, err := config.Load(flags.Arg(0))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if cfg.Name != trace.Scenario {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: trace was recorded for scenario %q, not %q\n", trace.Scenario, cfg.Name)
+		return 1
+	}
+
+	// Replay exactly the recorded iterations with the recorded seed
+	cfg.Duration = 0
+	cfg.Repeat = trace.Iterations
+	cfg.Seed = &trace.Seed
+
+	executor, code := execute(cfg, f, chaoskit.WithReplay(trace), chaoskit.WithDecisionTrace())
+	if executor == nil {
+		return code
+	}
+
+	replayed := executor.DecisionTrace().FailedIterations
+	switch {
+	case !slices.Equal(replayed, trace.FailedIterations):
+		fmt.Printf("Replay diverged: recorded failures in iterations %v, replayed failures in iterations %v\n",
+			trace.FailedIterations, replayed)
+	case len(replayed) == 0:
+		fmt.Println("Replay reproduced the recorded run: no failed iterations")
+	default:
+		fmt.Printf("Replay reproduced the recorded failures: iterations %v\n", trace.FailedIterations)
+	}
+
+	return code
+}
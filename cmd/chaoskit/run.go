@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+	"github.com/rom8726/chaoskit/distributed"
+)
+
+// runFlags are the flags shared by run and replay
+type runFlags struct {
+	tui       *bool
+	refresh   *time.Duration
+	junitPath *string
+	jsonPath  *string
+}
+
+func addRunFlags(flags *flag.FlagSet) runFlags {
+	return runFlags{
+		tui:       flags.Bool("tui", false, "Show a live terminal view of iterations, injectors, validators and the rolling verdict"),
+		refresh:   flags.Duration("refresh", 250*time.Millisecond, "Refresh interval of -tui"),
+		junitPath: flags.String("junit", "", "Write a JUnit XML report to this path"),
+		jsonPath:  flags.String("json", "", "Write the JSON results (Reporter.SaveJSON) to this path"),
+	}
+}
+
+func runRun(args []string) int {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	f := addRunFlags(flags)
+	tracePath := flags.String("trace", "", "Record the chaos decision trace to this path (see chaoskit replay)")
+	workers := flags.String("workers", "", "Comma-separated addresses of agents to shard the scenario across (see chaoskit agent)")
+	workerToken := flags.String("worker-token", os.Getenv(agentTokenEnv), "Bearer token sent to the agents of -workers (default $"+agentTokenEnv+")")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: chaoskit run [flags] <scenario.yaml>\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	if *workers != "" {
+		if *f.tui || *tracePath != "" {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: -tui and -trace are not supported with -workers\n")
+			return 2
+		}
+
+		return coordinate(flags.Arg(0), strings.Split(*workers, ","), *workerToken, f)
+	}
+
+	cfg, err := config.Load(flags.Arg(0))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var opts []chaoskit.ExecutorOption
+	if *tracePath != "" {
+		opts = append(opts, chaoskit.WithDecisionTrace())
+	}
+
+	executor, code := execute(cfg, f, opts...)
+	if executor != nil && *tracePath != "" {
+		if err := executor.DecisionTrace().Save(*tracePath); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	return code
+}
+
+// execute runs a loaded scenario, prints its report and writes the report files.
+// It returns the executor (nil when the scenario could not be built) and the exit code.
+func execute(cfg *config.Scenario, f runFlags, extra ...chaoskit.ExecutorOption) (*chaoskit.Executor, int) {
+	scenario, err := cfg.Build()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	opts := append([]chaoskit.ExecutorOption{chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure)}, extra...)
+
+	var view *liveView
+	if *f.tui {
+		view = newLiveView(scenario, cfg.SuccessThresholds())
+		// Logs would scroll the live view away; injectors log to the default logger
+		quiet := slog.New(slog.NewTextHandler(io.Discard, nil))
+		slog.SetDefault(quiet)
+		opts = append(opts, chaoskit.WithSlogLogger(quiet), chaoskit.WithObservers(view))
+	}
+
+	executor := chaoskit.NewExecutor(opts...)
+	if view != nil {
+		view.reporter = executor.Reporter()
+	}
+
+	runErr := func() error {
+		if view == nil {
+			return executor.Run(ctx, scenario)
+		}
+
+		return view.run(ctx, os.Stdout, *f.refresh, func() error { return executor.Run(ctx, scenario) })
+	}()
+
+	// Iteration failures are part of the report; the run error only matters
+	// when nothing was recorded
+	reporter := executor.Reporter()
+	report, err := reporter.GetVerdict(cfg.SuccessThresholds())
+	if err != nil {
+		if runErr != nil && !errors.Is(runErr, context.Canceled) {
+			err = runErr
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return executor, 1
+	}
+
+	return executor, writeReports(reporter, report, f)
+}
+
+// coordinate runs a scenario file sharded across worker agents and prints the
+// report over their aggregated results
+func coordinate(path string, workers []string, token string, f runFlags) int {
+	document, err := os.ReadFile(path)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	coordinator := distributed.NewCoordinator(workers,
+		distributed.WithCoordinatorLogger(logger),
+		distributed.WithToken(token))
+	report, err := coordinator.Run(ctx, document)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", path, err)
+		return 1
+	}
+
+	return writeReports(coordinator.Reporter(), report, f)
+}
+
+// writeReports prints the text report, writes the report files and returns the exit code
+func writeReports(reporter *chaoskit.Reporter, report *chaoskit.Report, f runFlags) int {
+	fmt.Println(reporter.GenerateTextReport(report))
+
+	if *f.junitPath != "" {
+		if err := reporter.SaveJUnitXML(report, *f.junitPath); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+	if *f.jsonPath != "" {
+		if err := reporter.SaveJSON(*f.jsonPath); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	return report.ExitCode()
+}
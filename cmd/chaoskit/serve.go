@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rom8726/chaoskit/config"
+	"github.com/rom8726/chaoskit/server"
+)
+
+// shutdownTimeout bounds stopping active runs on exit
+const shutdownTimeout = 30 * time.Second
+
+// serveTokenEnv is the environment variable of the REST API token
+const serveTokenEnv = "CHAOSKIT_TOKEN"
+
+// globList is a repeatable flag of paths or glob patterns
+type globList []string
+
+func (g *globList) String() string {
+	return strings.Join(*g, ",")
+}
+
+func (g *globList) Set(value string) error {
+	*g = append(*g, value)
+
+	return nil
+}
+
+// expand resolves glob patterns; plain paths are kept as is
+func (g globList) expand() ([]string, error) {
+	var paths []string
+	for _, pattern := range g {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			matches = []string{pattern}
+		}
+		paths = append(paths, matches...)
+	}
+
+	return paths, nil
+}
+
+func runServe(args []string) int {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	var scenarios globList
+	flags.Var(&scenarios, "scenarios", "Path or glob of scenario files registered at startup; repeatable")
+	addr := flags.String("addr", "127.0.0.1:8080", "Listen address; anyone reaching it can register and run scenarios")
+	token := flags.String("token", os.Getenv(serveTokenEnv), "Bearer token required by the REST API (default $"+serveTokenEnv+")")
+	watch := flags.Duration("watch", 0, "Poll scenario files at this interval and hot reload changes into running runs (0 disables)")
+	_ = flags.Parse(args)
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	srv := server.New(server.WithLogger(logger), server.WithToken(*token))
+	if *token == "" && !isLoopback(*addr) {
+		logger.Warn("REST API listens beyond localhost without a token: anyone reaching it can run scenarios",
+			slog.String("hint", "set -token or $"+serveTokenEnv))
+	}
+
+	paths, err := scenarios.expand()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	for _, path := range paths {
+		scenario, err := config.Load(path)
+		if err == nil {
+			err = srv.Register(scenario)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if *watch > 0 {
+		for _, path := range paths {
+			go watchScenario(ctx, srv, logger, path, *watch)
+		}
+	}
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           srv.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Info("listening", slog.String("addr", *addr), slog.String("dashboard", "http://"+dashboardHost(*addr)+"/"))
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	case <-ctx.Done():
+	}
+
+	logger.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error("http shutdown failed", slog.Any("error", err))
+	}
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Error("runs did not stop in time", slog.Any("error", err))
+		return 1
+	}
+
+	return 0
+}
+
+// watchScenario re-registers the scenario file at path whenever it changes,
+// which hot reloads it into its running runs
+func watchScenario(ctx context.Context, srv *server.Server, logger *slog.Logger, path string, interval time.Duration) {
+	config.Watch(ctx, path, interval, func(scenario *config.Scenario, err error) {
+		if err == nil {
+			err = srv.Register(scenario)
+		}
+		if err != nil {
+			logger.Error("scenario reload failed", slog.String("path", path), slog.Any("error", err))
+			return
+		}
+		logger.Info("scenario file changed", slog.String("path", path), slog.String("scenario", scenario.Name))
+	})
+}
+
+// dashboardHost returns a browsable host for a listen address such as ":8080"
+func dashboardHost(addr string) string {
+	if strings.HasPrefix(addr, ":") {
+		return "localhost" + addr
+	}
+
+	return addr
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// liveRows is the number of latest iterations shown by the live view
+const liveRows = 12
+
+// liveView is a terminal UI of a running scenario. Executor observer callbacks
+// update the model; a ticker renders it, so slow terminals never slow down the run.
+type liveView struct {
+	scenario   string
+	thresholds *chaoskit.SuccessThresholds
+	reporter   *chaoskit.Reporter
+	injectors  []string
+	validators []string
+	started    time.Time
+
+	mu                sync.Mutex
+	iterations        int
+	failures          int
+	totalDuration     time.Duration
+	latest            []chaoskit.ExecutionResult
+	fires             map[string]int
+	validatorFailures map[string]int
+}
+
+func newLiveView(scenario *chaoskit.Scenario, thresholds *chaoskit.SuccessThresholds) *liveView {
+	return &liveView{
+		scenario:          scenario.Name(),
+		thresholds:        thresholds,
+		injectors:         scenario.InjectorNames(),
+		validators:        scenario.ValidatorNames(),
+		fires:             make(map[string]int),
+		validatorFailures: make(map[string]int),
+	}
+}
+
+func (v *liveView) OnIterationStart(ctx context.Context, _ string, _ int) context.Context {
+	return ctx
+}
+
+func (v *liveView) OnIterationEnd(_ context.Context, result chaoskit.ExecutionResult) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.iterations++
+	v.totalDuration += result.Duration
+	if !result.Success {
+		v.failures++
+	}
+	if result.FailureClass == chaoskit.FailureValidatorViolation && result.Error != nil {
+		for _, name := range v.validators {
+			if strings.HasPrefix(result.Error.Error(), "validator "+name+" failed:") {
+				v.validatorFailures[name]++
+			}
+		}
+	}
+
+	v.latest = append(v.latest, result)
+	if len(v.latest) > liveRows {
+		v.latest = v.latest[len(v.latest)-liveRows:]
+	}
+}
+
+func (v *liveView) OnStepStart(ctx context.Context, _ string) context.Context {
+	return ctx
+}
+
+func (v *liveView) OnStepEnd(context.Context, string, error) {}
+
+func (v *liveView) OnInjection(_ context.Context, event chaoskit.InjectionEvent) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.fires[event.Injector]++
+}
+
+// run executes fn while redrawing the view every interval, and draws the final state
+func (v *liveView) run(ctx context.Context, out io.Writer, interval time.Duration, fn func() error) error {
+	v.started = time.Now()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	// Hide the cursor while redrawing
+	_, _ = fmt.Fprint(out, "\033[?25l")
+	defer func() { _, _ = fmt.Fprint(out, "\033[?25h") }()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			_, _ = io.WriteString(out, v.render(ctx.Err() != nil, true))
+			return err
+		case <-ticker.C:
+			_, _ = io.WriteString(out, v.render(false, false))
+		}
+	}
+}
+
+// render draws the whole screen
+func (v *liveView) render(stopped, finished bool) string {
+	verdict := "-"
+	if report, err := v.reporter.GetVerdict(v.thresholds); err == nil {
+		verdict = report.Verdict.String()
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	var buf bytes.Buffer
+	_, _ = fmt.Fprint(&buf, "\033[H\033[2J")
+
+	status := "running, Ctrl+C to stop"
+	switch {
+	case stopped:
+		status = "stopped"
+	case finished:
+		status = "finished"
+	}
+	_, _ = fmt.Fprintf(&buf, "ChaosKit ▸ %s (%s, %s)\n", v.scenario, status, time.Since(v.started).Round(100*time.Millisecond))
+	_, _ = fmt.Fprintln(&buf, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+
+	var successRate float64
+	var avg time.Duration
+	if v.iterations > 0 {
+		successRate = float64(v.iterations-v.failures) / float64(v.iterations) * 100
+		avg = v.totalDuration / time.Duration(v.iterations)
+	}
+	_, _ = fmt.Fprintf(&buf, "Rolling verdict: %s   Success: %.2f%% (%d/%d)   Avg: %s\n\n",
+		verdict, successRate, v.iterations-v.failures, v.iterations, avg.Round(time.Microsecond))
+
+	_, _ = fmt.Fprintln(&buf, "Iterations:")
+	if len(v.latest) == 0 {
+		_, _ = fmt.Fprintln(&buf, "  (waiting for the first iteration)")
+	}
+	for _, result := range v.latest {
+		mark, detail := "✅", ""
+		if !result.Success {
+			mark = "❌"
+			if result.Error != nil {
+				detail = truncate(result.Error.Error(), 60)
+			}
+		}
+		_, _ = fmt.Fprintf(&buf, "  %s #%-6d %10s  [%s] %s\n", mark, result.Iteration,
+			result.Duration.Round(time.Microsecond), strings.Join(result.Injectors, ","), detail)
+	}
+
+	_, _ = fmt.Fprintln(&buf, "\nInjectors:")
+	if len(v.injectors) == 0 {
+		_, _ = fmt.Fprintln(&buf, "  (none)")
+	}
+	for _, name := range v.injectors {
+		_, _ = fmt.Fprintf(&buf, "  %-40s fired %d\n", name, v.fires[name])
+	}
+
+	_, _ = fmt.Fprintln(&buf, "\nValidators:")
+	if len(v.validators) == 0 {
+		_, _ = fmt.Fprintln(&buf, "  (none)")
+	}
+	for _, name := range v.validators {
+		if failures := v.validatorFailures[name]; failures > 0 {
+			_, _ = fmt.Fprintf(&buf, "  ❌ %-37s %d violation(s)\n", name, failures)
+		} else {
+			_, _ = fmt.Fprintf(&buf, "  ✅ %-37s ok\n", name)
+		}
+	}
+
+	if finished {
+		_, _ = fmt.Fprintln(&buf)
+	}
+
+	return buf.String()
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n-1]) + "…"
+}
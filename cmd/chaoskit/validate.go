@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rom8726/chaoskit/config"
+)
+
+func runValidate(args []string) int {
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	strict := flags.Bool("strict", false, "Treat warnings as errors")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: chaoskit validate [flags] <scenario.yaml|glob>...\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	paths, err := globList(flags.Args()).expand()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	failed := 0
+	for _, path := range paths {
+		if !validateFile(path, *strict) {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("\n%d of %d scenario file(s) failed validation\n", failed, len(paths))
+		return 1
+	}
+
+	return 0
+}
+
+// validateFile prints the result for one file and reports whether it passed
+func validateFile(path string, strict bool) bool {
+	scenario, err := config.Load(path)
+	if err != nil {
+		fmt.Printf("❌ %s\n", path)
+		// errors.Join separates errors with newlines
+		msg := strings.TrimPrefix(err.Error(), path+": ")
+		for _, line := range strings.Split(msg, "\n") {
+			fmt.Printf("   error: %s\n", line)
+		}
+
+		return false
+	}
+
+	warnings := scenario.Warnings()
+	ok := !strict || len(warnings) == 0
+
+	mark := "✅"
+	if !ok {
+		mark = "❌"
+	} else if len(warnings) > 0 {
+		mark = "⚠️ "
+	}
+	fmt.Printf("%s %s: %s (%d injector(s), %d validator(s), %s)\n",
+		mark, path, scenario.Name, len(scenario.Injectors), len(scenario.Validators), runLength(scenario))
+	for _, warning := range warnings {
+		fmt.Printf("   warning: %s\n", warning)
+	}
+
+	return ok
+}
+
+// runLength describes how long a scenario runs
+func runLength(scenario *config.Scenario) string {
+	switch {
+	case scenario.Duration > 0:
+		return "runs for " + scenario.Duration.String()
+	case scenario.Repeat > 0:
+		return fmt.Sprintf("%d iteration(s)", scenario.Repeat)
+	default:
+		return "run length set in code"
+	}
+}
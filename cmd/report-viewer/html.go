@@ -0,0 +1,238 @@
+package main
+
+import (
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// htmlTestCase is a test case prepared for the HTML template
+type htmlTestCase struct {
+	Name      string
+	Classname string
+	Status    string
+	Class     string
+	Time      float64
+	Type      string
+	Message   string
+	Details   string
+	Output    string
+
+	Properties []chaoskit.JUnitProperty
+}
+
+// htmlReport is the data rendered by htmlTemplate
+type htmlReport struct {
+	Suite       string
+	Timestamp   string
+	Duration    float64
+	Verdict     string
+	VerdictCSS  string
+	Total       int
+	Passed      int
+	Failures    int
+	Warnings    int
+	SuccessRate float64
+	Report      *chaoskit.Report
+	Shards      []htmlShard
+	TestCases   []htmlTestCase
+	GeneratedAt string
+}
+
+// htmlShard is the outcome of one merged report file
+type htmlShard struct {
+	Path    string
+	Verdict string
+	Class   string
+	Passed  int
+	Total   int
+	Time    float64
+}
+
+// verdictLabel returns the label and CSS class of a verdict
+func verdictLabel(verdict TestCaseVerdict) (string, string) {
+	switch verdict {
+	case VerdictFail:
+		return "FAIL", "fail"
+	case VerdictUnstable:
+		return "UNSTABLE", "unstable"
+	default:
+		return "PASS", "pass"
+	}
+}
+
+// writeHTMLReport renders the suite (with the ChaosKit report of a JSON input,
+// or the shards it was merged from) as a self-contained HTML page
+func writeHTMLReport(w io.Writer, suite *chaoskit.JUnitTestSuite, chaosReport *chaoskit.Report, shards []shard) error {
+	overallVerdict, passCount, unstableCount, failCount := calculateOverallVerdict(suite.TestCases)
+	if chaosReport != nil {
+		overallVerdict = reportVerdict(chaosReport)
+	}
+	totalTests := len(suite.TestCases)
+	if totalTests == 0 {
+		totalTests = suite.Tests
+	}
+
+	report := htmlReport{
+		Suite:       suite.Name,
+		Timestamp:   suite.Timestamp,
+		Duration:    suite.Time,
+		Total:       totalTests,
+		Passed:      passCount,
+		Failures:    failCount,
+		Warnings:    unstableCount,
+		GeneratedAt: time.Now().Format("2006-01-02 15:04:05"),
+	}
+	if t, err := time.Parse(time.RFC3339, suite.Timestamp); err == nil {
+		report.Timestamp = t.Format("2006-01-02 15:04:05")
+	}
+	if totalTests > 0 {
+		report.SuccessRate = float64(passCount) / float64(totalTests) * 100
+	}
+
+	report.Verdict, report.VerdictCSS = verdictLabel(overallVerdict)
+	report.Report = chaosReport
+
+	for _, s := range shards {
+		verdict, passed := s.verdict()
+		label, class := verdictLabel(verdict)
+		report.Shards = append(report.Shards, htmlShard{
+			Path:    s.path,
+			Verdict: label,
+			Class:   class,
+			Passed:  passed,
+			Total:   len(s.suite.TestCases),
+			Time:    s.suite.Time,
+		})
+	}
+
+	for _, testCase := range suite.TestCases {
+		tc := htmlTestCase{
+			Name:      testCase.Name,
+			Classname: testCase.Classname,
+			Status:    "PASS",
+			Class:     "pass",
+			Time:      testCase.Time,
+			Output:    strings.TrimSpace(testCase.SystemOut),
+		}
+		if testCase.Properties != nil {
+			tc.Properties = testCase.Properties.Properties
+		}
+		switch {
+		case testCase.Failure != nil:
+			tc.Status, tc.Class = "FAIL", "fail"
+			tc.Type, tc.Message = testCase.Failure.Type, testCase.Failure.Message
+			tc.Details = strings.TrimSpace(testCase.Failure.Content)
+		case testCase.Error != nil:
+			tc.Status, tc.Class = "ERROR", "unstable"
+			tc.Type, tc.Message = testCase.Error.Type, testCase.Error.Message
+			tc.Details = strings.TrimSpace(testCase.Error.Content)
+		}
+		report.TestCases = append(report.TestCases, tc)
+	}
+
+	return htmlTemplate.Execute(w, report)
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>ChaosKit Report: {{.Suite}}</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Roboto, Helvetica, Arial, sans-serif; margin: 0; background: #f5f6f8; color: #1f2328; }
+  main { max-width: 1100px; margin: 0 auto; padding: 24px; }
+  h1 { font-size: 22px; margin: 0 0 4px; }
+  .meta { color: #656d76; font-size: 13px; margin-bottom: 20px; }
+  .verdict { display: inline-block; padding: 6px 14px; border-radius: 6px; font-weight: 600; color: #fff; margin-bottom: 20px; }
+  .verdict.pass { background: #1a7f37; } .verdict.unstable { background: #bf8700; } .verdict.fail { background: #cf222e; }
+  .cards { display: grid; grid-template-columns: repeat(auto-fit, minmax(150px, 1fr)); gap: 12px; margin-bottom: 24px; }
+  .card { background: #fff; border: 1px solid #d0d7de; border-radius: 6px; padding: 12px 16px; }
+  .card .label { color: #656d76; font-size: 12px; text-transform: uppercase; }
+  .card .value { font-size: 22px; font-weight: 600; margin-top: 4px; }
+  table { width: 100%; border-collapse: collapse; background: #fff; border: 1px solid #d0d7de; border-radius: 6px; }
+  th, td { text-align: left; padding: 8px 12px; border-bottom: 1px solid #d0d7de; vertical-align: top; font-size: 14px; }
+  th { background: #f6f8fa; font-size: 12px; text-transform: uppercase; color: #656d76; }
+  .status { font-weight: 600; } .status.pass { color: #1a7f37; } .status.unstable { color: #bf8700; } .status.fail { color: #cf222e; }
+  table.shards { margin-bottom: 24px; }
+  .analysis { background: #fff; border: 1px solid #d0d7de; border-radius: 6px; padding: 4px 16px 12px; margin-bottom: 24px; font-size: 14px; }
+  .analysis h2 { font-size: 16px; } .analysis h3 { font-size: 13px; text-transform: uppercase; color: #656d76; margin-bottom: 4px; }
+  li.fail { color: #cf222e; } li.unstable { color: #bf8700; }
+  .classname { color: #656d76; font-size: 12px; }
+  details { margin-top: 6px; } summary { cursor: pointer; color: #0969da; font-size: 13px; }
+  pre { background: #f6f8fa; padding: 8px; border-radius: 4px; white-space: pre-wrap; word-break: break-word; font-size: 12px; }
+  .props { font-size: 12px; color: #656d76; margin-top: 4px; }
+  footer { color: #656d76; font-size: 12px; margin-top: 24px; }
+</style>
+</head>
+<body>
+<main>
+  <h1>{{.Suite}}</h1>
+  <div class="meta">{{if .Timestamp}}Executed {{.Timestamp}} · {{end}}Duration {{printf "%.2f" .Duration}}s</div>
+  <div class="verdict {{.VerdictCSS}}">VERDICT: {{.Verdict}}</div>
+  {{with .Report}}<p>{{.Summary}}</p>{{end}}
+  <div class="cards">
+    <div class="card"><div class="label">Total Tests</div><div class="value">{{.Total}}</div></div>
+    <div class="card"><div class="label">Passed</div><div class="value">{{.Passed}}</div></div>
+    <div class="card"><div class="label">Failures</div><div class="value">{{.Failures}}</div></div>
+    <div class="card"><div class="label">Warnings</div><div class="value">{{.Warnings}}</div></div>
+    <div class="card"><div class="label">Success Rate</div><div class="value">{{printf "%.2f" .SuccessRate}}%</div></div>
+  </div>
+  {{with .Report}}
+  <section class="analysis">
+    <h2>Analysis</h2>
+    <p>{{.TotalIterations}} iterations, {{.FailureCount}} failed · p50 {{.P50Duration}} · p90 {{.P90Duration}} · p99 {{.P99Duration}}</p>
+    {{if .Scenarios}}<h3>Scenarios</h3><ul>{{range .Scenarios}}<li>{{.ScenarioName}}: <strong>{{.Verdict}}</strong> ({{.SuccessCount}}/{{.TotalIterations}})</li>{{end}}</ul>{{end}}
+    {{if .CriticalFailures}}<h3>Critical failures</h3><ul>{{range .CriticalFailures}}<li class="fail"><strong>{{.ValidatorName}}</strong>: {{.Message}} ({{.Occurrences}}×)</li>{{end}}</ul>{{end}}
+    {{if .Warnings}}<h3>Warnings</h3><ul>{{range .Warnings}}<li class="unstable"><strong>{{.ValidatorName}}</strong>: {{.Message}} ({{.Occurrences}}×)</li>{{end}}</ul>{{end}}
+    {{if .Regressions}}<h3>Regressions</h3><ul>{{range .Regressions}}<li>{{.}}</li>{{end}}</ul>{{end}}
+    {{with .Analysis}}
+    {{if .ByClass}}<h3>Failure classes</h3><ul>{{range $class, $count := .ByClass}}<li>{{$class}}: {{$count}}</li>{{end}}</ul>{{end}}
+    {{if .TopErrors}}<h3>Top errors</h3><ol>{{range .TopErrors}}<li>{{.ErrorPattern}} ({{.Count}})</li>{{end}}</ol>{{end}}
+    {{end}}
+    {{if .Injectors}}<h3>Chaos applied</h3><ul>{{range .Injectors}}<li>{{.Name}}: {{.Injections}} injections</li>{{end}}</ul>{{end}}
+  </section>
+  {{end}}
+  {{if .Shards}}
+  <table class="shards">
+    <thead><tr><th>Shard</th><th>Verdict</th><th>Passed</th><th>Time</th></tr></thead>
+    <tbody>
+    {{range .Shards}}
+      <tr><td>{{.Path}}</td><td class="status {{.Class}}">{{.Verdict}}</td><td>{{.Passed}}/{{.Total}}</td><td>{{printf "%.2f" .Time}}s</td></tr>
+    {{end}}
+    </tbody>
+  </table>
+  {{end}}
+  {{if .TestCases}}
+  <table>
+    <thead><tr><th>#</th><th>Status</th><th>Test Case</th><th>Time</th></tr></thead>
+    <tbody>
+    {{range $i, $tc := .TestCases}}
+      <tr>
+        <td>{{$i | inc}}</td>
+        <td class="status {{$tc.Class}}">{{$tc.Status}}</td>
+        <td>
+          <div>{{$tc.Name}}</div>
+          <div class="classname">{{$tc.Classname}}</div>
+          {{if $tc.Message}}<div>{{if $tc.Type}}<strong>{{$tc.Type}}:</strong> {{end}}{{$tc.Message}}</div>{{end}}
+          {{if $tc.Properties}}<div class="props">{{range $tc.Properties}}{{if .Value}}{{.Name}}={{.Value}} {{end}}{{end}}</div>{{end}}
+          {{if $tc.Details}}<details><summary>Details</summary><pre>{{$tc.Details}}</pre></details>{{end}}
+          {{if $tc.Output}}<details><summary>Output</summary><pre>{{$tc.Output}}</pre></details>{{end}}
+        </td>
+        <td>{{if $tc.Time}}{{printf "%.3f" $tc.Time}}s{{end}}</td>
+      </tr>
+    {{end}}
+    </tbody>
+  </table>
+  {{end}}
+  <footer>Report generated by ChaosKit Report Viewer at {{.GeneratedAt}}</footer>
+</main>
+</body>
+</html>
+`))
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// loadSuite reads a report file: JUnit XML, a JSON report saved with
+// Reporter.SaveReport or the JSON written by Reporter.SaveJSON. For JSON
+// inputs the ChaosKit report is returned too, keeping the verdict, warnings
+// and failure analysis that JUnit XML loses.
+func loadSuite(path string) (*chaoskit.JUnitTestSuite, *chaoskit.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading file: %w", err)
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var suite chaoskit.JUnitTestSuite
+		if err := xml.Unmarshal(data, &suite); err != nil {
+			return nil, nil, fmt.Errorf("parsing XML: %w", err)
+		}
+
+		return &suite, nil, nil
+	}
+
+	reporter, report, err := decodeJSON(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+
+	xmlStr, err := reporter.GenerateJUnitXML(report)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var suite chaoskit.JUnitTestSuite
+	if err := xml.Unmarshal([]byte(xmlStr), &suite); err != nil {
+		return nil, nil, fmt.Errorf("parsing XML: %w", err)
+	}
+
+	return &suite, report, nil
+}
+
+// decodeJSON decodes a saved report, or rebuilds the reporter of a
+// Reporter.SaveJSON document and calculates its verdict with default thresholds
+func decodeJSON(data []byte) (*chaoskit.Reporter, *chaoskit.Report, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := fields["executions"]; !ok {
+		report, err := chaoskit.DecodeReport(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return chaoskit.NewReporter(), report, nil
+	}
+
+	reporter, err := chaoskit.DecodeReporter(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report, err := reporter.GetVerdict(chaoskit.DefaultThresholds())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reporter, report, nil
+}
+
+// reportVerdict maps a ChaosKit verdict to a test case verdict
+func reportVerdict(report *chaoskit.Report) TestCaseVerdict {
+	switch report.Verdict {
+	case chaoskit.VerdictFail:
+		return VerdictFail
+	case chaoskit.VerdictUnstable:
+		return VerdictUnstable
+	default:
+		return VerdictPass
+	}
+}
+
+// displayAnalysis prints the details of a ChaosKit JSON report
+func displayAnalysis(report *chaoskit.Report) {
+	fmt.Println("🔬 ANALYSIS")
+	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	fmt.Printf("Iterations:     %d (%d failed, %.2f%% success)\n",
+		report.TotalIterations, report.FailureCount, report.SuccessRate*100)
+	fmt.Printf("P50/P90/P99:    %s / %s / %s\n", report.P50Duration, report.P90Duration, report.P99Duration)
+
+	for _, scenario := range report.Scenarios {
+		fmt.Printf("Scenario:       %s %s (%d/%d)\n",
+			scenario.ScenarioName, scenario.Verdict, scenario.SuccessCount, scenario.TotalIterations)
+	}
+
+	printFailures("Critical failures:", "❌", report.CriticalFailures)
+	printFailures("Warnings:", "⚠️ ", report.Warnings)
+
+	if len(report.Regressions) > 0 {
+		fmt.Println("Regressions:")
+		for _, regression := range report.Regressions {
+			fmt.Printf("  - %s\n", regression)
+		}
+	}
+
+	if analysis := report.Analysis; analysis != nil {
+		if len(analysis.ByClass) > 0 {
+			fmt.Println("Failure classes:")
+			for _, class := range []chaoskit.FailureClass{
+				chaoskit.FailureTargetError,
+				chaoskit.FailureValidatorViolation,
+				chaoskit.FailureInjectedFault,
+				chaoskit.FailureFramework,
+			} {
+				if count := analysis.ByClass[class]; count > 0 {
+					fmt.Printf("  - %s: %d\n", class, count)
+				}
+			}
+		}
+		if len(analysis.TopErrors) > 0 {
+			fmt.Println("Top errors:")
+			for i, e := range analysis.TopErrors {
+				fmt.Printf("  %d. %s (%d occurrences)\n", i+1, e.ErrorPattern, e.Count)
+			}
+		}
+		for _, correlation := range analysis.Correlations {
+			fmt.Printf("Correlation:    %s\n", correlation)
+		}
+	}
+
+	for _, injector := range report.Injectors {
+		fmt.Printf("Injector:       %s (%d injections)\n", injector.Name, injector.Injections)
+	}
+	fmt.Println()
+}
+
+// printFailures prints validator failures under a title
+func printFailures(title, icon string, failures []chaoskit.ValidationFailure) {
+	if len(failures) == 0 {
+		return
+	}
+
+	fmt.Println(title)
+	for _, failure := range failures {
+		fmt.Printf("  %s %s: %s (occurred %d times)\n",
+			icon, failure.ValidatorName, failure.Message, failure.Occurrences)
+	}
+}
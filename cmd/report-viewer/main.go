@@ -1,7 +1,6 @@
 package main
 
 import (
-	"encoding/xml"
 	"flag"
 	"fmt"
 	"os"
@@ -12,34 +11,103 @@ import (
 )
 
 func main() {
+	var files fileList
+	flag.Var(&files, "file", "Path or glob of JUnit XML or JSON (Reporter.SaveReport, Reporter.SaveJSON) report files; repeat to merge shards")
+
 	var (
-		filePath = flag.String("file", "", "Path to JUnit XML report file")
 		verbose  = flag.Bool("verbose", false, "Show detailed information for each test case")
+		diffPath = flag.String("diff", "", "Path to a previous JSON report (Reporter.SaveReport) or JSON results (Reporter.SaveJSON) to compare -file against")
+		format   = flag.String("format", "text", "Output format: text or html")
+		watch    = flag.String("watch", "", "Directory, NDJSON result file (WithResultSink) or - for stdin to watch live")
+		interval = flag.Duration("interval", 2*time.Second, "Refresh interval of -watch")
 	)
 	flag.Parse()
 
-	if *filePath == "" {
-		_, _ = fmt.Fprintf(os.Stderr, "Usage: %s -file <path-to-junit-xml>\n", os.Args[0])
+	if *watch != "" {
+		os.Exit(runWatch(*watch, *interval))
+	}
+
+	if len(files) == 0 || (*format != "text" && *format != "html") {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage: %s -file <path-to-junit-xml> [-file ...] [-format text|html]\n", os.Args[0])
+		_, _ = fmt.Fprintf(os.Stderr, "       %s -file 'reports/*.xml'\n", os.Args[0])
+		_, _ = fmt.Fprintf(os.Stderr, "       %s -file <current-report.json> -diff <previous-report.json>\n", os.Args[0])
+		_, _ = fmt.Fprintf(os.Stderr, "       %s -watch <results-dir|results.ndjson|->\n", os.Args[0])
 		flag.PrintDefaults()
 		os.Exit(1)
 	}
 
-	// Read XML file
-	data, err := os.ReadFile(*filePath)
+	paths, err := files.expand()
 	if err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "Error reading file: %v\n", err)
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
-	// Parse XML
-	var suite chaoskit.JUnitTestSuite
-	if err := xml.Unmarshal(data, &suite); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "Error parsing XML: %v\n", err)
+	if *diffPath != "" {
+		if len(paths) != 1 {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: -diff compares exactly one -file report\n")
+			os.Exit(1)
+		}
+		os.Exit(displayDiff(*diffPath, paths[0]))
+	}
+
+	suite, report, shards, err := loadSuites(paths)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
+	if *format == "html" {
+		if err := writeHTMLReport(os.Stdout, suite, report, shards); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error writing HTML: %v\n", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	// Display report
-	displayReport(&suite, *verbose)
+	displayReport(suite, report, shards, *verbose)
+}
+
+// displayDiff compares two JSON reports and returns the process exit code.
+// Like -file, it accepts saved reports and Reporter.SaveJSON results.
+func displayDiff(previousPath, currentPath string) int {
+	previous, err := loadDiffReport(previousPath)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error reading previous report: %v\n", err)
+		return 1
+	}
+
+	current, err := loadDiffReport(currentPath)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Error reading current report: %v\n", err)
+		return 1
+	}
+
+	diff := chaoskit.DiffReports(previous, current)
+	fmt.Print(diff.String())
+
+	if diff.HasRegressions() {
+		return 1
+	}
+
+	return 0
+}
+
+// loadDiffReport reads a JSON report of -diff, rebuilding it from the results
+// of a Reporter.SaveJSON document (see decodeJSON)
+func loadDiffReport(path string) (*chaoskit.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	_, report, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse report %s: %w", path, err)
+	}
+
+	return report, nil
 }
 
 // TestCaseVerdict represents the verdict for a single test case
@@ -90,7 +158,7 @@ func calculateOverallVerdict(testCases []chaoskit.JUnitTestCase) (TestCaseVerdic
 	return overallVerdict, passCount, unstableCount, failCount
 }
 
-func displayReport(suite *chaoskit.JUnitTestSuite, verbose bool) {
+func displayReport(suite *chaoskit.JUnitTestSuite, report *chaoskit.Report, shards []shard, verbose bool) {
 	fmt.Println("╔════════════════════════════════════════════════════════════╗")
 	fmt.Println("║              ChaosKit JUnit XML Report Viewer              ║")
 	fmt.Println("╚════════════════════════════════════════════════════════════╝")
@@ -98,6 +166,9 @@ func displayReport(suite *chaoskit.JUnitTestSuite, verbose bool) {
 
 	// Calculate verdicts from test cases
 	overallVerdict, passCount, unstableCount, failCount := calculateOverallVerdict(suite.TestCases)
+	if report != nil {
+		overallVerdict = reportVerdict(report)
+	}
 	totalTests := len(suite.TestCases)
 	if totalTests == 0 {
 		totalTests = suite.Tests // Fallback to suite.Tests if no test cases
@@ -139,8 +210,19 @@ func displayReport(suite *chaoskit.JUnitTestSuite, verbose bool) {
 	case VerdictPass:
 		fmt.Println("✅ ALL TESTS PASSED")
 	}
+	if report != nil && report.Summary != "" {
+		fmt.Printf("   %s\n", report.Summary)
+	}
 	fmt.Println()
 
+	if report != nil {
+		displayAnalysis(report)
+	}
+
+	if len(shards) > 0 {
+		displayShards(shards)
+	}
+
 	// Test cases
 	if len(suite.TestCases) > 0 {
 		fmt.Println("🧪 TEST CASES")
@@ -164,6 +246,14 @@ func displayReport(suite *chaoskit.JUnitTestSuite, verbose bool) {
 				if testCase.Time > 0 {
 					fmt.Printf("   Time:   %.3f seconds\n", testCase.Time)
 				}
+				if testCase.Properties != nil && len(testCase.Properties.Properties) > 0 {
+					fmt.Println("   Properties:")
+					for _, property := range testCase.Properties.Properties {
+						if property.Value != "" {
+							fmt.Printf("     %s: %s\n", property.Name, property.Value)
+						}
+					}
+				}
 
 				if testCase.Failure != nil {
 					fmt.Printf("   Failure Type: %s\n", testCase.Failure.Type)
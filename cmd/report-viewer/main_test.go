@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// saveResults writes the JSON results of 10 iterations with failed failing
+// ones and returns their path
+func saveResults(t *testing.T, dir, name string, failed int) string {
+	t.Helper()
+
+	reporter := chaoskit.NewReporter()
+	for i := 1; i <= 10; i++ {
+		result := chaoskit.ExecutionResult{
+			ScenarioName: "checkout",
+			Iteration:    i,
+			Success:      i > failed,
+			Duration:     time.Millisecond,
+			Timestamp:    time.Unix(int64(i), 0).UTC(),
+		}
+		if !result.Success {
+			result.Error = errors.New("step failed: connection refused")
+		}
+		reporter.AddResult(result)
+	}
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, reporter.SaveJSON(path))
+
+	return path
+}
+
+func TestDisplayDiff(t *testing.T) {
+	dir := t.TempDir()
+	healthy := saveResults(t, dir, "healthy.json", 0)
+	degraded := saveResults(t, dir, "degraded.json", 5)
+	junk := filepath.Join(dir, "junk.json")
+	require.NoError(t, os.WriteFile(junk, []byte(`{"schema_version":2}`), 0o600))
+
+	report, err := loadDiffReport(healthy)
+	require.NoError(t, err)
+	assert.Equal(t, "checkout", report.ScenarioName)
+	assert.Equal(t, 10, report.TotalIterations)
+
+	assert.Equal(t, 0, displayDiff(healthy, healthy))
+	assert.Equal(t, 1, displayDiff(healthy, degraded), "results are rebuilt into reports and compared")
+	assert.Equal(t, 1, displayDiff(junk, healthy))
+	assert.Equal(t, 1, displayDiff(healthy, junk))
+	assert.Equal(t, 1, displayDiff(healthy, filepath.Join(dir, "missing.json")))
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// fileList is a repeatable -file flag; each value may be a glob pattern
+type fileList []string
+
+func (f *fileList) String() string { return strings.Join(*f, ",") }
+
+func (f *fileList) Set(value string) error {
+	*f = append(*f, value)
+
+	return nil
+}
+
+// expand resolves glob patterns into file paths, keeping the flag order
+func (f fileList) expand() ([]string, error) {
+	var paths []string
+	seen := make(map[string]struct{})
+	for _, pattern := range f {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			if strings.ContainsAny(pattern, "*?[") {
+				return nil, fmt.Errorf("no files match %q", pattern)
+			}
+			// Plain paths are reported by the reader
+			matches = []string{pattern}
+		}
+		for _, path := range matches {
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+			paths = append(paths, path)
+		}
+	}
+
+	return paths, nil
+}
+
+// shard is a suite loaded from one of several merged files
+type shard struct {
+	path   string
+	suite  *chaoskit.JUnitTestSuite
+	report *chaoskit.Report
+}
+
+// verdict returns the verdict of the shard, preferring the ChaosKit report verdict
+func (s shard) verdict() (TestCaseVerdict, int) {
+	verdict, passCount, _, _ := calculateOverallVerdict(s.suite.TestCases)
+	if s.report != nil {
+		verdict = reportVerdict(s.report)
+	}
+
+	return verdict, passCount
+}
+
+// loadSuites loads every file and merges them into one suite. A single file
+// is returned as is, with its ChaosKit report for JSON inputs; shards are
+// returned only when more than one file was loaded.
+func loadSuites(paths []string) (*chaoskit.JUnitTestSuite, *chaoskit.Report, []shard, error) {
+	shards := make([]shard, 0, len(paths))
+	for _, path := range paths {
+		suite, report, err := loadSuite(path)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("%s: %w", path, err)
+		}
+		shards = append(shards, shard{path: path, suite: suite, report: report})
+	}
+
+	if len(shards) == 1 {
+		return shards[0].suite, shards[0].report, nil, nil
+	}
+
+	return mergeSuites(shards), nil, shards, nil
+}
+
+// mergeSuites combines suites of parallel shards: test cases are concatenated,
+// counters summed, the duration is the longest shard and the timestamp the earliest
+func mergeSuites(shards []shard) *chaoskit.JUnitTestSuite {
+	merged := &chaoskit.JUnitTestSuite{}
+
+	var names []string
+	seenNames := make(map[string]struct{})
+	var earliest time.Time
+	for _, s := range shards {
+		suite := s.suite
+		if _, ok := seenNames[suite.Name]; !ok && suite.Name != "" {
+			seenNames[suite.Name] = struct{}{}
+			names = append(names, suite.Name)
+		}
+
+		merged.Tests += suite.Tests
+		merged.Failures += suite.Failures
+		merged.Errors += suite.Errors
+		merged.Time = max(merged.Time, suite.Time)
+		merged.TestCases = append(merged.TestCases, suite.TestCases...)
+
+		if t, err := time.Parse(time.RFC3339, suite.Timestamp); err == nil && (earliest.IsZero() || t.Before(earliest)) {
+			earliest = t
+			merged.Timestamp = suite.Timestamp
+		}
+	}
+
+	merged.Name = fmt.Sprintf("%s (%d shards)", strings.Join(names, ", "), len(shards))
+
+	return merged
+}
+
+// displayShards prints the verdict of each merged shard
+func displayShards(shards []shard) {
+	fmt.Println("🧩 SHARDS")
+	fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	for _, s := range shards {
+		verdict, passCount := s.verdict()
+		status := "✅ PASS"
+		switch verdict {
+		case VerdictFail:
+			status = "❌ FAIL"
+		case VerdictUnstable:
+			status = "⚠️  UNSTABLE"
+		}
+		fmt.Printf("%s  %s (%d/%d passed, %.2fs)\n",
+			status, s.path, passCount, len(s.suite.TestCases), s.suite.Time)
+	}
+	fmt.Println()
+}
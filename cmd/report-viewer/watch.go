@@ -0,0 +1,320 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// maxWatchFailures limits the recent failures shown in watch mode
+const maxWatchFailures = 5
+
+// ndjsonTail incrementally reads results appended to an NDJSON file
+// written with chaoskit.WithResultSink
+type ndjsonTail struct {
+	path    string
+	offset  int64
+	partial []byte
+}
+
+// read returns results appended since the last call. It reports truncated
+// when the file shrank (e.g. a new campaign overwrote it).
+func (t *ndjsonTail) read() (results []chaoskit.ExecutionResult, truncated bool, err error) {
+	f, err := os.Open(t.path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	if info.Size() < t.offset {
+		t.offset, t.partial = 0, nil
+		truncated = true
+	}
+	if info.Size() == t.offset {
+		return nil, truncated, nil
+	}
+
+	if _, err := f.Seek(t.offset, io.SeekStart); err != nil {
+		return nil, truncated, err
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, truncated, err
+	}
+	t.offset += int64(len(data))
+
+	// Keep an incomplete last line for the next read
+	data = append(t.partial, data...)
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		t.partial = data
+
+		return nil, truncated, nil
+	}
+	t.partial = append([]byte(nil), data[end+1:]...)
+
+	results, err = chaoskit.DecodeResults(bytes.NewReader(data[:end+1]))
+
+	return results, truncated, err
+}
+
+// watcher re-renders a live summary of in-progress results
+type watcher struct {
+	source   string
+	interval time.Duration
+
+	reporter *chaoskit.Reporter
+	tails    map[string]*ndjsonTail
+}
+
+// runWatch watches source until interrupted and returns the process exit code.
+// The source is a directory of NDJSON result files and finished report files,
+// a single NDJSON file, or "-" for an NDJSON stream on stdin.
+func runWatch(source string, interval time.Duration) int {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	w := &watcher{
+		source:   source,
+		interval: interval,
+		reporter: chaoskit.NewReporter(),
+		tails:    make(map[string]*ndjsonTail),
+	}
+
+	if source == "-" {
+		return w.watchStdin(ctx)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		suite, shards, err := w.poll()
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		w.render(os.Stdout, suite, shards)
+
+		select {
+		case <-ctx.Done():
+			return 0
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchStdin reads an NDJSON stream from stdin, re-rendering on every interval
+// and once more when the stream ends
+func (w *watcher) watchStdin(ctx context.Context) int {
+	done := make(chan error, 1)
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			results, err := chaoskit.DecodeResults(bytes.NewReader(scanner.Bytes()))
+			if err != nil {
+				done <- err
+				return
+			}
+			for _, result := range results {
+				w.reporter.AddResult(result)
+			}
+		}
+		done <- scanner.Err()
+	}()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return 0
+		case err := <-done:
+			w.render(os.Stdout, nil, nil)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return 1
+			}
+
+			return 0
+		case <-ticker.C:
+			w.render(os.Stdout, nil, nil)
+		}
+	}
+}
+
+// poll reads new results from NDJSON files and reloads finished report files
+func (w *watcher) poll() (*chaoskit.JUnitTestSuite, []shard, error) {
+	info, err := os.Stat(w.source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ndjsonPaths := []string{w.source}
+	var reportPaths []string
+	if info.IsDir() {
+		ndjsonPaths, reportPaths, err = listWatchFiles(w.source)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := w.readResults(ndjsonPaths); err != nil {
+		return nil, nil, err
+	}
+
+	if len(reportPaths) == 0 {
+		return nil, nil, nil
+	}
+
+	suite, _, shards, err := loadSuites(reportPaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	if shards == nil {
+		shards = []shard{{path: reportPaths[0], suite: suite}}
+	}
+
+	return suite, shards, nil
+}
+
+// readResults appends new results of every NDJSON file to the reporter.
+// A truncated file restarts the summary from scratch.
+func (w *watcher) readResults(paths []string) error {
+	var added []chaoskit.ExecutionResult
+	for _, path := range paths {
+		tail, ok := w.tails[path]
+		if !ok {
+			tail = &ndjsonTail{path: path}
+			w.tails[path] = tail
+		}
+
+		results, truncated, err := tail.read()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if truncated {
+			w.reporter = chaoskit.NewReporter()
+			for _, other := range w.tails {
+				if other != tail {
+					other.offset, other.partial = 0, nil
+				}
+			}
+
+			return w.readResults(paths)
+		}
+		added = append(added, results...)
+	}
+
+	for _, result := range added {
+		w.reporter.AddResult(result)
+	}
+
+	return nil
+}
+
+// listWatchFiles splits the files of a watched directory into NDJSON result
+// streams (*.ndjson, *.jsonl) and report files (*.xml, *.json)
+func listWatchFiles(dir string) (ndjsonPaths, reportPaths []string, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".ndjson", ".jsonl":
+			ndjsonPaths = append(ndjsonPaths, path)
+		case ".xml", ".json":
+			reportPaths = append(reportPaths, path)
+		}
+	}
+	sort.Strings(ndjsonPaths)
+	sort.Strings(reportPaths)
+
+	return ndjsonPaths, reportPaths, nil
+}
+
+// render clears the terminal and prints the live summary
+func (w *watcher) render(out io.Writer, suite *chaoskit.JUnitTestSuite, shards []shard) {
+	var buf bytes.Buffer
+
+	_, _ = fmt.Fprint(&buf, "\033[H\033[2J")
+	_, _ = fmt.Fprintf(&buf, "ChaosKit live results: %s (updated %s, Ctrl+C to stop)\n",
+		w.source, time.Now().Format("15:04:05"))
+	_, _ = fmt.Fprintln(&buf, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+
+	results := w.reporter.Results()
+	report, err := w.reporter.GetVerdict(chaoskit.DefaultThresholds())
+	if err != nil {
+		_, _ = fmt.Fprintln(&buf, "Waiting for results...")
+	} else {
+		_, _ = fmt.Fprintf(&buf, "Verdict so far: %s\n", report.Verdict)
+		_, _ = fmt.Fprintf(&buf, "%s\n\n", report.Summary)
+		_, _ = fmt.Fprintf(&buf, "Iterations:     %d (%d failed)\n", report.TotalIterations, report.FailureCount)
+		_, _ = fmt.Fprintf(&buf, "Success Rate:   %.2f%%\n", report.SuccessRate*100)
+		_, _ = fmt.Fprintf(&buf, "P50/P90/P99:    %s / %s / %s\n", report.P50Duration, report.P90Duration, report.P99Duration)
+		if last := results[len(results)-1]; !last.Timestamp.IsZero() {
+			_, _ = fmt.Fprintf(&buf, "Last Result:    %s (%s ago)\n",
+				last.Timestamp.Format("15:04:05"), time.Since(last.Timestamp).Truncate(time.Second))
+		}
+		for _, scenario := range report.Scenarios {
+			_, _ = fmt.Fprintf(&buf, "Scenario:       %s %s (%d/%d)\n",
+				scenario.ScenarioName, scenario.Verdict, scenario.SuccessCount, scenario.TotalIterations)
+		}
+
+		failures := recentFailures(results, maxWatchFailures)
+		if len(failures) > 0 {
+			_, _ = fmt.Fprintln(&buf, "\nRecent failures:")
+			for _, result := range failures {
+				_, _ = fmt.Fprintf(&buf, "  ❌ %s #%d: %v\n", result.ScenarioName, result.Iteration, result.Error)
+			}
+		}
+	}
+
+	if suite != nil {
+		verdict, passCount, _, _ := calculateOverallVerdict(suite.TestCases)
+		label, _ := verdictLabel(verdict)
+		_, _ = fmt.Fprintf(&buf, "\nFinished reports: %s (%d/%d test cases passed)\n", label, passCount, len(suite.TestCases))
+		for _, s := range shards {
+			verdict, passCount := s.verdict()
+			label, _ := verdictLabel(verdict)
+			_, _ = fmt.Fprintf(&buf, "  %-8s %s (%d/%d)\n", label, s.path, passCount, len(s.suite.TestCases))
+		}
+	}
+
+	_, _ = out.Write(buf.Bytes())
+}
+
+// recentFailures returns up to limit most recent failed results, newest first
+func recentFailures(results []chaoskit.ExecutionResult, limit int) []chaoskit.ExecutionResult {
+	var failures []chaoskit.ExecutionResult
+	for i := len(results) - 1; i >= 0 && len(failures) < limit; i-- {
+		if !results[i].Success {
+			failures = append(failures, results[i])
+		}
+	}
+
+	return failures
+}
@@ -0,0 +1,664 @@
+package config
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	"github.com/rom8726/chaoskit/kube"
+	"github.com/rom8726/chaoskit/validators"
+)
+
+// ComponentInfo describes an injector or validator type of scenario files
+type ComponentInfo = chaoskit.ComponentInfo
+
+// ParamInfo describes a parameter of a component type
+type ParamInfo = chaoskit.ParamInfo
+
+// injectorFactory builds an injector of one type from its parameters
+type injectorFactory struct {
+	description string
+	params      []ParamInfo
+	build       func(p Params) (chaoskit.Injector, error)
+}
+
+// validatorFactory builds a validator of one type from its parameters
+type validatorFactory struct {
+	description string
+	params      []ParamInfo
+	build       func(p Params) (chaoskit.Validator, error)
+}
+
+// injectorFactories holds the built-in injector types, registered in init
+var injectorFactories = map[string]injectorFactory{
+	"delay": {
+		description: "Random delay in MaybeDelay calls",
+		params: []ParamInfo{
+			{Name: "min", Description: "minimum delay (default 0)"},
+			{Name: "max", Description: "maximum delay (default min)"},
+			{Name: "probability", Description: "chance per call, 0-1 (default 1)"},
+			{Name: "interval", Description: "delay at most once per interval instead of by probability"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			minDelay, err := p.Duration("min", 0)
+			if err != nil {
+				return nil, err
+			}
+			maxDelay, err := p.Duration("max", minDelay)
+			if err != nil {
+				return nil, err
+			}
+			if maxDelay < minDelay {
+				return nil, fmt.Errorf("max (%s) is less than min (%s)", maxDelay, minDelay)
+			}
+			interval, err := p.Duration("interval", 0)
+			if err != nil {
+				return nil, err
+			}
+			if interval > 0 {
+				return injectors.RandomDelayWithInterval(minDelay, maxDelay, interval), nil
+			}
+			probability, err := probabilityParam(p, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.RandomDelayWithProbability(minDelay, maxDelay, probability), nil
+		},
+	},
+	"error": {
+		description: "Error returned by MaybeError calls",
+		params: []ParamInfo{
+			{Name: "message", Description: "error message (default \"chaos: injected error\")"},
+			{Name: "probability", Description: "chance per call, 0-1 (default 0.1)"},
+			{Name: "fault", Description: "typed fault instead of message: timeout, unavailable, throttled or corruption"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			probability, err := probabilityParam(p, 0.1)
+			if err != nil {
+				return nil, err
+			}
+			fault, err := p.String("fault", "")
+			if err != nil {
+				return nil, err
+			}
+			if fault != "" {
+				kind := chaoskit.FaultKind(fault)
+				switch kind {
+				case chaoskit.FaultTimeout, chaoskit.FaultUnavailable, chaoskit.FaultThrottled, chaoskit.FaultCorruption:
+					return injectors.FaultWithProbability(kind, probability), nil
+				default:
+					return nil, fmt.Errorf("fault must be timeout, unavailable, throttled or corruption, got %q", fault)
+				}
+			}
+			message, err := p.String("message", "chaos: injected error")
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.ErrorWithProbability(message, probability), nil
+		},
+	},
+	"panic": {
+		description: "Panic in MaybePanic calls",
+		params: []ParamInfo{
+			{Name: "probability", Description: "chance per call, 0-1 (default 0.01)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			probability, err := probabilityParam(p, 0.01)
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.PanicProbability(probability), nil
+		},
+	},
+	"value-corruption": {
+		description: "Corrupts values passed through MaybeCorrupt calls",
+		params: []ParamInfo{
+			{Name: "probability", Description: "chance per call, 0-1 (default 0.05)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			probability, err := probabilityParam(p, 0.05)
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.CorruptionProbability(probability), nil
+		},
+	},
+	"context-cancellation": {
+		description: "Cancels contexts derived with MaybeCancelContext",
+		params: []ParamInfo{
+			{Name: "probability", Description: "chance per call, 0-1 (default 0.1)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			probability, err := probabilityParam(p, 0.1)
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.NewContextCancellationInjector(probability), nil
+		},
+	},
+	"messaging": {
+		description: "Publish failures, redeliveries, reordering and consumer disconnects in wrapped NATS/AMQP clients",
+		params: []ParamInfo{
+			{Name: "subject", Description: "NATS subject or AMQP queue pattern, * and > wildcards (default: all)"},
+			{Name: "publish_failure", Description: "chance per publish, 0-1 (default 0)"},
+			{Name: "redelivery", Description: "chance per delivery, 0-1 (default 0)"},
+			{Name: "reorder", Description: "chance per delivery, 0-1 (default 0)"},
+			{Name: "disconnect", Description: "chance per delivery, 0-1 (default 0)"},
+			{Name: "disconnect_duration", Description: "time a disconnected consumer stays offline (default 1s)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			rule := injectors.MessagingRule{}
+			var err error
+			if rule.Pattern, err = p.String("subject", ""); err != nil {
+				return nil, err
+			}
+			err = probabilityParams(p, map[string]*float64{
+				"publish_failure": &rule.PublishFailure,
+				"redelivery":      &rule.Redelivery,
+				"reorder":         &rule.Reorder,
+				"disconnect":      &rule.Disconnect,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if rule.DisconnectDuration, err = p.Duration("disconnect_duration", injectors.DefaultDisconnectDuration); err != nil {
+				return nil, err
+			}
+
+			return injectors.MessagingChaos(rule), nil
+		},
+	},
+	"cpu-stress": {
+		description: "Busy CPU workers while the scenario runs",
+		params: []ParamInfo{
+			{Name: "workers", Description: "number of busy goroutines (default 1)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			workers, err := p.Int("workers", 1)
+			if err != nil {
+				return nil, err
+			}
+			if workers <= 0 {
+				return nil, fmt.Errorf("workers must be positive, got %d", workers)
+			}
+
+			return injectors.CPUStress(workers), nil
+		},
+	},
+	"memory-pressure": {
+		description: "Memory held while the scenario runs",
+		params: []ParamInfo{
+			{Name: "size_mb", Description: "allocated memory in MB (default 64)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			size, err := p.Int("size_mb", 64)
+			if err != nil {
+				return nil, err
+			}
+			if size <= 0 {
+				return nil, fmt.Errorf("size_mb must be positive, got %d", size)
+			}
+
+			return injectors.MemoryPressure(size), nil
+		},
+	},
+	"aws-fis": {
+		description: "AWS Fault Injection Service experiment running while the scenario runs",
+		params: []ParamInfo{
+			{Name: "template", Description: "experiment template ID (required)"},
+			{Name: "region", Description: "AWS region (default AWS_REGION)"},
+			{Name: "endpoint", Description: "FIS API URL (default https://fis.<region>.amazonaws.com)"},
+			{Name: "timeout", Description: "wait for the experiment to run or stop (default 5m)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			template, err := p.String("template", "")
+			if err != nil {
+				return nil, err
+			}
+			if template == "" {
+				return nil, fmt.Errorf("template is required")
+			}
+			region, err := p.String("region", cmp.Or(os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION")))
+			if err != nil {
+				return nil, err
+			}
+			if region == "" {
+				return nil, fmt.Errorf("region is required when AWS_REGION is not set")
+			}
+			endpoint, err := p.String("endpoint", "")
+			if err != nil {
+				return nil, err
+			}
+			timeout, err := p.Duration("timeout", injectors.DefaultFISTimeout)
+			if err != nil {
+				return nil, err
+			}
+
+			// Credentials are read from the environment when the experiment starts
+			client := injectors.NewFISClient(endpoint, region, injectors.AWSCredentials{})
+
+			return injectors.AWSFISExperiment(client, template, timeout), nil
+		},
+	},
+	"s3-fault": {
+		description: "S3 faults (SlowDown, partial reads, stale reads, multipart failures) in clients wrapped with injectors.WrapS3Client",
+		params: []ParamInfo{
+			{Name: "slow_down", Description: "chance per request of a 503 SlowDown, 0-1 (default 0)"},
+			{Name: "partial_read", Description: "chance per GetObject of a truncated body, 0-1 (default 0)"},
+			{Name: "staleness", Description: "chance per read of missing a recent write, 0-1 (default 0)"},
+			{Name: "stale_window", Description: "how long reads may miss a write (default 5s)"},
+			{Name: "multipart_failure", Description: "chance per UploadPart or CompleteMultipartUpload of a failure, 0-1 (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			var cfg injectors.S3FaultConfig
+			err := probabilityParams(p, map[string]*float64{
+				"slow_down":         &cfg.SlowDown,
+				"partial_read":      &cfg.PartialRead,
+				"staleness":         &cfg.Staleness,
+				"multipart_failure": &cfg.MultipartFailure,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if cfg.StaleWindow, err = p.Duration("stale_window", injectors.DefaultS3StaleWindow); err != nil {
+				return nil, err
+			}
+
+			return injectors.S3Fault(cfg), nil
+		},
+	},
+	"secrets-fault": {
+		description: "Vault/secret manager failures (sealed, 403, latency, rotated credentials) in stores wrapped with injectors.WrapSecretStore",
+		params: []ParamInfo{
+			{Name: "sealed", Description: "chance per read of a sealed store, 0-1 (default 0)"},
+			{Name: "seal_duration", Description: "time the store stays sealed (default: only the failed read)"},
+			{Name: "forbidden", Description: "chance per read of a permission denied error, 0-1 (default 0)"},
+			{Name: "latency", Description: "chance per read of a delay, 0-1 (default 0)"},
+			{Name: "delay", Description: "delay of slowed reads (default 2s)"},
+			{Name: "rotation", Description: "chance per CheckSecret call of rotated credentials, 0-1 (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			var cfg injectors.SecretsFaultConfig
+			err := probabilityParams(p, map[string]*float64{
+				"sealed":    &cfg.Sealed,
+				"forbidden": &cfg.Forbidden,
+				"latency":   &cfg.Latency,
+				"rotation":  &cfg.Rotation,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if cfg.SealDuration, err = p.Duration("seal_duration", 0); err != nil {
+				return nil, err
+			}
+			if cfg.Delay, err = p.Duration("delay", injectors.DefaultSecretsDelay); err != nil {
+				return nil, err
+			}
+
+			return injectors.SecretsFault(cfg), nil
+		},
+	},
+	"ebpf-network": {
+		description: "Latency and drops of the traffic of ports with an eBPF program (Linux, -tags ebpf)",
+		params: []ParamInfo{
+			{Name: "ports", Description: "TCP/UDP ports whose traffic is affected (required)"},
+			{Name: "interface", Description: "network interface (default lo)"},
+			{Name: "delay", Description: "added latency, needs the fq qdisc (default 0)"},
+			{Name: "drop", Description: "chance per packet of a drop, 0-1 (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			var cfg injectors.EBPFNetworkConfig
+			var err error
+			if cfg.Ports, err = p.Ints("ports"); err != nil {
+				return nil, err
+			}
+			if len(cfg.Ports) == 0 {
+				return nil, fmt.Errorf("ports is required")
+			}
+			if cfg.Interface, err = p.String("interface", injectors.DefaultEBPFInterface); err != nil {
+				return nil, err
+			}
+			if cfg.Delay, err = p.Duration("delay", 0); err != nil {
+				return nil, err
+			}
+			if cfg.Drop, err = p.Float("drop", 0); err != nil {
+				return nil, err
+			}
+			if cfg.Drop < 0 || cfg.Drop > 1 {
+				return nil, fmt.Errorf("drop must be between 0 and 1, got %v", cfg.Drop)
+			}
+			if cfg.Delay == 0 && cfg.Drop == 0 {
+				return nil, fmt.Errorf("delay or drop is required")
+			}
+
+			return injectors.EBPFNetwork(cfg), nil
+		},
+	},
+	"istio-fault": {
+		description: "Istio VirtualService fault injection (delays, aborts) for a mesh service",
+		params: []ParamInfo{
+			{Name: "host", Description: "service host, e.g. reviews or reviews.shop.svc.cluster.local (required)"},
+			{Name: "namespace", Description: "namespace of the VirtualService (default: of the pod, else default)"},
+			{Name: "virtual_service", Description: "VirtualService routing the host (default: first label of host)"},
+			{Name: "delay", Description: "fixed delay of delayed requests"},
+			{Name: "delay_percent", Description: "percentage of requests delayed (default 0)"},
+			{Name: "abort_percent", Description: "percentage of requests aborted (default 0)"},
+			{Name: "abort_status", Description: "HTTP status of aborted requests (default 503)"},
+			{Name: "server", Description: "Kubernetes API URL with the KUBE_TOKEN bearer token (default: in-cluster config)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			host, err := p.String("host", "")
+			if err != nil {
+				return nil, err
+			}
+			if host == "" {
+				return nil, fmt.Errorf("host is required")
+			}
+			namespace, err := p.String("namespace", kube.InClusterNamespace())
+			if err != nil {
+				return nil, err
+			}
+			var cfg injectors.IstioFaultConfig
+			if cfg.VirtualService, err = p.String("virtual_service", ""); err != nil {
+				return nil, err
+			}
+			if cfg.Delay, err = p.Duration("delay", 0); err != nil {
+				return nil, err
+			}
+			if cfg.DelayPercent, err = p.Float("delay_percent", 0); err != nil {
+				return nil, err
+			}
+			if cfg.AbortPercent, err = p.Float("abort_percent", 0); err != nil {
+				return nil, err
+			}
+			if cfg.AbortStatus, err = p.Int("abort_status", injectors.DefaultIstioAbortStatus); err != nil {
+				return nil, err
+			}
+			if cfg.DelayPercent <= 0 && cfg.AbortPercent <= 0 {
+				return nil, fmt.Errorf("delay_percent or abort_percent is required")
+			}
+			if cfg.DelayPercent > 0 && cfg.Delay <= 0 {
+				return nil, fmt.Errorf("delay is required with delay_percent")
+			}
+			server, err := p.String("server", "")
+			if err != nil {
+				return nil, err
+			}
+
+			client := kube.NewClient(server, os.Getenv("KUBE_TOKEN"), nil)
+			if server == "" {
+				if client, err = kube.InClusterClient(); err != nil {
+					return nil, err
+				}
+			}
+
+			return injectors.IstioFault(client, namespace, host, cfg), nil
+		},
+	},
+}
+
+// validatorFactories holds the built-in validator types, registered in init
+var validatorFactories = map[string]validatorFactory{
+	chaoskit.ValidatorGoroutineLimit: {
+		description: "Limits the number of goroutines",
+		params: []ParamInfo{
+			{Name: "max", Description: "maximum goroutines; 0 checks for leaks instead (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			limit, err := p.Int("max", 0)
+			if err != nil {
+				return nil, err
+			}
+			if limit <= 0 {
+				return validators.NoGoroutineLeak(), nil
+			}
+
+			return validators.GoroutineLimit(limit), nil
+		},
+	},
+	chaoskit.ValidatorMaxErrors: {
+		description: "Limits the number of step errors",
+		params: []ParamInfo{
+			{Name: "max", Description: "maximum errors (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			limit, err := p.Int("max", 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return validators.MaxErrors(limit), nil
+		},
+	},
+	chaoskit.ValidatorExecutionTime: {
+		description: "Bounds the iteration duration",
+		params: []ParamInfo{
+			{Name: "min", Description: "minimum duration (default 0)"},
+			{Name: "max", Description: "maximum duration (required)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			minTime, err := p.Duration("min", 0)
+			if err != nil {
+				return nil, err
+			}
+			maxTime, err := p.Duration("max", 0)
+			if err != nil {
+				return nil, err
+			}
+			if maxTime <= 0 {
+				return nil, fmt.Errorf("max is required")
+			}
+
+			return validators.ExecutionTime(minTime, maxTime), nil
+		},
+	},
+	chaoskit.ValidatorPanics: {
+		description: "Limits the number of recovered panics",
+		params: []ParamInfo{
+			{Name: "max", Description: "maximum panics (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			limit, err := p.Int("max", 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return validators.NoPanics(limit), nil
+		},
+	},
+	chaoskit.ValidatorInfiniteLoop: {
+		description: "Fails iterations that do not finish in time",
+		params: []ParamInfo{
+			{Name: "timeout", Description: "maximum iteration time (required)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			timeout, err := p.Duration("timeout", 0)
+			if err != nil {
+				return nil, err
+			}
+			if timeout <= 0 {
+				return nil, fmt.Errorf("timeout is required")
+			}
+
+			return validators.NoInfiniteLoop(timeout), nil
+		},
+	},
+	chaoskit.ValidatorMemoryLimit: {
+		description: "Limits heap memory",
+		params: []ParamInfo{
+			{Name: "max_mb", Description: "maximum heap in MB (required)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			limit, err := p.Int("max_mb", 0)
+			if err != nil {
+				return nil, err
+			}
+			if limit <= 0 {
+				return nil, fmt.Errorf("max_mb must be positive, got %d", limit)
+			}
+
+			return validators.MemoryUnderLimit(uint64(limit) * 1024 * 1024), nil
+		},
+	},
+	chaoskit.ValidatorRecursionDepth: {
+		description: "Limits the recursion depth recorded by the target",
+		params: []ParamInfo{
+			{Name: "max", Description: "maximum depth (required)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			limit, err := p.Int("max", 0)
+			if err != nil {
+				return nil, err
+			}
+			if limit <= 0 {
+				return nil, fmt.Errorf("max must be positive, got %d", limit)
+			}
+
+			return validators.RecursionDepthLimit(limit), nil
+		},
+	},
+	chaoskit.ValidatorSlowIteration: {
+		description: "Flags iterations slower than a timeout",
+		params: []ParamInfo{
+			{Name: "timeout", Description: "slow iteration threshold (required)"},
+		},
+		build: func(p Params) (chaoskit.Validator, error) {
+			timeout, err := p.Duration("timeout", 0)
+			if err != nil {
+				return nil, err
+			}
+			if timeout <= 0 {
+				return nil, fmt.Errorf("timeout is required")
+			}
+
+			return validators.NoSlowIteration(timeout), nil
+		},
+	},
+}
+
+func init() {
+	for typ, factory := range injectorFactories {
+		registerInjector(typ, factory)
+	}
+	for typ, factory := range validatorFactories {
+		registerValidator(typ, factory)
+	}
+}
+
+// registerInjector registers a built-in injector type
+func registerInjector(typ string, factory injectorFactory) {
+	chaoskit.RegisterInjectorFactory(typ, func(params map[string]any) (chaoskit.Injector, error) {
+		return factory.build(params)
+	}, factoryOptions(factory.description, factory.params)...)
+}
+
+// registerValidator registers a built-in validator type
+func registerValidator(typ string, factory validatorFactory) {
+	chaoskit.RegisterValidatorFactory(typ, func(params map[string]any) (chaoskit.Validator, error) {
+		return factory.build(params)
+	}, factoryOptions(factory.description, factory.params)...)
+}
+
+func factoryOptions(description string, params []ParamInfo) []chaoskit.FactoryOption {
+	opts := []chaoskit.FactoryOption{chaoskit.WithFactoryDescription(description)}
+	for _, param := range params {
+		opts = append(opts, chaoskit.WithFactoryParam(param.Name, param.Description))
+	}
+
+	return opts
+}
+
+// InjectorTypes returns the injector types supported in scenario files,
+// including those registered with chaoskit.RegisterInjectorFactory
+func InjectorTypes() []string {
+	return componentTypes(chaoskit.RegisteredInjectors())
+}
+
+// ValidatorTypes returns the validator types supported in scenario files,
+// including those registered with chaoskit.RegisterValidatorFactory
+func ValidatorTypes() []string {
+	return componentTypes(chaoskit.RegisteredValidators())
+}
+
+// DescribeInjectors returns the injector types of scenario files with their parameters
+func DescribeInjectors() []ComponentInfo {
+	return chaoskit.RegisteredInjectors()
+}
+
+// DescribeValidators returns the validator types of scenario files with their parameters
+func DescribeValidators() []ComponentInfo {
+	return chaoskit.RegisteredValidators()
+}
+
+func componentTypes(infos []ComponentInfo) []string {
+	types := make([]string, len(infos))
+	for i, info := range infos {
+		types[i] = info.Type
+	}
+
+	return types
+}
+
+// paramNames returns the names of params
+func paramNames(params []ParamInfo) []string {
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = param.Name
+	}
+
+	return names
+}
+
+// probabilityParam reads the probability parameter and checks its range
+func probabilityParam(p Params, def float64) (float64, error) {
+	probability, err := p.Float("probability", def)
+	if err != nil {
+		return 0, err
+	}
+	if probability < 0 || probability > 1 {
+		return 0, fmt.Errorf("probability must be between 0 and 1, got %v", probability)
+	}
+
+	return probability, nil
+}
+
+// probabilityParams reads the probabilities of a fault injector, at least
+// one of which must be set
+func probabilityParams(p Params, targets map[string]*float64) error {
+	var total float64
+	for _, name := range sortedKeys(targets) {
+		value, err := p.Float(name, 0)
+		if err != nil {
+			return err
+		}
+		if value < 0 || value > 1 {
+			return fmt.Errorf("%s must be between 0 and 1, got %v", name, value)
+		}
+		*targets[name] = value
+		total += value
+	}
+	if total == 0 {
+		return fmt.Errorf("one of %s is required", strings.Join(sortedKeys(targets), ", "))
+	}
+
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
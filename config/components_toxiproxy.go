@@ -0,0 +1,97 @@
+//go:build !no_toxiproxy
+
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+)
+
+// The ToxiProxy injector types are left out of builds tagged no_toxiproxy
+func init() {
+	registerInjector("toxiproxy-latency", injectorFactory{
+		description: "ToxiProxy latency toxic on a proxy",
+		params: []ParamInfo{
+			{Name: "host", Description: "ToxiProxy API URL (default http://localhost:8474)"},
+			{Name: "proxy", Description: "proxy name (required)"},
+			{Name: "latency", Description: "added latency (default 100ms)"},
+			{Name: "jitter", Description: "latency jitter (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			client, proxy, err := toxiproxyParams(p)
+			if err != nil {
+				return nil, err
+			}
+			latency, err := p.Duration("latency", 100*time.Millisecond)
+			if err != nil {
+				return nil, err
+			}
+			jitter, err := p.Duration("jitter", 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.ToxiProxyLatency(client, proxy, latency, jitter), nil
+		},
+	})
+	registerInjector("toxiproxy-bandwidth", injectorFactory{
+		description: "ToxiProxy bandwidth limit on a proxy",
+		params: []ParamInfo{
+			{Name: "host", Description: "ToxiProxy API URL (default http://localhost:8474)"},
+			{Name: "proxy", Description: "proxy name (required)"},
+			{Name: "rate_kbps", Description: "bandwidth limit in KB/s (default 100)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			client, proxy, err := toxiproxyParams(p)
+			if err != nil {
+				return nil, err
+			}
+			rate, err := p.Int("rate_kbps", 100)
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.ToxiProxyBandwidth(client, proxy, int64(rate)), nil
+		},
+	})
+	registerInjector("toxiproxy-timeout", injectorFactory{
+		description: "ToxiProxy timeout toxic on a proxy",
+		params: []ParamInfo{
+			{Name: "host", Description: "ToxiProxy API URL (default http://localhost:8474)"},
+			{Name: "proxy", Description: "proxy name (required)"},
+			{Name: "timeout", Description: "close connections after this time; 0 holds data until the toxic is removed (default 0)"},
+		},
+		build: func(p Params) (chaoskit.Injector, error) {
+			client, proxy, err := toxiproxyParams(p)
+			if err != nil {
+				return nil, err
+			}
+			timeout, err := p.Duration("timeout", 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return injectors.ToxiProxyTimeout(client, proxy, timeout), nil
+		},
+	})
+}
+
+// toxiproxyParams reads the ToxiProxy API host and proxy name
+func toxiproxyParams(p Params) (*injectors.ToxiProxyClient, string, error) {
+	host, err := p.String("host", "http://localhost:8474")
+	if err != nil {
+		return nil, "", err
+	}
+	proxy, err := p.String("proxy", "")
+	if err != nil {
+		return nil, "", err
+	}
+	if proxy == "" {
+		return nil, "", fmt.Errorf("proxy is required")
+	}
+
+	return injectors.NewToxiProxyClient(host), proxy, nil
+}
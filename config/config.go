@@ -0,0 +1,370 @@
+// Package config loads declarative chaos scenarios from YAML or JSON files.
+//
+// A scenario file names the injectors and validators to use (see InjectorTypes
+// and ValidatorTypes), the run length and the verdict thresholds:
+//
+//	name: checkout-soak
+//	target:
+//	  type: http
+//	  url: http://localhost:8080/health
+//	injectors:
+//	  - type: delay
+//	    params: {min: 10ms, max: 50ms, probability: 0.2}
+//	validators:
+//	  - type: goroutine-limit
+//	    params: {max: 200}
+//	repeat: 100
+//	thresholds:
+//	  min_success_rate: 0.95
+//
+// Scenarios with a configured target are built with Build. Programs that
+// provide their own target and steps use Builder and add them in code.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// Scenario is a declarative chaos scenario
+type Scenario struct {
+	// Name is the scenario name used in reports
+	Name string `json:"name" yaml:"name"`
+
+	// Target is the system under test; optional when the program provides it
+	Target *TargetConfig `json:"target,omitempty" yaml:"target,omitempty"`
+
+	// Injectors lists fault injectors applied during the run
+	Injectors []Component `json:"injectors,omitempty" yaml:"injectors,omitempty"`
+
+	// Validators lists invariants checked after every iteration
+	Validators []Component `json:"validators,omitempty" yaml:"validators,omitempty"`
+
+	// Repeat is the number of iterations (ignored when Duration is set)
+	Repeat int `json:"repeat,omitempty" yaml:"repeat,omitempty"`
+
+	// Duration runs iterations until the duration elapses
+	Duration time.Duration `json:"duration,omitempty" yaml:"duration,omitempty"`
+
+	// Seed makes injector decisions reproducible
+	Seed *int64 `json:"seed,omitempty" yaml:"seed,omitempty"`
+
+	// ChaosPoints configures the faults of named chaos points
+	ChaosPoints []ChaosPoint `json:"chaos_points,omitempty" yaml:"chaos_points,omitempty"`
+
+	// Thresholds decide the verdict; chaoskit.DefaultThresholds when empty
+	Thresholds *chaoskit.SuccessThresholds `json:"thresholds,omitempty" yaml:"thresholds,omitempty"`
+}
+
+// ChaosPoint configures the faults of a named chaos point (see chaoskit.ChaosPoint)
+type ChaosPoint struct {
+	// Name is the point name used by MaybeErrorAt, ShouldFailAt etc.
+	Name string `json:"name" yaml:"name"`
+
+	// Error is the message of injected errors
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	// ErrorProbability is the probability of failing at the point
+	ErrorProbability float64 `json:"error_probability,omitempty" yaml:"error_probability,omitempty"`
+
+	// PanicProbability is the probability of panicking at the point
+	PanicProbability float64 `json:"panic_probability,omitempty" yaml:"panic_probability,omitempty"`
+
+	// Delay is the injected delay
+	Delay time.Duration `json:"delay,omitempty" yaml:"delay,omitempty"`
+
+	// DelayProbability is the probability of the delay
+	DelayProbability float64 `json:"delay_probability,omitempty" yaml:"delay_probability,omitempty"`
+
+	// MaxPerSecond caps injections at the point per second, whichever injector decides them
+	MaxPerSecond int `json:"max_per_second,omitempty" yaml:"max_per_second,omitempty"`
+
+	// MaxPerIteration caps injections at the point per iteration
+	MaxPerIteration int `json:"max_per_iteration,omitempty" yaml:"max_per_iteration,omitempty"`
+}
+
+// Component is an injector or validator of a registered type
+type Component struct {
+	// Type selects the constructor (e.g. "delay", "goroutine-limit")
+	Type string `json:"type" yaml:"type"`
+
+	// Params are the constructor parameters
+	Params Params `json:"params,omitempty" yaml:"params,omitempty"`
+}
+
+// Load reads and validates a scenario file
+func Load(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	scenario, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return scenario, nil
+}
+
+// Parse decodes and validates a scenario in YAML or JSON
+func Parse(data []byte) (*Scenario, error) {
+	// JSON is converted to YAML so both formats share decoding rules
+	// (duration strings, unknown field checks), as in chaoskit.LoadThresholds
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var doc map[string]any
+		if err := json.Unmarshal(trimmed, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse scenario: %w", err)
+		}
+		var err error
+		if data, err = yaml.Marshal(doc); err != nil {
+			return nil, fmt.Errorf("failed to parse scenario: %w", err)
+		}
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	scenario := &Scenario{}
+	if err := decoder.Decode(scenario); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+// Validate checks the scenario and builds every component once,
+// so parameter mistakes are reported before a run starts
+func (s *Scenario) Validate() error {
+	var errs []error
+
+	if s.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	if s.Repeat < 0 {
+		errs = append(errs, fmt.Errorf("repeat must be >= 0, got %d", s.Repeat))
+	}
+	if s.Duration < 0 {
+		errs = append(errs, fmt.Errorf("duration must be >= 0, got %s", s.Duration))
+	}
+	if s.Target != nil {
+		if err := s.Target.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("target: %w", err))
+		}
+		// Programs without a configured target may set the run length in code
+		if s.Repeat == 0 && s.Duration == 0 {
+			errs = append(errs, errors.New("repeat or duration is required"))
+		}
+	}
+	for i, component := range s.Injectors {
+		if _, err := buildInjector(component); err != nil {
+			errs = append(errs, fmt.Errorf("injectors[%d]: %w", i, err))
+		}
+	}
+	for i, component := range s.Validators {
+		if _, err := buildValidator(component); err != nil {
+			errs = append(errs, fmt.Errorf("validators[%d]: %w", i, err))
+		}
+	}
+	points := make(map[string]bool, len(s.ChaosPoints))
+	for i, point := range s.ChaosPoints {
+		if err := point.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("chaos_points[%d]: %w", i, err))
+		}
+		if points[point.Name] {
+			errs = append(errs, fmt.Errorf("chaos_points[%d]: duplicate point %q", i, point.Name))
+		}
+		points[point.Name] = true
+	}
+	if s.Thresholds != nil {
+		if err := s.Thresholds.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("thresholds: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SuccessThresholds returns the configured thresholds or chaoskit.DefaultThresholds
+func (s *Scenario) SuccessThresholds() *chaoskit.SuccessThresholds {
+	if s.Thresholds != nil {
+		return s.Thresholds
+	}
+
+	return chaoskit.DefaultThresholds()
+}
+
+// Builder returns a scenario builder with fresh injectors and validators,
+// run length and seed applied. The configured target, if any, is set with its steps.
+func (s *Scenario) Builder() (*chaoskit.ScenarioBuilder, error) {
+	builder := chaoskit.NewScenario(s.Name)
+
+	for i, component := range s.Injectors {
+		injector, err := buildInjector(component)
+		if err != nil {
+			return nil, fmt.Errorf("injectors[%d]: %w", i, err)
+		}
+		builder.Inject(component.Type, injector)
+	}
+	for i, component := range s.Validators {
+		validator, err := buildValidator(component)
+		if err != nil {
+			return nil, fmt.Errorf("validators[%d]: %w", i, err)
+		}
+		builder.Assert(component.Type, validator)
+	}
+
+	for _, point := range s.ChaosPoints {
+		builder.WithChaosPoints(point.build())
+	}
+
+	if s.Duration > 0 {
+		builder.RunFor(s.Duration)
+	} else if s.Repeat > 0 {
+		builder.Repeat(s.Repeat)
+	}
+	if s.Seed != nil {
+		builder.WithSeed(*s.Seed)
+	}
+
+	if s.Target != nil {
+		target, err := s.Target.build()
+		if err != nil {
+			return nil, fmt.Errorf("target: %w", err)
+		}
+		builder.WithTarget(target.target)
+		for _, step := range target.steps {
+			builder.Step(step.name, step.fn)
+		}
+	}
+
+	return builder, nil
+}
+
+// Build returns a runnable scenario. Injectors are created anew on every call,
+// so one config can be run repeatedly.
+func (s *Scenario) Build() (*chaoskit.Scenario, error) {
+	if s.Target == nil {
+		return nil, fmt.Errorf("scenario %s has no target", s.Name)
+	}
+
+	builder, err := s.Builder()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.Build(), nil
+}
+
+// buildInjector creates an injector from its config
+func buildInjector(component Component) (chaoskit.Injector, error) {
+	factory, info, ok := chaoskit.LookupInjectorFactory(component.Type)
+	if !ok {
+		return nil, fmt.Errorf("unknown injector type %q (supported: %s)",
+			component.Type, strings.Join(InjectorTypes(), ", "))
+	}
+	if err := component.Params.checkKnown(paramNames(info.Params)); err != nil {
+		return nil, fmt.Errorf("%s: %w", component.Type, err)
+	}
+
+	injector, err := factory(component.Params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", component.Type, err)
+	}
+
+	return injector, nil
+}
+
+// buildValidator creates a validator from its config
+func buildValidator(component Component) (chaoskit.Validator, error) {
+	factory, info, ok := chaoskit.LookupValidatorFactory(component.Type)
+	if !ok {
+		return nil, fmt.Errorf("unknown validator type %q (supported: %s)",
+			component.Type, strings.Join(ValidatorTypes(), ", "))
+	}
+	if err := component.Params.checkKnown(paramNames(info.Params)); err != nil {
+		return nil, fmt.Errorf("%s: %w", component.Type, err)
+	}
+
+	validator, err := factory(component.Params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", component.Type, err)
+	}
+
+	return validator, nil
+}
+
+// validate checks the point name and probabilities
+func (p ChaosPoint) validate() error {
+	var errs []error
+
+	if p.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	for _, probability := range []struct {
+		name  string
+		value float64
+	}{
+		{"error_probability", p.ErrorProbability},
+		{"panic_probability", p.PanicProbability},
+		{"delay_probability", p.DelayProbability},
+	} {
+		if probability.value < 0 || probability.value > 1 {
+			errs = append(errs, fmt.Errorf("%s must be in [0, 1], got %g", probability.name, probability.value))
+		}
+	}
+	if p.Delay < 0 {
+		errs = append(errs, fmt.Errorf("delay must be >= 0, got %s", p.Delay))
+	}
+	if p.DelayProbability > 0 && p.Delay == 0 {
+		errs = append(errs, errors.New("delay is required with delay_probability"))
+	}
+	if p.MaxPerSecond < 0 {
+		errs = append(errs, fmt.Errorf("max_per_second must be >= 0, got %d", p.MaxPerSecond))
+	}
+	if p.MaxPerIteration < 0 {
+		errs = append(errs, fmt.Errorf("max_per_iteration must be >= 0, got %d", p.MaxPerIteration))
+	}
+	if p.ErrorProbability == 0 && p.PanicProbability == 0 && p.DelayProbability == 0 &&
+		p.MaxPerSecond == 0 && p.MaxPerIteration == 0 {
+		errs = append(errs, errors.New("no fault or limit configured"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// build returns the chaoskit configuration of the point
+func (p ChaosPoint) build() *chaoskit.ChaosPointConfig {
+	point := chaoskit.ChaosPoint(p.Name)
+	if p.ErrorProbability > 0 {
+		var err error
+		if p.Error != "" {
+			err = errors.New(p.Error)
+		}
+		point.FailWith(err, p.ErrorProbability)
+	}
+	if p.PanicProbability > 0 {
+		point.Panic(p.PanicProbability)
+	}
+	if p.DelayProbability > 0 {
+		point.Delay(p.Delay, p.DelayProbability)
+	}
+	if p.MaxPerSecond > 0 || p.MaxPerIteration > 0 {
+		point.Limit(chaoskit.InjectionLimit{PerSecond: p.MaxPerSecond, PerIteration: p.MaxPerIteration})
+	}
+
+	return point
+}
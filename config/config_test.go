@@ -0,0 +1,331 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/chaoskit"
+)
+
+const scenarioYAML = `
+name: checkout
+target:
+  type: http
+  url: %s
+  expect_status: 200
+injectors:
+  - type: delay
+    params: {min: 1ms, max: 2ms, probability: 0.5}
+validators:
+  - type: goroutine-limit
+    params: {max: 1000}
+repeat: 5
+seed: 42
+thresholds:
+  min_success_rate: 0.9
+  critical_validators: [goroutine-limit]
+`
+
+func TestParse_RunsHTTPScenario(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	cfg, err := Parse([]byte(fmt.Sprintf(scenarioYAML, server.URL)))
+	require.NoError(t, err)
+	assert.Equal(t, "checkout", cfg.Name)
+	assert.Equal(t, 0.9, cfg.SuccessThresholds().MinSuccessRate)
+
+	scenario, err := cfg.Build()
+	require.NoError(t, err)
+
+	executor := chaoskit.NewExecutor()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+	assert.Equal(t, int32(5), requests.Load())
+
+	report, err := executor.Reporter().GetVerdict(cfg.SuccessThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, chaoskit.VerdictPass, report.Verdict)
+}
+
+func TestParse_JSON(t *testing.T) {
+	cfg, err := Parse([]byte(`{
+		"name": "noop",
+		"target": {"type": "noop"},
+		"injectors": [{"type": "error", "params": {"probability": 1}}],
+		"duration": "50ms"
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, 50*time.Millisecond, cfg.Duration)
+
+	scenario, err := cfg.Build()
+	require.NoError(t, err)
+
+	executor := chaoskit.NewExecutor(chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure))
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.NotEmpty(t, results)
+	assert.Equal(t, chaoskit.FailureInjectedFault, results[0].FailureClass)
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := Parse([]byte(`
+target: {type: ftp}
+injectors:
+  - type: delay
+    params: {min: 10ms, max: 1ms}
+  - type: earthquake
+  - type: error
+    params: {fault: meteor}
+  - type: ebpf-network
+    params: {ports: [5432, http], drop: 0.1}
+validators:
+  - type: goroutine-limit
+    params: {limit: 10}
+thresholds:
+  min_success_rate: 2
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "name is required")
+	assert.Contains(t, err.Error(), `unknown target type "ftp"`)
+	assert.Contains(t, err.Error(), "injectors[0]: delay: max (1ms) is less than min (10ms)")
+	assert.Contains(t, err.Error(), `injectors[1]: unknown injector type "earthquake"`)
+	assert.Contains(t, err.Error(), `injectors[2]: error: fault must be timeout, unavailable, throttled or corruption, got "meteor"`)
+	assert.Contains(t, err.Error(), "injectors[3]: ebpf-network: parameter ports: expected an integer, got http")
+	assert.Contains(t, err.Error(), "validators[0]: goroutine-limit: unknown parameter(s) limit")
+	assert.Contains(t, err.Error(), "thresholds:")
+
+	_, err = Parse([]byte("name: x\nrepat: 10\n"))
+	assert.Error(t, err)
+
+	_, err = Parse([]byte("name: x\ntarget: {type: noop}\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repeat or duration is required")
+}
+
+func TestParse_ChaosPoints(t *testing.T) {
+	cfg, err := Parse([]byte(`
+name: points
+repeat: 20
+seed: 1
+chaos_points:
+  - name: inventory.reserve
+    error: timeout
+    error_probability: 1
+  - name: payment.commit
+    delay: 1ms
+    delay_probability: 1
+  - name: inventory.loop
+    max_per_iteration: 1
+`))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Warnings())
+
+	builder, err := cfg.Builder()
+	require.NoError(t, err)
+
+	var errs []error
+	scenario := builder.
+		WithTarget(noopTarget{}).
+		Step("reserve", func(ctx context.Context, target chaoskit.Target) error {
+			chaoskit.MaybeDelayAt(ctx, "payment.commit")
+			errs = append(errs, chaoskit.MaybeErrorAt(ctx, "inventory.reserve"))
+			return nil
+		}).
+		Build()
+	require.NoError(t, chaoskit.NewExecutor().Run(context.Background(), scenario))
+	require.Len(t, errs, 20)
+	assert.True(t, chaoskit.IsInjected(errs[0]))
+	assert.EqualError(t, errs[0], "timeout")
+
+	_, err = Parse([]byte(`
+name: points
+chaos_points:
+  - error_probability: 1.5
+  - name: slow
+    delay_probability: 0.5
+  - name: slow
+    panic_probability: 0.1
+  - name: idle
+  - name: limited
+    max_per_second: -1
+`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "chaos_points[0]: name is required")
+	assert.Contains(t, err.Error(), "error_probability must be in [0, 1], got 1.5")
+	assert.Contains(t, err.Error(), "chaos_points[1]: delay is required with delay_probability")
+	assert.Contains(t, err.Error(), `chaos_points[2]: duplicate point "slow"`)
+	assert.Contains(t, err.Error(), "chaos_points[3]: no fault or limit configured")
+	assert.Contains(t, err.Error(), "chaos_points[4]: max_per_second must be >= 0, got -1")
+}
+
+func TestScenario_Warnings(t *testing.T) {
+	cfg, err := Parse([]byte(`
+name: warn
+target: {type: noop}
+repeat: 10
+duration: 1s
+validators:
+  - type: goroutine-limit
+    params: {max: 10}
+thresholds:
+  min_success_rate: 0
+  critical_validators: [goroutine-limit, goroutine_limit_10, memory-limit]
+  warning_validators: [goroutine-limit]
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"repeat (10) is ignored because duration (1s) is set",
+		"no injectors: the run injects no faults",
+		`thresholds: validator "goroutine-limit" is both critical and warning; critical wins`,
+		`thresholds.critical_validators: validator "memory-limit" is not used by the scenario`,
+		"thresholds: min_success_rate is 0, failed iterations never fail the run",
+	}, cfg.Warnings())
+
+	cfg, err = Parse([]byte(fmt.Sprintf(scenarioYAML, "http://localhost")))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Warnings())
+}
+
+func TestBuilder_WithoutTarget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scenario.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("name: custom\nrepeat: 3\n"), 0644))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+
+	_, err = cfg.Build()
+	assert.Error(t, err)
+
+	builder, err := cfg.Builder()
+	require.NoError(t, err)
+
+	steps := 0
+	scenario := builder.
+		WithTarget(noopTarget{}).
+		Step("count", func(ctx context.Context, target chaoskit.Target) error {
+			steps++
+			return nil
+		}).
+		Build()
+	require.NoError(t, chaoskit.NewExecutor().Run(context.Background(), scenario))
+	assert.Equal(t, 3, steps)
+}
+
+func TestDescribeComponents(t *testing.T) {
+	injectorInfos := DescribeInjectors()
+	require.Len(t, injectorInfos, len(InjectorTypes()))
+	validatorInfos := DescribeValidators()
+	require.Len(t, validatorInfos, len(ValidatorTypes()))
+
+	for _, info := range append(injectorInfos, validatorInfos...) {
+		assert.NotEmpty(t, info.Description, info.Type)
+		for _, param := range info.Params {
+			assert.NotEmpty(t, param.Description, "%s.%s", info.Type, param.Name)
+		}
+	}
+
+	assert.Equal(t, "aws-fis", injectorInfos[0].Type)
+	assert.Equal(t, "context-cancellation", injectorInfos[1].Type)
+	assert.Equal(t, "delay", injectorInfos[3].Type)
+	assert.Equal(t, []string{"min", "max", "probability", "interval"}, paramNames(injectorInfos[3].Params))
+}
+
+// A third-party injector type, registered once per process
+func init() {
+	chaoskit.RegisterInjectorFactory("test-noop", func(params map[string]any) (chaoskit.Injector, error) {
+		name, err := Params(params).String("name", "custom")
+		if err != nil {
+			return nil, err
+		}
+
+		return &customInjector{name: name}, nil
+	}, chaoskit.WithFactoryDescription("No-op injector"), chaoskit.WithFactoryParam("name", "injector name"))
+}
+
+type customInjector struct{ name string }
+
+func (i *customInjector) Name() string                     { return i.name }
+func (i *customInjector) Inject(ctx context.Context) error { return nil }
+func (i *customInjector) Stop(ctx context.Context) error   { return nil }
+
+func TestParse_RegisteredInjector(t *testing.T) {
+	cfg, err := Parse([]byte(`
+name: custom
+target: {type: noop}
+injectors:
+  - type: test-noop
+    params: {name: mine}
+repeat: 1
+`))
+	require.NoError(t, err)
+	scenario, err := cfg.Build()
+	require.NoError(t, err)
+	require.NoError(t, chaoskit.NewExecutor().Run(context.Background(), scenario))
+	assert.Contains(t, InjectorTypes(), "test-noop")
+
+	_, err = Parse([]byte(`
+name: custom
+target: {type: noop}
+injectors:
+  - type: test-noop
+    params: {rate: 1}
+repeat: 1
+`))
+	assert.ErrorContains(t, err, "test-noop")
+}
+
+func TestWatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scenario.yaml")
+	write := func(content string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	start := time.Now().Add(-time.Hour)
+	write("name: watched\ntarget: {type: noop}\nrepeat: 1\n", start)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan *Scenario, 4)
+	errs := make(chan error, 4)
+	go Watch(ctx, path, 5*time.Millisecond, func(s *Scenario, err error) {
+		if err != nil {
+			errs <- err
+			return
+		}
+		changes <- s
+	})
+	// Let the watcher record the initial file
+	time.Sleep(50 * time.Millisecond)
+
+	write("name: watched\ntarget: {type: noop}\nrepeat: 2\n", start.Add(time.Minute))
+	select {
+	case s := <-changes:
+		assert.Equal(t, 2, s.Repeat)
+	case <-time.After(5 * time.Second):
+		t.Fatal("change not reported")
+	}
+
+	write("name: watched\nrepeat: -1\n", start.Add(2*time.Minute))
+	select {
+	case err := <-errs:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("load error not reported")
+	}
+}
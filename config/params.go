@@ -0,0 +1,170 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Params holds component parameters as decoded from a scenario file
+type Params map[string]any
+
+// Float returns a numeric parameter or def when it is absent
+func (p Params) Float(key string, def float64) (float64, error) {
+	value, ok := p[key]
+	if !ok {
+		return def, nil
+	}
+
+	switch v := value.(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("parameter %s: expected a number, got %v", key, value)
+	}
+}
+
+// Int returns an integer parameter or def when it is absent
+func (p Params) Int(key string, def int) (int, error) {
+	value, ok := p[key]
+	if !ok {
+		return def, nil
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case uint64:
+		return int(v), nil
+	case float64:
+		if v != float64(int(v)) {
+			return 0, fmt.Errorf("parameter %s: expected an integer, got %v", key, v)
+		}
+
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("parameter %s: expected an integer, got %v", key, value)
+	}
+}
+
+// Duration returns a duration parameter ("50ms", "2s") or def when it is absent.
+// Plain numbers are read as milliseconds.
+func (p Params) Duration(key string, def time.Duration) (time.Duration, error) {
+	value, ok := p[key]
+	if !ok {
+		return def, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("parameter %s: %w", key, err)
+		}
+
+		return d, nil
+	case int, int64, uint64, float64:
+		ms, err := p.Float(key, 0)
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(ms * float64(time.Millisecond)), nil
+	default:
+		return 0, fmt.Errorf("parameter %s: expected a duration, got %v", key, value)
+	}
+}
+
+// String returns a string parameter or def when it is absent
+func (p Params) String(key, def string) (string, error) {
+	value, ok := p[key]
+	if !ok {
+		return def, nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("parameter %s: expected a string, got %v", key, value)
+	}
+
+	return s, nil
+}
+
+// Strings returns a list of strings parameter or nil when it is absent
+func (p Params) Strings(key string) ([]string, error) {
+	value, ok := p[key]
+	if !ok {
+		return nil, nil
+	}
+
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("parameter %s: expected a list, got %v", key, value)
+	}
+
+	out := make([]string, 0, len(list))
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("parameter %s: expected strings, got %v", key, item)
+		}
+		out = append(out, s)
+	}
+
+	return out, nil
+}
+
+// Ints returns a list of integers parameter or nil when it is absent
+func (p Params) Ints(key string) ([]int, error) {
+	value, ok := p[key]
+	if !ok {
+		return nil, nil
+	}
+
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("parameter %s: expected a list, got %v", key, value)
+	}
+
+	out := make([]int, 0, len(list))
+	for _, item := range list {
+		n, err := Params{key: item}.Int(key, 0)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, n)
+	}
+
+	return out, nil
+}
+
+// checkKnown returns an error naming parameters not in known
+func (p Params) checkKnown(known []string) error {
+	allowed := make(map[string]struct{}, len(known))
+	for _, key := range known {
+		allowed[key] = struct{}{}
+	}
+
+	var unknown []string
+	for key := range p {
+		if _, ok := allowed[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+
+	return fmt.Errorf("unknown parameter(s) %s (supported: %s)",
+		strings.Join(unknown, ", "), strings.Join(known, ", "))
+}
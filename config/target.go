@@ -0,0 +1,197 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// Target types
+const (
+	// TargetHTTP sends one HTTP request per iteration and checks the status code
+	TargetHTTP = "http"
+
+	// TargetNoop does nothing besides consulting the active injectors;
+	// useful to exercise injectors and validators without a system under test
+	TargetNoop = "noop"
+)
+
+// DefaultHTTPTimeout is the request timeout of HTTP targets
+const DefaultHTTPTimeout = 10 * time.Second
+
+// TargetConfig describes the system under test
+type TargetConfig struct {
+	// Type is TargetHTTP or TargetNoop
+	Type string `json:"type" yaml:"type"`
+
+	// URL is the request URL of HTTP targets
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+
+	// Method is the HTTP method (GET by default)
+	Method string `json:"method,omitempty" yaml:"method,omitempty"`
+
+	// Headers are added to every request
+	Headers map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+
+	// Body is the request body
+	Body string `json:"body,omitempty" yaml:"body,omitempty"`
+
+	// ExpectStatus is the expected status code; any 2xx when zero
+	ExpectStatus int `json:"expect_status,omitempty" yaml:"expect_status,omitempty"`
+
+	// Timeout limits every request (DefaultHTTPTimeout when zero)
+	Timeout time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// targetSteps is a target with the steps exercising it
+type targetSteps struct {
+	target chaoskit.Target
+	steps  []configStep
+}
+
+type configStep struct {
+	name string
+	fn   func(context.Context, chaoskit.Target) error
+}
+
+func (t *TargetConfig) validate() error {
+	switch t.Type {
+	case TargetHTTP:
+		u, err := url.Parse(t.URL)
+		if err != nil {
+			return fmt.Errorf("invalid url: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("url must be http or https, got %q", t.URL)
+		}
+		if t.ExpectStatus != 0 && (t.ExpectStatus < 100 || t.ExpectStatus > 599) {
+			return fmt.Errorf("invalid expect_status %d", t.ExpectStatus)
+		}
+		if t.Timeout < 0 {
+			return fmt.Errorf("timeout must be >= 0, got %s", t.Timeout)
+		}
+
+		return nil
+	case TargetNoop:
+		return nil
+	case "":
+		return errors.New("type is required")
+	default:
+		return fmt.Errorf("unknown target type %q (supported: %s, %s)", t.Type, TargetHTTP, TargetNoop)
+	}
+}
+
+func (t *TargetConfig) build() (*targetSteps, error) {
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+
+	if t.Type == TargetNoop {
+		return &targetSteps{
+			target: noopTarget{},
+			steps:  []configStep{{name: "chaos", fn: applyChaos}},
+		}, nil
+	}
+
+	target := &httpTarget{config: *t}
+
+	return &targetSteps{
+		target: target,
+		steps:  []configStep{{name: "request", fn: target.request}},
+	}, nil
+}
+
+// applyChaos consults the injectors available through the context helpers
+func applyChaos(ctx context.Context, _ chaoskit.Target) error {
+	chaoskit.MaybeDelay(ctx)
+	chaoskit.MaybePanic(ctx)
+
+	return chaoskit.MaybeError(ctx)
+}
+
+// noopTarget is a target without a system under test
+type noopTarget struct{}
+
+func (noopTarget) Name() string                       { return TargetNoop }
+func (noopTarget) Setup(ctx context.Context) error    { return nil }
+func (noopTarget) Teardown(ctx context.Context) error { return nil }
+
+// httpTarget sends one request per iteration
+type httpTarget struct {
+	config TargetConfig
+	client *http.Client
+}
+
+func (t *httpTarget) Name() string {
+	return "http " + t.config.URL
+}
+
+func (t *httpTarget) Setup(ctx context.Context) error {
+	timeout := t.config.Timeout
+	if timeout == 0 {
+		timeout = DefaultHTTPTimeout
+	}
+	t.client = &http.Client{Timeout: timeout}
+
+	return nil
+}
+
+func (t *httpTarget) Teardown(ctx context.Context) error {
+	t.client.CloseIdleConnections()
+
+	return nil
+}
+
+// request applies client-side chaos, sends the request and checks the status code
+func (t *httpTarget) request(ctx context.Context, _ chaoskit.Target) error {
+	if err := applyChaos(ctx, t); err != nil {
+		return err
+	}
+
+	ctx, cancel := chaoskit.MaybeCancelContext(ctx)
+	defer cancel()
+
+	method := t.config.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if t.config.Body != "" {
+		body = strings.NewReader(t.config.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, t.config.URL, body)
+	if err != nil {
+		return err
+	}
+	for key, value := range t.config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if t.config.ExpectStatus != 0 {
+		if resp.StatusCode != t.config.ExpectStatus {
+			return fmt.Errorf("unexpected status %d (expected %d)", resp.StatusCode, t.config.ExpectStatus)
+		}
+
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
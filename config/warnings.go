@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Warnings returns likely mistakes which do not prevent a run:
+// thresholds naming validators the scenario does not use, verdict
+// gates that can never fail and settings that are ignored.
+// The scenario must be valid (see Validate).
+func (s *Scenario) Warnings() []string {
+	var warnings []string
+
+	if s.Repeat > 0 && s.Duration > 0 {
+		warnings = append(warnings, fmt.Sprintf("repeat (%d) is ignored because duration (%s) is set", s.Repeat, s.Duration))
+	}
+	if len(s.Injectors) == 0 && len(s.ChaosPoints) == 0 {
+		warnings = append(warnings, "no injectors: the run injects no faults")
+	}
+
+	if s.Thresholds == nil {
+		return warnings
+	}
+	t := s.Thresholds
+
+	known := s.validatorNames()
+	check := func(field, name string) {
+		if !slices.Contains(known, name) {
+			warnings = append(warnings, fmt.Sprintf("thresholds.%s: validator %q is not used by the scenario", field, name))
+		}
+	}
+	for _, name := range t.CriticalValidators {
+		check("critical_validators", name)
+		if slices.Contains(t.WarningValidators, name) {
+			warnings = append(warnings, fmt.Sprintf("thresholds: validator %q is both critical and warning; critical wins", name))
+		}
+	}
+	for _, name := range t.WarningValidators {
+		check("warning_validators", name)
+	}
+	for _, name := range sortedKeys(t.ValidatorLimits) {
+		check("validator_limits", name)
+	}
+
+	if t.MinSuccessRate == 0 && t.MaxFailedIterations == 0 {
+		warnings = append(warnings, "thresholds: min_success_rate is 0, failed iterations never fail the run")
+	}
+	if t.RequireAllValidatorsPassing && len(s.Validators) == 0 {
+		warnings = append(warnings, "thresholds: require_all_validators_passing is set but the scenario has no validators")
+	}
+
+	return warnings
+}
+
+// validatorNames returns the names thresholds may use for the scenario
+// validators: the component type and the validator name
+func (s *Scenario) validatorNames() []string {
+	var names []string
+	for _, component := range s.Validators {
+		names = append(names, component.Type)
+		if validator, err := buildValidator(component); err == nil {
+			names = append(names, validator.Name())
+		}
+	}
+
+	return names
+}
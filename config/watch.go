@@ -0,0 +1,49 @@
+package config
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// DefaultWatchInterval is the polling interval used by Watch when none is given
+const DefaultWatchInterval = 2 * time.Second
+
+// Watch polls the scenario file at path until ctx is done and calls onChange
+// with the reloaded scenario each time its modification time or size changes.
+// Load errors, e.g. of a half-written or invalid file, are passed to onChange
+// as well, and the next change is loaded again. The file as it is when Watch
+// starts is not reported.
+func Watch(ctx context.Context, path string, interval time.Duration, onChange func(*Scenario, error)) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	last, _ := os.Stat(path)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			if last != nil {
+				last = nil
+				onChange(nil, err)
+			}
+
+			continue
+		}
+		if last != nil && info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size() {
+			continue
+		}
+		last = info
+
+		onChange(Load(path))
+	}
+}
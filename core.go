@@ -129,6 +129,14 @@ type StepInjector interface {
 	AfterStep(ctx context.Context, err error) error
 }
 
+// IntensityScaler is implemented by injectors whose fault probability can be
+// scaled, e.g. by WithIntensity
+type IntensityScaler interface {
+	Injector
+	// ScaleIntensity multiplies the fault probability by factor (capped at 1)
+	ScaleIntensity(factor float64)
+}
+
 // ChaosProvider is a universal interface for context-based chaos injection
 type ChaosProvider interface {
 	Name() string
@@ -154,6 +162,20 @@ type ChaosPanicProvider interface {
 	GetPanicProbability() float64
 }
 
+// ChaosCorruptionProvider provides value corruption capability (see MaybeCorrupt)
+type ChaosCorruptionProvider interface {
+	Injector
+	ShouldCorrupt() bool
+	GetCorruptionProbability() float64
+}
+
+// ChaosContextValueProvider provides context value corruption capability (see MaybeCorruptContextValue)
+type ChaosContextValueProvider interface {
+	Injector
+	ShouldCorruptContextValue(key any) bool
+	CorruptContextValue(key, value any) any
+}
+
 // ChaosNetworkProvider provides network chaos injection capability
 type ChaosNetworkProvider interface {
 	Injector
@@ -162,6 +184,14 @@ type ChaosNetworkProvider interface {
 	ShouldDropConnection(host string, port int) bool
 }
 
+// ChaosMessagingProvider provides messaging chaos (see WrapPublish,
+// WrapMessageHandler and WrapDeliveries). Several providers may be active;
+// the first returning a fault for a publish or delivery applies it.
+type ChaosMessagingProvider interface {
+	Injector
+	GetMessagingFault(op MessagingOp, subject string) (MessagingFault, bool)
+}
+
 // ChaosContextCancellationProvider provides context cancellation capability
 type ChaosContextCancellationProvider interface {
 	Injector
@@ -182,6 +212,15 @@ type MetricsProvider interface {
 	GetMetrics() map[string]interface{}
 }
 
+// HealthChecker is implemented by injectors that can tell whether their chaos
+// is still being applied (e.g. the ToxiProxy connection is alive). The
+// Executor polls it during a run and disables an injector reporting an error,
+// flagging it in the report (see WithHealthCheckInterval).
+type HealthChecker interface {
+	Injector
+	HealthCheck(ctx context.Context) error
+}
+
 // Validator checks system invariants.
 // Implement this interface to verify that the system maintains expected properties
 // during chaos testing.
@@ -212,6 +251,13 @@ type Resettable interface {
 	Reset()
 }
 
+// Finalizer is implemented by validators holding resources (background
+// samplers, files) to release when a run ends. Finalize is called once per
+// run, before the injectors are stopped; an error is recorded as a framework failure.
+type Finalizer interface {
+	Finalize(ctx context.Context) error
+}
+
 // StepWrapper is implemented by validators that can wrap step execution.
 // This allows validators to intercept and modify step behavior, such as
 // adding timeouts, monitoring, or other cross-cutting concerns.
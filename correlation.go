@@ -0,0 +1,139 @@
+package chaoskit
+
+import (
+	"fmt"
+	"sort"
+)
+
+// maxCorrelations limits how many injection correlations are reported
+const maxCorrelations = 10
+
+// InjectionCorrelation relates an injector to iteration failures.
+// An injector is considered active in an iteration if it recorded
+// at least one injection event during that iteration.
+type InjectionCorrelation struct {
+	// Injector is the injector name
+	Injector string `json:"injector"`
+
+	// FailuresWithInjection is the number of failed iterations with the injector active
+	FailuresWithInjection int `json:"failures_with_injection"`
+
+	// Failures is the total number of failed iterations
+	Failures int `json:"failures"`
+
+	// PassesWithInjection is the number of passed iterations with the injector active
+	PassesWithInjection int `json:"passes_with_injection"`
+
+	// Passes is the total number of passed iterations
+	Passes int `json:"passes"`
+
+	// FailureCoverage is the share of failed iterations with the injector active
+	FailureCoverage float64 `json:"failure_coverage"`
+
+	// PassCoverage is the share of passed iterations with the injector active
+	PassCoverage float64 `json:"pass_coverage"`
+
+	// Score is FailureCoverage - PassCoverage; higher means stronger correlation
+	Score float64 `json:"score"`
+}
+
+// String returns a human-readable correlation description
+func (c InjectionCorrelation) String() string {
+	return fmt.Sprintf("%.0f%% of failures had %s active (vs %.0f%% of passing iterations)",
+		c.FailureCoverage*100, c.Injector, c.PassCoverage*100)
+}
+
+// iterationKey identifies an iteration across scenarios sharing a reporter
+type iterationKey struct {
+	scenario  string
+	iteration int
+}
+
+// correlateInjections finds injectors active more often in failing iterations
+// than in passing ones (caller must hold r.mu)
+func (r *Reporter) correlateInjections() []InjectionCorrelation {
+	if r.aggregates != nil {
+		return r.correlation.correlations()
+	}
+	if len(r.activeInjectors) == 0 {
+		return nil
+	}
+
+	counts := &correlationCounts{}
+	for _, result := range r.results {
+		if result.Iteration == 0 {
+			continue
+		}
+		counts.add(result, r.activeInjectors[iterationKey{scenario: result.ScenarioName, iteration: result.Iteration}])
+	}
+
+	return counts.correlations()
+}
+
+// correlationCounts counts iterations and the injectors active in them
+type correlationCounts struct {
+	failures int
+	passes   int
+	stats    map[string]*InjectionCorrelation
+}
+
+// add counts an iteration result with its active injectors
+func (c *correlationCounts) add(result ExecutionResult, active map[string]struct{}) {
+	if result.Success {
+		c.passes++
+	} else {
+		c.failures++
+	}
+
+	for injector := range active {
+		if c.stats == nil {
+			c.stats = make(map[string]*InjectionCorrelation)
+		}
+		stats, ok := c.stats[injector]
+		if !ok {
+			stats = &InjectionCorrelation{Injector: injector}
+			c.stats[injector] = stats
+		}
+		if result.Success {
+			stats.PassesWithInjection++
+		} else {
+			stats.FailuresWithInjection++
+		}
+	}
+}
+
+// correlations returns the positive correlations, strongest first
+func (c *correlationCounts) correlations() []InjectionCorrelation {
+	if c.failures == 0 || len(c.stats) == 0 {
+		return nil
+	}
+
+	correlations := make([]InjectionCorrelation, 0, len(c.stats))
+	for _, stats := range c.stats {
+		corr := *stats
+		corr.Failures = c.failures
+		corr.Passes = c.passes
+		corr.FailureCoverage = float64(corr.FailuresWithInjection) / float64(c.failures)
+		if c.passes > 0 {
+			corr.PassCoverage = float64(corr.PassesWithInjection) / float64(c.passes)
+		}
+		corr.Score = corr.FailureCoverage - corr.PassCoverage
+
+		if corr.FailuresWithInjection > 0 && corr.Score > 0 {
+			correlations = append(correlations, corr)
+		}
+	}
+
+	sort.Slice(correlations, func(i, j int) bool {
+		if correlations[i].Score != correlations[j].Score {
+			return correlations[i].Score > correlations[j].Score
+		}
+
+		return correlations[i].Injector < correlations[j].Injector
+	})
+	if len(correlations) > maxCorrelations {
+		correlations = correlations[:maxCorrelations]
+	}
+
+	return correlations
+}
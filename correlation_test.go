@@ -0,0 +1,45 @@
+package chaoskit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_InjectionCorrelations(t *testing.T) {
+	reporter := NewReporter()
+
+	// 10 iterations: db-timeout is active in all 4 failures and 1 pass,
+	// cpu-stress is active everywhere and must not be reported
+	for i := 1; i <= 10; i++ {
+		failed := i <= 4
+		result := ExecutionResult{ScenarioName: "corr", Iteration: i, Success: !failed}
+		if failed {
+			result.Error = errors.New("step call failed: timeout")
+		}
+		reporter.AddResult(result)
+
+		reporter.AddInjection(InjectionEvent{Injector: "cpu-stress", Type: "cpu", Scenario: "corr", Iteration: i})
+		if failed || i == 5 {
+			reporter.AddInjection(InjectionEvent{Injector: "db-timeout", Type: InjectionTypeDelay,
+				Scenario: "corr", Iteration: i})
+		}
+	}
+
+	report, err := reporter.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	require.NotNil(t, report.Analysis)
+	require.Len(t, report.Analysis.Correlations, 1)
+
+	c := report.Analysis.Correlations[0]
+	assert.Equal(t, "db-timeout", c.Injector)
+	assert.Equal(t, 4, c.FailuresWithInjection)
+	assert.Equal(t, 1, c.PassesWithInjection)
+	assert.InDelta(t, 1.0, c.FailureCoverage, 1e-9)
+	assert.InDelta(t, 1.0/6.0, c.PassCoverage, 1e-9)
+	assert.Equal(t, "100% of failures had db-timeout active (vs 17% of passing iterations)", c.String())
+
+	assert.Contains(t, reporter.GenerateTextReport(report), "Injection Correlations:")
+}
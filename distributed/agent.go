@@ -0,0 +1,415 @@
+// Package distributed shards the iterations of a declarative scenario (see
+// package config) across worker agents on several processes or hosts, so a
+// campaign can generate load a single process can't, and aggregates their
+// results into one Reporter with a single verdict.
+//
+// Every worker runs an Agent behind HTTP (chaoskit agent). The Coordinator
+// sends each agent a Shard and reads back its results, with the injection
+// events of their iterations, as they are recorded:
+//
+//	POST /api/shards                run a shard, streaming its results as NDJSON
+//	POST /api/shards/{id}/stop      stop a shard; its stream ends
+//	POST /api/shards/{id}/pause     hold a shard at its next iteration
+//	POST /api/shards/{id}/resume    continue a paused shard
+//	GET  /api/health                agent status and running shards
+//
+// The shard ID is sent in the ShardHeader of the stream response.
+//
+// Agents also serve the protocol as the gRPC service of agent.proto (package
+// agentpb), for coordinators and tools written in other languages: register
+// Agent.GRPCServer with agentpb.RegisterAgentServer (chaoskit agent
+// -grpc-addr). Its messages carry the same fields as the JSON documents.
+//
+// The target is built on every worker, so its addresses must be reachable
+// from the worker hosts.
+//
+// An agent runs any scenario it receives, including injectors acting with
+// its own credentials and HTTP targets at arbitrary URLs: expose it only to
+// coordinators, and set a shared token (WithAgentToken and WithToken) when
+// it listens beyond localhost.
+package distributed
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+// maxShardSize limits the size of a shard request
+const maxShardSize = 1 << 20
+
+// ShardHeader is the response header carrying the ID of a started shard
+const ShardHeader = "X-Chaoskit-Shard"
+
+// ErrShardNotFound is returned for commands to shards not running on the agent
+var ErrShardNotFound = errors.New("shard not found")
+
+// errUnauthorized is returned for requests without the token of the agent
+var errUnauthorized = errors.New("missing or invalid agent token")
+
+// Shard is the part of a scenario run by one agent
+type Shard struct {
+	// Scenario is the scenario document (YAML or JSON)
+	Scenario string `json:"scenario"`
+
+	// Index is the 0-based position of the shard
+	Index int `json:"index"`
+
+	// Repeat overrides the iterations of the scenario; ignored for
+	// scenarios with a duration, which every shard runs in full
+	Repeat int `json:"repeat,omitempty"`
+
+	// Seed overrides the seed of the scenario
+	Seed *int64 `json:"seed,omitempty"`
+}
+
+// config returns the scenario of the shard with its overrides applied
+func (s Shard) config() (*config.Scenario, error) {
+	cfg, err := config.Parse([]byte(s.Scenario))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Target == nil {
+		return nil, fmt.Errorf("scenario %s has no target", cfg.Name)
+	}
+	if s.Repeat > 0 && cfg.Duration == 0 {
+		cfg.Repeat = s.Repeat
+	}
+	if s.Seed != nil {
+		cfg.Seed = s.Seed
+	}
+
+	return cfg, nil
+}
+
+// ShardInfo describes a shard running on an agent
+type ShardInfo struct {
+	ID        string    `json:"id"`
+	Scenario  string    `json:"scenario"`
+	Index     int       `json:"index"`
+	Paused    bool      `json:"paused"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Health is the status reported by an agent
+type Health struct {
+	Status string      `json:"status"`
+	Shards []ShardInfo `json:"shards"`
+}
+
+// shardRun is a shard running on the agent
+type shardRun struct {
+	info     ShardInfo
+	executor *chaoskit.Executor
+	cancel   context.CancelFunc
+}
+
+// Agent runs the shards sent by a Coordinator
+type Agent struct {
+	logger       *slog.Logger
+	executorOpts []chaoskit.ExecutorOption
+	token        string
+
+	mu          sync.Mutex
+	shards      map[string]*shardRun
+	nextShardID int
+}
+
+// AgentOption configures an Agent
+type AgentOption func(*Agent)
+
+// WithAgentLogger sets the logger of the agent and its shard runs
+func WithAgentLogger(logger *slog.Logger) AgentOption {
+	return func(a *Agent) {
+		a.logger = logger
+	}
+}
+
+// WithExecutorOptions adds executor options (exporters, redactor, artifacts)
+// applied to every shard run
+func WithExecutorOptions(opts ...chaoskit.ExecutorOption) AgentOption {
+	return func(a *Agent) {
+		a.executorOpts = append(a.executorOpts, opts...)
+	}
+}
+
+// WithAgentToken makes the agent reject requests without the bearer token
+// token, which coordinators send with WithToken
+func WithAgentToken(token string) AgentOption {
+	return func(a *Agent) {
+		a.token = token
+	}
+}
+
+// NewAgent creates an agent
+func NewAgent(opts ...AgentOption) *Agent {
+	a := &Agent{
+		logger: slog.Default(),
+		shards: make(map[string]*shardRun),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Handler returns the HTTP handler of the agent
+func (a *Agent) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/shards", a.handleShard)
+	mux.HandleFunc("POST /api/shards/{id}/{command}", a.handleCommand)
+	mux.HandleFunc("GET /api/health", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, a.Health())
+	})
+	if a.token == "" {
+		return mux
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.authorized(r.Header.Get("Authorization")) {
+			writeError(w, http.StatusUnauthorized, errUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// authorized reports whether the Authorization header value carries the
+// token of the agent
+func (a *Agent) authorized(authorization string) bool {
+	if a.token == "" {
+		return true
+	}
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) == 1
+}
+
+// Health returns the agent status with its running shards
+func (a *Agent) Health() Health {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	health := Health{Status: "ok", Shards: make([]ShardInfo, 0, len(a.shards))}
+	for _, shard := range a.shards {
+		info := shard.info
+		info.Paused = shard.executor.Paused()
+		health.Shards = append(health.Shards, info)
+	}
+	sort.Slice(health.Shards, func(i, j int) bool {
+		return health.Shards[i].StartedAt.Before(health.Shards[j].StartedAt)
+	})
+
+	return health
+}
+
+// Command sends a command ("stop", "pause" or "resume") to a running shard
+func (a *Agent) Command(id, command string) error {
+	a.mu.Lock()
+	shard, ok := a.shards[id]
+	a.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrShardNotFound, id)
+	}
+
+	switch command {
+	case "stop":
+		shard.cancel()
+	case "pause":
+		shard.executor.Pause()
+	case "resume":
+		shard.executor.Resume()
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+	a.logger.Info("shard command", slog.String("shard", id), slog.String("command", command))
+
+	return nil
+}
+
+func (a *Agent) handleCommand(w http.ResponseWriter, r *http.Request) {
+	if err := a.Command(r.PathValue("id"), r.PathValue("command")); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrShardNotFound) {
+			status = http.StatusNotFound
+		}
+		writeError(w, status, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// startShard registers a shard run and returns it with its context
+func (a *Agent) startShard(ctx context.Context, cfg *config.Scenario, index int, executor *chaoskit.Executor) (*shardRun, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.nextShardID++
+	shard := &shardRun{
+		info: ShardInfo{
+			ID:        fmt.Sprintf("shard-%d", a.nextShardID),
+			Scenario:  cfg.Name,
+			Index:     index,
+			StartedAt: time.Now(),
+		},
+		executor: executor,
+		cancel:   cancel,
+	}
+	a.shards[shard.info.ID] = shard
+
+	return shard, ctx
+}
+
+// finishShard unregisters a shard run
+func (a *Agent) finishShard(shard *shardRun) {
+	shard.cancel()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.shards, shard.info.ID)
+}
+
+// handleShard runs a shard until it completes or the coordinator goes away,
+// streaming its results as NDJSON
+func (a *Agent) handleShard(w http.ResponseWriter, r *http.Request) {
+	var shard Shard
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxShardSize)).Decode(&shard); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid shard: %w", err))
+		return
+	}
+	cfg, scenario, err := shard.build()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	a.runShard(r.Context(), shard, cfg, scenario, newFlushWriter(w), func(id string) error {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set(ShardHeader, id)
+		w.WriteHeader(http.StatusOK)
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+
+		return nil
+	})
+}
+
+// build returns the scenario of the shard
+func (s Shard) build() (*config.Scenario, *chaoskit.Scenario, error) {
+	cfg, err := s.config()
+	if err != nil {
+		return nil, nil, err
+	}
+	scenario, err := cfg.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cfg, scenario, nil
+}
+
+// runShard runs a shard until it completes or ctx is done. started is called
+// with the shard ID before the run; results are streamed to sink with
+// WithResultSink, and setup failures of the run are recorded as framework
+// failures, so they reach the coordinator as results.
+func (a *Agent) runShard(
+	ctx context.Context,
+	shard Shard,
+	cfg *config.Scenario,
+	scenario *chaoskit.Scenario,
+	sink io.Writer,
+	started func(id string) error,
+) {
+	logger := a.logger.With(slog.String("scenario", cfg.Name), slog.Int("shard", shard.Index))
+	// Results are aggregated by the coordinator; keep only the last one here
+	opts := append([]chaoskit.ExecutorOption{
+		chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure),
+		chaoskit.WithSlogLogger(logger),
+		chaoskit.WithResultRetention(1),
+		chaoskit.WithResultSink(sink),
+	}, a.executorOpts...)
+	executor := chaoskit.NewExecutor(opts...)
+
+	run, ctx := a.startShard(ctx, cfg, shard.Index, executor)
+	defer a.finishShard(run)
+
+	if err := started(run.info.ID); err != nil {
+		logger.Warn("shard not started", slog.String("id", run.info.ID), slog.String("error", err.Error()))
+		return
+	}
+
+	logger.Info("shard started", slog.String("id", run.info.ID),
+		slog.Int("repeat", cfg.Repeat), slog.Duration("duration", cfg.Duration))
+	if err := executor.Run(ctx, scenario); err != nil && ctx.Err() == nil {
+		logger.Warn("shard run failed", slog.String("error", err.Error()))
+	}
+	logger.Info("shard finished", slog.String("id", run.info.ID))
+}
+
+// flushWriter flushes every write, so results reach the coordinator as they
+// are recorded (the executor serializes writes to its result sink)
+type flushWriter struct {
+	w       io.Writer
+	flusher http.Flusher
+}
+
+func newFlushWriter(w http.ResponseWriter) *flushWriter {
+	fw := &flushWriter{w: w}
+	if flusher, ok := w.(http.Flusher); ok {
+		fw.flusher = flusher
+	}
+
+	return fw
+}
+
+func (f *flushWriter) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	if err == nil && f.flusher != nil {
+		f.flusher.Flush()
+	}
+
+	return n, err
+}
+
+// errorBody is the JSON body of error responses
+type errorBody struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorBody{Error: err.Error()})
+}
+
+// responseError returns the error of a non-OK agent response
+func responseError(resp *http.Response) error {
+	var body errorBody
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxShardSize)).Decode(&body); err != nil || body.Error == "" {
+		return fmt.Errorf("agent returned %s", resp.Status)
+	}
+
+	return errors.New(body.Error)
+}
@@ -0,0 +1,935 @@
+// Remote agent protocol of chaoskit distributed runs.
+//
+// distributed.Agent serves this service next to its HTTP protocol, which
+// distributed.Coordinator uses, for coordinators and tools written in other
+// languages. Messages carry the fields of the JSON documents of the HTTP
+// protocol: results are the NDJSON result lines of chaoskit.WithResultSink
+// (see docs/report-schema.json) and durations are in milliseconds. The Go
+// code in package agentpb is generated with go generate ./distributed.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: distributed/agent.proto
+
+package agentpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ShardSpec is the part of a scenario run by one agent (distributed.Shard)
+type ShardSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scenario document in YAML or JSON (see package config)
+	Scenario string `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	// 0-based position of the shard
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Overrides the iterations of the scenario; ignored for scenarios with a duration
+	Repeat int32 `protobuf:"varint,3,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	// Overrides the seed of the scenario when set
+	Seed          *int64 `protobuf:"varint,4,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardSpec) Reset() {
+	*x = ShardSpec{}
+	mi := &file_distributed_agent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardSpec) ProtoMessage() {}
+
+func (x *ShardSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardSpec.ProtoReflect.Descriptor instead.
+func (*ShardSpec) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShardSpec) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *ShardSpec) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ShardSpec) GetRepeat() int32 {
+	if x != nil {
+		return x.Repeat
+	}
+	return 0
+}
+
+func (x *ShardSpec) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+// ShardRef names a running shard
+type ShardRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardRef) Reset() {
+	*x = ShardRef{}
+	mi := &file_distributed_agent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardRef) ProtoMessage() {}
+
+func (x *ShardRef) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardRef.ProtoReflect.Descriptor instead.
+func (*ShardRef) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShardRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ShardEvent is an event of a running shard
+type ShardEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*ShardEvent_Started
+	//	*ShardEvent_Result
+	Event         isShardEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardEvent) Reset() {
+	*x = ShardEvent{}
+	mi := &file_distributed_agent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardEvent) ProtoMessage() {}
+
+func (x *ShardEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardEvent.ProtoReflect.Descriptor instead.
+func (*ShardEvent) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShardEvent) GetEvent() isShardEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ShardEvent) GetStarted() *ShardStarted {
+	if x != nil {
+		if x, ok := x.Event.(*ShardEvent_Started); ok {
+			return x.Started
+		}
+	}
+	return nil
+}
+
+func (x *ShardEvent) GetResult() *ExecutionResult {
+	if x != nil {
+		if x, ok := x.Event.(*ShardEvent_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+type isShardEvent_Event interface {
+	isShardEvent_Event()
+}
+
+type ShardEvent_Started struct {
+	// Sent first: the ID of the started shard
+	Started *ShardStarted `protobuf:"bytes,1,opt,name=started,proto3,oneof"`
+}
+
+type ShardEvent_Result struct {
+	// An iteration result, with the injection events of the iteration
+	Result *ExecutionResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+func (*ShardEvent_Started) isShardEvent_Event() {}
+
+func (*ShardEvent_Result) isShardEvent_Event() {}
+
+type ShardStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardStarted) Reset() {
+	*x = ShardStarted{}
+	mi := &file_distributed_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardStarted) ProtoMessage() {}
+
+func (x *ShardStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardStarted.ProtoReflect.Descriptor instead.
+func (*ShardStarted) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShardStarted) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ExecutionResult is a chaoskit.ExecutionResult
+type ExecutionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scenario      string                 `protobuf:"bytes,1,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Iteration     int32                  `protobuf:"varint,2,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	StepsExecuted int32                  `protobuf:"varint,6,opt,name=steps_executed,json=stepsExecuted,proto3" json:"steps_executed,omitempty"`
+	// RFC 3339 timestamp
+	Timestamp     string            `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Injectors     []string          `protobuf:"bytes,8,rep,name=injectors,proto3" json:"injectors,omitempty"`
+	Artifacts     []string          `protobuf:"bytes,9,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Output        string            `protobuf:"bytes,10,opt,name=output,proto3" json:"output,omitempty"`
+	FailureClass  string            `protobuf:"bytes,11,opt,name=failure_class,json=failureClass,proto3" json:"failure_class,omitempty"`
+	StepDurations []*StepDuration   `protobuf:"bytes,12,rep,name=step_durations,json=stepDurations,proto3" json:"step_durations,omitempty"`
+	Injections    []*InjectionEvent `protobuf:"bytes,13,rep,name=injections,proto3" json:"injections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionResult) Reset() {
+	*x = ExecutionResult{}
+	mi := &file_distributed_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionResult) ProtoMessage() {}
+
+func (x *ExecutionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionResult.ProtoReflect.Descriptor instead.
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExecutionResult) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *ExecutionResult) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *ExecutionResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExecutionResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExecutionResult) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ExecutionResult) GetStepsExecuted() int32 {
+	if x != nil {
+		return x.StepsExecuted
+	}
+	return 0
+}
+
+func (x *ExecutionResult) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *ExecutionResult) GetInjectors() []string {
+	if x != nil {
+		return x.Injectors
+	}
+	return nil
+}
+
+func (x *ExecutionResult) GetArtifacts() []string {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *ExecutionResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *ExecutionResult) GetFailureClass() string {
+	if x != nil {
+		return x.FailureClass
+	}
+	return ""
+}
+
+func (x *ExecutionResult) GetStepDurations() []*StepDuration {
+	if x != nil {
+		return x.StepDurations
+	}
+	return nil
+}
+
+func (x *ExecutionResult) GetInjections() []*InjectionEvent {
+	if x != nil {
+		return x.Injections
+	}
+	return nil
+}
+
+type StepDuration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	DurationMs    float64                `protobuf:"fixed64,2,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepDuration) Reset() {
+	*x = StepDuration{}
+	mi := &file_distributed_agent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepDuration) ProtoMessage() {}
+
+func (x *StepDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepDuration.ProtoReflect.Descriptor instead.
+func (*StepDuration) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StepDuration) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *StepDuration) GetDurationMs() float64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// InjectionEvent is a chaoskit.InjectionEvent
+type InjectionEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Injector string                 `protobuf:"bytes,1,opt,name=injector,proto3" json:"injector,omitempty"`
+	Type     string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// RFC 3339 timestamp
+	Timestamp string `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Scenario  string `protobuf:"bytes,4,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Iteration int32  `protobuf:"varint,5,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	DelayMs   int64  `protobuf:"varint,6,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	Point     string `protobuf:"bytes,7,opt,name=point,proto3" json:"point,omitempty"`
+	FaultId   string `protobuf:"bytes,8,opt,name=fault_id,json=faultId,proto3" json:"fault_id,omitempty"`
+	// Injector-specific details as a JSON object
+	AttributesJson string `protobuf:"bytes,9,opt,name=attributes_json,json=attributesJson,proto3" json:"attributes_json,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InjectionEvent) Reset() {
+	*x = InjectionEvent{}
+	mi := &file_distributed_agent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InjectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InjectionEvent) ProtoMessage() {}
+
+func (x *InjectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InjectionEvent.ProtoReflect.Descriptor instead.
+func (*InjectionEvent) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InjectionEvent) GetInjector() string {
+	if x != nil {
+		return x.Injector
+	}
+	return ""
+}
+
+func (x *InjectionEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InjectionEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *InjectionEvent) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *InjectionEvent) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *InjectionEvent) GetDelayMs() int64 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+func (x *InjectionEvent) GetPoint() string {
+	if x != nil {
+		return x.Point
+	}
+	return ""
+}
+
+func (x *InjectionEvent) GetFaultId() string {
+	if x != nil {
+		return x.FaultId
+	}
+	return ""
+}
+
+func (x *InjectionEvent) GetAttributesJson() string {
+	if x != nil {
+		return x.AttributesJson
+	}
+	return ""
+}
+
+type CommandReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandReply) Reset() {
+	*x = CommandReply{}
+	mi := &file_distributed_agent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandReply) ProtoMessage() {}
+
+func (x *CommandReply) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandReply.ProtoReflect.Descriptor instead.
+func (*CommandReply) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{7}
+}
+
+type HealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthRequest) Reset() {
+	*x = HealthRequest{}
+	mi := &file_distributed_agent_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRequest) ProtoMessage() {}
+
+func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{8}
+}
+
+// HealthReply is a distributed.Health
+type HealthReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Shards        []*ShardInfo           `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthReply) Reset() {
+	*x = HealthReply{}
+	mi := &file_distributed_agent_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthReply) ProtoMessage() {}
+
+func (x *HealthReply) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthReply.ProtoReflect.Descriptor instead.
+func (*HealthReply) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HealthReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HealthReply) GetShards() []*ShardInfo {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+// ShardInfo is a distributed.ShardInfo
+type ShardInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Scenario string                 `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Index    int32                  `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Paused   bool                   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	// RFC 3339 timestamp
+	StartedAt     string `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardInfo) Reset() {
+	*x = ShardInfo{}
+	mi := &file_distributed_agent_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardInfo) ProtoMessage() {}
+
+func (x *ShardInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_distributed_agent_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardInfo.ProtoReflect.Descriptor instead.
+func (*ShardInfo) Descriptor() ([]byte, []int) {
+	return file_distributed_agent_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ShardInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShardInfo) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *ShardInfo) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ShardInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ShardInfo) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+var File_distributed_agent_proto protoreflect.FileDescriptor
+
+const file_distributed_agent_proto_rawDesc = "" +
+	"\n" +
+	"\x17distributed/agent.proto\x12\x11chaoskit.agent.v1\"w\n" +
+	"\tShardSpec\x12\x1a\n" +
+	"\bscenario\x18\x01 \x01(\tR\bscenario\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06repeat\x18\x03 \x01(\x05R\x06repeat\x12\x17\n" +
+	"\x04seed\x18\x04 \x01(\x03H\x00R\x04seed\x88\x01\x01B\a\n" +
+	"\x05_seed\"\x1a\n" +
+	"\bShardRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x01\n" +
+	"\n" +
+	"ShardEvent\x12;\n" +
+	"\astarted\x18\x01 \x01(\v2\x1f.chaoskit.agent.v1.ShardStartedH\x00R\astarted\x12<\n" +
+	"\x06result\x18\x02 \x01(\v2\".chaoskit.agent.v1.ExecutionResultH\x00R\x06resultB\a\n" +
+	"\x05event\"\x1e\n" +
+	"\fShardStarted\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe5\x03\n" +
+	"\x0fExecutionResult\x12\x1a\n" +
+	"\bscenario\x18\x01 \x01(\tR\bscenario\x12\x1c\n" +
+	"\titeration\x18\x02 \x01(\x05R\titeration\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\x12%\n" +
+	"\x0esteps_executed\x18\x06 \x01(\x05R\rstepsExecuted\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\tR\ttimestamp\x12\x1c\n" +
+	"\tinjectors\x18\b \x03(\tR\tinjectors\x12\x1c\n" +
+	"\tartifacts\x18\t \x03(\tR\tartifacts\x12\x16\n" +
+	"\x06output\x18\n" +
+	" \x01(\tR\x06output\x12#\n" +
+	"\rfailure_class\x18\v \x01(\tR\ffailureClass\x12F\n" +
+	"\x0estep_durations\x18\f \x03(\v2\x1f.chaoskit.agent.v1.StepDurationR\rstepDurations\x12A\n" +
+	"\n" +
+	"injections\x18\r \x03(\v2!.chaoskit.agent.v1.InjectionEventR\n" +
+	"injections\"C\n" +
+	"\fStepDuration\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\tR\x04step\x12\x1f\n" +
+	"\vduration_ms\x18\x02 \x01(\x01R\n" +
+	"durationMs\"\x8d\x02\n" +
+	"\x0eInjectionEvent\x12\x1a\n" +
+	"\binjector\x18\x01 \x01(\tR\binjector\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\tR\ttimestamp\x12\x1a\n" +
+	"\bscenario\x18\x04 \x01(\tR\bscenario\x12\x1c\n" +
+	"\titeration\x18\x05 \x01(\x05R\titeration\x12\x19\n" +
+	"\bdelay_ms\x18\x06 \x01(\x03R\adelayMs\x12\x14\n" +
+	"\x05point\x18\a \x01(\tR\x05point\x12\x19\n" +
+	"\bfault_id\x18\b \x01(\tR\afaultId\x12'\n" +
+	"\x0fattributes_json\x18\t \x01(\tR\x0eattributesJson\"\x0e\n" +
+	"\fCommandReply\"\x0f\n" +
+	"\rHealthRequest\"[\n" +
+	"\vHealthReply\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x124\n" +
+	"\x06shards\x18\x02 \x03(\v2\x1c.chaoskit.agent.v1.ShardInfoR\x06shards\"\x84\x01\n" +
+	"\tShardInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bscenario\x18\x02 \x01(\tR\bscenario\x12\x14\n" +
+	"\x05index\x18\x03 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06paused\x18\x04 \x01(\bR\x06paused\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt2\xf3\x02\n" +
+	"\x05Agent\x12I\n" +
+	"\bRunShard\x12\x1c.chaoskit.agent.v1.ShardSpec\x1a\x1d.chaoskit.agent.v1.ShardEvent0\x01\x12D\n" +
+	"\x04Stop\x12\x1b.chaoskit.agent.v1.ShardRef\x1a\x1f.chaoskit.agent.v1.CommandReply\x12E\n" +
+	"\x05Pause\x12\x1b.chaoskit.agent.v1.ShardRef\x1a\x1f.chaoskit.agent.v1.CommandReply\x12F\n" +
+	"\x06Resume\x12\x1b.chaoskit.agent.v1.ShardRef\x1a\x1f.chaoskit.agent.v1.CommandReply\x12J\n" +
+	"\x06Health\x12 .chaoskit.agent.v1.HealthRequest\x1a\x1e.chaoskit.agent.v1.HealthReplyB1Z/github.com/rom8726/chaoskit/distributed/agentpbb\x06proto3"
+
+var (
+	file_distributed_agent_proto_rawDescOnce sync.Once
+	file_distributed_agent_proto_rawDescData []byte
+)
+
+func file_distributed_agent_proto_rawDescGZIP() []byte {
+	file_distributed_agent_proto_rawDescOnce.Do(func() {
+		file_distributed_agent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_distributed_agent_proto_rawDesc), len(file_distributed_agent_proto_rawDesc)))
+	})
+	return file_distributed_agent_proto_rawDescData
+}
+
+var file_distributed_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_distributed_agent_proto_goTypes = []any{
+	(*ShardSpec)(nil),       // 0: chaoskit.agent.v1.ShardSpec
+	(*ShardRef)(nil),        // 1: chaoskit.agent.v1.ShardRef
+	(*ShardEvent)(nil),      // 2: chaoskit.agent.v1.ShardEvent
+	(*ShardStarted)(nil),    // 3: chaoskit.agent.v1.ShardStarted
+	(*ExecutionResult)(nil), // 4: chaoskit.agent.v1.ExecutionResult
+	(*StepDuration)(nil),    // 5: chaoskit.agent.v1.StepDuration
+	(*InjectionEvent)(nil),  // 6: chaoskit.agent.v1.InjectionEvent
+	(*CommandReply)(nil),    // 7: chaoskit.agent.v1.CommandReply
+	(*HealthRequest)(nil),   // 8: chaoskit.agent.v1.HealthRequest
+	(*HealthReply)(nil),     // 9: chaoskit.agent.v1.HealthReply
+	(*ShardInfo)(nil),       // 10: chaoskit.agent.v1.ShardInfo
+}
+var file_distributed_agent_proto_depIdxs = []int32{
+	3,  // 0: chaoskit.agent.v1.ShardEvent.started:type_name -> chaoskit.agent.v1.ShardStarted
+	4,  // 1: chaoskit.agent.v1.ShardEvent.result:type_name -> chaoskit.agent.v1.ExecutionResult
+	5,  // 2: chaoskit.agent.v1.ExecutionResult.step_durations:type_name -> chaoskit.agent.v1.StepDuration
+	6,  // 3: chaoskit.agent.v1.ExecutionResult.injections:type_name -> chaoskit.agent.v1.InjectionEvent
+	10, // 4: chaoskit.agent.v1.HealthReply.shards:type_name -> chaoskit.agent.v1.ShardInfo
+	0,  // 5: chaoskit.agent.v1.Agent.RunShard:input_type -> chaoskit.agent.v1.ShardSpec
+	1,  // 6: chaoskit.agent.v1.Agent.Stop:input_type -> chaoskit.agent.v1.ShardRef
+	1,  // 7: chaoskit.agent.v1.Agent.Pause:input_type -> chaoskit.agent.v1.ShardRef
+	1,  // 8: chaoskit.agent.v1.Agent.Resume:input_type -> chaoskit.agent.v1.ShardRef
+	8,  // 9: chaoskit.agent.v1.Agent.Health:input_type -> chaoskit.agent.v1.HealthRequest
+	2,  // 10: chaoskit.agent.v1.Agent.RunShard:output_type -> chaoskit.agent.v1.ShardEvent
+	7,  // 11: chaoskit.agent.v1.Agent.Stop:output_type -> chaoskit.agent.v1.CommandReply
+	7,  // 12: chaoskit.agent.v1.Agent.Pause:output_type -> chaoskit.agent.v1.CommandReply
+	7,  // 13: chaoskit.agent.v1.Agent.Resume:output_type -> chaoskit.agent.v1.CommandReply
+	9,  // 14: chaoskit.agent.v1.Agent.Health:output_type -> chaoskit.agent.v1.HealthReply
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_distributed_agent_proto_init() }
+func file_distributed_agent_proto_init() {
+	if File_distributed_agent_proto != nil {
+		return
+	}
+	file_distributed_agent_proto_msgTypes[0].OneofWrappers = []any{}
+	file_distributed_agent_proto_msgTypes[2].OneofWrappers = []any{
+		(*ShardEvent_Started)(nil),
+		(*ShardEvent_Result)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_distributed_agent_proto_rawDesc), len(file_distributed_agent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_distributed_agent_proto_goTypes,
+		DependencyIndexes: file_distributed_agent_proto_depIdxs,
+		MessageInfos:      file_distributed_agent_proto_msgTypes,
+	}.Build()
+	File_distributed_agent_proto = out.File
+	file_distributed_agent_proto_goTypes = nil
+	file_distributed_agent_proto_depIdxs = nil
+}
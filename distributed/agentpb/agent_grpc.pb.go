@@ -0,0 +1,302 @@
+// Remote agent protocol of chaoskit distributed runs.
+//
+// distributed.Agent serves this service next to its HTTP protocol, which
+// distributed.Coordinator uses, for coordinators and tools written in other
+// languages. Messages carry the fields of the JSON documents of the HTTP
+// protocol: results are the NDJSON result lines of chaoskit.WithResultSink
+// (see docs/report-schema.json) and durations are in milliseconds. The Go
+// code in package agentpb is generated with go generate ./distributed.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: distributed/agent.proto
+
+package agentpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Agent_RunShard_FullMethodName = "/chaoskit.agent.v1.Agent/RunShard"
+	Agent_Stop_FullMethodName     = "/chaoskit.agent.v1.Agent/Stop"
+	Agent_Pause_FullMethodName    = "/chaoskit.agent.v1.Agent/Pause"
+	Agent_Resume_FullMethodName   = "/chaoskit.agent.v1.Agent/Resume"
+	Agent_Health_FullMethodName   = "/chaoskit.agent.v1.Agent/Health"
+)
+
+// AgentClient is the client API for Agent service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Agent runs scenario shards for a coordinator
+type AgentClient interface {
+	// RunShard runs a shard and streams its events until the shard completes
+	// or is stopped. The first event carries the shard ID.
+	RunShard(ctx context.Context, in *ShardSpec, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShardEvent], error)
+	// Stop stops a running shard; its RunShard stream ends
+	Stop(ctx context.Context, in *ShardRef, opts ...grpc.CallOption) (*CommandReply, error)
+	// Pause holds a running shard at its next iteration with its injectors stopped
+	Pause(ctx context.Context, in *ShardRef, opts ...grpc.CallOption) (*CommandReply, error)
+	// Resume lets a paused shard continue
+	Resume(ctx context.Context, in *ShardRef, opts ...grpc.CallOption) (*CommandReply, error)
+	// Health reports the agent status and its running shards
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthReply, error)
+}
+
+type agentClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentClient(cc grpc.ClientConnInterface) AgentClient {
+	return &agentClient{cc}
+}
+
+func (c *agentClient) RunShard(ctx context.Context, in *ShardSpec, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ShardEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[0], Agent_RunShard_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ShardSpec, ShardEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_RunShardClient = grpc.ServerStreamingClient[ShardEvent]
+
+func (c *agentClient) Stop(ctx context.Context, in *ShardRef, opts ...grpc.CallOption) (*CommandReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Agent_Stop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) Pause(ctx context.Context, in *ShardRef, opts ...grpc.CallOption) (*CommandReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Agent_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) Resume(ctx context.Context, in *ShardRef, opts ...grpc.CallOption) (*CommandReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandReply)
+	err := c.cc.Invoke(ctx, Agent_Resume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthReply)
+	err := c.cc.Invoke(ctx, Agent_Health_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentServer is the server API for Agent service.
+// All implementations must embed UnimplementedAgentServer
+// for forward compatibility.
+//
+// Agent runs scenario shards for a coordinator
+type AgentServer interface {
+	// RunShard runs a shard and streams its events until the shard completes
+	// or is stopped. The first event carries the shard ID.
+	RunShard(*ShardSpec, grpc.ServerStreamingServer[ShardEvent]) error
+	// Stop stops a running shard; its RunShard stream ends
+	Stop(context.Context, *ShardRef) (*CommandReply, error)
+	// Pause holds a running shard at its next iteration with its injectors stopped
+	Pause(context.Context, *ShardRef) (*CommandReply, error)
+	// Resume lets a paused shard continue
+	Resume(context.Context, *ShardRef) (*CommandReply, error)
+	// Health reports the agent status and its running shards
+	Health(context.Context, *HealthRequest) (*HealthReply, error)
+	mustEmbedUnimplementedAgentServer()
+}
+
+// UnimplementedAgentServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAgentServer struct{}
+
+func (UnimplementedAgentServer) RunShard(*ShardSpec, grpc.ServerStreamingServer[ShardEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method RunShard not implemented")
+}
+func (UnimplementedAgentServer) Stop(context.Context, *ShardRef) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedAgentServer) Pause(context.Context, *ShardRef) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedAgentServer) Resume(context.Context, *ShardRef) (*CommandReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (UnimplementedAgentServer) Health(context.Context, *HealthRequest) (*HealthReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
+func (UnimplementedAgentServer) testEmbeddedByValue()               {}
+
+// UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentServer will
+// result in compilation errors.
+type UnsafeAgentServer interface {
+	mustEmbedUnimplementedAgentServer()
+}
+
+func RegisterAgentServer(s grpc.ServiceRegistrar, srv AgentServer) {
+	// If the following call pancis, it indicates UnimplementedAgentServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Agent_ServiceDesc, srv)
+}
+
+func _Agent_RunShard_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ShardSpec)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).RunShard(m, &grpc.GenericServerStream[ShardSpec, ShardEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Agent_RunShardServer = grpc.ServerStreamingServer[ShardEvent]
+
+func _Agent_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Stop(ctx, req.(*ShardRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Pause(ctx, req.(*ShardRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_Resume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Resume(ctx, req.(*ShardRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Agent_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chaoskit.agent.v1.Agent",
+	HandlerType: (*AgentServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Stop",
+			Handler:    _Agent_Stop_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Agent_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Agent_Resume_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _Agent_Health_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RunShard",
+			Handler:       _Agent_RunShard_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "distributed/agent.proto",
+}
@@ -0,0 +1,330 @@
+package distributed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+// ErrNoWorkers is returned by Coordinator.Run without worker agents
+var ErrNoWorkers = errors.New("no worker agents")
+
+// Coordinator shards scenario runs across worker agents and aggregates
+// their results into one Reporter
+type Coordinator struct {
+	workers  []string
+	client   *http.Client
+	token    string
+	logger   *slog.Logger
+	reporter *chaoskit.Reporter
+
+	mu        sync.Mutex
+	iteration int
+	active    map[string]string // worker URL -> ID of its running shard
+}
+
+// CoordinatorOption configures a Coordinator
+type CoordinatorOption func(*Coordinator)
+
+// WithCoordinatorLogger sets the logger of the coordinator
+func WithCoordinatorLogger(logger *slog.Logger) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.logger = logger
+	}
+}
+
+// WithHTTPClient sets the client used to call the agents. It should have no
+// overall timeout, since a shard response lasts as long as the shard runs.
+func WithHTTPClient(client *http.Client) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.client = client
+	}
+}
+
+// WithToken sets the bearer token sent to the agents (see WithAgentToken)
+func WithToken(token string) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.token = token
+	}
+}
+
+// WithReporter sets the reporter the results of all workers are added to,
+// e.g. a bounded one (chaoskit.WithBounded) for long campaigns
+func WithReporter(reporter *chaoskit.Reporter) CoordinatorOption {
+	return func(c *Coordinator) {
+		c.reporter = reporter
+	}
+}
+
+// NewCoordinator creates a coordinator for the agents at the given base URLs
+// (e.g. "http://10.0.0.5:9090"); a URL without scheme is taken as http
+func NewCoordinator(workers []string, opts ...CoordinatorOption) *Coordinator {
+	c := &Coordinator{
+		client: &http.Client{},
+		logger: slog.Default(),
+		active: make(map[string]string),
+	}
+	for _, worker := range workers {
+		if !strings.Contains(worker, "://") {
+			worker = "http://" + worker
+		}
+		c.workers = append(c.workers, strings.TrimSuffix(worker, "/"))
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.reporter == nil {
+		c.reporter = chaoskit.NewReporter()
+	}
+
+	return c
+}
+
+// Reporter returns the reporter holding the results of all workers
+func (c *Coordinator) Reporter() *chaoskit.Reporter {
+	return c.reporter
+}
+
+// Run runs the scenario document on all workers and returns the verdict over
+// their aggregated results. The iterations of a repeat scenario are split
+// between the workers; a duration scenario runs on every worker for its full
+// duration. Each shard gets its own seed (the scenario seed plus the shard
+// index), so the workers don't inject the same faults in lockstep.
+//
+// Results are numbered in the order they arrive; their injection events feed
+// the injector summaries and timeline (up to chaoskit.MaxIterationInjections
+// per iteration). A worker that can't be reached or fails mid-run is recorded
+// as a framework failure, so it fails the verdict instead of silently
+// shrinking the campaign.
+func (c *Coordinator) Run(ctx context.Context, document []byte) (*chaoskit.Report, error) {
+	if len(c.workers) == 0 {
+		return nil, ErrNoWorkers
+	}
+
+	cfg, err := config.Parse(document)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Target == nil {
+		return nil, fmt.Errorf("scenario %s has no target", cfg.Name)
+	}
+
+	shards := plan(cfg, string(document), len(c.workers))
+	c.logger.Info("distributed run started",
+		slog.String("scenario", cfg.Name),
+		slog.Int("workers", len(c.workers)),
+		slog.Int("shards", len(shards)))
+
+	var wg sync.WaitGroup
+	for i, shard := range shards {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			worker := c.workers[i]
+			if err := c.runShard(ctx, worker, shard); err != nil && ctx.Err() == nil {
+				c.logger.Error("shard failed",
+					slog.String("worker", worker),
+					slog.Int("shard", shard.Index),
+					slog.String("error", err.Error()))
+				c.reporter.AddResult(chaoskit.ExecutionResult{
+					ScenarioName: cfg.Name,
+					Success:      false,
+					Error:        fmt.Errorf("worker %s: %w", worker, err),
+					Timestamp:    time.Now(),
+					FailureClass: chaoskit.FailureFramework,
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	c.logger.Info("distributed run finished", slog.String("scenario", cfg.Name))
+
+	return c.reporter.GetVerdict(cfg.SuccessThresholds())
+}
+
+// plan splits the scenario into one shard per worker; workers left without
+// iterations of a repeat scenario get no shard
+func plan(cfg *config.Scenario, document string, workers int) []Shard {
+	shards := make([]Shard, 0, workers)
+	for i := range workers {
+		shard := Shard{Scenario: document, Index: i}
+		if cfg.Duration == 0 {
+			shard.Repeat = cfg.Repeat / workers
+			if i < cfg.Repeat%workers {
+				shard.Repeat++
+			}
+			if shard.Repeat == 0 {
+				break
+			}
+		}
+		if cfg.Seed != nil {
+			seed := *cfg.Seed + int64(i)
+			shard.Seed = &seed
+		}
+		shards = append(shards, shard)
+	}
+
+	return shards
+}
+
+// runShard sends a shard to a worker and records its results as they arrive
+func (c *Coordinator) runShard(ctx context.Context, worker string, shard Shard) error {
+	body, err := json.Marshal(shard)
+	if err != nil {
+		return err
+	}
+	resp, err := c.post(ctx, worker+"/api/shards", body)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+
+	if id := resp.Header.Get(ShardHeader); id != "" {
+		c.mu.Lock()
+		c.active[worker] = id
+		c.mu.Unlock()
+		defer func() {
+			c.mu.Lock()
+			delete(c.active, worker)
+			c.mu.Unlock()
+		}()
+	}
+
+	return chaoskit.StreamResults(resp.Body, func(result chaoskit.ExecutionResult) error {
+		c.record(result)
+
+		return nil
+	})
+}
+
+// record adds a worker result to the reporter under a campaign-wide iteration
+// number, its injection events first so they are correlated with it
+func (c *Coordinator) record(result chaoskit.ExecutionResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if result.Iteration > 0 {
+		c.iteration++
+		result.Iteration = c.iteration
+	}
+	for i := range result.Injections {
+		if result.Injections[i].Iteration > 0 {
+			result.Injections[i].Iteration = result.Iteration
+		}
+		c.reporter.AddInjection(result.Injections[i])
+	}
+	c.reporter.AddResult(result)
+}
+
+// Pause holds the running shards on all workers at their next iteration
+func (c *Coordinator) Pause(ctx context.Context) error {
+	return c.command(ctx, "pause")
+}
+
+// Resume lets paused shards continue
+func (c *Coordinator) Resume(ctx context.Context) error {
+	return c.command(ctx, "resume")
+}
+
+// Stop stops the running shards; Run returns the verdict over the results
+// recorded so far
+func (c *Coordinator) Stop(ctx context.Context) error {
+	return c.command(ctx, "stop")
+}
+
+// command sends a shard command to every worker running a shard
+func (c *Coordinator) command(ctx context.Context, command string) error {
+	c.mu.Lock()
+	active := maps.Clone(c.active)
+	c.mu.Unlock()
+
+	var errs []error
+	for worker, id := range active {
+		resp, err := c.post(ctx, worker+"/api/shards/"+id+"/"+command, nil)
+		if err == nil {
+			if resp.StatusCode != http.StatusNoContent {
+				err = responseError(resp)
+			}
+			_ = resp.Body.Close()
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("worker %s: %w", worker, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Health returns the status of every worker; unreachable workers are
+// reported in the error
+func (c *Coordinator) Health(ctx context.Context) (map[string]Health, error) {
+	statuses := make(map[string]Health, len(c.workers))
+	var errs []error
+	for _, worker := range c.workers {
+		health, err := c.health(ctx, worker)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("worker %s: %w", worker, err))
+			continue
+		}
+		statuses[worker] = health
+	}
+
+	return statuses, errors.Join(errs...)
+}
+
+func (c *Coordinator) health(ctx context.Context, worker string) (Health, error) {
+	var health Health
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, worker+"/api/health", nil)
+	if err != nil {
+		return health, err
+	}
+	resp, err := c.do(req)
+	if err != nil {
+		return health, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return health, responseError(resp)
+	}
+	err = json.NewDecoder(resp.Body).Decode(&health)
+
+	return health, err
+}
+
+// post sends a POST request with a JSON body to an agent
+func (c *Coordinator) post(ctx context.Context, url string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.do(req)
+}
+
+// do sends a request to an agent with the token of the coordinator
+func (c *Coordinator) do(req *http.Request) (*http.Response, error) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return c.client.Do(req)
+}
@@ -0,0 +1,160 @@
+package distributed
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+const shardedScenario = `
+name: sharded
+target: {type: noop}
+injectors:
+  - type: delay
+    params: {min: 1ms, max: 2ms, probability: 1}
+repeat: 10
+seed: 7
+`
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func newTestAgent(t *testing.T) string {
+	t.Helper()
+
+	ts := httptest.NewServer(NewAgent(WithAgentLogger(discardLogger())).Handler())
+	t.Cleanup(ts.Close)
+
+	return ts.URL
+}
+
+func TestCoordinator_AggregatesWorkers(t *testing.T) {
+	coordinator := NewCoordinator(
+		[]string{newTestAgent(t), strings.TrimPrefix(newTestAgent(t), "http://"), newTestAgent(t)},
+		WithCoordinatorLogger(discardLogger()),
+	)
+
+	report, err := coordinator.Run(context.Background(), []byte(shardedScenario))
+	require.NoError(t, err)
+	assert.Equal(t, chaoskit.VerdictPass, report.Verdict)
+	assert.Equal(t, 10, report.TotalIterations)
+
+	iterations := make(map[int]bool)
+	for _, result := range coordinator.Reporter().Results() {
+		assert.Equal(t, "sharded", result.ScenarioName)
+		iterations[result.Iteration] = true
+	}
+	assert.Len(t, iterations, 10)
+	assert.True(t, iterations[1])
+	assert.True(t, iterations[10])
+}
+
+func TestCoordinator_UnreachableWorker(t *testing.T) {
+	down := httptest.NewServer(nil)
+	down.Close()
+
+	coordinator := NewCoordinator([]string{newTestAgent(t), down.URL}, WithCoordinatorLogger(discardLogger()))
+
+	report, err := coordinator.Run(context.Background(), []byte(shardedScenario))
+	require.NoError(t, err)
+	assert.Equal(t, chaoskit.VerdictFail, report.Verdict)
+
+	var frameworkFailures int
+	for _, result := range coordinator.Reporter().Results() {
+		if result.FailureClass == chaoskit.FailureFramework {
+			frameworkFailures++
+			assert.Contains(t, result.Error.Error(), down.URL)
+		}
+	}
+	assert.Equal(t, 1, frameworkFailures)
+}
+
+func TestCoordinator_Errors(t *testing.T) {
+	_, err := NewCoordinator(nil).Run(context.Background(), []byte(shardedScenario))
+	require.ErrorIs(t, err, ErrNoWorkers)
+
+	coordinator := NewCoordinator([]string{newTestAgent(t)}, WithCoordinatorLogger(discardLogger()))
+	_, err = coordinator.Run(context.Background(), []byte("name: no-target\nrepeat: 1\n"))
+	require.Error(t, err)
+}
+
+func TestPlan(t *testing.T) {
+	seed := int64(100)
+	shards := plan(&config.Scenario{Repeat: 5, Seed: &seed}, "doc", 3)
+	require.Len(t, shards, 3)
+	assert.Equal(t, []int{2, 2, 1}, []int{shards[0].Repeat, shards[1].Repeat, shards[2].Repeat})
+	assert.Equal(t, int64(102), *shards[2].Seed)
+
+	assert.Len(t, plan(&config.Scenario{Repeat: 2}, "doc", 4), 2)
+	assert.Len(t, plan(&config.Scenario{Duration: time.Minute}, "doc", 4), 4)
+}
+
+func TestCoordinator_Commands(t *testing.T) {
+	agent := NewAgent(WithAgentLogger(discardLogger()))
+	ts := httptest.NewServer(agent.Handler())
+	t.Cleanup(ts.Close)
+
+	coordinator := NewCoordinator([]string{ts.URL}, WithCoordinatorLogger(discardLogger()))
+	ctx := context.Background()
+
+	done := make(chan *chaoskit.Report, 1)
+	go func() {
+		report, err := coordinator.Run(ctx, []byte("name: endless\ntarget: {type: noop}\nduration: 1m\n"))
+		assert.NoError(t, err)
+		done <- report
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(agent.Health().Shards) == 1 && len(coordinator.Reporter().Results()) > 0
+	}, 5*time.Second, time.Millisecond)
+
+	require.NoError(t, coordinator.Pause(ctx))
+	statuses, err := coordinator.Health(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses[ts.URL].Shards, 1)
+	assert.True(t, statuses[ts.URL].Shards[0].Paused)
+
+	require.NoError(t, coordinator.Resume(ctx))
+	assert.False(t, agent.Health().Shards[0].Paused)
+
+	require.NoError(t, coordinator.Stop(ctx))
+	report := <-done
+	assert.Equal(t, chaoskit.VerdictPass, report.Verdict)
+	assert.Empty(t, agent.Health().Shards)
+
+	require.ErrorIs(t, agent.Command("shard-1", "stop"), ErrShardNotFound)
+}
+
+func TestCoordinator_Token(t *testing.T) {
+	agent := NewAgent(WithAgentLogger(discardLogger()), WithAgentToken("secret"))
+	ts := httptest.NewServer(agent.Handler())
+	t.Cleanup(ts.Close)
+
+	coordinator := NewCoordinator([]string{ts.URL}, WithCoordinatorLogger(discardLogger()), WithToken("wrong"))
+	report, err := coordinator.Run(context.Background(), []byte(shardedScenario))
+	require.NoError(t, err)
+	assert.Equal(t, chaoskit.VerdictFail, report.Verdict)
+	results := coordinator.Reporter().Results()
+	require.Len(t, results, 1)
+	assert.Contains(t, results[0].Error.Error(), "missing or invalid agent token")
+	_, err = coordinator.Health(context.Background())
+	assert.ErrorContains(t, err, "missing or invalid agent token")
+
+	coordinator = NewCoordinator([]string{ts.URL}, WithCoordinatorLogger(discardLogger()), WithToken("secret"))
+	report, err = coordinator.Run(context.Background(), []byte(shardedScenario))
+	require.NoError(t, err)
+	assert.Equal(t, chaoskit.VerdictPass, report.Verdict)
+	_, err = coordinator.Health(context.Background())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,189 @@
+package distributed
+
+//go:generate protoc -I .. --go_out=.. --go_opt=module=github.com/rom8726/chaoskit --go-grpc_out=.. --go-grpc_opt=module=github.com/rom8726/chaoskit ../distributed/agent.proto
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/distributed/agentpb"
+)
+
+// grpcAgent serves an Agent as the Agent service of agent.proto
+type grpcAgent struct {
+	agentpb.UnimplementedAgentServer
+
+	agent *Agent
+}
+
+// GRPCServer returns the agent as the gRPC Agent service of agent.proto.
+// With WithAgentToken, calls need the token in their authorization metadata
+// ("Bearer <token>").
+//
+//	server := grpc.NewServer()
+//	agentpb.RegisterAgentServer(server, agent.GRPCServer())
+func (a *Agent) GRPCServer() agentpb.AgentServer {
+	return &grpcAgent{agent: a}
+}
+
+// RunShard runs a shard like POST /api/shards, sending its ID first and then
+// its results as they are recorded
+func (g *grpcAgent) RunShard(spec *agentpb.ShardSpec, stream grpc.ServerStreamingServer[agentpb.ShardEvent]) error {
+	if err := g.authorize(stream.Context()); err != nil {
+		return err
+	}
+
+	shard := Shard{
+		Scenario: spec.GetScenario(),
+		Index:    int(spec.GetIndex()),
+		Repeat:   int(spec.GetRepeat()),
+		Seed:     spec.Seed,
+	}
+	cfg, scenario, err := shard.build()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Result lines of the executor are decoded and sent as they are written;
+	// once sending fails, writes fail too instead of blocking the run
+	reader, writer := io.Pipe()
+	sent := make(chan error, 1)
+	go func() {
+		err := chaoskit.StreamResults(reader, func(result chaoskit.ExecutionResult) error {
+			return stream.Send(&agentpb.ShardEvent{
+				Event: &agentpb.ShardEvent_Result{Result: resultToProto(result)},
+			})
+		})
+		_ = reader.CloseWithError(errors.Join(err, io.ErrClosedPipe))
+		sent <- err
+	}()
+
+	var startErr error
+	g.agent.runShard(stream.Context(), shard, cfg, scenario, writer, func(id string) error {
+		startErr = stream.Send(&agentpb.ShardEvent{
+			Event: &agentpb.ShardEvent_Started{Started: &agentpb.ShardStarted{Id: id}},
+		})
+
+		return startErr
+	})
+	_ = writer.Close()
+	if err := <-sent; err != nil {
+		return err
+	}
+
+	return startErr
+}
+
+func (g *grpcAgent) Stop(ctx context.Context, ref *agentpb.ShardRef) (*agentpb.CommandReply, error) {
+	return g.command(ctx, ref, "stop")
+}
+
+func (g *grpcAgent) Pause(ctx context.Context, ref *agentpb.ShardRef) (*agentpb.CommandReply, error) {
+	return g.command(ctx, ref, "pause")
+}
+
+func (g *grpcAgent) Resume(ctx context.Context, ref *agentpb.ShardRef) (*agentpb.CommandReply, error) {
+	return g.command(ctx, ref, "resume")
+}
+
+func (g *grpcAgent) command(ctx context.Context, ref *agentpb.ShardRef, command string) (*agentpb.CommandReply, error) {
+	if err := g.authorize(ctx); err != nil {
+		return nil, err
+	}
+	if err := g.agent.Command(ref.GetId(), command); err != nil {
+		if errors.Is(err, ErrShardNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &agentpb.CommandReply{}, nil
+}
+
+func (g *grpcAgent) Health(ctx context.Context, _ *agentpb.HealthRequest) (*agentpb.HealthReply, error) {
+	if err := g.authorize(ctx); err != nil {
+		return nil, err
+	}
+	health := g.agent.Health()
+	reply := &agentpb.HealthReply{Status: health.Status}
+	for _, shard := range health.Shards {
+		reply.Shards = append(reply.Shards, &agentpb.ShardInfo{
+			Id:        shard.ID,
+			Scenario:  shard.Scenario,
+			Index:     int32(shard.Index),
+			Paused:    shard.Paused,
+			StartedAt: shard.StartedAt.Format(time.RFC3339Nano),
+		})
+	}
+
+	return reply, nil
+}
+
+// authorize checks the token in the authorization metadata of a call
+func (g *grpcAgent) authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var authorization string
+	if values := md.Get("authorization"); len(values) > 0 {
+		authorization = values[0]
+	}
+	if !g.agent.authorized(authorization) {
+		return status.Error(codes.Unauthenticated, errUnauthorized.Error())
+	}
+
+	return nil
+}
+
+// resultToProto converts a result to its agent.proto message
+func resultToProto(result chaoskit.ExecutionResult) *agentpb.ExecutionResult {
+	msg := &agentpb.ExecutionResult{
+		Scenario:      result.ScenarioName,
+		Iteration:     int32(result.Iteration),
+		Success:       result.Success,
+		DurationMs:    result.Duration.Milliseconds(),
+		StepsExecuted: int32(result.StepsExecuted),
+		Timestamp:     result.Timestamp.Format(time.RFC3339Nano),
+		Injectors:     result.Injectors,
+		Artifacts:     result.Artifacts,
+		Output:        result.Output,
+		FailureClass:  string(chaoskit.ClassifyFailure(result)),
+	}
+	if result.Error != nil {
+		msg.Error = result.Error.Error()
+	}
+	for _, sd := range result.StepDurations {
+		msg.StepDurations = append(msg.StepDurations, &agentpb.StepDuration{
+			Step:       sd.Step,
+			DurationMs: float64(sd.Duration) / float64(time.Millisecond),
+		})
+	}
+	for _, event := range result.Injections {
+		injection := &agentpb.InjectionEvent{
+			Injector:  event.Injector,
+			Type:      string(event.Type),
+			Timestamp: event.Timestamp.Format(time.RFC3339Nano),
+			Scenario:  event.Scenario,
+			Iteration: int32(event.Iteration),
+			DelayMs:   event.Delay.Milliseconds(),
+			Point:     event.Point,
+			FaultId:   event.FaultID,
+		}
+		if len(event.Attributes) > 0 {
+			if attributes, err := json.Marshal(event.Attributes); err == nil {
+				injection.AttributesJson = string(attributes)
+			}
+		}
+		msg.Injections = append(msg.Injections, injection)
+	}
+
+	return msg
+}
@@ -0,0 +1,224 @@
+package distributed
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/rom8726/chaoskit/distributed/agentpb"
+)
+
+// newTestGRPCAgent serves agent over an in-memory gRPC connection
+func newTestGRPCAgent(t *testing.T, agent *Agent) agentpb.AgentClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	agentpb.RegisterAgentServer(server, agent.GRPCServer())
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return agentpb.NewAgentClient(conn)
+}
+
+// httpShardLines runs shard on an HTTP agent and returns its result lines
+func httpShardLines(t *testing.T, shard Shard) []map[string]any {
+	t.Helper()
+
+	body, err := json.Marshal(shard)
+	require.NoError(t, err)
+	resp, err := http.Post(newTestAgent(t)+"/api/shards", "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// grpcShardLines runs shard on a gRPC agent and returns its ID and results
+// in the JSON form of their proto messages
+func grpcShardLines(t *testing.T, shard Shard) (string, []map[string]any) {
+	t.Helper()
+
+	client := newTestGRPCAgent(t, NewAgent(WithAgentLogger(discardLogger())))
+	stream, err := client.RunShard(context.Background(), &agentpb.ShardSpec{
+		Scenario: shard.Scenario,
+		Index:    int32(shard.Index),
+		Repeat:   int32(shard.Repeat),
+		Seed:     shard.Seed,
+	})
+	require.NoError(t, err)
+
+	var id string
+	var lines []map[string]any
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		if started := event.GetStarted(); started != nil {
+			require.Empty(t, lines, "the shard ID is sent first")
+			id = started.GetId()
+			continue
+		}
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(event.GetResult())
+		require.NoError(t, err)
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(data, &line))
+		lines = append(lines, line)
+	}
+
+	return id, lines
+}
+
+// protoNames maps JSON fields of the HTTP protocol to agent.proto fields
+// whose encoding differs
+var protoNames = map[string]string{
+	"delay":      "delay_ms",
+	"attributes": "attributes_json",
+}
+
+func TestGRPCAgent_MatchesHTTP(t *testing.T) {
+	seed := int64(7)
+	shard := Shard{Scenario: shardedScenario, Repeat: 3, Seed: &seed}
+
+	want := httpShardLines(t, shard)
+	id, got := grpcShardLines(t, shard)
+	assert.Equal(t, "shard-1", id)
+	require.Len(t, want, 3)
+	require.Len(t, got, len(want))
+	require.NotEmpty(t, want[0]["injections"])
+
+	for i := range want {
+		delete(want[i], "schema_version")
+		for key, value := range want[i] {
+			require.Contains(t, got[i], key, "result field %q is missing in agent.proto", key)
+			switch key {
+			case "duration_ms", "timestamp", "step_durations":
+				// Timing differs between the runs
+			case "injections":
+				assertInjections(t, value.([]any), got[i][key].([]any))
+			default:
+				assert.EqualValues(t, value, got[i][key], "result field %q", key)
+			}
+		}
+	}
+}
+
+func assertInjections(t *testing.T, want, got []any) {
+	t.Helper()
+
+	require.Len(t, got, len(want))
+	for j := range want {
+		wantEvent, gotEvent := want[j].(map[string]any), got[j].(map[string]any)
+		for key, value := range wantEvent {
+			name := key
+			if renamed, ok := protoNames[key]; ok {
+				name = renamed
+			}
+			require.Contains(t, gotEvent, name, "injection field %q is missing in agent.proto", key)
+			switch key {
+			case "injector", "type", "scenario", "iteration":
+				assert.EqualValues(t, value, gotEvent[name], "injection field %q", key)
+			}
+		}
+	}
+}
+
+func TestGRPCAgent_CommandsAndHealth(t *testing.T) {
+	agent := NewAgent(WithAgentLogger(discardLogger()))
+	client := newTestGRPCAgent(t, agent)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.RunShard(ctx, &agentpb.ShardSpec{
+		Scenario: "name: endless\ntarget: {type: noop}\nduration: 1m\n",
+	})
+	require.NoError(t, err)
+	event, err := stream.Recv()
+	require.NoError(t, err)
+	id := event.GetStarted().GetId()
+	require.NotEmpty(t, id)
+
+	_, err = client.Pause(ctx, &agentpb.ShardRef{Id: id})
+	require.NoError(t, err)
+	health, err := client.Health(ctx, &agentpb.HealthRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", health.GetStatus())
+	require.Len(t, health.GetShards(), 1)
+	assert.True(t, health.GetShards()[0].GetPaused())
+	assert.Equal(t, "endless", health.GetShards()[0].GetScenario())
+
+	_, err = client.Resume(ctx, &agentpb.ShardRef{Id: id})
+	require.NoError(t, err)
+	_, err = client.Stop(ctx, &agentpb.ShardRef{Id: id})
+	require.NoError(t, err)
+	for {
+		if _, err = stream.Recv(); err != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, err, io.EOF)
+	require.Eventually(t, func() bool { return len(agent.Health().Shards) == 0 }, 5*time.Second, time.Millisecond)
+
+	_, err = client.Stop(ctx, &agentpb.ShardRef{Id: id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Errors of server streams are received with the first message
+	stream, err = client.RunShard(ctx, &agentpb.ShardSpec{Scenario: "name: no-target\nrepeat: 1\n"})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCAgent_Token(t *testing.T) {
+	client := newTestGRPCAgent(t, NewAgent(WithAgentLogger(discardLogger()), WithAgentToken("secret")))
+
+	_, err := client.Health(context.Background(), &agentpb.HealthRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err := client.RunShard(context.Background(), &agentpb.ShardSpec{Scenario: shardedScenario})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer secret")
+	health, err := client.Health(ctx, &agentpb.HealthRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", health.GetStatus())
+}
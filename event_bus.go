@@ -0,0 +1,84 @@
+package chaoskit
+
+import (
+	"context"
+	"sync"
+)
+
+// eventBusKey is a private type for context key
+type eventBusKey struct{}
+
+// eventBus delivers the injection events of a run to subscribers
+type eventBus struct {
+	mu          sync.RWMutex
+	next        int
+	subscribers map[int]func(InjectionEvent)
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{subscribers: make(map[int]func(InjectionEvent))}
+}
+
+// attachEventBus makes the run event bus available to Subscribe
+func attachEventBus(ctx context.Context, bus *eventBus) context.Context {
+	return context.WithValue(ctx, eventBusKey{}, bus)
+}
+
+// eventBusFromContext returns the run event bus, or nil outside of a run
+func eventBusFromContext(ctx context.Context) *eventBus {
+	bus, _ := ctx.Value(eventBusKey{}).(*eventBus)
+
+	return bus
+}
+
+// Subscribe calls fn with every injection event of the running scenario from
+// now on, so target code and validators can react to faults as they happen
+// (e.g. check that an alert fired shortly after a fault). fn is called
+// synchronously by the injecting goroutine, possibly concurrently, and must
+// not block. The subscription ends when ctx is done, when the returned
+// function is called or when the run ends. Outside of a run it is a no-op.
+func Subscribe(ctx context.Context, fn func(InjectionEvent)) (unsubscribe func()) {
+	bus := eventBusFromContext(ctx)
+	if bus == nil {
+		return func() {}
+	}
+
+	bus.mu.Lock()
+	id := bus.next
+	bus.next++
+	bus.subscribers[id] = fn
+	bus.mu.Unlock()
+
+	var once sync.Once
+	remove := func() {
+		once.Do(func() {
+			bus.mu.Lock()
+			delete(bus.subscribers, id)
+			bus.mu.Unlock()
+		})
+	}
+	stop := context.AfterFunc(ctx, remove)
+
+	return func() {
+		stop()
+		remove()
+	}
+}
+
+// publish delivers an event to the subscribers
+func (b *eventBus) publish(event InjectionEvent) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	subscribers := make([]func(InjectionEvent), 0, len(b.subscribers))
+	for _, fn := range b.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	b.mu.RUnlock()
+
+	for _, fn := range subscribers {
+		fn(event)
+	}
+}
@@ -0,0 +1,42 @@
+package chaoskit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribe(t *testing.T) {
+	// No-op outside of scenario runs
+	Subscribe(context.Background(), func(InjectionEvent) { t.Fatal("unexpected event") })()
+
+	var all, single []InjectionEvent
+	iteration := 0
+	scenario := NewScenario("subscribe").
+		WithTarget(&testTarget{}).
+		Inject("errors", &alwaysErrorInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			if iteration == 1 {
+				// Subscriptions outlive the iteration, unless their context ends
+				Subscribe(context.Background(), func(event InjectionEvent) { t.Fatal("not a run context") })
+				Subscribe(ctx, func(event InjectionEvent) { all = append(all, event) })
+				unsubscribe := Subscribe(ctx, func(event InjectionEvent) { single = append(single, event) })
+				_ = MaybeError(ctx)
+				unsubscribe()
+			}
+			_ = MaybeError(ctx)
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+
+	require.Len(t, all, 3)
+	assert.Equal(t, "always-error", all[0].Injector)
+	assert.Equal(t, []int{1, 1, 2}, []int{all[0].Iteration, all[1].Iteration, all[2].Iteration})
+	assert.Len(t, single, 1)
+}
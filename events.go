@@ -0,0 +1,173 @@
+package chaoskit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// injectionRecorderKey is a private type for context key
+type injectionRecorderKey struct{}
+
+// Injection event types emitted by the built-in chaos context helpers
+const (
+	InjectionTypeDelay          = "delay"
+	InjectionTypeError          = "error"
+	InjectionTypePanic          = "panic"
+	InjectionTypeNetworkLatency = "network_latency"
+	InjectionTypeNetworkDrop    = "network_drop"
+	InjectionTypeCancellation   = "cancellation"
+	InjectionTypeFailure        = "failure"
+	InjectionTypeTimeout        = "timeout"
+	InjectionTypeCorruption     = "corruption"
+
+	// Messaging faults (see ChaosMessagingProvider)
+	InjectionTypePublishFailure     = "publish_failure"
+	InjectionTypeRedelivery         = "redelivery"
+	InjectionTypeReorder            = "reorder"
+	InjectionTypeConsumerDisconnect = "consumer_disconnect"
+)
+
+// MaxIterationInjections limits how many injection events an ExecutionResult keeps
+const MaxIterationInjections = 1000
+
+// InjectionEvent describes a single fault applied by an injector
+type InjectionEvent struct {
+	// Injector is the name of the injector that applied the fault
+	Injector string `json:"injector"`
+
+	// Type is the kind of fault (see InjectionType* constants)
+	Type string `json:"type"`
+
+	// Timestamp is when the fault was applied
+	Timestamp time.Time `json:"timestamp"`
+
+	// Scenario is the scenario during which the fault was applied
+	Scenario string `json:"scenario,omitempty"`
+
+	// Iteration is the 1-based iteration during which the fault was applied
+	Iteration int `json:"iteration,omitempty"`
+
+	// Delay is the injected latency, if any
+	Delay time.Duration `json:"delay,omitempty"`
+
+	// Point is the named chaos point the fault was injected at (see MaybeErrorAt)
+	Point string `json:"point,omitempty"`
+
+	// FaultID identifies the fault; it matches the FaultID of the ChaosError
+	// returned or raised for it, if any
+	FaultID string `json:"fault_id,omitempty"`
+
+	// Attributes holds injector-specific details (host, port, error message, ...)
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+// ExecutionObserver receives execution lifecycle callbacks from the Executor.
+// Implement this interface to export traces, stream progress or react to
+// injected faults while a scenario is running.
+//
+// OnIterationStart and OnStepStart may return a derived context (for example
+// one carrying a tracing span); it is passed down to the step and to user code.
+// Callbacks are invoked synchronously and must be safe for concurrent use.
+type ExecutionObserver interface {
+	OnIterationStart(ctx context.Context, scenario string, iteration int) context.Context
+	OnIterationEnd(ctx context.Context, result ExecutionResult)
+	OnStepStart(ctx context.Context, step string) context.Context
+	OnStepEnd(ctx context.Context, step string, err error)
+	OnInjection(ctx context.Context, event InjectionEvent)
+}
+
+// RunObserver is implemented by execution observers also notified when a
+// scenario run starts (before the target is set up) and ends (after it is
+// torn down), with the error Executor.Run returns
+type RunObserver interface {
+	OnRunStart(ctx context.Context, scenario string)
+	OnRunEnd(ctx context.Context, scenario string, err error)
+}
+
+// injectionRecorder forwards injection events to the reporter and executor observers
+type injectionRecorder struct {
+	scenario  string
+	iteration int
+	reporter  *Reporter
+	observers []ExecutionObserver
+	redactor  *Redactor
+	bus       *eventBus
+
+	// keepEvents keeps all iteration events for the artifacts event log,
+	// otherwise up to MaxIterationInjections are kept for the result
+	keepEvents bool
+	mu         sync.Mutex
+	events     []InjectionEvent
+	counts     map[string]int
+}
+
+func (r *injectionRecorder) record(ctx context.Context, event InjectionEvent) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	if event.Scenario == "" {
+		event.Scenario = r.scenario
+	}
+	if event.Iteration == 0 {
+		event.Iteration = r.iteration
+	}
+	if event.Point == "" {
+		event.Point = ChaosPointFromContext(ctx)
+	}
+	if event.FaultID == "" {
+		event.FaultID = NewFaultID()
+	}
+	event = r.redactor.RedactEvent(event)
+
+	r.mu.Lock()
+	if r.keepEvents || len(r.events) < MaxIterationInjections {
+		r.events = append(r.events, event)
+	}
+	if r.counts == nil {
+		r.counts = make(map[string]int)
+	}
+	r.counts[event.Injector]++
+	r.mu.Unlock()
+
+	if r.reporter != nil {
+		r.reporter.AddInjection(event)
+	}
+
+	for _, obs := range r.observers {
+		obs.OnInjection(ctx, event)
+	}
+	r.bus.publish(event)
+}
+
+// recorded returns the kept iteration events
+func (r *injectionRecorder) recorded() []InjectionEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]InjectionEvent(nil), r.events...)
+}
+
+// resultInjections returns the iteration events kept in the result
+func (r *injectionRecorder) resultInjections() []InjectionEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]InjectionEvent(nil), r.events[:min(len(r.events), MaxIterationInjections)]...)
+}
+
+// attachInjectionRecorder attaches an injection recorder to context
+func attachInjectionRecorder(ctx context.Context, r *injectionRecorder) context.Context {
+	return context.WithValue(ctx, injectionRecorderKey{}, r)
+}
+
+// RecordInjection reports an applied fault via context-attached recorder (no-op if absent).
+// Injectors that apply faults outside of the chaos context helpers
+// (e.g. in BeforeStep) should call this so observers can see them.
+func RecordInjection(ctx context.Context, event InjectionEvent) {
+	if v := ctx.Value(injectionRecorderKey{}); v != nil {
+		if r, ok := v.(*injectionRecorder); ok {
+			r.record(ctx, event)
+		}
+	}
+}
@@ -204,5 +204,5 @@ func main() {
 	_ = executor.Reporter().SaveJUnitXML(report, "chaos-context-report.xml")
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
@@ -149,5 +149,5 @@ func main() {
 	// and will appear in the report if steps exceed the timeout
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -64,32 +65,24 @@ func (t *ExampleTarget) Execute(ctx context.Context) error {
 
 	// These calls will trigger monkey patched versions
 	// With probability, they will panic
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				fmt.Printf("[Target] Recovered from panic: %v\n", r)
-			}
-		}()
-		criticalFunction()
-	}()
-
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				fmt.Printf("[Target] Recovered from panic: %v\n", r)
-			}
-		}()
-		_, _ = databaseQuery("SELECT * FROM users")
-	}()
-
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				fmt.Printf("[Target] Recovered from panic: %v\n", r)
-			}
-		}()
-		_ = networkCall("api.example.com", 443)
-	}()
+	calls := []func(context.Context) error{
+		func(ctx context.Context) error {
+			criticalFunction()
+			return nil
+		},
+		func(ctx context.Context) error {
+			_, err := databaseQuery("SELECT * FROM users")
+			return err
+		},
+		func(ctx context.Context) error {
+			return networkCall("api.example.com", 443)
+		},
+	}
+	for _, call := range calls {
+		if err := chaoskit.Protect(ctx, call); errors.Is(err, chaoskit.ErrPanicRecovered) {
+			fmt.Printf("[Target] Recovered from panic: %v\n", err)
+		}
+	}
 
 	return nil
 }
@@ -189,5 +182,5 @@ func main() {
 	log.Println("6. Delay can be applied before or after function execution")
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
@@ -183,5 +183,5 @@ func main() {
 	log.Println("Run: go run -gcflags=all=-l main.go")
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
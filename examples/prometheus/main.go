@@ -58,12 +58,12 @@ func main() {
 		Repeat(100).
 		Build()
 
-	// Create executor
+	// Create executor feeding the Prometheus exporter while the scenario runs
 	executor := chaoskit.NewExecutor(
 		chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure),
+		chaoskit.WithExporters(promExporter),
 	)
 
-	// Run scenario and feed metrics to Prometheus exporter
 	ctx := context.Background()
 	fmt.Println("Running chaos scenario...")
 
@@ -71,19 +71,6 @@ func main() {
 		log.Printf("Scenario failed: %v", err)
 	}
 
-	// Export metrics to Prometheus exporter
-	for _, result := range executor.Reporter().Results() {
-		promExporter.RecordExecution(result)
-	}
-
-	// Export injector metrics
-	stats := executor.Metrics().Stats()
-	if injectorMetrics, ok := stats["injector_metrics"].(map[string]map[string]interface{}); ok {
-		for name, metrics := range injectorMetrics {
-			promExporter.RecordInjectorMetrics(name, metrics)
-		}
-	}
-
 	// Print text report
 	fmt.Println("\n" + executor.Reporter().GenerateReport())
 
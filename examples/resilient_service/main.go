@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
@@ -100,17 +101,8 @@ func (s *ResilientService) ProcessRequest(ctx context.Context) error {
 func (s *ResilientService) ProcessRequestWithRetry(ctx context.Context, maxRetries int) error {
 	s.requestCount.Add(1)
 	for attempt := 0; attempt < maxRetries; attempt++ {
-		err := func() (err error) {
-			// Recover from panics in retry loop
-			defer func() {
-				if r := recover(); r != nil {
-					s.panicCount.Add(1)
-					s.recoveredPanics.Add(1)
-					err = fmt.Errorf("recovered from panic: %v", r)
-					log.Printf("[%s] Retry attempt %d: recovered from panic: %v", s.name, attempt+1, r)
-				}
-			}()
-
+		// Protect recovers from panics in the retry loop
+		err := chaoskit.Protect(ctx, func(ctx context.Context) error {
 			// Potential chaos points
 			chaoskit.MaybePanic(ctx)
 			chaoskit.MaybeDelay(ctx)
@@ -121,7 +113,12 @@ func (s *ResilientService) ProcessRequestWithRetry(ctx context.Context, maxRetri
 			}
 
 			return nil
-		}()
+		})
+		if errors.Is(err, chaoskit.ErrPanicRecovered) {
+			s.panicCount.Add(1)
+			s.recoveredPanics.Add(1)
+			log.Printf("[%s] Retry attempt %d: %v", s.name, attempt+1, err)
+		}
 
 		if err == nil {
 			s.successCount.Add(1)
@@ -176,8 +173,8 @@ func main() {
 		Inject("panic", injectors.PanicProbability(0.15)). // 15% chance
 		// Inject delays
 		Inject("delay", injectors.RandomDelay(5*time.Millisecond, 20*time.Millisecond)).
-		// Inject errors with 15% probability
-		Inject("error", injectors.ErrorWithProbability("temporary error", 0.15)).
+		// Inject unavailable dependency faults with 15% probability
+		Inject("error", injectors.FaultWithProbability(chaoskit.FaultUnavailable, 0.15)).
 		// Validators - allow some panics since we recover from them
 		Assert("panic_recovery", validators.NoPanics(20)).                      // Allow up to 20 panics (we have 25 iterations * 5 requests * 0.15 = ~19 expected)
 		Assert("goroutine_limit", validators.GoroutineLimit(500)).              // High limit to allow for retries
@@ -241,5 +238,5 @@ func main() {
 	}
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
@@ -127,5 +127,5 @@ func main() {
 	fmt.Println(executor.Reporter().GenerateTextReport(report))
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
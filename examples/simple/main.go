@@ -177,5 +177,5 @@ func main() {
 	log.Println(executor.Reporter().GenerateTextReport(report))
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// TestArtifacts writes the report, JUnit XML, decision trace (and dumps of
+// failed iterations) into <root>/<test>/<scenario>; in CI, set the root for
+// all tests with -chaos.artifacts or CHAOSKIT_ARTIFACTS and upload it
+func TestArtifacts(t *testing.T) {
+	root := t.TempDir()
+
+	chaostest.RunChaos(t, "artifacts", &TestTarget{}, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("call", func(ctx context.Context, target chaoskit.Target) error {
+				chaoskit.MaybeDelay(ctx)
+				target.(*TestTarget).Increment()
+
+				return nil
+			}).
+			Inject("delay", injectors.RandomDelayWithProbability(time.Microsecond, 10*time.Microsecond, 0.5))
+	},
+		chaostest.WithRepeat(10),
+		chaostest.WithoutReport(),
+		chaostest.WithArtifactRoot(root),
+	)
+
+	dir := filepath.Join(root, "TestArtifacts", "artifacts")
+	for _, file := range []string{chaostest.ArtifactReport, chaostest.ArtifactJUnit, chaostest.ArtifactDecisions} {
+		if _, err := os.Stat(filepath.Join(dir, file)); err != nil {
+			t.Errorf("missing artifact %s: %v", file, err)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// BenchmarkIncrementWithChaos compares the increment step with and without
+// injected delays; compare runs with benchstat:
+//
+//	go test -bench IncrementWithChaos -count 10 > chaos.txt && benchstat chaos.txt
+func BenchmarkIncrementWithChaos(b *testing.B) {
+	target := &TestTarget{}
+
+	chaostest.RunChaosBench(b, "increment-bench", target, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("increment", func(ctx context.Context, target chaoskit.Target) error {
+				chaoskit.MaybeDelay(ctx)
+				target.(*TestTarget).Increment()
+
+				return nil
+			}).
+			Inject("delay", injectors.RandomDelayWithProbability(10*time.Microsecond, 50*time.Microsecond, 0.2))
+	})
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// startEchoServer stands in for a testcontainers-go container: it starts a
+// dependency and returns its address and a function stopping it
+func startEchoServer(ctx context.Context) (string, func(context.Context) error, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", nil, err
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 64)
+				n, _ := conn.Read(buf)
+				_, _ = conn.Write(buf[:n])
+			}()
+		}
+	}()
+
+	return listener.Addr().String(), func(context.Context) error { return listener.Close() }, nil
+}
+
+// TestContainerTarget provisions a dependency for the chaos test and reaches
+// it through ContainerTarget.Addr; with chaostest.WithToxiproxy and
+// WithProxiedContainer the address would be that of a ToxiProxy proxy
+func TestContainerTarget(t *testing.T) {
+	target := chaostest.NewContainerTarget("echo-service",
+		chaostest.WithContainer("echo", startEchoServer),
+	)
+
+	chaostest.RunChaos(t, "containers", target, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("echo", func(ctx context.Context, _ chaoskit.Target) error {
+				chaoskit.MaybeDelay(ctx)
+
+				conn, err := net.DialTimeout("tcp", target.Addr("echo"), time.Second)
+				if err != nil {
+					return err
+				}
+				defer conn.Close()
+				if _, err := conn.Write([]byte("ping")); err != nil {
+					return err
+				}
+				_, err = conn.Read(make([]byte, 4))
+
+				return err
+			}).
+			Inject("delay", injectors.RandomDelayWithProbability(time.Microsecond, 100*time.Microsecond, 0.5))
+	}, chaostest.WithRepeat(5), chaostest.WithoutReport())
+
+	if addr := target.Addr("echo"); addr != "" {
+		t.Errorf("echo dependency is still running at %s after Teardown", addr)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+func init() {
+	chaoskit.RegisterChaosPoints("counter.increment", "counter.reset")
+}
+
+// TestChaosPointCoverage logs which registered chaos points the test hit,
+// like -cover output, and fails when less than half of them were hit
+func TestChaosPointCoverage(t *testing.T) {
+	chaostest.RunChaos(t, "coverage", &TestTarget{}, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("increment", func(ctx context.Context, target chaoskit.Target) error {
+				chaoskit.MaybeDelayAt(ctx, "counter.increment")
+				target.(*TestTarget).Increment()
+
+				return nil
+			}).
+			WithChaosPoints(chaoskit.ChaosPoint("counter.increment").Delay(time.Microsecond, 0.5))
+	},
+		chaostest.WithRepeat(10),
+		chaostest.WithChaosPointCoverage(0.5),
+		chaostest.WithoutReport(),
+	)
+}
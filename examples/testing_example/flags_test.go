@@ -0,0 +1,24 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// TestChaosOffSwitch shows quarantining: with CHAOSKIT_OFF=1 (or -chaos.off)
+// no injector runs, so a scenario that always fails under chaos passes
+func TestChaosOffSwitch(t *testing.T) {
+	t.Setenv(chaostest.EnvOff, "1")
+
+	chaostest.RunChaos(t, "quarantined", &TestTarget{}, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("call", func(ctx context.Context, target chaoskit.Target) error {
+				return chaoskit.MaybeError(ctx)
+			}).
+			Inject("errors", injectors.ErrorWithProbability("always", 1))
+	}, chaostest.WithRepeat(5), chaostest.WithStrictThresholds())
+}
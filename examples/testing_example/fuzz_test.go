@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// FuzzIncrementWithChaos lets the fuzzer pick the seed, delay and error
+// probabilities of the scenario:
+//
+//	go test -run XXX -fuzz IncrementWithChaos -fuzztime 30s
+func FuzzIncrementWithChaos(f *testing.F) {
+	f.Add(int64(42), []byte{0x40, 0x00, 0x20, 0x00})
+
+	target := &TestTarget{}
+
+	chaostest.FuzzChaos(f, "increment-fuzz", target, func(s *chaoskit.ScenarioBuilder, p *chaostest.ChaosParams) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("increment", func(ctx context.Context, target chaoskit.Target) error {
+				chaoskit.MaybeDelay(ctx)
+				target.(*TestTarget).Increment()
+
+				return nil
+			}).
+			Inject("delay", injectors.RandomDelayWithProbability(
+				time.Microsecond, p.Duration(time.Microsecond, time.Millisecond), p.Probability(0.5)))
+	},
+		chaostest.WithRepeat(3),
+		chaostest.WithoutReport(),
+	)
+}
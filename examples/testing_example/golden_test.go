@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// TestIncrementGolden locks in the report of a seeded scenario;
+// refresh the golden file with: go test -run IncrementGolden -chaos.update
+func TestIncrementGolden(t *testing.T) {
+	scenario := chaoskit.NewScenario("increment-golden").
+		WithTarget(&TestTarget{}).
+		WithSeed(7).
+		Step("increment", func(ctx context.Context, target chaoskit.Target) error {
+			chaoskit.MaybeDelay(ctx)
+			target.(*TestTarget).Increment()
+
+			return nil
+		}).
+		Inject("delay", injectors.RandomDelayWithProbability(time.Microsecond, 10*time.Microsecond, 0.3)).
+		Repeat(20).
+		Build()
+
+	executor := chaoskit.NewExecutor(chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure))
+	if err := executor.Run(context.Background(), scenario); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	report, err := executor.Reporter().GetVerdict(chaoskit.DefaultThresholds())
+	if err != nil {
+		t.Fatalf("GetVerdict() error = %v", err)
+	}
+
+	chaostest.AssertReportMatches(t, report, "testdata/increment.golden.json",
+		chaostest.ReportTolerances{SuccessRate: 0.05, FailureCount: 1, Injections: 0.25})
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"testing"
+
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// TestMain runs the tests with chaostest.Main, which prints the verdicts of
+// all chaos tests at the end and writes them to summary.json when an artifact
+// root is set (e.g. go test -chaos.artifacts=chaos-artifacts)
+func TestMain(m *testing.M) {
+	chaostest.Main(m)
+}
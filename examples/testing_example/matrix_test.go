@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// TestIncrementMatrix runs the increment scenario for every fault set,
+// intensity and seed, with a verdict per combination
+func TestIncrementMatrix(t *testing.T) {
+	target := &TestTarget{}
+
+	results := chaostest.RunMatrix(t, target, chaostest.ChaosMatrix{
+		Name: "increment",
+		Scenario: func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+			return s.Step("increment", func(ctx context.Context, target chaoskit.Target) error {
+				chaoskit.MaybeDelay(ctx)
+				target.(*TestTarget).Increment()
+
+				return nil
+			})
+		},
+		Faults: []chaostest.MatrixFaults{
+			{Name: "delay", Injectors: func(p float64) []chaoskit.Injector {
+				return []chaoskit.Injector{injectors.RandomDelayWithProbability(time.Microsecond, 100*time.Microsecond, p)}
+			}},
+			{Name: "cancellation", Injectors: func(p float64) []chaoskit.Injector {
+				return []chaoskit.Injector{injectors.NewContextCancellationInjector(p)}
+			}},
+		},
+		Intensities: []float64{0.1, 0.5},
+		Seeds:       []int64{1, 2},
+	}, chaostest.WithRepeat(5), chaostest.WithoutReport())
+
+	if len(results) != 8 {
+		t.Fatalf("got %d matrix results, want 8", len(results))
+	}
+}
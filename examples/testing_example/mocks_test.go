@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/testing/mocks"
+)
+
+// withWarmup is a custom scenario wrapper under test: it adds a warmup step
+// and a watchdog validator to a scenario
+func withWarmup(s *chaoskit.ScenarioBuilder, watchdog chaoskit.Validator) *chaoskit.ScenarioBuilder {
+	return s.
+		Step("warmup", func(ctx context.Context, target chaoskit.Target) error { return nil }).
+		Assert("watchdog", watchdog)
+}
+
+// TestScenarioWrapperWithMocks unit-tests withWarmup with fakes instead of real chaos
+func TestScenarioWrapperWithMocks(t *testing.T) {
+	target := mocks.NewFakeTarget("service")
+	injector := mocks.NewFakeInjector("fake")
+	watchdog := mocks.NewFakeValidator("watchdog").Script(nil, errors.New("stalled"))
+
+	scenario := withWarmup(chaoskit.NewScenario("wrapped").WithTarget(target), watchdog).
+		Inject("fake", injector).
+		Repeat(3).
+		Build()
+	executor := chaoskit.NewExecutor(chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure))
+	if err := executor.Run(context.Background(), scenario); err == nil {
+		t.Fatal("expected the scripted watchdog failure to fail the run")
+	}
+
+	if calls := target.Calls(); !slices.Equal(calls, []string{mocks.CallSetup, mocks.CallTeardown}) {
+		t.Errorf("target calls = %v, want Setup then Teardown", calls)
+	}
+	if injector.CallCount(mocks.CallInject) != 1 || injector.CallCount(mocks.CallStop) != 1 {
+		t.Errorf("injector calls = %v, want one Inject and one Stop", injector.Calls())
+	}
+	if got := watchdog.CallCount(mocks.CallValidate); got != 3 {
+		t.Errorf("watchdog validated %d times, want 3", got)
+	}
+
+	report, err := executor.Reporter().GetVerdict(chaoskit.DefaultThresholds())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.FailureCount != 1 {
+		t.Errorf("expected 1 failed iteration, got %d", report.FailureCount)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+var errPaymentDeclined = errors.New("payment declined")
+
+// chargePayment is patched by the monkey patch injector of TestMonkeyPatching
+var chargePayment = func(amount int) error {
+	return nil
+}
+
+// TestMonkeyPatching is skipped unless run with go test -gcflags=all=-l,
+// instead of passing without its patch applying
+func TestMonkeyPatching(t *testing.T) {
+	chaostest.RequireMonkeyPatching(t)
+
+	declined := 0
+	chaostest.RunChaos(t, "monkey-patching", &TestTarget{}, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("charge", func(ctx context.Context, target chaoskit.Target) error {
+				if err := chargePayment(100); errors.Is(err, errPaymentDeclined) {
+					declined++
+				}
+
+				return nil
+			}).
+			Inject("declines", injectors.MonkeyPatchError([]injectors.ErrorPatchTarget{
+				{Func: &chargePayment, Error: errPaymentDeclined, Probability: 1},
+			}))
+	}, chaostest.WithRepeat(3), chaostest.WithoutReport())
+
+	if declined != 3 {
+		t.Errorf("expected 3 declined payments, got %d", declined)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// recordingT records the outcome of a chaos test expected to fail
+type recordingT struct {
+	failed bool
+	logs   []string
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) { r.failed = true }
+func (r *recordingT) FailNow()                                  { r.failed = true }
+func (r *recordingT) Helper()                                   {}
+func (r *recordingT) Logf(format string, args ...interface{}) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+// TestShrinking shows how WithShrinking narrows a failure down to the
+// injector causing it
+func TestShrinking(t *testing.T) {
+	rec := &recordingT{}
+
+	chaostest.RunChaos(rec, "shrinking", &TestTarget{}, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("call", func(ctx context.Context, target chaoskit.Target) error {
+				chaoskit.MaybeDelay(ctx)
+				target.(*TestTarget).Increment()
+
+				return chaoskit.MaybeError(ctx)
+			}).
+			Inject("delay", injectors.RandomDelayWithProbability(time.Microsecond, 10*time.Microsecond, 0.5)).
+			Inject("errors", injectors.ErrorWithProbability("payment declined", 1)).
+			Inject("panics", injectors.PanicProbability(0))
+	}, chaostest.WithRepeat(3), chaostest.WithoutReport(), chaostest.WithShrinking(0))
+
+	if !rec.failed {
+		t.Fatal("expected the chaos test to fail")
+	}
+	log := strings.Join(rec.logs, "\n")
+	if !strings.Contains(log, "to 1 of 3 injectors [error_injector]") {
+		t.Fatalf("expected the failure to shrink to the error injector, got:\n%s", log)
+	}
+	t.Log(log)
+}
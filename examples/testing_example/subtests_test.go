@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/injectors"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// TestSubtests runs each iteration as a subtest (TestSubtests/iter-1 ...);
+// a failing one logs how to rerun it alone, e.g.
+// go test -run '^TestSubtests$/^iter-3$' -chaos.seed=<seed>
+func TestSubtests(t *testing.T) {
+	chaostest.RunChaos(t, "subtests", &TestTarget{}, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+		return s.
+			Step("call", func(ctx context.Context, target chaoskit.Target) error {
+				chaoskit.MaybeDelay(ctx)
+				target.(*TestTarget).Increment()
+
+				return nil
+			}).
+			Inject("delay", injectors.RandomDelayWithProbability(time.Microsecond, 10*time.Microsecond, 0.5))
+	},
+		chaostest.WithRepeat(5),
+		chaostest.WithSubtests(),
+		chaostest.WithoutReport(),
+	)
+}
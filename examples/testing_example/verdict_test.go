@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -159,3 +160,48 @@ func TestVerdictWithoutVerdictCalculation(t *testing.T) {
 		chaostest.WithoutVerdict(), // No verdict calculation
 	)
 }
+
+// TestRequirePass runs an executor directly and asserts its verdict,
+// replacing the GetVerdict/GenerateTextReport boilerplate
+func TestRequirePass(t *testing.T) {
+	scenario := chaoskit.NewScenario("require-pass").
+		WithTarget(&TestTarget{}).
+		Step("work", func(ctx context.Context, target chaoskit.Target) error {
+			target.(*TestTarget).Increment()
+			return nil
+		}).
+		Inject("delay", injectors.RandomDelay(time.Microsecond, 10*time.Microsecond)).
+		Repeat(5).
+		Build()
+
+	executor := chaoskit.NewExecutor()
+	if err := executor.Run(context.Background(), scenario); err != nil {
+		t.Fatal(err)
+	}
+
+	report := chaostest.RequirePass(t, executor, chaoskit.DefaultThresholds())
+	chaostest.RequireAtLeastUnstable(t, executor, chaoskit.RelaxedThresholds())
+	if report.TotalIterations != 5 {
+		t.Errorf("expected 5 iterations, got %d", report.TotalIterations)
+	}
+}
+
+// TestRequirePassFails shows the concise failure of RequirePass
+func TestRequirePassFails(t *testing.T) {
+	scenario := chaoskit.NewScenario("require-pass-fails").
+		WithTarget(&TestTarget{}).
+		Step("work", func(ctx context.Context, target chaoskit.Target) error {
+			return errors.New("dependency down")
+		}).
+		Repeat(3).
+		Build()
+
+	executor := chaoskit.NewExecutor(chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure))
+	_ = executor.Run(context.Background(), scenario)
+
+	rec := &recordingT{}
+	chaostest.RequireAtLeastUnstable(rec, executor, nil)
+	if !rec.failed {
+		t.Error("expected RequireAtLeastUnstable to fail for a scenario failing every iteration")
+	}
+}
@@ -293,5 +293,5 @@ func main() {
 	log.Println("  - Modify proxyListen if port 18080 is occupied")
 
 	// Exit with verdict code
-	os.Exit(report.Verdict.ExitCode())
+	os.Exit(report.ExitCode())
 }
@@ -0,0 +1,20 @@
+package chaoskit
+
+import "context"
+
+// executionIDKey is a private type for context key
+type executionIDKey struct{}
+
+// ExecutionID returns the ID of the Executor.Run call ctx belongs to, or ""
+// outside runs. Injectors holding process-wide state, such as monkey patches,
+// use it to tell apart runs of parallel tests.
+func ExecutionID(ctx context.Context) string {
+	id, _ := ctx.Value(executionIDKey{}).(string)
+
+	return id
+}
+
+// attachExecutionID attaches a new execution ID to ctx
+func attachExecutionID(ctx context.Context) context.Context {
+	return context.WithValue(ctx, executionIDKey{}, NewFaultID())
+}
@@ -2,11 +2,19 @@ package chaoskit
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"math/rand"
+	"net"
 	"os"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -18,6 +26,37 @@ type ExecutionResult struct {
 	Duration      time.Duration
 	StepsExecuted int
 	Timestamp     time.Time
+
+	// Iteration is the 1-based iteration number within the scenario run
+	Iteration int
+
+	// Injectors lists the names of injectors active during this execution
+	Injectors []string
+
+	// PanicStack is the stack trace of a recovered step panic, if any
+	PanicStack string
+
+	// StepDurations holds the duration of each executed step in execution order
+	StepDurations []StepDuration
+
+	// Artifacts lists post-mortem artifact files captured on failure (see WithArtifacts)
+	Artifacts []string
+
+	// Output is the tail of the target output of a failed iteration (see WithOutputCapture)
+	Output string
+
+	// FailureClass tells where the failure came from (see ClassifyFailure)
+	FailureClass FailureClass
+
+	// Injections lists the faults injected during this execution in injection
+	// order (up to MaxIterationInjections)
+	Injections []InjectionEvent
+}
+
+// StepDuration is the duration of a single step execution
+type StepDuration struct {
+	Step     string
+	Duration time.Duration
 }
 
 // FailurePolicy defines how the executor handles failures
@@ -32,10 +71,26 @@ const (
 
 // Executor runs scenarios
 type Executor struct {
-	metrics       *MetricsCollector
-	reporter      *Reporter
-	logger        *slog.Logger
-	failurePolicy FailurePolicy
+	metrics         *MetricsCollector
+	reporter        *Reporter
+	logger          *slog.Logger
+	failurePolicy   FailurePolicy
+	observers       []ExecutionObserver
+	exporters       []ResultExporter
+	resultSink      io.Writer
+	sinkMu          sync.Mutex
+	artifacts       *ArtifactConfig
+	outputTail      int
+	redactor        *Redactor
+	trace           *traceRecorder
+	replay          *traceReplay
+	intensity       *float64
+	iterationRunner IterationRunner
+	resultRetention int
+	pendingReload   atomic.Pointer[Scenario]
+	pauseMu         sync.Mutex
+	resumed         chan struct{} // non-nil while paused (see Pause)
+	healthInterval  time.Duration
 }
 
 // ExecutorOption configures an Executor
@@ -70,6 +125,15 @@ func WithFailurePolicy(policy FailurePolicy) ExecutorOption {
 	}
 }
 
+// WithIntensity scales the fault probability of injectors implementing
+// IntensityScaler by factor before they start: 0.5 halves it, 2 doubles it
+// (capped at 1). Other injectors run unscaled.
+func WithIntensity(factor float64) ExecutorOption {
+	return func(e *Executor) {
+		e.intensity = &factor
+	}
+}
+
 // WithMetrics sets a custom metrics collector
 func WithMetrics(metrics *MetricsCollector) ExecutorOption {
 	return func(e *Executor) {
@@ -84,6 +148,38 @@ func WithReporter(reporter *Reporter) ExecutorOption {
 	}
 }
 
+// WithResultRetention keeps only the last n detailed results in the reporter,
+// while its statistics still cover every iteration (see Reporter.SetResultRetention)
+func WithResultRetention(n int) ExecutorOption {
+	return func(e *Executor) {
+		e.resultRetention = n
+	}
+}
+
+// WithExporters registers exporters fed with results and injector metrics
+// while the scenario runs
+func WithExporters(exporters ...ResultExporter) ExecutorOption {
+	return func(e *Executor) {
+		e.exporters = append(e.exporters, exporters...)
+	}
+}
+
+// WithObservers registers observers notified about iterations, steps and injections
+func WithObservers(observers ...ExecutionObserver) ExecutorOption {
+	return func(e *Executor) {
+		e.observers = append(e.observers, observers...)
+	}
+}
+
+// WithResultSink streams every execution result to w as one NDJSON line
+// as soon as the iteration completes. Pass an *os.File to keep results
+// of long campaigns even if the process crashes mid-run.
+func WithResultSink(w io.Writer) ExecutorOption {
+	return func(e *Executor) {
+		e.resultSink = w
+	}
+}
+
 // NewExecutor creates a new executor with options
 func NewExecutor(opts ...ExecutorOption) *Executor {
 	e := &Executor{
@@ -97,9 +193,24 @@ func NewExecutor(opts ...ExecutorOption) *Executor {
 		opt(e)
 	}
 
+	if e.redactor != nil {
+		e.reporter.SetRedactor(e.redactor)
+	}
+	if e.resultRetention > 0 {
+		e.reporter.SetResultRetention(e.resultRetention)
+	}
+
 	return e
 }
 
+// WithRedactor redacts results, injection events and injector metrics before
+// they reach the reporter, exporters, observers, the result sink and artifacts
+func WithRedactor(redactor *Redactor) ExecutorOption {
+	return func(e *Executor) {
+		e.redactor = redactor
+	}
+}
+
 // wrappedStep is a helper type that wraps a function to implement the Step interface
 type wrappedStep struct {
 	name    string
@@ -162,94 +273,77 @@ func (e *Executor) getAllInjectors(scenario *Scenario) []Injector {
 }
 
 // Run executes a scenario
-func (e *Executor) Run(ctx context.Context, scenario *Scenario) error {
+func (e *Executor) Run(ctx context.Context, scenario *Scenario) (err error) {
 	if scenario.target == nil {
 		return fmt.Errorf("scenario %s has no target", scenario.name)
 	}
 
 	// Create a deterministic random generator if seed is set
-	var rng *rand.Rand
-	if scenario.seed != nil {
-		rng = rand.New(rand.NewSource(*scenario.seed))
+	seed := rand.Int63()
+	switch {
+	case e.replay != nil:
+		seed = e.replay.seed
+		if e.logger != nil {
+			e.logger.Info("replaying decision trace",
+				slog.String("scenario", scenario.name),
+				slog.Int64("seed", seed))
+		}
+	case scenario.seed != nil:
+		seed = *scenario.seed
 		if e.logger != nil {
 			e.logger.Info("using deterministic seed",
 				slog.String("scenario", scenario.name),
-				slog.Int64("seed", *scenario.seed))
+				slog.Int64("seed", seed))
 		}
-	} else {
-		rng = rand.New(rand.NewSource(rand.Int63()))
 	}
-	ctx = AttachRand(ctx, rng)
-
-	// Setup target
-	if err := scenario.target.Setup(ctx); err != nil {
-		return fmt.Errorf("setup failed: %w", err)
+	e.pendingReload.Store(nil)
+	ctx = attachSeededRand(ctx, seed)
+	ctx = attachRunSeed(ctx, seed)
+	ctx = attachExecutionID(ctx)
+	ctx = attachInjectionRates(ctx, newInjectionRates())
+	ctx = attachEventBus(ctx, newEventBus())
+	if e.trace != nil {
+		e.trace.begin(scenario.name, seed)
 	}
+	e.notifyRunStart(ctx, scenario.name)
 	defer func() {
-		if err := scenario.target.Teardown(ctx); err != nil {
-			if e.logger != nil {
-				e.logger.Warn("teardown error",
-					slog.String("scenario", scenario.name),
-					slog.String("error", err.Error()))
-			}
-		}
+		e.notifyRunEnd(ctx, scenario.name, err)
 	}()
 
-	// Collect all injectors (from direct injectors and scopes)
-	allInjectors := e.getAllInjectors(scenario)
-
-	// Setup network injectors first (if they need proxy setup)
-	networkInjectors := make([]Injector, 0)
-	for _, inj := range allInjectors {
-		if lifecycle, ok := inj.(NetworkInjectorLifecycle); ok {
-			if err := lifecycle.SetupNetwork(ctx); err != nil {
-				return fmt.Errorf("network setup failed for %s: %w", inj.Name(), err)
-			}
-			networkInjectors = append(networkInjectors, inj)
-			if e.logger != nil {
-				e.logger.Info("network injector setup completed",
-					slog.String("scenario", scenario.name),
-					slog.String("injector", inj.Name()))
-			}
+	// Capture target output per iteration
+	if e.outputTail > 0 {
+		output := newTailBuffer(e.outputTail)
+		ctx = attachOutput(ctx, output)
+		if capturer, ok := scenario.target.(OutputCapturer); ok {
+			capturer.SetOutput(output)
 		}
 	}
-	defer func() {
-		for _, inj := range networkInjectors {
-			if lifecycle, ok := inj.(NetworkInjectorLifecycle); ok {
-				if err := lifecycle.TeardownNetwork(ctx); err != nil {
-					if e.logger != nil {
-						e.logger.Warn("network teardown error",
-							slog.String("scenario", scenario.name),
-							slog.String("injector", inj.Name()),
-							slog.String("error", err.Error()))
-					}
-				}
-			}
-		}
-	}()
 
-	// Start injectors
-	activeInjectors := make([]Injector, 0, len(allInjectors))
-	for _, inj := range allInjectors {
-		if err := inj.Inject(ctx); err != nil {
-			if e.logger != nil {
-				e.logger.Error("injector failed to start",
-					slog.String("scenario", scenario.name),
-					slog.String("injector", inj.Name()),
-					slog.String("error", err.Error()))
-			}
-			// Stop already started injectors
-			e.stopInjectors(ctx, activeInjectors)
+	// Setup target. Teardown runs in reverse (see teardown.go), deferred
+	// so it also runs when a later component panics.
+	if err := callSafely(func() error { return scenario.target.Setup(ctx) }); err != nil {
+		err = fmt.Errorf("setup failed: %w", err)
+		e.recordFrameworkFailure(scenario, err)
 
-			return fmt.Errorf("injector %s failed: %w", inj.Name(), err)
-		}
-		activeInjectors = append(activeInjectors, inj)
+		return err
+	}
+	defer e.teardownTarget(ctx, scenario)
+
+	injectors, err := e.startInjectors(ctx, scenario, "")
+	if err != nil {
+		return err
 	}
-	defer e.stopInjectors(ctx, activeInjectors)
+	run := &scenarioRun{scenario: scenario, injectors: injectors}
+	defer func() {
+		e.stopRunInjectors(ctx, run.scenario, run.injectors)
+	}()
+	defer func() {
+		e.finalizeValidators(ctx, run.scenario, run.scenario.validators)
+	}()
 
 	// Execute scenario
 	if scenario.duration > 0 {
-		return e.runForDuration(ctx, scenario)
+		return e.runForDuration(ctx, run)
 	}
 
 	// Check if repeat is set
@@ -258,12 +352,76 @@ func (e *Executor) Run(ctx context.Context, scenario *Scenario) error {
 			scenario.name, scenario.repeat)
 	}
 
-	return e.runRepeated(ctx, scenario)
+	return e.runRepeated(ctx, run)
 }
 
-func (e *Executor) stopInjectors(ctx context.Context, injectors []Injector) {
+// notifyRunStart notifies the observers implementing RunObserver about the start of a run
+func (e *Executor) notifyRunStart(ctx context.Context, scenario string) {
+	for _, obs := range e.observers {
+		if runObs, ok := obs.(RunObserver); ok {
+			runObs.OnRunStart(ctx, scenario)
+		}
+	}
+}
+
+// notifyRunEnd notifies the observers implementing RunObserver about the end
+// of a run, with err redacted
+func (e *Executor) notifyRunEnd(ctx context.Context, scenario string, err error) {
+	err = e.redactor.RedactError(err)
+	for _, obs := range e.observers {
+		if runObs, ok := obs.(RunObserver); ok {
+			runObs.OnRunEnd(ctx, scenario, err)
+		}
+	}
+}
+
+func injectorNames(injectors []Injector) []string {
+	if len(injectors) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(injectors))
+	for _, inj := range injectors {
+		names = append(names, inj.Name())
+	}
+
+	return names
+}
+
+func (e *Executor) snapshotInjectorMetrics(injectors []Injector) {
+	for _, inj := range injectors {
+		if metricsProvider, ok := inj.(MetricsProvider); ok {
+			metrics := metricsProvider.GetMetrics()
+			e.reporter.SetInjectorMetrics(inj.Name(), metrics)
+			for _, exp := range e.exporters {
+				exp.RecordInjectorMetrics(inj.Name(), metrics)
+			}
+		}
+	}
+}
+
+// scaleIntensity scales the fault probability of injectors by factor
+func (e *Executor) scaleIntensity(scenario *Scenario, injectors []Injector, factor float64) {
 	for _, inj := range injectors {
-		if err := inj.Stop(ctx); err != nil {
+		if scaler, ok := inj.(IntensityScaler); ok {
+			scaler.ScaleIntensity(factor)
+
+			continue
+		}
+		if e.logger != nil {
+			e.logger.Warn("injector does not support intensity scaling",
+				slog.String("scenario", scenario.name),
+				slog.String("injector", inj.Name()),
+				slog.Float64("intensity", factor))
+		}
+	}
+}
+
+// stopInjectors stops injectors in reverse order, so stacked patches of the
+// same function are undone last-in first-out
+func (e *Executor) stopInjectors(ctx context.Context, injectors []Injector) {
+	for _, inj := range slices.Backward(injectors) {
+		if err := callSafely(func() error { return inj.Stop(ctx) }); err != nil {
 			if e.logger != nil {
 				e.logger.Warn("injector failed to stop",
 					slog.String("injector", inj.Name()),
@@ -273,22 +431,33 @@ func (e *Executor) stopInjectors(ctx context.Context, injectors []Injector) {
 	}
 }
 
-func (e *Executor) runRepeated(ctx context.Context, scenario *Scenario) error {
+func (e *Executor) runRepeated(ctx context.Context, run *scenarioRun) error {
 	var firstError error
 
-	for i := 0; i < scenario.repeat; i++ {
+	for i := 0; i < run.scenario.repeat; i++ {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
 		}
 
+		if err := e.holdWhilePaused(ctx, run); err != nil {
+			return err
+		}
+		if err := e.applyReload(ctx, run); err != nil {
+			return err
+		}
+		e.checkInjectorHealth(ctx, run)
+		scenario := run.scenario
+
 		// Reset validators before each iteration
 		e.resetValidators(scenario.validators)
 
-		result := e.executeOnce(ctx, scenario)
-		e.metrics.RecordExecution(result)
-		e.reporter.AddResult(result)
+		result, ran := e.runIteration(ctx, scenario, i+1)
+		if !ran {
+			continue
+		}
+		e.recordResult(result)
 
 		if result.Error != nil {
 			if firstError == nil {
@@ -311,8 +480,8 @@ func (e *Executor) runRepeated(ctx context.Context, scenario *Scenario) error {
 	return firstError
 }
 
-func (e *Executor) runForDuration(ctx context.Context, scenario *Scenario) error {
-	ctx, cancel := context.WithTimeout(ctx, scenario.duration)
+func (e *Executor) runForDuration(ctx context.Context, run *scenarioRun) error {
+	ctx, cancel := context.WithTimeout(ctx, run.scenario.duration)
 	defer cancel()
 
 	iteration := 0
@@ -330,16 +499,33 @@ func (e *Executor) runForDuration(ctx context.Context, scenario *Scenario) error
 		default:
 		}
 
+		if err := e.holdWhilePaused(ctx, run); err != nil {
+			// A run that times out while paused ends as usual
+			if errors.Is(err, context.DeadlineExceeded) {
+				return firstError
+			}
+
+			return err
+		}
+		if err := e.applyReload(ctx, run); err != nil {
+			return err
+		}
+		e.checkInjectorHealth(ctx, run)
+		scenario := run.scenario
+
 		// Reset validators before each iteration
 		e.resetValidators(scenario.validators)
 
-		result := e.executeOnce(ctx, scenario)
-		e.metrics.RecordExecution(result)
-		e.reporter.AddResult(result)
+		result, ran := e.runIteration(ctx, scenario, iteration+1)
+		iteration++
+		if !ran {
+			continue
+		}
+		e.recordResult(result)
 
 		if result.Error != nil {
 			if firstError == nil {
-				firstError = fmt.Errorf("execution %d failed: %w", iteration+1, result.Error)
+				firstError = fmt.Errorf("execution %d failed: %w", iteration, result.Error)
 			}
 
 			if e.failurePolicy == FailFast {
@@ -349,14 +535,72 @@ func (e *Executor) runForDuration(ctx context.Context, scenario *Scenario) error
 			if e.logger != nil {
 				e.logger.Warn("execution failed (continuing)",
 					slog.String("scenario", scenario.name),
-					slog.Int("iteration", iteration+1),
+					slog.Int("iteration", iteration),
 					slog.String("error", result.Error.Error()))
 			}
 		}
-		iteration++
 	}
 }
 
+// recordResult stores the result in metrics, reporter and exporters and streams it to the sink
+func (e *Executor) recordResult(result ExecutionResult) {
+	e.metrics.RecordExecution(result)
+	e.reporter.AddResult(result)
+	if e.trace != nil {
+		e.trace.finishIteration(result)
+	}
+	for _, exp := range e.exporters {
+		exp.RecordExecution(result)
+	}
+
+	if e.resultSink != nil {
+		if err := e.writeResult(result); err != nil && e.logger != nil {
+			e.logger.Warn("failed to write result to sink",
+				slog.String("scenario", result.ScenarioName),
+				slog.Int("iteration", result.Iteration),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+// recordFrameworkFailure records a failure of the test setup (target setup/teardown,
+// injector lifecycle) so it shows up in reports separately from target failures
+func (e *Executor) recordFrameworkFailure(scenario *Scenario, err error) {
+	e.recordResult(e.redactor.RedactResult(ExecutionResult{
+		ScenarioName: scenario.name,
+		Success:      false,
+		Error:        err,
+		Timestamp:    time.Now(),
+		Injectors:    injectorNames(e.getAllInjectors(scenario)),
+		FailureClass: FailureFramework,
+	}))
+}
+
+func (e *Executor) recordValidatorMetrics(validatorName string, failed bool) {
+	for _, exp := range e.exporters {
+		if validatorExp, ok := exp.(ValidatorMetricsExporter); ok {
+			validatorExp.RecordValidatorMetrics(validatorName, failed, false)
+		}
+	}
+}
+
+func (e *Executor) writeResult(result ExecutionResult) error {
+	jr := newJSONResult(result)
+	jr.SchemaVersion = ReportSchemaVersion
+	line, err := json.Marshal(jr)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	e.sinkMu.Lock()
+	defer e.sinkMu.Unlock()
+
+	_, err = e.resultSink.Write(line)
+
+	return err
+}
+
 func (e *Executor) resetValidators(validators []Validator) {
 	for _, val := range validators {
 		if resettable, ok := val.(Resettable); ok {
@@ -365,29 +609,76 @@ func (e *Executor) resetValidators(validators []Validator) {
 	}
 }
 
-func (e *Executor) executeOnce(ctx context.Context, scenario *Scenario) ExecutionResult {
+// executeOnce runs a single iteration and notifies observers about its start and end
+func (e *Executor) executeOnce(ctx context.Context, scenario *Scenario, iteration int) ExecutionResult {
+	for _, obs := range e.observers {
+		ctx = obs.OnIterationStart(ctx, scenario.name, iteration)
+	}
+
+	result := e.redactor.RedactResult(e.executeIteration(ctx, scenario, iteration))
+
+	for _, obs := range e.observers {
+		obs.OnIterationEnd(ctx, result)
+	}
+
+	return result
+}
+
+func (e *Executor) executeIteration(ctx context.Context, scenario *Scenario, iteration int) (result ExecutionResult) {
 	start := time.Now()
-	result := ExecutionResult{
+	result = ExecutionResult{
 		ScenarioName: scenario.name,
+		Iteration:    iteration,
 		Success:      true,
 		Timestamp:    start,
 	}
 
 	// Ensure rand generator is attached (in case executeOnce is called directly)
 	if ctx.Value(randKey{}) == nil {
-		var rng *rand.Rand
+		seed := rand.Int63()
 		if scenario.seed != nil {
-			rng = rand.New(rand.NewSource(*scenario.seed))
-		} else {
-			rng = rand.New(rand.NewSource(rand.Int63()))
+			seed = *scenario.seed
 		}
-		ctx = AttachRand(ctx, rng)
+		ctx = attachSeededRand(ctx, seed)
 	}
 
 	// Attach event recorder to context for steps to use
 	recorder := &validatorEventRecorder{validators: scenario.validators}
 	ctx = AttachRecorder(ctx, recorder)
 
+	// Attach injection recorder so injection events reach the report timeline and observers
+	injections := &injectionRecorder{
+		scenario:  scenario.name,
+		iteration: iteration,
+		reporter:  e.reporter,
+		observers: e.observers,
+		redactor:  e.redactor,
+		bus:       eventBusFromContext(ctx),
+	}
+	ctx = attachInjectionRecorder(ctx, injections)
+	defer func() {
+		result.Injections = injections.resultInjections()
+	}()
+
+	// Capture post-mortem artifacts if the iteration fails
+	if e.artifacts != nil {
+		injections.keepEvents = e.artifacts.EventLog
+		capture := startArtifactCapture(e.artifacts, e.logger, e.redactor)
+		defer func() {
+			capture.finish(&result, injections.recorded())
+		}()
+	}
+
+	// Keep the output tail of a failed iteration (runs before artifact capture)
+	if output := outputFromContext(ctx); output != nil {
+		output.Reset()
+		defer func() {
+			if !result.Success {
+				result.Output = output.String()
+			}
+		}()
+	}
+
 	// Attach logger to context for injectors and validators to use
 	if e.logger != nil {
 		ctx = AttachLogger(ctx, e.logger)
@@ -395,26 +686,48 @@ func (e *Executor) executeOnce(ctx context.Context, scenario *Scenario) Executio
 
 	// Collect all injectors (from direct injectors and scopes)
 	allInjectors := e.getAllInjectors(scenario)
+	result.Injectors = injectorNames(allInjectors)
 
 	// Attach chaos context for user code to use
-	chaosCtx := e.buildChaosContext(ctx, allInjectors)
+	decisions := newIterationDecisions(iteration, e.trace, e.replay)
+	chaosCtx := e.buildChaosContext(allInjectors, decisions)
+	chaosCtx.points = scenario.points
+	chaosCtx.scopes = e.buildScopedChaos(scenario, decisions)
+	chaosCtx.limiter = newInjectionLimiter(scenario, injectionRatesFromContext(ctx))
+	for _, scoped := range chaosCtx.scopes {
+		scoped.limiter = chaosCtx.limiter
+	}
+	e.recordInjectorMetrics(allInjectors)
 	ctx = AttachChaos(ctx, chaosCtx)
 
 	// Execute steps with panic recovery
 	for i, step := range scenario.steps {
+		stepCtx := ctx
+		for _, obs := range e.observers {
+			stepCtx = obs.OnStepStart(stepCtx, step.Name())
+		}
+
+		stepStart := time.Now()
+		frameworkErr := false
 		stepErr := func() (err error) {
 			defer func() {
 				if r := recover(); r != nil {
 					// record panic and convert to error
-					recorder.RecordPanic(ctx)
+					recorder.RecordPanic(stepCtx)
+					result.PanicStack = string(debug.Stack())
 					err = fmt.Errorf("panic in step %s: %v", step.Name(), r)
+					if injected, ok := r.(*ChaosError); ok {
+						err = fmt.Errorf("panic in step %s: %w", step.Name(), injected)
+					}
 				}
 			}()
 
 			// Apply injectors before step
 			for _, inj := range allInjectors {
 				if stepInj, ok := inj.(StepInjector); ok {
-					if err := stepInj.BeforeStep(ctx); err != nil {
+					if err := stepInj.BeforeStep(stepCtx); err != nil {
+						frameworkErr = true
+
 						return fmt.Errorf("injector %s before step failed: %w", inj.Name(), err)
 					}
 				}
@@ -435,12 +748,14 @@ func (e *Executor) executeOnce(ctx context.Context, scenario *Scenario) Executio
 			}
 
 			// Execute wrapped step
-			stepErr := wrappedStepFunc(ctx, scenario.target)
+			stepErr := wrappedStepFunc(stepCtx, scenario.target)
 
 			// Apply injectors after step
 			for _, inj := range allInjectors {
 				if stepInj, ok := inj.(StepInjector); ok {
-					if err := stepInj.AfterStep(ctx, stepErr); err != nil {
+					if err := stepInj.AfterStep(stepCtx, stepErr); err != nil {
+						frameworkErr = true
+
 						return fmt.Errorf("injector %s after step failed: %w", inj.Name(), err)
 					}
 				}
@@ -448,10 +763,22 @@ func (e *Executor) executeOnce(ctx context.Context, scenario *Scenario) Executio
 
 			return stepErr
 		}()
+		result.StepDurations = append(result.StepDurations, StepDuration{
+			Step:     step.Name(),
+			Duration: time.Since(stepStart),
+		})
+
+		for _, obs := range e.observers {
+			obs.OnStepEnd(stepCtx, step.Name(), e.redactor.RedactError(stepErr))
+		}
 
 		if stepErr != nil {
 			result.Success = false
 			result.Error = fmt.Errorf("step %s failed: %w", step.Name(), stepErr)
+			result.FailureClass = classifyStepError(stepErr)
+			if frameworkErr {
+				result.FailureClass = FailureFramework
+			}
 			result.StepsExecuted = i
 			result.Duration = time.Since(start)
 
@@ -462,9 +789,12 @@ func (e *Executor) executeOnce(ctx context.Context, scenario *Scenario) Executio
 
 	// Run validators
 	for _, val := range scenario.validators {
-		if err := val.Validate(ctx, scenario.target); err != nil {
+		err := val.Validate(ctx, scenario.target)
+		e.recordValidatorMetrics(val.Name(), err != nil)
+		if err != nil {
 			result.Success = false
 			result.Error = fmt.Errorf("validator %s failed: %w", val.Name(), err)
+			result.FailureClass = FailureValidatorViolation
 			result.Duration = time.Since(start)
 
 			return result
@@ -476,21 +806,44 @@ func (e *Executor) executeOnce(ctx context.Context, scenario *Scenario) Executio
 	return result
 }
 
-func (e *Executor) buildChaosContext(ctx context.Context, injectors []Injector) *ChaosContext {
+// buildChaosContext wires injectors into the context helpers; decisions
+// records or replays the faults of the iteration (nil when not tracing)
+func (e *Executor) buildChaosContext(injectors []Injector, decisions *iterationDecisions) *ChaosContext {
 	chaos := &ChaosContext{
 		providers: make(map[string]ChaosProvider),
+		injectors: injectors,
 	}
 
+	var messagingProviders []ChaosMessagingProvider
 	// Find delay injector
 	for _, inj := range injectors {
 		if delayProvider, ok := inj.(ChaosDelayProvider); ok {
 			// Copy provider to local variable to avoid closure issues
 			dp := delayProvider
-			chaos.delayFunc = func() bool {
-				delay, ok := dp.GetChaosDelay(ctx)
-				if ok && delay > 0 {
+			chaos.delayFunc = func(ctx context.Context) bool {
+				call := decisions.next(traceDelay)
+				var delay time.Duration
+				var ok bool
+				if decision, replaying := decisions.replayed(traceDelay, call); replaying {
+					delay, ok = decision.Delay, decision.Delay > 0
+				} else {
+					delay, ok = dp.GetChaosDelay(ctx)
+				}
+				if ok && delay > 0 && chaos.limiter.allow(ctx, dp.Name()) {
+					var attributes map[string]any
+					if bounded := boundDelay(ctx, delay); bounded != delay {
+						attributes = map[string]any{"unbounded_delay": delay.String()}
+						delay = bounded
+					}
+					decisions.record(Decision{Helper: traceDelay, Call: call, Injector: dp.Name(), Delay: delay})
 					GetLogger(ctx).Debug("delay injected in user code",
 						slog.Duration("delay", delay))
+					RecordInjection(ctx, InjectionEvent{
+						Injector:   dp.Name(),
+						Type:       InjectionTypeDelay,
+						Delay:      delay,
+						Attributes: attributes,
+					})
 					time.Sleep(delay)
 
 					return true
@@ -503,12 +856,30 @@ func (e *Executor) buildChaosContext(ctx context.Context, injectors []Injector)
 		if panicProvider, ok := inj.(ChaosErrorProvider); ok {
 			// Copy provider to local variable to avoid closure issues
 			pp := panicProvider
-			chaos.errorFunc = func() error {
-				if err := pp.ShouldReturnError(); err != nil {
+			chaos.errorFunc = func(ctx context.Context) error {
+				call := decisions.next(traceError)
+				var err error
+				if decision, replaying := decisions.replayed(traceError, call); replaying {
+					if decision.Error != "" {
+						err = errors.New(decision.Error)
+					}
+				} else {
+					err = pp.ShouldReturnError()
+				}
+				if err != nil && chaos.limiter.allow(ctx, pp.Name()) {
+					decisions.record(Decision{Helper: traceError, Call: call, Injector: pp.Name(), Error: err.Error()})
+					injected := NewChaosError(ctx, pp.Name(), InjectionTypeError, err)
 					GetLogger(ctx).Debug("error returned in user code",
-						slog.String("error", err.Error()))
+						slog.String("error", err.Error()),
+						slog.String("fault_id", injected.FaultID))
+					RecordInjection(ctx, InjectionEvent{
+						Injector:   pp.Name(),
+						Type:       InjectionTypeError,
+						FaultID:    injected.FaultID,
+						Attributes: map[string]any{"error": err.Error()},
+					})
 
-					return err
+					return injected
 				}
 
 				return nil
@@ -518,15 +889,86 @@ func (e *Executor) buildChaosContext(ctx context.Context, injectors []Injector)
 		if panicProvider, ok := inj.(ChaosPanicProvider); ok {
 			// Copy provider to local variable to avoid closure issues
 			pp := panicProvider
-			chaos.panicFunc = func() bool {
-				if pp.ShouldChaosPanic() {
+			chaos.panicFunc = func(ctx context.Context) *ChaosError {
+				call := decisions.next(tracePanic)
+				var panics bool
+				if decision, replaying := decisions.replayed(tracePanic, call); replaying {
+					panics = decision.Helper != ""
+				} else {
+					panics = pp.ShouldChaosPanic()
+				}
+				if panics && chaos.limiter.allow(ctx, pp.Name()) {
+					decisions.record(Decision{Helper: tracePanic, Call: call, Injector: pp.Name()})
+					injected := NewChaosError(ctx, pp.Name(), InjectionTypePanic, errors.New(injectedPanicMessage))
 					GetLogger(ctx).Debug("panic triggered in user code",
-						slog.Float64("probability", pp.GetPanicProbability()))
+						slog.Float64("probability", pp.GetPanicProbability()),
+						slog.String("fault_id", injected.FaultID))
+					RecordInjection(ctx, InjectionEvent{
+						Injector:   pp.Name(),
+						Type:       InjectionTypePanic,
+						FaultID:    injected.FaultID,
+						Attributes: map[string]any{"probability": pp.GetPanicProbability()},
+					})
 
-					return true
+					return injected
 				}
 
-				return false
+				return nil
+			}
+		}
+
+		if corruptionProvider, ok := inj.(ChaosCorruptionProvider); ok {
+			// Copy provider to local variable to avoid closure issues
+			cp := corruptionProvider
+			chaos.corruptFunc = func(ctx context.Context) bool {
+				call := decisions.next(traceCorrupt)
+				var corrupts bool
+				if decision, replaying := decisions.replayed(traceCorrupt, call); replaying {
+					corrupts = decision.Helper != ""
+				} else {
+					corrupts = cp.ShouldCorrupt()
+				}
+				corrupts = corrupts && chaos.limiter.allow(ctx, cp.Name())
+				if corrupts {
+					decisions.record(Decision{Helper: traceCorrupt, Call: call, Injector: cp.Name()})
+					GetLogger(ctx).Debug("value corrupted in user code",
+						slog.Float64("probability", cp.GetCorruptionProbability()))
+					RecordInjection(ctx, InjectionEvent{
+						Injector:   cp.Name(),
+						Type:       InjectionTypeCorruption,
+						Attributes: map[string]any{"probability": cp.GetCorruptionProbability()},
+					})
+				}
+
+				return corrupts
+			}
+		}
+
+		if contextValueProvider, ok := inj.(ChaosContextValueProvider); ok {
+			// Copy provider to local variable to avoid closure issues
+			vp := contextValueProvider
+			chaos.contextValueFunc = func(ctx context.Context, key, value any) (any, bool) {
+				call := decisions.next(traceContext)
+				var corrupts bool
+				if decision, replaying := decisions.replayed(traceContext, call); replaying {
+					corrupts = decision.Helper != ""
+				} else {
+					corrupts = vp.ShouldCorruptContextValue(key)
+				}
+				if !corrupts || !chaos.limiter.allow(ctx, vp.Name()) {
+					return nil, false
+				}
+
+				decisions.record(Decision{Helper: traceContext, Call: call, Injector: vp.Name()})
+				GetLogger(ctx).Debug("context value corrupted in user code",
+					slog.String("key", contextKeyName(key)))
+				RecordInjection(ctx, InjectionEvent{
+					Injector:   vp.Name(),
+					Type:       InjectionTypeCorruption,
+					Attributes: map[string]any{"context_key": contextKeyName(key)},
+				})
+
+				return vp.CorruptContextValue(key, value), true
 			}
 		}
 
@@ -534,32 +976,62 @@ func (e *Executor) buildChaosContext(ctx context.Context, injectors []Injector)
 		if networkProvider, ok := inj.(ChaosNetworkProvider); ok {
 			// Copy provider to local variable to avoid closure issues
 			np := networkProvider
-			chaos.networkFunc = func(host string, port int) bool {
-				if !np.ShouldApplyNetworkChaos(host, port) {
-					return false
+			chaos.networkFunc = func(ctx context.Context, host string, port int) *ChaosError {
+				call := decisions.next(traceNetwork)
+				decision, replaying := decisions.replayed(traceNetwork, call)
+				if !replaying && !np.ShouldApplyNetworkChaos(host, port) {
+					return nil
+				}
+				if !chaos.limiter.allow(ctx, np.Name()) {
+					return nil
 				}
 
 				// Apply latency if configured
-				if latency, hasLatency := np.GetNetworkLatency(host, port); hasLatency && latency > 0 {
+				latency, hasLatency := decision.Delay, decision.Delay > 0
+				if !replaying {
+					latency, hasLatency = np.GetNetworkLatency(host, port)
+				}
+				if hasLatency && latency > 0 {
+					decisions.record(Decision{Helper: traceNetwork, Call: call, Injector: np.Name(), Delay: latency})
 					GetLogger(ctx).Debug("network latency injected",
 						slog.String("host", host),
 						slog.Int("port", port),
 						slog.Duration("latency", latency))
+					RecordInjection(ctx, InjectionEvent{
+						Injector:   np.Name(),
+						Type:       InjectionTypeNetworkLatency,
+						Delay:      latency,
+						Attributes: map[string]any{"host": host, "port": port},
+					})
 					time.Sleep(latency)
 
-					return true
+					return nil
 				}
 
 				// Check for connection drop
-				if np.ShouldDropConnection(host, port) {
+				dropped := decision.Drop
+				if !replaying {
+					dropped = np.ShouldDropConnection(host, port)
+				}
+				if dropped {
+					decisions.record(Decision{Helper: traceNetwork, Call: call, Injector: np.Name(), Drop: true})
+					injected := NewChaosError(ctx, np.Name(), InjectionTypeNetworkDrop,
+						fmt.Errorf("connection to %s dropped", net.JoinHostPort(host, strconv.Itoa(port))))
 					GetLogger(ctx).Debug("network connection drop simulated",
 						slog.String("host", host),
-						slog.Int("port", port))
+						slog.Int("port", port),
+						slog.String("fault_id", injected.FaultID))
+					RecordInjection(ctx, InjectionEvent{
+						Injector:   np.Name(),
+						Type:       InjectionTypeNetworkDrop,
+						FaultID:    injected.FaultID,
+						Attributes: map[string]any{"host": host, "port": port},
+					})
 
-					return true
+					return injected
 				}
 
-				return false
+				return nil
 			}
 		}
 
@@ -567,24 +1039,70 @@ func (e *Executor) buildChaosContext(ctx context.Context, injectors []Injector)
 		if cancellationProvider, ok := inj.(ChaosContextCancellationProvider); ok {
 			// Copy provider to local variable to avoid closure issues
 			cp := cancellationProvider
-			chaos.cancellationFunc = func(parent context.Context) (context.Context, context.CancelFunc) {
+			chaos.cancellationFunc = func(parent context.Context, opts CancelOptions) (context.Context, context.CancelFunc) {
+				if op, ok := cp.(ChaosCancellationOptionsProvider); ok {
+					return op.GetChaosContextWithOptions(parent, opts)
+				}
+
 				return cp.GetChaosContext(parent)
 			}
 		}
 
+		// Messaging providers combine: the first applying a fault wins
+		if messagingProvider, ok := inj.(ChaosMessagingProvider); ok {
+			messagingProviders = append(messagingProviders, messagingProvider)
+		}
+
 		// Register universal providers
 		if universalProvider, ok := inj.(ChaosProvider); ok {
 			chaos.RegisterProvider(universalProvider)
 		}
+	}
+	if len(messagingProviders) > 0 {
+		chaos.messagingFunc = messagingChaosFunc(chaos, messagingProviders, decisions)
+	}
+
+	return chaos
+}
+
+// buildScopedChaos returns the chaos contexts of the scenario scopes used
+// within WithChaosScope, or nil without scopes
+func (e *Executor) buildScopedChaos(scenario *Scenario, decisions *iterationDecisions) map[string]*ChaosContext {
+	if len(scenario.scopes) == 0 {
+		return nil
+	}
+
+	// Scopes sharing a name share their injectors
+	var names []string
+	injectors := make(map[string][]Injector, len(scenario.scopes))
+	for _, scope := range scenario.scopes {
+		if _, ok := injectors[scope.name]; !ok {
+			names = append(names, scope.name)
+		}
+		injectors[scope.name] = append(injectors[scope.name], scope.injectors...)
+	}
+
+	scoped := make(map[string]*ChaosContext, len(names))
+	for _, name := range names {
+		chaos := e.buildChaosContext(injectors[name], decisions)
+		chaos.points = scenario.points
+		scoped[name] = chaos
+	}
+
+	return scoped
+}
 
-		// Collect metrics if available
+// recordInjectorMetrics collects metrics of injectors providing them
+func (e *Executor) recordInjectorMetrics(injectors []Injector) {
+	for _, inj := range injectors {
 		if metricsProvider, ok := inj.(MetricsProvider); ok {
 			metrics := metricsProvider.GetMetrics()
 			e.metrics.RecordInjectorMetrics(inj.Name(), metrics)
+			for _, exp := range e.exporters {
+				exp.RecordInjectorMetrics(inj.Name(), metrics)
+			}
 		}
 	}
-
-	return chaos
 }
 
 // Metrics returns the metrics collector
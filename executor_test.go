@@ -0,0 +1,391 @@
+package chaoskit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTarget struct{}
+
+func (t *testTarget) Name() string                       { return "test-target" }
+func (t *testTarget) Setup(ctx context.Context) error    { return nil }
+func (t *testTarget) Teardown(ctx context.Context) error { return nil }
+
+func TestExecutor_WithResultSink(t *testing.T) {
+	var buf bytes.Buffer
+	executor := NewExecutor(
+		WithResultSink(&buf),
+		WithFailurePolicy(ContinueOnFailure),
+	)
+
+	iteration := 0
+	scenario := NewScenario("sink").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			if iteration == 2 {
+				return errors.New("boom")
+			}
+
+			return nil
+		}).
+		Repeat(3).
+		Build()
+
+	err := executor.Run(context.Background(), scenario)
+	require.Error(t, err)
+
+	var lines []map[string]any
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+
+	require.Len(t, lines, 3)
+	assert.Equal(t, "sink", lines[0]["scenario"])
+	assert.Equal(t, float64(1), lines[0]["iteration"])
+	assert.Equal(t, true, lines[0]["success"])
+	assert.Equal(t, false, lines[1]["success"])
+	assert.Contains(t, lines[1]["error"], "boom")
+	assert.Equal(t, float64(3), lines[2]["iteration"])
+}
+
+type testMetricsInjector struct {
+	injected int
+}
+
+func (i *testMetricsInjector) Name() string                     { return "test-injector" }
+func (i *testMetricsInjector) Inject(ctx context.Context) error { return nil }
+func (i *testMetricsInjector) Stop(ctx context.Context) error   { return nil }
+func (i *testMetricsInjector) GetMetrics() map[string]interface{} {
+	return map[string]interface{}{"injected": i.injected}
+}
+
+func TestExecutor_ReportIncludesAppliedChaos(t *testing.T) {
+	injector := &testMetricsInjector{}
+	executor := NewExecutor()
+
+	scenario := NewScenario("chaos-applied").
+		WithTarget(&testTarget{}).
+		Inject("test", injector).
+		Step("step", func(ctx context.Context, target Target) error {
+			injector.injected++
+			RecordInjection(ctx, InjectionEvent{
+				Injector: injector.Name(),
+				Type:     InjectionTypeDelay,
+				Delay:    time.Millisecond,
+			})
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+
+	require.Len(t, report.Injectors, 1)
+	summary := report.Injectors[0]
+	assert.Equal(t, "test-injector", summary.Name)
+	assert.Equal(t, 2, summary.Injections)
+	assert.Equal(t, 2, summary.ByType[InjectionTypeDelay])
+	assert.Equal(t, 2*time.Millisecond, summary.TotalDelay)
+	assert.Equal(t, 2, summary.Metrics["injected"])
+
+	require.Len(t, report.Timeline, 2)
+	assert.Equal(t, 1, report.Timeline[0].Iteration)
+	assert.Equal(t, 2, report.Timeline[1].Iteration)
+
+	text := executor.Reporter().GenerateTextReport(report)
+	assert.Contains(t, text, "Chaos Applied:")
+	assert.Contains(t, text, "test-injector: 2 injections (delay: 2), total delay 2ms")
+}
+
+func TestExecutor_StepDurations(t *testing.T) {
+	executor := NewExecutor()
+
+	scenario := NewScenario("steps").
+		WithTarget(&testTarget{}).
+		Step("fast", func(ctx context.Context, target Target) error { return nil }).
+		Step("slow", func(ctx context.Context, target Target) error {
+			time.Sleep(5 * time.Millisecond)
+
+			return nil
+		}).
+		Repeat(3).
+		Build()
+
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 3)
+	require.Len(t, results[0].StepDurations, 2)
+	assert.Equal(t, "fast", results[0].StepDurations[0].Step)
+	assert.GreaterOrEqual(t, results[0].StepDurations[1].Duration, 5*time.Millisecond)
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	require.Len(t, report.Steps, 2)
+
+	slow := report.Steps[1]
+	assert.Equal(t, "slow", slow.Name)
+	assert.Equal(t, 3, slow.Count)
+	assert.GreaterOrEqual(t, slow.Min, 5*time.Millisecond)
+	assert.LessOrEqual(t, slow.Min, slow.Avg)
+	assert.LessOrEqual(t, slow.Avg, slow.Max)
+
+	assert.Contains(t, executor.Reporter().GenerateTextReport(report), "Step Durations:")
+
+	xmlStr, err := executor.Reporter().GenerateJUnitXML(report)
+	require.NoError(t, err)
+	assert.Contains(t, xmlStr, `name="step.slow.avg"`)
+}
+
+func TestExecutor_WithArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	executor := NewExecutor(
+		WithArtifacts(DefaultArtifactConfig(dir)),
+		WithFailurePolicy(ContinueOnFailure),
+	)
+
+	iteration := 0
+	scenario := NewScenario("artifacts/run").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			RecordInjection(ctx, InjectionEvent{Injector: "test-injector", Type: InjectionTypeError})
+			if iteration == 2 {
+				panic("boom")
+			}
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 2)
+	assert.Empty(t, results[0].Artifacts)
+
+	iterationDir := filepath.Join(dir, "artifacts_run", "iteration-2")
+	assert.ElementsMatch(t, []string{
+		filepath.Join(iterationDir, ArtifactError),
+		filepath.Join(iterationDir, ArtifactGoroutines),
+		filepath.Join(iterationDir, ArtifactHeap),
+		filepath.Join(iterationDir, ArtifactEvents),
+	}, results[1].Artifacts)
+
+	errText, err := os.ReadFile(filepath.Join(iterationDir, ArtifactError))
+	require.NoError(t, err)
+	assert.Contains(t, string(errText), "panic stack:")
+
+	var events []InjectionEvent
+	data, err := os.ReadFile(filepath.Join(iterationDir, ArtifactEvents))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &events))
+	require.Len(t, events, 1)
+	assert.Equal(t, 2, events[0].Iteration)
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	require.Len(t, report.Artifacts, 1)
+	assert.Equal(t, 2, report.Artifacts[0].Iteration)
+	assert.Contains(t, executor.Reporter().GenerateTextReport(report), "Failure Artifacts:")
+}
+
+type outputTarget struct {
+	testTarget
+	out io.Writer
+}
+
+func (t *outputTarget) SetOutput(w io.Writer) { t.out = w }
+
+func TestExecutor_WithOutputCapture(t *testing.T) {
+	target := &outputTarget{}
+	executor := NewExecutor(
+		WithOutputCapture(16),
+		WithFailurePolicy(ContinueOnFailure),
+	)
+
+	iteration := 0
+	scenario := NewScenario("output").
+		WithTarget(target).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			_, _ = fmt.Fprintf(target.(*outputTarget).out, "process line %d\n", iteration)
+			_, _ = fmt.Fprintf(IterationOutput(ctx), "step %d\n", iteration)
+			if iteration == 2 {
+				return errors.New("boom")
+			}
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 2)
+	assert.Empty(t, results[0].Output)
+	assert.Equal(t, "...s line 2\nstep 2\n", results[1].Output)
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	xmlStr, err := executor.Reporter().GenerateJUnitXML(report, WithJUnitIterations())
+	require.NoError(t, err)
+	assert.Contains(t, xmlStr, "<system-out>...s line 2&#xA;step 2&#xA;</system-out>")
+}
+
+func TestIterationOutput_Disabled(t *testing.T) {
+	assert.Equal(t, io.Discard, IterationOutput(context.Background()))
+}
+
+func TestExecutor_ResultInjections(t *testing.T) {
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+	iteration := 0
+	scenario := NewScenario("decisions").
+		WithTarget(&testTarget{}).
+		Inject("delays", &alwaysDelayInjector{}).
+		Inject("errors", &alwaysErrorInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			MaybeDelayAt(ctx, "db.query")
+			if iteration == 2 {
+				return MaybeErrorAt(ctx, "payment.commit")
+			}
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 2)
+	require.Len(t, results[0].Injections, 1)
+	require.Len(t, results[1].Injections, 2)
+	assert.Equal(t, InjectionTypeDelay, results[1].Injections[0].Type)
+	assert.Equal(t, "db.query", results[1].Injections[0].Point)
+	assert.Equal(t, InjectionTypeError, results[1].Injections[1].Type)
+	assert.Equal(t, "payment.commit", results[1].Injections[1].Point)
+	assert.Equal(t, FaultID(results[1].Error), results[1].Injections[1].FaultID)
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	require.Len(t, report.FailedIterations, 1)
+	assert.Equal(t, 2, report.FailedIterations[0].Iteration)
+	text := executor.Reporter().GenerateTextReport(report)
+	assert.Contains(t, text, "Failed Iterations:\n  - decisions #2: step step failed: injected\n"+
+		"      1. always-delay delay at db.query (1µs)\n"+
+		"      2. always-error error at payment.commit\n")
+
+	// Injections survive the NDJSON round trip
+	data, err := json.Marshal(newJSONResult(results[1]))
+	require.NoError(t, err)
+	decoded, err := DecodeResults(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, decoded, 1)
+	assert.Equal(t, results[1].Injections[1].FaultID, decoded[0].Injections[1].FaultID)
+}
+
+func TestExecutor_ExecutionID(t *testing.T) {
+	var ids []string
+	scenario := NewScenario("execution-id").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			ids = append(ids, ExecutionID(ctx))
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+
+	require.Len(t, ids, 4)
+	assert.NotEmpty(t, ids[0])
+	assert.Equal(t, ids[0], ids[1])
+	assert.NotEqual(t, ids[0], ids[2])
+	assert.Empty(t, ExecutionID(context.Background()))
+}
+
+type scalableInjector struct {
+	testMetricsInjector
+	factors []float64
+}
+
+func (i *scalableInjector) ScaleIntensity(factor float64) { i.factors = append(i.factors, factor) }
+
+func TestExecutor_WithIntensity(t *testing.T) {
+	injector := &scalableInjector{}
+	scenario := NewScenario("intensity").
+		WithTarget(&testTarget{}).
+		Inject("scalable", injector).
+		Inject("plain", &testMetricsInjector{}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Build()
+
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+	assert.Empty(t, injector.factors)
+
+	require.NoError(t, NewExecutor(WithIntensity(0.5)).Run(context.Background(), scenario))
+	assert.Equal(t, []float64{0.5}, injector.factors)
+}
+
+func TestExecutor_WithIterationRunner(t *testing.T) {
+	draws := make(map[int]int64)
+	iteration := 0
+	scenario := NewScenario("iteration-runner").
+		WithTarget(&testTarget{}).
+		WithSeed(42).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			draws[iteration] = GetRand(ctx).Int63()
+
+			return nil
+		}).
+		Repeat(3).
+		Build()
+
+	var seeds []int64
+	runAll := func(ctx context.Context, iteration int, seed int64, run func(context.Context) ExecutionResult) {
+		seeds = append(seeds, seed)
+		assert.True(t, run(ctx).Success)
+	}
+	executor := NewExecutor(WithIterationRunner(runAll))
+	require.NoError(t, executor.Run(context.Background(), scenario))
+	assert.Equal(t, []int64{IterationSeed(42, 1), IterationSeed(42, 2), IterationSeed(42, 3)}, seeds)
+	assert.Len(t, executor.Reporter().Results(), 3)
+	thirdDraw := draws[3]
+
+	// Running only the third iteration reproduces it
+	iteration = 2
+	onlyThird := func(ctx context.Context, iteration int, seed int64, run func(context.Context) ExecutionResult) {
+		if iteration == 3 {
+			run(ctx)
+		}
+	}
+	executor = NewExecutor(WithIterationRunner(onlyThird))
+	require.NoError(t, executor.Run(context.Background(), scenario))
+	assert.Equal(t, thirdDraw, draws[3])
+	assert.Len(t, executor.Reporter().Results(), 1)
+}
@@ -0,0 +1,184 @@
+package exporters
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rom8726/chaoskit/config"
+)
+
+// ChaosMeshAPIVersion is the API version of the exported Chaos Mesh experiments
+const ChaosMeshAPIVersion = "chaos-mesh.org/v1alpha1"
+
+// ErrNoChaosMeshExperiments is returned by ChaosMeshExport when no injector
+// of the scenario has a Chaos Mesh counterpart
+var ErrNoChaosMeshExperiments = errors.New("no injector of the scenario can be exported to Chaos Mesh")
+
+// chaosMeshOptions configures ChaosMeshExport
+type chaosMeshOptions struct {
+	namespace string
+	labels    map[string]string
+	mode      string
+	value     string
+	duration  time.Duration
+}
+
+// ChaosMeshOption configures ChaosMeshExport
+type ChaosMeshOption func(*chaosMeshOptions)
+
+// WithChaosMeshNamespace sets the namespace of the experiments and of the
+// selected pods (default "default")
+func WithChaosMeshNamespace(namespace string) ChaosMeshOption {
+	return func(o *chaosMeshOptions) {
+		o.namespace = namespace
+	}
+}
+
+// WithChaosMeshSelector selects the target pods by labels
+func WithChaosMeshSelector(labels map[string]string) ChaosMeshOption {
+	return func(o *chaosMeshOptions) {
+		o.labels = labels
+	}
+}
+
+// WithChaosMeshMode sets how many selected pods are affected: "one", "all"
+// (default), "fixed" or "fixed-percent" / "random-max-percent" with value
+func WithChaosMeshMode(mode, value string) ChaosMeshOption {
+	return func(o *chaosMeshOptions) {
+		o.mode = mode
+		o.value = value
+	}
+}
+
+// WithChaosMeshDuration sets how long the experiments run (default: the
+// scenario duration; experiments of repeat scenarios run until deleted)
+func WithChaosMeshDuration(duration time.Duration) ChaosMeshOption {
+	return func(o *chaosMeshOptions) {
+		o.duration = duration
+	}
+}
+
+// ChaosMeshExport translates the injectors of a scenario into Chaos Mesh
+// experiments (multi-document YAML), so a scenario prototyped in-process can
+// run as cluster-level chaos against the selected pods:
+//
+//	toxiproxy-latency    NetworkChaos delay
+//	toxiproxy-bandwidth  NetworkChaos bandwidth
+//	toxiproxy-timeout    NetworkChaos loss (100%)
+//	cpu-stress           StressChaos cpu
+//	memory-pressure      StressChaos memory
+//	panic                PodChaos pod-kill
+//
+// Injectors acting inside the process (delay, error, value-corruption,
+// context-cancellation) have no counterpart; they are listed as skipped in
+// a comment at the top of the output.
+func ChaosMeshExport(scenario *config.Scenario, opts ...ChaosMeshOption) ([]byte, error) {
+	o := chaosMeshOptions{namespace: "default", mode: "all", duration: scenario.Duration}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var objects []manifest
+	var skipped []string
+	for i, component := range scenario.Injectors {
+		kind, spec, err := chaosMeshSpec(component)
+		if err != nil {
+			return nil, fmt.Errorf("injectors[%d]: %w", i, err)
+		}
+		if kind == "" {
+			skipped = append(skipped, component.Type)
+			continue
+		}
+
+		spec["mode"] = o.mode
+		if o.value != "" {
+			spec["value"] = o.value
+		}
+		selector := map[string]any{"namespaces": []string{o.namespace}}
+		if len(o.labels) > 0 {
+			selector["labelSelectors"] = o.labels
+		}
+		spec["selector"] = selector
+		if o.duration > 0 && kind != "PodChaos" {
+			spec["duration"] = o.duration.String()
+		}
+
+		objects = append(objects, manifest{
+			APIVersion: ChaosMeshAPIVersion,
+			Kind:       kind,
+			Metadata:   scenarioMetadata(scenario.Name, i, component.Type, o.namespace),
+			Spec:       spec,
+		})
+	}
+	if len(objects) == 0 {
+		return nil, ErrNoChaosMeshExperiments
+	}
+
+	comments := []string{"Chaos Mesh experiments of scenario " + scenario.Name}
+	if len(skipped) > 0 {
+		comments = append(comments, "skipped injectors without a counterpart: "+strings.Join(skipped, ", "))
+	}
+
+	return encodeManifests(comments, objects)
+}
+
+// chaosMeshSpec returns the kind and action-specific spec of a component,
+// or an empty kind when it has no Chaos Mesh counterpart
+func chaosMeshSpec(component config.Component) (string, map[string]any, error) {
+	p := component.Params
+	switch component.Type {
+	case "toxiproxy-latency":
+		latency, err := p.Duration("latency", 100*time.Millisecond)
+		if err != nil {
+			return "", nil, err
+		}
+		jitter, err := p.Duration("jitter", 0)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "NetworkChaos", map[string]any{
+			"action": "delay",
+			"delay":  map[string]any{"latency": latency.String(), "jitter": jitter.String()},
+		}, nil
+	case "toxiproxy-bandwidth":
+		rate, err := p.Int("rate_kbps", 100)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "NetworkChaos", map[string]any{
+			"action":    "bandwidth",
+			"bandwidth": map[string]any{"rate": fmt.Sprintf("%dkbps", rate), "limit": 20971520, "buffer": 10000},
+		}, nil
+	case "toxiproxy-timeout":
+		return "NetworkChaos", map[string]any{
+			"action": "loss",
+			"loss":   map[string]any{"loss": "100"},
+		}, nil
+	case "cpu-stress":
+		workers, err := p.Int("workers", 1)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "StressChaos", map[string]any{
+			"stressors": map[string]any{"cpu": map[string]any{"workers": workers, "load": 100}},
+		}, nil
+	case "memory-pressure":
+		size, err := p.Int("size_mb", 64)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "StressChaos", map[string]any{
+			"stressors": map[string]any{"memory": map[string]any{"workers": 1, "size": fmt.Sprintf("%dMB", size)}},
+		}, nil
+	case "panic":
+		return "PodChaos", map[string]any{"action": "pod-kill"}, nil
+	default:
+		return "", nil, nil
+	}
+}
@@ -0,0 +1,113 @@
+package exporters
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/rom8726/chaoskit/config"
+)
+
+func TestChaosMeshExport(t *testing.T) {
+	scenario := &config.Scenario{
+		Name:     "Checkout API",
+		Duration: 5 * time.Minute,
+		Injectors: []config.Component{
+			{Type: "toxiproxy-latency", Params: config.Params{"proxy": "db", "latency": "250ms", "jitter": "50ms"}},
+			{Type: "delay", Params: config.Params{"min": "1ms", "max": "5ms"}},
+			{Type: "toxiproxy-timeout", Params: config.Params{"proxy": "db"}},
+			{Type: "cpu-stress", Params: config.Params{"workers": 2}},
+			{Type: "memory-pressure", Params: config.Params{"size_mb": 128}},
+			{Type: "panic", Params: config.Params{"probability": 0.01}},
+		},
+	}
+
+	out, err := ChaosMeshExport(scenario,
+		WithChaosMeshNamespace("shop"),
+		WithChaosMeshSelector(map[string]string{"app": "checkout"}))
+	if err != nil {
+		t.Fatalf("ChaosMeshExport failed: %v", err)
+	}
+	if !strings.Contains(string(out), "# skipped injectors without a counterpart: delay\n") {
+		t.Errorf("expected skipped injectors comment, got:\n%s", out)
+	}
+
+	objects := decodeManifests(t, out)
+	if len(objects) != 5 {
+		t.Fatalf("expected 5 experiments, got %d:\n%s", len(objects), out)
+	}
+
+	latency := objects[0]
+	if latency.APIVersion != ChaosMeshAPIVersion || latency.Kind != "NetworkChaos" {
+		t.Errorf("unexpected latency resource: %s %s", latency.APIVersion, latency.Kind)
+	}
+	if latency.Metadata.Name != "checkout-api-0-toxiproxy-latency" || latency.Metadata.Namespace != "shop" {
+		t.Errorf("unexpected latency metadata: %+v", latency.Metadata)
+	}
+	if latency.Spec["action"] != "delay" || latency.Spec["duration"] != "5m0s" {
+		t.Errorf("unexpected latency spec: %v", latency.Spec)
+	}
+	delay, _ := latency.Spec["delay"].(map[string]any)
+	if delay["latency"] != "250ms" || delay["jitter"] != "50ms" {
+		t.Errorf("unexpected delay: %v", delay)
+	}
+	selector, _ := latency.Spec["selector"].(map[string]any)
+	labels, _ := selector["labelSelectors"].(map[string]any)
+	if labels["app"] != "checkout" {
+		t.Errorf("unexpected selector: %v", selector)
+	}
+
+	if objects[1].Spec["action"] != "loss" {
+		t.Errorf("expected toxiproxy-timeout as loss, got %v", objects[1].Spec)
+	}
+	cpu, _ := objects[2].Spec["stressors"].(map[string]any)["cpu"].(map[string]any)
+	if objects[2].Kind != "StressChaos" || cpu["workers"] != 2 {
+		t.Errorf("unexpected cpu stress: %s %v", objects[2].Kind, objects[2].Spec)
+	}
+	memory, _ := objects[3].Spec["stressors"].(map[string]any)["memory"].(map[string]any)
+	if memory["size"] != "128MB" {
+		t.Errorf("unexpected memory stress: %v", objects[3].Spec)
+	}
+	podKill := objects[4]
+	if podKill.Kind != "PodChaos" || podKill.Spec["action"] != "pod-kill" {
+		t.Errorf("unexpected pod kill: %s %v", podKill.Kind, podKill.Spec)
+	}
+	if _, ok := podKill.Spec["duration"]; ok {
+		t.Errorf("pod-kill should not have a duration: %v", podKill.Spec)
+	}
+}
+
+func TestChaosMeshExport_Unsupported(t *testing.T) {
+	scenario := &config.Scenario{
+		Name:      "in-process",
+		Injectors: []config.Component{{Type: "delay"}, {Type: "error"}},
+	}
+
+	if _, err := ChaosMeshExport(scenario); !errors.Is(err, ErrNoChaosMeshExperiments) {
+		t.Fatalf("expected ErrNoChaosMeshExperiments, got %v", err)
+	}
+}
+
+// decodeManifests decodes multi-document YAML output of an export
+func decodeManifests(t *testing.T, data []byte) []manifest {
+	t.Helper()
+
+	var manifests []manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var m manifest
+		err := decoder.Decode(&m)
+		if errors.Is(err, io.EOF) {
+			return manifests
+		}
+		if err != nil {
+			t.Fatalf("failed to decode manifests: %v\n%s", err, data)
+		}
+		manifests = append(manifests, m)
+	}
+}
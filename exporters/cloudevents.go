@@ -0,0 +1,381 @@
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// CloudEventsSpecVersion is the CloudEvents version of emitted events
+const CloudEventsSpecVersion = "1.0"
+
+// CloudEventsContentType is the content type of events in the structured mode
+const CloudEventsContentType = "application/cloudevents+json"
+
+// DefaultCloudEventSource is the source of emitted events by default
+const DefaultCloudEventSource = "/chaoskit"
+
+// Types of the CloudEvents emitted by CloudEventsExporter
+const (
+	CloudEventScenarioStarted  = "io.chaoskit.scenario.started"
+	CloudEventScenarioFinished = "io.chaoskit.scenario.finished"
+	CloudEventInjection        = "io.chaoskit.injection.applied"
+	CloudEventValidatorFailed  = "io.chaoskit.validator.failed"
+	CloudEventVerdict          = "io.chaoskit.verdict"
+)
+
+// CloudEvent is a CloudEvents 1.0 event in the JSON format. Events of a run
+// carry its execution ID in the executionid extension attribute.
+type CloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype,omitempty"`
+	ExecutionID     string    `json:"executionid,omitempty"`
+	Data            any       `json:"data,omitempty"`
+}
+
+// ScenarioEventData is the data of scenario started and finished events
+type ScenarioEventData struct {
+	Scenario string `json:"scenario"`
+	Success  *bool  `json:"success,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ValidatorFailedEventData is the data of validator failed events
+type ValidatorFailedEventData struct {
+	Scenario  string `json:"scenario"`
+	Iteration int    `json:"iteration"`
+	Validator string `json:"validator"`
+	Error     string `json:"error"`
+}
+
+// VerdictEventData is the data of verdict events
+type VerdictEventData struct {
+	Scenario        string                       `json:"scenario"`
+	Verdict         string                       `json:"verdict"`
+	Summary         string                       `json:"summary"`
+	TotalIterations int                          `json:"total_iterations"`
+	FailureCount    int                          `json:"failure_count"`
+	SuccessRate     float64                      `json:"success_rate"`
+	Failures        []chaoskit.ValidationFailure `json:"failures,omitempty"`
+	Injectors       []chaoskit.InjectorSummary   `json:"injectors,omitempty"`
+}
+
+// CloudEventSink delivers CloudEvents
+type CloudEventSink interface {
+	Send(ctx context.Context, event CloudEvent) error
+}
+
+// CloudEventSinkFunc adapts a function to CloudEventSink
+type CloudEventSinkFunc func(ctx context.Context, event CloudEvent) error
+
+// Send calls f(ctx, event)
+func (f CloudEventSinkFunc) Send(ctx context.Context, event CloudEvent) error {
+	return f(ctx, event)
+}
+
+// HTTPCloudEventSink posts events to url in the structured content mode of
+// the CloudEvents HTTP binding, with header (e.g. Authorization) on every
+// request. A nil client uses http.DefaultClient.
+func HTTPCloudEventSink(url string, client *http.Client, header http.Header) CloudEventSink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return CloudEventSinkFunc(func(ctx context.Context, event CloudEvent) error {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode cloud event: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create cloud event request: %w", err)
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		req.Header.Set("Content-Type", CloudEventsContentType)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to send cloud event: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		_, _ = io.Copy(io.Discard, resp.Body)
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("cloud event sink returned status %d", resp.StatusCode)
+		}
+
+		return nil
+	})
+}
+
+// KafkaMessage is a CloudEvent in the structured content mode of the
+// CloudEvents Kafka binding
+type KafkaMessage struct {
+	Topic string
+
+	// Key is the execution ID of the run, so the events of a run stay in order
+	Key     []byte
+	Value   []byte
+	Headers map[string]string
+}
+
+// KafkaCloudEventSink sends events to topic through produce, which writes a
+// message with the Kafka client of the application:
+//
+//	writer := &kafka.Writer{Addr: kafka.TCP("localhost:9092")}
+//	sink := exporters.KafkaCloudEventSink("chaos-events", func(ctx context.Context, msg exporters.KafkaMessage) error {
+//		headers := []kafka.Header{{Key: "content-type", Value: []byte(msg.Headers["content-type"])}}
+//		return writer.WriteMessages(ctx, kafka.Message{Topic: msg.Topic, Key: msg.Key, Value: msg.Value, Headers: headers})
+//	})
+func KafkaCloudEventSink(topic string, produce func(ctx context.Context, msg KafkaMessage) error) CloudEventSink {
+	return CloudEventSinkFunc(func(ctx context.Context, event CloudEvent) error {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode cloud event: %w", err)
+		}
+
+		return produce(ctx, KafkaMessage{
+			Topic:   topic,
+			Key:     []byte(event.ExecutionID),
+			Value:   value,
+			Headers: map[string]string{"content-type": CloudEventsContentType},
+		})
+	})
+}
+
+// CloudEventsExporter emits CloudEvents about chaos activity (scenario
+// started and finished, injections, validator failures and verdicts) to a
+// sink, so incident-management and audit systems consuming CloudEvents can
+// ingest it. Events are sent in order by a background goroutine, so slow
+// sinks don't slow down the run; when the buffer is full events are dropped.
+//
+// Register the exporter as an observer and a verdict listener, and close it
+// to flush pending events:
+//
+//	events := exporters.NewCloudEventsExporter(exporters.HTTPCloudEventSink(url, nil, nil))
+//	defer events.Close(context.Background())
+//	executor := chaoskit.NewExecutor(chaoskit.WithObservers(events))
+//	executor.Reporter().AddVerdictListener(events)
+type CloudEventsExporter struct {
+	sink    CloudEventSink
+	source  string
+	types   []string
+	timeout time.Duration
+	buffer  int
+
+	mu      sync.RWMutex
+	closed  bool
+	queue   chan CloudEvent
+	done    chan struct{}
+	dropped atomic.Int64
+}
+
+// CloudEventsOption configures a CloudEventsExporter
+type CloudEventsOption func(*CloudEventsExporter)
+
+// WithCloudEventSource sets the source attribute of events (default: DefaultCloudEventSource)
+func WithCloudEventSource(source string) CloudEventsOption {
+	return func(c *CloudEventsExporter) {
+		c.source = source
+	}
+}
+
+// WithCloudEventTypes sets which event types are emitted (default: all)
+func WithCloudEventTypes(types ...string) CloudEventsOption {
+	return func(c *CloudEventsExporter) {
+		c.types = types
+	}
+}
+
+// WithCloudEventBuffer sets how many events wait for the sink before new
+// ones are dropped (default: 1024)
+func WithCloudEventBuffer(size int) CloudEventsOption {
+	return func(c *CloudEventsExporter) {
+		c.buffer = size
+	}
+}
+
+// WithCloudEventTimeout sets the timeout of sending one event (default: 10s)
+func WithCloudEventTimeout(timeout time.Duration) CloudEventsOption {
+	return func(c *CloudEventsExporter) {
+		c.timeout = timeout
+	}
+}
+
+// NewCloudEventsExporter creates an exporter sending events to sink
+func NewCloudEventsExporter(sink CloudEventSink, opts ...CloudEventsOption) *CloudEventsExporter {
+	c := &CloudEventsExporter{
+		sink:    sink,
+		source:  DefaultCloudEventSource,
+		timeout: 10 * time.Second,
+		buffer:  1024,
+		done:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.queue = make(chan CloudEvent, max(c.buffer, 1))
+
+	go c.run()
+
+	return c
+}
+
+// run sends queued events until the queue is closed
+func (c *CloudEventsExporter) run() {
+	defer close(c.done)
+
+	for event := range c.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		if err := c.sink.Send(ctx, event); err != nil {
+			slog.Default().Warn("failed to send cloud event",
+				slog.String("type", event.Type),
+				slog.String("id", event.ID),
+				slog.String("error", err.Error()))
+		}
+		cancel()
+	}
+}
+
+// Dropped returns the number of events dropped because the buffer was full
+// or the exporter was closed
+func (c *CloudEventsExporter) Dropped() int64 {
+	return c.dropped.Load()
+}
+
+// Close stops accepting events and waits until pending events are sent or ctx is done
+func (c *CloudEventsExporter) Close(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.closed {
+		c.closed = true
+		close(c.queue)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// emit queues an event of type eventType
+func (c *CloudEventsExporter) emit(ctx context.Context, eventType, id, subject string, at time.Time, data any) {
+	if len(c.types) > 0 && !slices.Contains(c.types, eventType) {
+		return
+	}
+	if id == "" {
+		id = chaoskit.NewFaultID()
+	}
+
+	event := CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              id,
+		Source:          c.source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            at.UTC(),
+		DataContentType: "application/json",
+		ExecutionID:     chaoskit.ExecutionID(ctx),
+		Data:            data,
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		c.dropped.Add(1)
+
+		return
+	}
+	select {
+	case c.queue <- event:
+	default:
+		c.dropped.Add(1)
+	}
+}
+
+// OnRunStart implements chaoskit.RunObserver
+func (c *CloudEventsExporter) OnRunStart(ctx context.Context, scenario string) {
+	c.emit(ctx, CloudEventScenarioStarted, "", scenario, time.Now(), ScenarioEventData{Scenario: scenario})
+}
+
+// OnRunEnd implements chaoskit.RunObserver
+func (c *CloudEventsExporter) OnRunEnd(ctx context.Context, scenario string, err error) {
+	success := err == nil
+	data := ScenarioEventData{Scenario: scenario, Success: &success}
+	if err != nil {
+		data.Error = err.Error()
+	}
+	c.emit(ctx, CloudEventScenarioFinished, "", scenario, time.Now(), data)
+}
+
+// OnIterationStart implements chaoskit.ExecutionObserver
+func (c *CloudEventsExporter) OnIterationStart(ctx context.Context, _ string, _ int) context.Context {
+	return ctx
+}
+
+// OnIterationEnd emits a validator failed event when a validator failed the iteration
+func (c *CloudEventsExporter) OnIterationEnd(ctx context.Context, result chaoskit.ExecutionResult) {
+	validator := chaoskit.FailedValidator(result)
+	if validator == "" {
+		return
+	}
+
+	c.emit(ctx, CloudEventValidatorFailed, "", result.ScenarioName, time.Now(), ValidatorFailedEventData{
+		Scenario:  result.ScenarioName,
+		Iteration: result.Iteration,
+		Validator: validator,
+		Error:     result.Error.Error(),
+	})
+}
+
+// OnStepStart implements chaoskit.ExecutionObserver
+func (c *CloudEventsExporter) OnStepStart(ctx context.Context, _ string) context.Context {
+	return ctx
+}
+
+// OnStepEnd implements chaoskit.ExecutionObserver
+func (c *CloudEventsExporter) OnStepEnd(context.Context, string, error) {}
+
+// OnInjection emits an injection event, identified by the fault ID
+func (c *CloudEventsExporter) OnInjection(ctx context.Context, event chaoskit.InjectionEvent) {
+	c.emit(ctx, CloudEventInjection, event.FaultID, event.Scenario, event.Timestamp, event)
+}
+
+// OnVerdict implements chaoskit.VerdictListener
+func (c *CloudEventsExporter) OnVerdict(report *chaoskit.Report) {
+	failures := make([]chaoskit.ValidationFailure, 0, len(report.CriticalFailures)+len(report.Warnings))
+	failures = append(failures, report.CriticalFailures...)
+	failures = append(failures, report.Warnings...)
+
+	c.emit(context.Background(), CloudEventVerdict, "", report.ScenarioName, time.Now(), VerdictEventData{
+		Scenario:        report.ScenarioName,
+		Verdict:         report.Verdict.String(),
+		Summary:         report.Summary,
+		TotalIterations: report.TotalIterations,
+		FailureCount:    report.FailureCount,
+		SuccessRate:     report.SuccessRate,
+		Failures:        failures,
+		Injectors:       report.Injectors,
+	})
+}
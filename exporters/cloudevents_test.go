@@ -0,0 +1,146 @@
+package exporters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+type failingValidator struct{}
+
+func (failingValidator) Name() string { return "invariant" }
+
+func (failingValidator) Validate(context.Context, chaoskit.Target) error {
+	return errors.New("balance mismatch")
+}
+
+func (failingValidator) Severity() chaoskit.ValidationSeverity { return chaoskit.SeverityCritical }
+
+func TestCloudEventsExporter_HTTP(t *testing.T) {
+	var mu sync.Mutex
+	var events []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != CloudEventsContentType {
+			t.Errorf("Content-Type = %q, want %q", ct, CloudEventsContentType)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer token" {
+			t.Errorf("Authorization = %q", auth)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var event map[string]any
+		if err := json.Unmarshal(body, &event); err != nil {
+			t.Errorf("invalid event %s: %v", body, err)
+		}
+		mu.Lock()
+		events = append(events, event)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	exporter := NewCloudEventsExporter(
+		HTTPCloudEventSink(server.URL, nil, http.Header{"Authorization": {"Bearer token"}}),
+		WithCloudEventSource("/ci/payments"),
+	)
+	executor := chaoskit.NewExecutor(
+		chaoskit.WithObservers(exporter),
+		chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure),
+	)
+	executor.Reporter().AddVerdictListener(exporter)
+
+	scenario := chaoskit.NewScenario("payments").
+		WithTarget(&traceTestTarget{}).
+		Step("inject", func(ctx context.Context, target chaoskit.Target) error {
+			chaoskit.RecordInjection(ctx, chaoskit.InjectionEvent{
+				Injector: "test-delay",
+				Type:     chaoskit.InjectionTypeDelay,
+				Delay:    time.Millisecond,
+			})
+
+			return nil
+		}).
+		Assert("invariant", failingValidator{}).
+		Build()
+
+	_ = executor.Run(context.Background(), scenario)
+	_, _ = executor.Reporter().GetVerdict(chaoskit.DefaultThresholds())
+	if err := exporter.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	wantTypes := []string{
+		CloudEventScenarioStarted,
+		CloudEventInjection,
+		CloudEventValidatorFailed,
+		CloudEventScenarioFinished,
+		CloudEventVerdict,
+	}
+	if len(events) != len(wantTypes) {
+		t.Fatalf("expected %d events, got %d: %v", len(wantTypes), len(events), events)
+	}
+	executionID := events[0]["executionid"]
+	for i, event := range events {
+		if event["type"] != wantTypes[i] {
+			t.Errorf("event %d type = %v, want %s", i, event["type"], wantTypes[i])
+		}
+		if event["specversion"] != "1.0" || event["source"] != "/ci/payments" || event["id"] == "" {
+			t.Errorf("event %d has invalid attributes: %v", i, event)
+		}
+		if wantTypes[i] != CloudEventVerdict && (executionID == nil || event["executionid"] != executionID) {
+			t.Errorf("event %d executionid = %v, want %v", i, event["executionid"], executionID)
+		}
+	}
+
+	injection := events[1]["data"].(map[string]any)
+	if events[1]["id"] != injection["fault_id"] {
+		t.Errorf("injection event id = %v, want the fault ID %v", events[1]["id"], injection["fault_id"])
+	}
+	if failed := events[2]["data"].(map[string]any); failed["validator"] != "invariant" {
+		t.Errorf("unexpected validator failed data %v", failed)
+	}
+	if verdict := events[4]["data"].(map[string]any); verdict["verdict"] != "FAIL" {
+		t.Errorf("unexpected verdict data %v", verdict)
+	}
+}
+
+func TestCloudEventsExporter_KafkaAndTypes(t *testing.T) {
+	var messages []KafkaMessage
+	exporter := NewCloudEventsExporter(
+		KafkaCloudEventSink("chaos", func(ctx context.Context, msg KafkaMessage) error {
+			messages = append(messages, msg)
+			return nil
+		}),
+		WithCloudEventTypes(CloudEventScenarioStarted),
+	)
+
+	ctx := context.Background()
+	exporter.OnRunStart(ctx, "orders")
+	exporter.OnRunEnd(ctx, "orders", nil)
+	if err := exporter.Close(ctx); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if len(messages) != 1 {
+		t.Fatalf("expected only the scenario started event, got %d messages", len(messages))
+	}
+	msg := messages[0]
+	var event CloudEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Topic != "chaos" || msg.Headers["content-type"] != CloudEventsContentType || event.Type != CloudEventScenarioStarted {
+		t.Errorf("unexpected message %+v", msg)
+	}
+
+	exporter.OnRunStart(ctx, "orders")
+	if exporter.Dropped() != 1 {
+		t.Errorf("events after Close should be dropped, got %d dropped", exporter.Dropped())
+	}
+}
@@ -0,0 +1,293 @@
+package exporters
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+// LitmusAPIVersion is the API version of the exported LitmusChaos engines
+const LitmusAPIVersion = "litmuschaos.io/v1alpha1"
+
+// ErrNoLitmusExperiments is returned by LitmusExport when no injector of the
+// scenario has a LitmusChaos counterpart
+var ErrNoLitmusExperiments = errors.New("no injector of the scenario can be exported to LitmusChaos")
+
+// litmusOptions configures LitmusExport
+type litmusOptions struct {
+	namespace      string
+	appLabel       string
+	appKind        string
+	serviceAccount string
+	duration       time.Duration
+}
+
+// LitmusOption configures LitmusExport
+type LitmusOption func(*litmusOptions)
+
+// WithLitmusNamespace sets the namespace of the engines and of the target
+// application (default "default")
+func WithLitmusNamespace(namespace string) LitmusOption {
+	return func(o *litmusOptions) {
+		o.namespace = namespace
+	}
+}
+
+// WithLitmusApp selects the target application by label (e.g. "app=checkout")
+// and kind ("deployment" by default)
+func WithLitmusApp(label, kind string) LitmusOption {
+	return func(o *litmusOptions) {
+		o.appLabel = label
+		if kind != "" {
+			o.appKind = kind
+		}
+	}
+}
+
+// WithLitmusServiceAccount sets the service account running the experiments
+// (default "litmus-admin")
+func WithLitmusServiceAccount(name string) LitmusOption {
+	return func(o *litmusOptions) {
+		o.serviceAccount = name
+	}
+}
+
+// WithLitmusDuration sets how long the experiments run (default: the
+// scenario duration, or the experiment default for repeat scenarios)
+func WithLitmusDuration(duration time.Duration) LitmusOption {
+	return func(o *litmusOptions) {
+		o.duration = duration
+	}
+}
+
+// LitmusExport translates a scenario into LitmusChaos ChaosEngine manifests
+// (multi-document YAML), one engine per injector so they run concurrently as
+// in the scenario. Engines reference the ChaosHub experiments, which must be
+// installed in the namespace:
+//
+//	toxiproxy-latency    pod-network-latency
+//	toxiproxy-bandwidth  pod-network-rate-limit
+//	toxiproxy-timeout    pod-network-loss (100%)
+//	cpu-stress           pod-cpu-hog
+//	memory-pressure      pod-memory-hog
+//	panic                pod-delete
+//
+// An HTTP target becomes a continuous httpProbe of every experiment, with its
+// response timeout bounded by the execution-time, infinite-loop and
+// slow-iteration validators. Other injectors and validators act inside the
+// process; they are listed as skipped in a comment at the top of the output.
+func LitmusExport(scenario *config.Scenario, opts ...LitmusOption) ([]byte, error) {
+	o := litmusOptions{
+		namespace:      "default",
+		appKind:        "deployment",
+		serviceAccount: "litmus-admin",
+		duration:       scenario.Duration,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	probes, notes, err := litmusProbes(scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	var engines []manifest
+	var skipped []string
+	for i, component := range scenario.Injectors {
+		experiment, env, err := litmusExperiment(component)
+		if err != nil {
+			return nil, fmt.Errorf("injectors[%d]: %w", i, err)
+		}
+		if experiment == "" {
+			skipped = append(skipped, component.Type)
+			continue
+		}
+
+		if o.duration > 0 {
+			env = append(env, litmusEnv("TOTAL_CHAOS_DURATION", strconv.Itoa(int(o.duration.Seconds()))))
+		}
+		experimentSpec := map[string]any{"components": map[string]any{"env": env}}
+		if len(probes) > 0 {
+			experimentSpec["probe"] = probes
+		}
+
+		spec := map[string]any{
+			"engineState":         "active",
+			"chaosServiceAccount": o.serviceAccount,
+			"experiments":         []map[string]any{{"name": experiment, "spec": experimentSpec}},
+		}
+		if o.appLabel != "" {
+			spec["appinfo"] = map[string]any{"appns": o.namespace, "applabel": o.appLabel, "appkind": o.appKind}
+		}
+		engines = append(engines, manifest{
+			APIVersion: LitmusAPIVersion,
+			Kind:       "ChaosEngine",
+			Metadata:   scenarioMetadata(scenario.Name, i, experiment, o.namespace),
+			Spec:       spec,
+		})
+	}
+	if len(engines) == 0 {
+		return nil, ErrNoLitmusExperiments
+	}
+
+	comments := []string{"LitmusChaos engines of scenario " + scenario.Name}
+	if len(skipped) > 0 {
+		comments = append(comments, "skipped injectors without a counterpart: "+strings.Join(skipped, ", "))
+	}
+	comments = append(comments, notes...)
+
+	return encodeManifests(comments, engines)
+}
+
+// litmusExperiment returns the ChaosHub experiment and tunables of a
+// component, or an empty experiment when it has no LitmusChaos counterpart
+func litmusExperiment(component config.Component) (string, []map[string]string, error) {
+	p := component.Params
+	switch component.Type {
+	case "toxiproxy-latency":
+		latency, err := p.Duration("latency", 100*time.Millisecond)
+		if err != nil {
+			return "", nil, err
+		}
+		jitter, err := p.Duration("jitter", 0)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "pod-network-latency", []map[string]string{
+			litmusEnv("NETWORK_LATENCY", strconv.FormatInt(latency.Milliseconds(), 10)),
+			litmusEnv("JITTER", strconv.FormatInt(jitter.Milliseconds(), 10)),
+		}, nil
+	case "toxiproxy-bandwidth":
+		rate, err := p.Int("rate_kbps", 100)
+		if err != nil {
+			return "", nil, err
+		}
+
+		// rate_kbps is in KB/s, tc "kbit" in kilobits per second
+		return "pod-network-rate-limit", []map[string]string{
+			litmusEnv("NETWORK_BANDWIDTH", fmt.Sprintf("%dkbit", rate*8)),
+		}, nil
+	case "toxiproxy-timeout":
+		return "pod-network-loss", []map[string]string{
+			litmusEnv("NETWORK_PACKET_LOSS_PERCENTAGE", "100"),
+		}, nil
+	case "cpu-stress":
+		workers, err := p.Int("workers", 1)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "pod-cpu-hog", []map[string]string{
+			litmusEnv("CPU_CORES", strconv.Itoa(workers)),
+			litmusEnv("CPU_LOAD", "100"),
+		}, nil
+	case "memory-pressure":
+		size, err := p.Int("size_mb", 64)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "pod-memory-hog", []map[string]string{
+			litmusEnv("MEMORY_CONSUMPTION", strconv.Itoa(size)),
+		}, nil
+	case "panic":
+		return "pod-delete", []map[string]string{
+			litmusEnv("FORCE", "true"),
+		}, nil
+	default:
+		return "", nil, nil
+	}
+}
+
+func litmusEnv(name, value string) map[string]string {
+	return map[string]string{"name": name, "value": value}
+}
+
+// litmusProbes returns the probe checking the HTTP target of scenario, if any,
+// with notes on what could not be mapped to it
+func litmusProbes(scenario *config.Scenario) ([]map[string]any, []string, error) {
+	target := scenario.Target
+	httpTarget := target != nil && target.Type == config.TargetHTTP
+	var notes []string
+	methodName := "get"
+	if httpTarget && target.Method != "" {
+		methodName = strings.ToLower(target.Method)
+	}
+	if methodName != "get" && methodName != "post" {
+		notes = append(notes, fmt.Sprintf("no target probe: httpProbe supports GET and POST, not %s", target.Method))
+		httpTarget = false
+	}
+
+	timeout := time.Duration(0)
+	if httpTarget {
+		timeout = target.Timeout
+	}
+	var skipped []string
+	for i, component := range scenario.Validators {
+		key := ""
+		switch component.Type {
+		case chaoskit.ValidatorExecutionTime:
+			key = "max"
+		case chaoskit.ValidatorInfiniteLoop, chaoskit.ValidatorSlowIteration:
+			key = "timeout"
+		}
+		if key == "" || !httpTarget {
+			skipped = append(skipped, component.Type)
+			continue
+		}
+
+		limit, err := component.Params.Duration(key, 0)
+		if err != nil {
+			return nil, nil, fmt.Errorf("validators[%d]: %w", i, err)
+		}
+		if limit > 0 && (timeout == 0 || limit < timeout) {
+			timeout = limit
+		}
+	}
+	if len(skipped) > 0 {
+		notes = append(notes, "skipped in-process validators: "+strings.Join(skipped, ", "))
+	}
+	if !httpTarget {
+		return nil, notes, nil
+	}
+	if timeout == 0 {
+		timeout = config.DefaultHTTPTimeout
+	}
+
+	status := "200"
+	if target.ExpectStatus != 0 {
+		status = strconv.Itoa(target.ExpectStatus)
+	}
+	method := map[string]any{"criteria": "==", "responseCode": status}
+	if methodName == "post" {
+		method["body"] = target.Body
+		if contentType, ok := target.Headers["Content-Type"]; ok {
+			method["contentType"] = contentType
+		}
+	}
+
+	probe := map[string]any{
+		"name": manifestName(scenario.Name + "-target"),
+		"type": "httpProbe",
+		"mode": "Continuous",
+		"httpProbe/inputs": map[string]any{
+			"url":             target.URL,
+			"responseTimeout": timeout.Milliseconds(),
+			"method":          map[string]any{methodName: method},
+		},
+		"runProperties": map[string]any{
+			"probeTimeout": (timeout + time.Second).String(),
+			"interval":     "2s",
+			"attempt":      1,
+		},
+	}
+
+	return []map[string]any{probe}, notes, nil
+}
@@ -0,0 +1,114 @@
+package exporters
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit/config"
+)
+
+func TestLitmusExport(t *testing.T) {
+	scenario := &config.Scenario{
+		Name:     "checkout",
+		Duration: 2 * time.Minute,
+		Target: &config.TargetConfig{
+			Type:         config.TargetHTTP,
+			URL:          "http://checkout.shop.svc/health",
+			ExpectStatus: 204,
+			Timeout:      3 * time.Second,
+		},
+		Injectors: []config.Component{
+			{Type: "toxiproxy-latency", Params: config.Params{"proxy": "db", "latency": "250ms"}},
+			{Type: "error"},
+			{Type: "toxiproxy-bandwidth", Params: config.Params{"proxy": "db", "rate_kbps": 64}},
+			{Type: "panic"},
+		},
+		Validators: []config.Component{
+			{Type: "execution-time", Params: config.Params{"max": "1s"}},
+			{Type: "goroutine-limit", Params: config.Params{"max": 100}},
+		},
+	}
+
+	out, err := LitmusExport(scenario, WithLitmusNamespace("shop"), WithLitmusApp("app=checkout", ""))
+	if err != nil {
+		t.Fatalf("LitmusExport failed: %v", err)
+	}
+	for _, comment := range []string{
+		"# skipped injectors without a counterpart: error\n",
+		"# skipped in-process validators: goroutine-limit\n",
+	} {
+		if !strings.Contains(string(out), comment) {
+			t.Errorf("expected %q in output:\n%s", comment, out)
+		}
+	}
+
+	engines := decodeManifests(t, out)
+	if len(engines) != 3 {
+		t.Fatalf("expected 3 engines, got %d:\n%s", len(engines), out)
+	}
+
+	engine := engines[0]
+	if engine.APIVersion != LitmusAPIVersion || engine.Kind != "ChaosEngine" {
+		t.Errorf("unexpected engine resource: %s %s", engine.APIVersion, engine.Kind)
+	}
+	if engine.Metadata.Name != "checkout-0-pod-network-latency" || engine.Metadata.Namespace != "shop" {
+		t.Errorf("unexpected engine metadata: %+v", engine.Metadata)
+	}
+	appinfo, _ := engine.Spec["appinfo"].(map[string]any)
+	if appinfo["applabel"] != "app=checkout" || appinfo["appkind"] != "deployment" || appinfo["appns"] != "shop" {
+		t.Errorf("unexpected appinfo: %v", appinfo)
+	}
+
+	experiments, _ := engine.Spec["experiments"].([]any)
+	if len(experiments) != 1 {
+		t.Fatalf("expected 1 experiment, got %v", engine.Spec["experiments"])
+	}
+	experiment, _ := experiments[0].(map[string]any)
+	if experiment["name"] != "pod-network-latency" {
+		t.Errorf("unexpected experiment: %v", experiment["name"])
+	}
+	spec, _ := experiment["spec"].(map[string]any)
+	env := map[string]any{}
+	components, _ := spec["components"].(map[string]any)
+	for _, item := range components["env"].([]any) {
+		pair, _ := item.(map[string]any)
+		env[pair["name"].(string)] = pair["value"]
+	}
+	if env["NETWORK_LATENCY"] != "250" || env["TOTAL_CHAOS_DURATION"] != "120" {
+		t.Errorf("unexpected env: %v", env)
+	}
+
+	probes, _ := spec["probe"].([]any)
+	if len(probes) != 1 {
+		t.Fatalf("expected 1 probe, got %v", spec["probe"])
+	}
+	probe, _ := probes[0].(map[string]any)
+	inputs, _ := probe["httpProbe/inputs"].(map[string]any)
+	if probe["type"] != "httpProbe" || inputs["url"] != "http://checkout.shop.svc/health" {
+		t.Errorf("unexpected probe: %v", probe)
+	}
+	if inputs["responseTimeout"] != 1000 {
+		t.Errorf("expected response timeout bounded by execution-time, got %v", inputs["responseTimeout"])
+	}
+	get, _ := inputs["method"].(map[string]any)["get"].(map[string]any)
+	if get["responseCode"] != "204" {
+		t.Errorf("unexpected probe method: %v", inputs["method"])
+	}
+
+	if engines[2].Metadata.Name != "checkout-3-pod-delete" {
+		t.Errorf("unexpected pod-delete engine: %+v", engines[2].Metadata)
+	}
+}
+
+func TestLitmusExport_Unsupported(t *testing.T) {
+	scenario := &config.Scenario{
+		Name:      "in-process",
+		Injectors: []config.Component{{Type: "value-corruption"}},
+	}
+
+	if _, err := LitmusExport(scenario); !errors.Is(err, ErrNoLitmusExperiments) {
+		t.Fatalf("expected ErrNoLitmusExperiments, got %v", err)
+	}
+}
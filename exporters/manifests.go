@@ -0,0 +1,66 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// manifest is an exported Kubernetes custom resource
+type manifest struct {
+	APIVersion string           `yaml:"apiVersion"`
+	Kind       string           `yaml:"kind"`
+	Metadata   manifestMetadata `yaml:"metadata"`
+	Spec       map[string]any   `yaml:"spec"`
+}
+
+type manifestMetadata struct {
+	Name      string            `yaml:"name"`
+	Namespace string            `yaml:"namespace"`
+	Labels    map[string]string `yaml:"labels"`
+}
+
+// scenarioMetadata returns the metadata of the index-th resource exported
+// from a scenario, labeled with the scenario name
+func scenarioMetadata(scenario string, index int, suffix, namespace string) manifestMetadata {
+	return manifestMetadata{
+		Name:      manifestName(fmt.Sprintf("%s-%d-%s", scenario, index, suffix)),
+		Namespace: namespace,
+		Labels:    map[string]string{"chaoskit/scenario": manifestName(scenario)},
+	}
+}
+
+var invalidManifestName = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// manifestName turns name into a Kubernetes resource name (DNS-1123 label)
+func manifestName(name string) string {
+	name = strings.Trim(invalidManifestName.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+
+	return name
+}
+
+// encodeManifests encodes manifests as multi-document YAML after comment lines
+func encodeManifests(comments []string, manifests []manifest) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, comment := range comments {
+		_, _ = fmt.Fprintf(&buf, "# %s\n", comment)
+	}
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	for _, m := range manifests {
+		if err := encoder.Encode(m); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
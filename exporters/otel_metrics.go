@@ -0,0 +1,164 @@
+package exporters
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// OTelMetricsExporter records ChaosKit metrics through the OpenTelemetry metrics API.
+// It is the OTLP counterpart of PrometheusExporter and exposes the same
+// recording methods, so it can be fed by the same code paths.
+//
+// Example:
+//
+//	exporter, err := exporters.NewOTelMetricsExporter(meterProvider)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, result := range executor.Reporter().Results() {
+//		exporter.RecordExecution(result)
+//	}
+type OTelMetricsExporter struct {
+	mu              sync.RWMutex
+	injectorMetrics map[string]map[string]any
+
+	executions         metric.Int64Counter
+	executionDuration  metric.Float64Histogram
+	validatorChecks    metric.Int64Counter
+	validatorFailures  metric.Int64Counter
+	validatorWarnings  metric.Int64Counter
+	injectorOperations metric.Int64ObservableCounter
+	injectorProb       metric.Float64ObservableGauge
+	injectorActive     metric.Int64ObservableGauge
+}
+
+var (
+	_ chaoskit.ResultExporter           = (*OTelMetricsExporter)(nil)
+	_ chaoskit.ValidatorMetricsExporter = (*OTelMetricsExporter)(nil)
+)
+
+// NewOTelMetricsExporter creates a metrics exporter using the given meter provider
+func NewOTelMetricsExporter(meterProvider metric.MeterProvider) (*OTelMetricsExporter, error) {
+	meter := meterProvider.Meter(otelInstrumentationName)
+	o := &OTelMetricsExporter{
+		injectorMetrics: make(map[string]map[string]any),
+	}
+
+	var err error
+	if o.executions, err = meter.Int64Counter("chaoskit.executions",
+		metric.WithDescription("Total number of scenario executions")); err != nil {
+		return nil, fmt.Errorf("failed to create executions counter: %w", err)
+	}
+	if o.executionDuration, err = meter.Float64Histogram("chaoskit.execution.duration",
+		metric.WithDescription("Duration of scenario executions"),
+		metric.WithUnit("s")); err != nil {
+		return nil, fmt.Errorf("failed to create execution duration histogram: %w", err)
+	}
+	if o.validatorChecks, err = meter.Int64Counter("chaoskit.validator.checks",
+		metric.WithDescription("Total number of validator checks")); err != nil {
+		return nil, fmt.Errorf("failed to create validator checks counter: %w", err)
+	}
+	if o.validatorFailures, err = meter.Int64Counter("chaoskit.validator.failures",
+		metric.WithDescription("Total number of validator failures")); err != nil {
+		return nil, fmt.Errorf("failed to create validator failures counter: %w", err)
+	}
+	if o.validatorWarnings, err = meter.Int64Counter("chaoskit.validator.warnings",
+		metric.WithDescription("Total number of validator warnings")); err != nil {
+		return nil, fmt.Errorf("failed to create validator warnings counter: %w", err)
+	}
+	if o.injectorOperations, err = meter.Int64ObservableCounter("chaoskit.injector.operations",
+		metric.WithDescription("Total operations by injector")); err != nil {
+		return nil, fmt.Errorf("failed to create injector operations counter: %w", err)
+	}
+	if o.injectorProb, err = meter.Float64ObservableGauge("chaoskit.injector.probability",
+		metric.WithDescription("Configured probability for the injector")); err != nil {
+		return nil, fmt.Errorf("failed to create injector probability gauge: %w", err)
+	}
+	if o.injectorActive, err = meter.Int64ObservableGauge("chaoskit.injector.active",
+		metric.WithDescription("Whether the injector is currently active")); err != nil {
+		return nil, fmt.Errorf("failed to create injector active gauge: %w", err)
+	}
+
+	if _, err = meter.RegisterCallback(o.observeInjectors,
+		o.injectorOperations, o.injectorProb, o.injectorActive); err != nil {
+		return nil, fmt.Errorf("failed to register injector callback: %w", err)
+	}
+
+	return o, nil
+}
+
+// RecordExecution records an execution result
+func (o *OTelMetricsExporter) RecordExecution(result chaoskit.ExecutionResult) {
+	scenario := result.ScenarioName
+	if scenario == "" {
+		scenario = "unknown"
+	}
+
+	outcome := "success"
+	if !result.Success {
+		outcome = "failure"
+	}
+
+	ctx := context.Background()
+	o.executions.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("scenario", scenario),
+		attribute.String("result", outcome),
+	))
+	o.executionDuration.Record(ctx, result.Duration.Seconds(), metric.WithAttributes(
+		attribute.String("scenario", scenario),
+	))
+}
+
+// RecordInjectorMetrics records metrics from an injector.
+// The latest snapshot is reported on each collection cycle.
+func (o *OTelMetricsExporter) RecordInjectorMetrics(injectorName string, metrics map[string]any) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.injectorMetrics[injectorName] = metrics
+}
+
+// RecordValidatorMetrics records validator execution
+func (o *OTelMetricsExporter) RecordValidatorMetrics(validatorName string, failed bool, warning bool) {
+	ctx := context.Background()
+	attrs := metric.WithAttributes(attribute.String("validator", validatorName))
+
+	o.validatorChecks.Add(ctx, 1, attrs)
+	if failed {
+		o.validatorFailures.Add(ctx, 1, attrs)
+	}
+	if warning {
+		o.validatorWarnings.Add(ctx, 1, attrs)
+	}
+}
+
+func (o *OTelMetricsExporter) observeInjectors(_ context.Context, observer metric.Observer) error {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	for injector, metrics := range o.injectorMetrics {
+		attrs := metric.WithAttributes(attribute.String("injector", injector))
+
+		active := int64(1)
+		if stopped, ok := metrics["stopped"].(bool); ok && stopped {
+			active = 0
+		}
+		observer.ObserveInt64(o.injectorActive, active, attrs)
+
+		if count, ok := extractInt64(metrics, "delay_count", "cancel_count", "panic_count", "count"); ok {
+			observer.ObserveInt64(o.injectorOperations, count, attrs)
+		}
+
+		if prob, ok := extractFloat64(metrics, "probability"); ok {
+			observer.ObserveFloat64(o.injectorProb, prob, attrs)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package exporters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestOTelMetricsExporter_Collect(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	exporter, err := NewOTelMetricsExporter(provider)
+	if err != nil {
+		t.Fatalf("failed to create exporter: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		exporter.RecordExecution(chaoskit.ExecutionResult{
+			ScenarioName: "otel",
+			Success:      i != 0,
+			Duration:     20 * time.Millisecond,
+		})
+	}
+	exporter.RecordInjectorMetrics("delay", map[string]any{
+		"delay_count": int64(7),
+		"probability": 0.25,
+		"stopped":     false,
+	})
+	exporter.RecordValidatorMetrics("goroutine_limit", true, false)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect failed: %v", err)
+	}
+
+	metrics := make(map[string]metricdata.Metrics)
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m
+		}
+	}
+
+	executions, ok := metrics["chaoskit.executions"].Data.(metricdata.Sum[int64])
+	if !ok {
+		t.Fatal("expected chaoskit.executions sum")
+	}
+	var total int64
+	for _, dp := range executions.DataPoints {
+		total += dp.Value
+	}
+	if total != 3 {
+		t.Errorf("expected 3 executions, got %d", total)
+	}
+
+	duration, ok := metrics["chaoskit.execution.duration"].Data.(metricdata.Histogram[float64])
+	if !ok || len(duration.DataPoints) != 1 || duration.DataPoints[0].Count != 3 {
+		t.Error("expected execution duration histogram with 3 observations")
+	}
+
+	operations, ok := metrics["chaoskit.injector.operations"].Data.(metricdata.Sum[int64])
+	if !ok || len(operations.DataPoints) != 1 || operations.DataPoints[0].Value != 7 {
+		t.Error("expected injector operations to report delay_count")
+	}
+
+	failures, ok := metrics["chaoskit.validator.failures"].Data.(metricdata.Sum[int64])
+	if !ok || len(failures.DataPoints) != 1 || failures.DataPoints[0].Value != 1 {
+		t.Error("expected one validator failure")
+	}
+}
@@ -0,0 +1,121 @@
+package exporters
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rom8726/chaoskit"
+)
+
+const otelInstrumentationName = "github.com/rom8726/chaoskit"
+
+// OTelTraceExporter emits OpenTelemetry spans for scenario executions:
+// a span per iteration with child spans per step and per injection event.
+// Spans are started from the context passed to the executor, so chaos runs
+// appear inside the caller's trace and the target's own spans become children
+// of the step that produced them.
+//
+// Register the exporter on an executor as an observer:
+//
+//	exporter := exporters.NewOTelTraceExporter(tracerProvider)
+//	executor := chaoskit.NewExecutor(chaoskit.WithObservers(exporter))
+type OTelTraceExporter struct {
+	tracer trace.Tracer
+}
+
+// NewOTelTraceExporter creates a trace exporter using the given tracer provider
+func NewOTelTraceExporter(tracerProvider trace.TracerProvider) *OTelTraceExporter {
+	return &OTelTraceExporter{
+		tracer: tracerProvider.Tracer(otelInstrumentationName),
+	}
+}
+
+// OnIterationStart starts the iteration span
+func (o *OTelTraceExporter) OnIterationStart(ctx context.Context, scenario string, iteration int) context.Context {
+	ctx, _ = o.tracer.Start(ctx, "chaoskit.iteration "+scenario,
+		trace.WithAttributes(
+			attribute.String("chaoskit.scenario", scenario),
+			attribute.Int("chaoskit.iteration", iteration),
+		))
+
+	return ctx
+}
+
+// OnIterationEnd finishes the iteration span with the execution outcome
+func (o *OTelTraceExporter) OnIterationEnd(ctx context.Context, result chaoskit.ExecutionResult) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.Bool("chaoskit.success", result.Success),
+		attribute.Int("chaoskit.steps_executed", result.StepsExecuted),
+		attribute.StringSlice("chaoskit.injectors", result.Injectors),
+	)
+
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+
+	span.End()
+}
+
+// OnStepStart starts a step span as a child of the iteration span
+func (o *OTelTraceExporter) OnStepStart(ctx context.Context, step string) context.Context {
+	ctx, _ = o.tracer.Start(ctx, "chaoskit.step "+step,
+		trace.WithAttributes(attribute.String("chaoskit.step", step)))
+
+	return ctx
+}
+
+// OnStepEnd finishes the step span
+func (o *OTelTraceExporter) OnStepEnd(ctx context.Context, _ string, err error) {
+	span := trace.SpanFromContext(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// OnInjection records the injected fault as a child span of the active span.
+// For delays the span covers the injected latency.
+func (o *OTelTraceExporter) OnInjection(ctx context.Context, event chaoskit.InjectionEvent) {
+	attrs := []attribute.KeyValue{
+		attribute.String("chaoskit.injector", event.Injector),
+		attribute.String("chaoskit.injection.type", event.Type),
+	}
+	if event.Delay > 0 {
+		attrs = append(attrs, attribute.Int64("chaoskit.injection.delay_ms", event.Delay.Milliseconds()))
+	}
+	for key, value := range event.Attributes {
+		attrs = append(attrs, otelAttribute("chaoskit.injection."+key, value))
+	}
+
+	_, span := o.tracer.Start(ctx, "chaoskit.injection "+event.Type,
+		trace.WithTimestamp(event.Timestamp),
+		trace.WithAttributes(attrs...))
+	span.End(trace.WithTimestamp(event.Timestamp.Add(event.Delay)))
+}
+
+func otelAttribute(key string, value any) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
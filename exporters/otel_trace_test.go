@@ -0,0 +1,86 @@
+package exporters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/rom8726/chaoskit"
+)
+
+type traceTestTarget struct{}
+
+func (t *traceTestTarget) Name() string                       { return "trace-target" }
+func (t *traceTestTarget) Setup(ctx context.Context) error    { return nil }
+func (t *traceTestTarget) Teardown(ctx context.Context) error { return nil }
+
+func TestOTelTraceExporter_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	exporter := NewOTelTraceExporter(provider)
+	executor := chaoskit.NewExecutor(
+		chaoskit.WithObservers(exporter),
+		chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure),
+	)
+
+	scenario := chaoskit.NewScenario("traced").
+		WithTarget(&traceTestTarget{}).
+		Step("inject", func(ctx context.Context, target chaoskit.Target) error {
+			chaoskit.RecordInjection(ctx, chaoskit.InjectionEvent{
+				Injector: "test-delay",
+				Type:     chaoskit.InjectionTypeDelay,
+				Delay:    5 * time.Millisecond,
+			})
+
+			return nil
+		}).
+		Step("fail", func(ctx context.Context, target chaoskit.Target) error {
+			return errors.New("boom")
+		}).
+		Repeat(2).
+		Build()
+
+	_ = executor.Run(context.Background(), scenario)
+
+	spans := recorder.Ended()
+	byName := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, span := range spans {
+		byName[span.Name()] = append(byName[span.Name()], span)
+	}
+
+	if got := len(byName["chaoskit.iteration traced"]); got != 2 {
+		t.Fatalf("expected 2 iteration spans, got %d", got)
+	}
+	if got := len(byName["chaoskit.step inject"]); got != 2 {
+		t.Fatalf("expected 2 inject step spans, got %d", got)
+	}
+	if got := len(byName["chaoskit.injection delay"]); got != 2 {
+		t.Fatalf("expected 2 injection spans, got %d", got)
+	}
+
+	// Injection span must be a child of the step span
+	injection := byName["chaoskit.injection delay"][0]
+	step := byName["chaoskit.step inject"][0]
+	if injection.Parent().SpanID() != step.SpanContext().SpanID() {
+		t.Error("expected injection span to be a child of the step span")
+	}
+
+	// Step span must be a child of the iteration span
+	iteration := byName["chaoskit.iteration traced"][0]
+	if step.Parent().SpanID() != iteration.SpanContext().SpanID() {
+		t.Error("expected step span to be a child of the iteration span")
+	}
+
+	if iteration.Status().Code.String() != "Error" {
+		t.Errorf("expected failed iteration to have error status, got %s", iteration.Status().Code)
+	}
+
+	if d := injection.EndTime().Sub(injection.StartTime()); d != 5*time.Millisecond {
+		t.Errorf("expected injection span to cover the delay, got %s", d)
+	}
+}
@@ -2,7 +2,9 @@ package exporters
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -18,14 +20,49 @@ type PrometheusExporter struct {
 	executions       map[string]*executionMetrics
 	injectorMetrics  map[string]map[string]any
 	validatorMetrics map[string]*validatorMetrics
+	buckets          []float64
 	startTime        time.Time
 }
 
+// DefaultDurationBuckets are the default execution duration histogram buckets in seconds
+var DefaultDurationBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0}
+
+// PrometheusOption configures PrometheusExporter and PrometheusCollector
+type PrometheusOption func(*prometheusOptions)
+
+type prometheusOptions struct {
+	buckets []float64
+}
+
+// WithBuckets sets the execution duration histogram buckets in seconds
+// (default: DefaultDurationBuckets). Use wider buckets for iterations taking
+// minutes, e.g. prometheus.ExponentialBuckets(1, 2, 10).
+func WithBuckets(buckets []float64) PrometheusOption {
+	return func(o *prometheusOptions) {
+		o.buckets = buckets
+	}
+}
+
+func newPrometheusOptions(opts []PrometheusOption) *prometheusOptions {
+	o := &prometheusOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	// Buckets must be sorted and unique
+	o.buckets = slices.Compact(slices.Sorted(slices.Values(o.buckets)))
+	if len(o.buckets) == 0 {
+		o.buckets = DefaultDurationBuckets
+	}
+
+	return o
+}
+
 type executionMetrics struct {
 	total           int64
 	success         int64
 	failure         int64
-	durationBuckets map[float64]int64 // duration in seconds -> count
+	durationBuckets []int64 // per-bucket (non-cumulative) counts, aligned with buckets
 	totalDuration   time.Duration
 }
 
@@ -35,14 +72,22 @@ type validatorMetrics struct {
 	warnings    int64
 }
 
+var (
+	_ chaoskit.ResultExporter           = (*PrometheusExporter)(nil)
+	_ chaoskit.ValidatorMetricsExporter = (*PrometheusExporter)(nil)
+)
+
 // NewPrometheusExporter creates a new Prometheus exporter
-func NewPrometheusExporter(namespace, subsystem string) *PrometheusExporter {
+func NewPrometheusExporter(namespace, subsystem string, opts ...PrometheusOption) *PrometheusExporter {
+	options := newPrometheusOptions(opts)
+
 	return &PrometheusExporter{
 		namespace:        namespace,
 		subsystem:        subsystem,
 		executions:       make(map[string]*executionMetrics),
 		injectorMetrics:  make(map[string]map[string]any),
 		validatorMetrics: make(map[string]*validatorMetrics),
+		buckets:          options.buckets,
 		startTime:        time.Now(),
 	}
 }
@@ -60,7 +105,7 @@ func (p *PrometheusExporter) RecordExecution(result chaoskit.ExecutionResult) {
 	metrics, ok := p.executions[scenario]
 	if !ok {
 		metrics = &executionMetrics{
-			durationBuckets: make(map[float64]int64),
+			durationBuckets: make([]int64, len(p.buckets)),
 		}
 		p.executions[scenario] = metrics
 	}
@@ -74,13 +119,10 @@ func (p *PrometheusExporter) RecordExecution(result chaoskit.ExecutionResult) {
 		metrics.failure++
 	}
 
-	// Record duration in histogram buckets
+	// Record duration in the smallest fitting histogram bucket (larger ones go to +Inf only)
 	durationSec := result.Duration.Seconds()
-	buckets := []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0}
-	for _, bucket := range buckets {
-		if durationSec <= bucket {
-			metrics.durationBuckets[bucket]++
-		}
+	if i := sort.SearchFloat64s(p.buckets, durationSec); i < len(p.buckets) {
+		metrics.durationBuckets[i]++
 	}
 }
 
@@ -179,12 +221,11 @@ func (p *PrometheusExporter) writeExecutionMetrics(sb *strings.Builder) {
 		metrics := p.executions[scenario]
 
 		// Write buckets
-		buckets := []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1.0, 2.5, 5.0, 10.0}
 		cumulativeCount := int64(0)
-		for _, bucket := range buckets {
-			cumulativeCount += metrics.durationBuckets[bucket]
-			_, _ = fmt.Fprintf(sb, "chaoskit_execution_duration_seconds_bucket{scenario=\"%s\",le=\"%.3f\"} %d\n",
-				scenario, bucket, cumulativeCount)
+		for i, bucket := range p.buckets {
+			cumulativeCount += metrics.durationBuckets[i]
+			_, _ = fmt.Fprintf(sb, "chaoskit_execution_duration_seconds_bucket{scenario=\"%s\",le=\"%s\"} %d\n",
+				scenario, strconv.FormatFloat(bucket, 'f', -1, 64), cumulativeCount)
 		}
 
 		// +Inf bucket
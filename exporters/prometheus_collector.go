@@ -0,0 +1,177 @@
+package exporters
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rom8726/chaoskit"
+)
+
+var (
+	_ chaoskit.ResultExporter           = (*PrometheusCollector)(nil)
+	_ chaoskit.ValidatorMetricsExporter = (*PrometheusCollector)(nil)
+	_ prometheus.Collector              = (*PrometheusCollector)(nil)
+)
+
+// PrometheusCollector exposes ChaosKit metrics through prometheus/client_golang.
+// Unlike PrometheusExporter, which renders the text exposition itself, it is
+// a prometheus.Collector registered on an application's registry, so ChaosKit
+// metrics are served by the existing /metrics endpoint.
+//
+// Example:
+//
+//	collector, err := exporters.NewPrometheusCollector(prometheus.DefaultRegisterer, "chaoskit", "")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	executor := chaoskit.NewExecutor(chaoskit.WithExporters(collector))
+type PrometheusCollector struct {
+	executions        *prometheus.CounterVec
+	executionDuration *prometheus.HistogramVec
+	validatorChecks   *prometheus.CounterVec
+	validatorFailures *prometheus.CounterVec
+	validatorWarnings *prometheus.CounterVec
+
+	injectorActive     *prometheus.Desc
+	injectorOperations *prometheus.Desc
+	injectorProb       *prometheus.Desc
+
+	mu              sync.RWMutex
+	injectorMetrics map[string]map[string]any
+}
+
+// NewPrometheusCollector creates a collector and registers it on reg
+func NewPrometheusCollector(
+	reg prometheus.Registerer,
+	namespace, subsystem string,
+	opts ...PrometheusOption,
+) (*PrometheusCollector, error) {
+	options := newPrometheusOptions(opts)
+
+	c := &PrometheusCollector{
+		executions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "executions_total",
+			Help:      "Total number of scenario executions",
+		}, []string{"scenario", "result"}),
+		executionDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "execution_duration_seconds",
+			Help:      "Duration of scenario executions",
+			Buckets:   options.buckets,
+		}, []string{"scenario"}),
+		validatorChecks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "validator_checks_total",
+			Help:      "Total number of validator checks",
+		}, []string{"validator"}),
+		validatorFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "validator_failures_total",
+			Help:      "Total number of validator failures",
+		}, []string{"validator"}),
+		validatorWarnings: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "validator_warnings_total",
+			Help:      "Total number of validator warnings",
+		}, []string{"validator"}),
+		injectorActive: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "injector_active"),
+			"Whether the injector is currently active", []string{"injector"}, nil),
+		injectorOperations: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "injector_operations_total"),
+			"Total operations by injector", []string{"injector"}, nil),
+		injectorProb: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, subsystem, "injector_probability"),
+			"Configured probability for the injector", []string{"injector"}, nil),
+		injectorMetrics: make(map[string]map[string]any),
+	}
+
+	if err := reg.Register(c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// RecordExecution records an execution result
+func (c *PrometheusCollector) RecordExecution(result chaoskit.ExecutionResult) {
+	scenario := result.ScenarioName
+	if scenario == "" {
+		scenario = "unknown"
+	}
+
+	outcome := "success"
+	if !result.Success {
+		outcome = "failure"
+	}
+
+	c.executions.WithLabelValues(scenario, outcome).Inc()
+	c.executionDuration.WithLabelValues(scenario).Observe(result.Duration.Seconds())
+}
+
+// RecordInjectorMetrics records metrics from an injector.
+// The latest snapshot is reported on each scrape.
+func (c *PrometheusCollector) RecordInjectorMetrics(injectorName string, metrics map[string]any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.injectorMetrics[injectorName] = metrics
+}
+
+// RecordValidatorMetrics records validator execution
+func (c *PrometheusCollector) RecordValidatorMetrics(validatorName string, failed bool, warning bool) {
+	c.validatorChecks.WithLabelValues(validatorName).Inc()
+	if failed {
+		c.validatorFailures.WithLabelValues(validatorName).Inc()
+	}
+	if warning {
+		c.validatorWarnings.WithLabelValues(validatorName).Inc()
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *PrometheusCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.executions.Describe(ch)
+	c.executionDuration.Describe(ch)
+	c.validatorChecks.Describe(ch)
+	c.validatorFailures.Describe(ch)
+	c.validatorWarnings.Describe(ch)
+	ch <- c.injectorActive
+	ch <- c.injectorOperations
+	ch <- c.injectorProb
+}
+
+// Collect implements prometheus.Collector
+func (c *PrometheusCollector) Collect(ch chan<- prometheus.Metric) {
+	c.executions.Collect(ch)
+	c.executionDuration.Collect(ch)
+	c.validatorChecks.Collect(ch)
+	c.validatorFailures.Collect(ch)
+	c.validatorWarnings.Collect(ch)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for injector, metrics := range c.injectorMetrics {
+		active := 1.0
+		if stopped, ok := metrics["stopped"].(bool); ok && stopped {
+			active = 0
+		}
+		ch <- prometheus.MustNewConstMetric(c.injectorActive, prometheus.GaugeValue, active, injector)
+
+		if count, ok := extractInt64(metrics, "delay_count", "cancel_count", "panic_count", "count"); ok {
+			ch <- prometheus.MustNewConstMetric(c.injectorOperations, prometheus.CounterValue, float64(count), injector)
+		}
+
+		if prob, ok := extractFloat64(metrics, "probability"); ok {
+			ch <- prometheus.MustNewConstMetric(c.injectorProb, prometheus.GaugeValue, prob, injector)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package exporters
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestPrometheusCollector_Registry(t *testing.T) {
+	registry := prometheus.NewRegistry()
+
+	collector, err := NewPrometheusCollector(registry, "chaoskit", "")
+	if err != nil {
+		t.Fatalf("failed to create collector: %v", err)
+	}
+
+	collector.RecordExecution(chaoskit.ExecutionResult{ScenarioName: "native", Success: true, Duration: time.Millisecond})
+	collector.RecordExecution(chaoskit.ExecutionResult{ScenarioName: "native", Duration: time.Millisecond})
+	collector.RecordInjectorMetrics("delay", map[string]any{"delay_count": int64(4), "probability": 0.5})
+	collector.RecordValidatorMetrics("goroutine_limit", true, false)
+
+	expected := `
+# HELP chaoskit_executions_total Total number of scenario executions
+# TYPE chaoskit_executions_total counter
+chaoskit_executions_total{result="failure",scenario="native"} 1
+chaoskit_executions_total{result="success",scenario="native"} 1
+# HELP chaoskit_injector_operations_total Total operations by injector
+# TYPE chaoskit_injector_operations_total counter
+chaoskit_injector_operations_total{injector="delay"} 4
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"chaoskit_executions_total", "chaoskit_injector_operations_total"); err != nil {
+		t.Error(err)
+	}
+
+	// Registering a second collector with the same names must fail
+	if _, err := NewPrometheusCollector(registry, "chaoskit", ""); err == nil {
+		t.Error("expected duplicate registration error")
+	}
+}
@@ -1,6 +1,7 @@
 package exporters
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -127,6 +128,36 @@ func TestPrometheusExporter_DurationHistogram(t *testing.T) {
 	}
 }
 
+func TestPrometheusExporter_WithBuckets(t *testing.T) {
+	exporter := NewPrometheusExporter("chaoskit", "test", WithBuckets([]float64{300, 60, 600}))
+
+	for _, d := range []time.Duration{30 * time.Second, 2 * time.Minute, 3 * time.Minute, time.Hour} {
+		exporter.RecordExecution(chaoskit.ExecutionResult{
+			ScenarioName: "db-workflow",
+			Success:      true,
+			Duration:     d,
+		})
+	}
+
+	metrics := exporter.Export()
+
+	expected := []string{
+		`chaoskit_execution_duration_seconds_bucket{scenario="db-workflow",le="60"} 1`,
+		`chaoskit_execution_duration_seconds_bucket{scenario="db-workflow",le="300"} 3`,
+		`chaoskit_execution_duration_seconds_bucket{scenario="db-workflow",le="600"} 3`,
+		`chaoskit_execution_duration_seconds_bucket{scenario="db-workflow",le="+Inf"} 4`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(metrics, line) {
+			t.Errorf("Expected %q in output:\n%s", line, metrics)
+		}
+	}
+
+	if strings.Contains(metrics, `le="0.001"`) {
+		t.Error("Default buckets must not be used when custom buckets are set")
+	}
+}
+
 func TestPrometheusExporter_InjectorMetrics(t *testing.T) {
 	exporter := NewPrometheusExporter("chaoskit", "test")
 
@@ -282,3 +313,23 @@ func TestPrometheusExporter_MultipleScenarios(t *testing.T) {
 		}
 	}
 }
+
+func TestPrometheusExporter_WithExporters(t *testing.T) {
+	exporter := NewPrometheusExporter("chaoskit", "")
+	executor := chaoskit.NewExecutor(chaoskit.WithExporters(exporter))
+
+	scenario := chaoskit.NewScenario("live").
+		WithTarget(&traceTestTarget{}).
+		Step("noop", func(ctx context.Context, target chaoskit.Target) error { return nil }).
+		Repeat(3).
+		Build()
+
+	if err := executor.Run(context.Background(), scenario); err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+
+	output := exporter.Export()
+	if !strings.Contains(output, `chaoskit_executions_total{scenario="live",result="success"} 3`) {
+		t.Errorf("expected executions recorded live, got:\n%s", output)
+	}
+}
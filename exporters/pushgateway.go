@@ -0,0 +1,89 @@
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// pushTimeout bounds Push calls made without a context
+const pushTimeout = 10 * time.Second
+
+// Push sends the current metrics to a Prometheus Pushgateway, replacing
+// all metrics previously pushed for jobName. Use it at the end of short-lived
+// runs (e.g. CI) where no scrapeable endpoint outlives the process.
+//
+// Example:
+//
+//	defer func() {
+//		if err := exporter.Push("http://pushgateway:9091", "chaos-ci"); err != nil {
+//			log.Printf("push failed: %v", err)
+//		}
+//	}()
+func (p *PrometheusExporter) Push(gatewayURL, jobName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pushTimeout)
+	defer cancel()
+
+	return p.PushContext(ctx, gatewayURL, jobName, nil)
+}
+
+// PushContext is like Push but uses ctx and adds grouping labels
+// (e.g. {"instance": hostname}) to the Pushgateway grouping key
+func (p *PrometheusExporter) PushContext(
+	ctx context.Context,
+	gatewayURL string,
+	jobName string,
+	grouping map[string]string,
+) error {
+	if jobName == "" {
+		return fmt.Errorf("job name is required")
+	}
+
+	pushURL := strings.TrimRight(gatewayURL, "/") + "/metrics/" + pushgatewayPathSegment("job", jobName)
+
+	labels := make([]string, 0, len(grouping))
+	for name := range grouping {
+		labels = append(labels, name)
+	}
+	sort.Strings(labels)
+	for _, name := range labels {
+		pushURL += "/" + pushgatewayPathSegment(name, grouping[name])
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, pushURL, bytes.NewBufferString(p.Export()))
+	if err != nil {
+		return fmt.Errorf("failed to create push request: %w", err)
+	}
+	req.Header.Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to push metrics: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+		return fmt.Errorf("pushgateway returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// pushgatewayPathSegment encodes a grouping key label as "name/value",
+// using the base64 form for values that cannot appear in a path segment
+func pushgatewayPathSegment(name, value string) string {
+	if value == "" || strings.Contains(value, "/") {
+		return name + "@base64/" + base64.RawURLEncoding.EncodeToString([]byte(value))
+	}
+
+	return name + "/" + url.PathEscape(value)
+}
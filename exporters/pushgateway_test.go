@@ -0,0 +1,63 @@
+package exporters
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestPrometheusExporter_Push(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.EscapedPath()
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer server.Close()
+
+	exporter := NewPrometheusExporter("chaoskit", "")
+	exporter.RecordExecution(chaoskit.ExecutionResult{
+		ScenarioName: "push",
+		Success:      true,
+		Duration:     time.Millisecond,
+	})
+
+	if err := exporter.PushContext(t.Context(), server.URL, "chaos-ci", map[string]string{
+		"instance": "runner-1",
+		"branch":   "feature/x",
+	}); err != nil {
+		t.Fatalf("push failed: %v", err)
+	}
+
+	if method != http.MethodPut {
+		t.Errorf("expected PUT, got %s", method)
+	}
+	if path != "/metrics/job/chaos-ci/branch@base64/ZmVhdHVyZS94/instance/runner-1" {
+		t.Errorf("unexpected push path %s", path)
+	}
+	if !strings.Contains(body, `scenario="push"`) {
+		t.Errorf("expected pushed metrics to contain scenario, got %s", body)
+	}
+}
+
+func TestPrometheusExporter_PushError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad metrics", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	exporter := NewPrometheusExporter("chaoskit", "")
+	if err := exporter.Push(server.URL, "chaos-ci"); err == nil {
+		t.Error("expected push error")
+	}
+}
@@ -0,0 +1,312 @@
+package exporters
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// SQLiteDriverName is the database/sql driver used by SQLiteStore.
+// The default matches github.com/mattn/go-sqlite3; set it to "sqlite"
+// when using modernc.org/sqlite.
+var SQLiteDriverName = "sqlite3"
+
+const historySchema = `
+CREATE TABLE IF NOT EXISTS chaoskit_runs (
+	id               INTEGER PRIMARY KEY AUTOINCREMENT,
+	scenario         TEXT    NOT NULL,
+	verdict          TEXT    NOT NULL,
+	success_rate     REAL    NOT NULL,
+	total_iterations INTEGER NOT NULL,
+	success_count    INTEGER NOT NULL,
+	failure_count    INTEGER NOT NULL,
+	avg_duration_ns  INTEGER NOT NULL,
+	duration_ns      INTEGER NOT NULL,
+	executed_at      INTEGER NOT NULL,
+	report_json      TEXT    NOT NULL
+);
+CREATE INDEX IF NOT EXISTS chaoskit_runs_scenario_idx ON chaoskit_runs (scenario, executed_at);
+CREATE TABLE IF NOT EXISTS chaoskit_results (
+	run_id         INTEGER NOT NULL REFERENCES chaoskit_runs (id) ON DELETE CASCADE,
+	scenario       TEXT    NOT NULL,
+	iteration      INTEGER NOT NULL,
+	success        INTEGER NOT NULL,
+	error          TEXT,
+	duration_ns    INTEGER NOT NULL,
+	steps_executed INTEGER NOT NULL,
+	timestamp      INTEGER NOT NULL,
+	injectors      TEXT
+);
+CREATE INDEX IF NOT EXISTS chaoskit_results_run_idx ON chaoskit_results (run_id);
+`
+
+// HistoryStore persists reports and execution results of every run
+// for trend analysis across runs
+type HistoryStore struct {
+	db *sql.DB
+}
+
+// RunSummary is a stored run without its detailed results
+type RunSummary struct {
+	ID              int64
+	ScenarioName    string
+	Verdict         string
+	SuccessRate     float64
+	TotalIterations int
+	SuccessCount    int
+	FailureCount    int
+	AvgDuration     time.Duration
+	Duration        time.Duration
+	ExecutedAt      time.Time
+}
+
+// TrendPoint is a single run in a trend
+type TrendPoint struct {
+	RunID       int64
+	ExecutedAt  time.Time
+	SuccessRate float64
+	AvgDuration time.Duration
+	Verdict     string
+}
+
+// Trend describes how a scenario behaved over its last runs (oldest first)
+type Trend struct {
+	ScenarioName string
+	Points       []TrendPoint
+
+	// AvgSuccessRate is the mean success rate over all points
+	AvgSuccessRate float64
+
+	// SuccessRateSlope is the least-squares slope of the success rate per run;
+	// negative values mean resilience is getting worse
+	SuccessRateSlope float64
+}
+
+// IsDegrading reports whether the success rate tends to decrease over the runs
+func (t *Trend) IsDegrading() bool {
+	return len(t.Points) > 1 && t.SuccessRateSlope < 0
+}
+
+// SQLiteStore opens (or creates) a SQLite history database at path.
+// A SQLite database/sql driver must be registered by the caller, e.g.:
+//
+//	import _ "github.com/mattn/go-sqlite3"
+//
+//	store, err := exporters.SQLiteStore("chaos-history.db")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer store.Close()
+//	_, err = store.Record(executor.Reporter(), report)
+func SQLiteStore(path string) (*HistoryStore, error) {
+	db, err := sql.Open(SQLiteDriverName, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history database: %w", err)
+	}
+
+	store, err := NewHistoryStore(db)
+	if err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// NewHistoryStore creates a history store on an already opened SQLite database
+// and creates the schema if needed
+func NewHistoryStore(db *sql.DB) (*HistoryStore, error) {
+	if _, err := db.Exec(historySchema); err != nil {
+		return nil, fmt.Errorf("failed to create history schema: %w", err)
+	}
+
+	return &HistoryStore{db: db}, nil
+}
+
+// Close closes the underlying database
+func (s *HistoryStore) Close() error {
+	return s.db.Close()
+}
+
+// Record stores the report together with all results accumulated by the reporter
+func (s *HistoryStore) Record(reporter *chaoskit.Reporter, report *chaoskit.Report) (int64, error) {
+	return s.SaveRun(report, reporter.Results())
+}
+
+// SaveRun stores a report and its execution results and returns the run ID
+func (s *HistoryStore) SaveRun(report *chaoskit.Report, results []chaoskit.ExecutionResult) (int64, error) {
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	ctx := context.Background()
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, `INSERT INTO chaoskit_runs
+		(scenario, verdict, success_rate, total_iterations, success_count, failure_count,
+		 avg_duration_ns, duration_ns, executed_at, report_json)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		report.ScenarioName, report.Verdict.String(), report.SuccessRate, report.TotalIterations,
+		report.SuccessCount, report.FailureCount, int64(report.AvgDuration), int64(report.Duration),
+		report.ExecutionTime.UnixNano(), string(reportJSON))
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert run: %w", err)
+	}
+
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get run id: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO chaoskit_results
+		(run_id, scenario, iteration, success, error, duration_ns, steps_executed, timestamp, injectors)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare result insert: %w", err)
+	}
+	defer func() { _ = stmt.Close() }()
+
+	for _, result := range results {
+		var errMsg sql.NullString
+		if result.Error != nil {
+			errMsg = sql.NullString{String: result.Error.Error(), Valid: true}
+		}
+
+		if _, err := stmt.ExecContext(ctx, runID, result.ScenarioName, result.Iteration, result.Success, errMsg,
+			int64(result.Duration), result.StepsExecuted, result.Timestamp.UnixNano(),
+			strings.Join(result.Injectors, ",")); err != nil {
+			return 0, fmt.Errorf("failed to insert result: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit run: %w", err)
+	}
+
+	return runID, nil
+}
+
+// Scenarios returns the names of all scenarios with stored runs
+func (s *HistoryStore) Scenarios() ([]string, error) {
+	rows, err := s.db.Query(`SELECT DISTINCT scenario FROM chaoskit_runs ORDER BY scenario`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scenarios: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var scenarios []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		scenarios = append(scenarios, name)
+	}
+
+	return scenarios, rows.Err()
+}
+
+// RecentRuns returns up to limit most recent runs of a scenario, newest first
+func (s *HistoryStore) RecentRuns(scenario string, limit int) ([]RunSummary, error) {
+	rows, err := s.db.Query(`SELECT id, scenario, verdict, success_rate, total_iterations,
+		success_count, failure_count, avg_duration_ns, duration_ns, executed_at
+		FROM chaoskit_runs WHERE scenario = ? ORDER BY executed_at DESC, id DESC LIMIT ?`,
+		scenario, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query runs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var runs []RunSummary
+	for rows.Next() {
+		var (
+			run         RunSummary
+			avgDuration int64
+			duration    int64
+			executedAt  int64
+		)
+		if err := rows.Scan(&run.ID, &run.ScenarioName, &run.Verdict, &run.SuccessRate,
+			&run.TotalIterations, &run.SuccessCount, &run.FailureCount,
+			&avgDuration, &duration, &executedAt); err != nil {
+			return nil, err
+		}
+		run.AvgDuration = time.Duration(avgDuration)
+		run.Duration = time.Duration(duration)
+		run.ExecutedAt = time.Unix(0, executedAt)
+		runs = append(runs, run)
+	}
+
+	return runs, rows.Err()
+}
+
+// SuccessRateTrend returns the success rate trend over the last n runs of a scenario
+func (s *HistoryStore) SuccessRateTrend(scenario string, lastN int) (*Trend, error) {
+	runs, err := s.RecentRuns(scenario, lastN)
+	if err != nil {
+		return nil, err
+	}
+
+	trend := &Trend{
+		ScenarioName: scenario,
+		Points:       make([]TrendPoint, 0, len(runs)),
+	}
+
+	// Runs are newest first, trend points are oldest first
+	for i := len(runs) - 1; i >= 0; i-- {
+		run := runs[i]
+		trend.Points = append(trend.Points, TrendPoint{
+			RunID:       run.ID,
+			ExecutedAt:  run.ExecutedAt,
+			SuccessRate: run.SuccessRate,
+			AvgDuration: run.AvgDuration,
+			Verdict:     run.Verdict,
+		})
+	}
+
+	n := float64(len(trend.Points))
+	if n == 0 {
+		return trend, nil
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for i, point := range trend.Points {
+		x := float64(i)
+		sumX += x
+		sumY += point.SuccessRate
+		sumXY += x * point.SuccessRate
+		sumXX += x * x
+	}
+	trend.AvgSuccessRate = sumY / n
+	if denominator := n*sumXX - sumX*sumX; denominator != 0 {
+		trend.SuccessRateSlope = (n*sumXY - sumX*sumY) / denominator
+	}
+
+	return trend, nil
+}
+
+// LoadRunReport returns the full report stored for a run
+func (s *HistoryStore) LoadRunReport(runID int64) (*chaoskit.Report, error) {
+	var reportJSON string
+	err := s.db.QueryRow(`SELECT report_json FROM chaoskit_runs WHERE id = ?`, runID).Scan(&reportJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load run %d: %w", runID, err)
+	}
+
+	report, err := chaoskit.DecodeReport([]byte(reportJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse run %d report: %w", runID, err)
+	}
+
+	return report, nil
+}
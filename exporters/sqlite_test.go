@@ -0,0 +1,76 @@
+package exporters
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestSQLiteStore_SuccessRateTrend(t *testing.T) {
+	store, err := SQLiteStore(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	base := time.Now().Add(-time.Hour)
+	rates := []float64{1.0, 0.9, 0.8, 0.7}
+	var lastRunID int64
+	for i, rate := range rates {
+		report := &chaoskit.Report{
+			ScenarioName:    "history",
+			Verdict:         chaoskit.VerdictPass,
+			SuccessRate:     rate,
+			TotalIterations: 10,
+			SuccessCount:    int(rate * 10),
+			FailureCount:    10 - int(rate*10),
+			AvgDuration:     time.Duration(i+1) * time.Millisecond,
+			ExecutionTime:   base.Add(time.Duration(i) * time.Minute),
+		}
+		results := []chaoskit.ExecutionResult{
+			{ScenarioName: "history", Iteration: 1, Success: true, Timestamp: report.ExecutionTime},
+			{ScenarioName: "history", Iteration: 2, Error: errors.New("boom"), Timestamp: report.ExecutionTime,
+				Injectors: []string{"delay", "panic"}},
+		}
+
+		lastRunID, err = store.SaveRun(report, results)
+		if err != nil {
+			t.Fatalf("failed to save run: %v", err)
+		}
+	}
+
+	trend, err := store.SuccessRateTrend("history", 3)
+	if err != nil {
+		t.Fatalf("failed to query trend: %v", err)
+	}
+	if len(trend.Points) != 3 {
+		t.Fatalf("expected 3 trend points, got %d", len(trend.Points))
+	}
+	if trend.Points[0].SuccessRate != 0.9 || trend.Points[2].SuccessRate != 0.7 {
+		t.Errorf("expected points oldest first, got %+v", trend.Points)
+	}
+	if !trend.IsDegrading() {
+		t.Errorf("expected degrading trend, slope %f", trend.SuccessRateSlope)
+	}
+	if trend.AvgSuccessRate < 0.79 || trend.AvgSuccessRate > 0.81 {
+		t.Errorf("expected average success rate 0.8, got %f", trend.AvgSuccessRate)
+	}
+
+	scenarios, err := store.Scenarios()
+	if err != nil || len(scenarios) != 1 || scenarios[0] != "history" {
+		t.Errorf("unexpected scenarios %v (err %v)", scenarios, err)
+	}
+
+	report, err := store.LoadRunReport(lastRunID)
+	if err != nil {
+		t.Fatalf("failed to load report: %v", err)
+	}
+	if report.SuccessRate != 0.7 {
+		t.Errorf("expected stored report success rate 0.7, got %f", report.SuccessRate)
+	}
+}
@@ -0,0 +1,213 @@
+package exporters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"text/template"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// DefaultWebhookTemplate renders a Slack-compatible incoming webhook payload
+const DefaultWebhookTemplate = `{"text": {{ json .Text }}}`
+
+// WebhookMessage is the data passed to the webhook template
+type WebhookMessage struct {
+	// Text is a preformatted human-readable message
+	Text string
+
+	ScenarioName string
+	Verdict      string
+	Summary      string
+	SuccessRate  float64
+
+	// TopFailures are the most frequent critical failures and warnings
+	TopFailures []chaoskit.ValidationFailure
+
+	// Injectors summarizes the chaos applied by each injector
+	Injectors []chaoskit.InjectorSummary
+
+	Report *chaoskit.Report
+}
+
+// Webhook posts verdicts to an HTTP endpoint such as a Slack incoming webhook
+type Webhook struct {
+	url         string
+	tmpl        *template.Template
+	client      *http.Client
+	headers     map[string]string
+	verdicts    []chaoskit.Verdict
+	topFailures int
+	timeout     time.Duration
+}
+
+// WebhookOption configures a Webhook
+type WebhookOption func(*Webhook)
+
+// WithWebhookClient sets the HTTP client used to send notifications
+func WithWebhookClient(client *http.Client) WebhookOption {
+	return func(w *Webhook) {
+		w.client = client
+	}
+}
+
+// WithWebhookHeader adds an HTTP header to every notification request
+func WithWebhookHeader(key, value string) WebhookOption {
+	return func(w *Webhook) {
+		w.headers[key] = value
+	}
+}
+
+// WithNotifyVerdicts sets which verdicts trigger a notification
+// (default: FAIL and UNSTABLE)
+func WithNotifyVerdicts(verdicts ...chaoskit.Verdict) WebhookOption {
+	return func(w *Webhook) {
+		w.verdicts = verdicts
+	}
+}
+
+// WithWebhookTopFailures sets how many failures are included in the message
+// (default: 5, none when n <= 0)
+func WithWebhookTopFailures(n int) WebhookOption {
+	return func(w *Webhook) {
+		w.topFailures = max(n, 0)
+	}
+}
+
+// WithWebhookTimeout sets the timeout used when notifying from OnVerdict (default: 10s)
+func WithWebhookTimeout(timeout time.Duration) WebhookOption {
+	return func(w *Webhook) {
+		w.timeout = timeout
+	}
+}
+
+// WebhookNotifier creates a notifier posting to url.
+// tmpl is a text/template rendered with WebhookMessage into the request body;
+// an empty tmpl uses DefaultWebhookTemplate. The template has a "json" function
+// which encodes a value as JSON.
+//
+// Example:
+//
+//	notifier, err := exporters.WebhookNotifier(os.Getenv("SLACK_WEBHOOK_URL"), "")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	executor.Reporter().AddVerdictListener(notifier)
+func WebhookNotifier(url, tmpl string, opts ...WebhookOption) (*Webhook, error) {
+	if tmpl == "" {
+		tmpl = DefaultWebhookTemplate
+	}
+
+	parsed, err := template.New("webhook").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+
+			return string(b), err
+		},
+	}).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook template: %w", err)
+	}
+
+	w := &Webhook{
+		url:         url,
+		tmpl:        parsed,
+		client:      http.DefaultClient,
+		headers:     map[string]string{"Content-Type": "application/json"},
+		verdicts:    []chaoskit.Verdict{chaoskit.VerdictFail, chaoskit.VerdictUnstable},
+		topFailures: 5,
+		timeout:     10 * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w, nil
+}
+
+// OnVerdict implements chaoskit.VerdictListener. Errors are logged.
+func (w *Webhook) OnVerdict(report *chaoskit.Report) {
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	if err := w.Notify(ctx, report); err != nil {
+		slog.Default().Warn("failed to send verdict webhook",
+			slog.String("scenario", report.ScenarioName),
+			slog.String("error", err.Error()))
+	}
+}
+
+// Notify sends the report if its verdict is one of the notified verdicts
+func (w *Webhook) Notify(ctx context.Context, report *chaoskit.Report) error {
+	if !slices.Contains(w.verdicts, report.Verdict) {
+		return nil
+	}
+
+	var body bytes.Buffer
+	if err := w.tmpl.Execute(&body, w.message(report)); err != nil {
+		return fmt.Errorf("failed to render webhook template: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, &body)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	for key, value := range w.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (w *Webhook) message(report *chaoskit.Report) WebhookMessage {
+	failures := make([]chaoskit.ValidationFailure, 0, len(report.CriticalFailures)+len(report.Warnings))
+	failures = append(failures, report.CriticalFailures...)
+	failures = append(failures, report.Warnings...)
+	slices.SortStableFunc(failures, func(a, b chaoskit.ValidationFailure) int {
+		if a.Severity != b.Severity {
+			return int(a.Severity) - int(b.Severity)
+		}
+
+		return b.Occurrences - a.Occurrences
+	})
+	if len(failures) > w.topFailures {
+		failures = failures[:w.topFailures]
+	}
+
+	var text bytes.Buffer
+	_, _ = fmt.Fprintf(&text, "ChaosKit verdict for %s: %s\n%s", report.ScenarioName, report.Verdict, report.Summary)
+	for _, failure := range failures {
+		_, _ = fmt.Fprintf(&text, "\n• [%s] %s: %s (%d times)",
+			failure.Severity, failure.ValidatorName, failure.Message, failure.Occurrences)
+	}
+
+	return WebhookMessage{
+		Text:         text.String(),
+		ScenarioName: report.ScenarioName,
+		Verdict:      report.Verdict.String(),
+		Summary:      report.Summary,
+		SuccessRate:  report.SuccessRate,
+		TopFailures:  failures,
+		Injectors:    report.Injectors,
+		Report:       report,
+	}
+}
@@ -0,0 +1,104 @@
+package exporters
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestWebhookNotifier_OnVerdict(t *testing.T) {
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- body
+	}))
+	defer server.Close()
+
+	notifier, err := WebhookNotifier(server.URL, "")
+	if err != nil {
+		t.Fatalf("failed to create notifier: %v", err)
+	}
+
+	reporter := chaoskit.NewReporter()
+	reporter.AddVerdictListener(notifier)
+	reporter.AddResult(chaoskit.ExecutionResult{
+		ScenarioName: "webhook",
+		Error:        errors.New("validator goroutine_limit failed: too many goroutines"),
+	})
+
+	if _, err := reporter.GetVerdict(chaoskit.DefaultThresholds()); err != nil {
+		t.Fatalf("GetVerdict failed: %v", err)
+	}
+
+	var payload struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(<-bodies, &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	if !strings.Contains(payload.Text, "webhook: FAIL") {
+		t.Errorf("expected verdict in message, got %q", payload.Text)
+	}
+	if !strings.Contains(payload.Text, "goroutine_limit") {
+		t.Errorf("expected top failure in message, got %q", payload.Text)
+	}
+}
+
+func TestWebhookNotifier_SkipsPass(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	notifier, err := WebhookNotifier(server.URL, `{{ .Verdict }}`)
+	if err != nil {
+		t.Fatalf("failed to create notifier: %v", err)
+	}
+
+	if err := notifier.Notify(t.Context(), &chaoskit.Report{Verdict: chaoskit.VerdictPass}); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+	if called {
+		t.Error("expected passing verdict not to be notified")
+	}
+}
+
+func TestWebhookNotifier_NegativeTopFailures(t *testing.T) {
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies <- body
+	}))
+	defer server.Close()
+
+	notifier, err := WebhookNotifier(server.URL, "", WithWebhookTopFailures(-1))
+	if err != nil {
+		t.Fatalf("failed to create notifier: %v", err)
+	}
+
+	report := &chaoskit.Report{
+		ScenarioName:     "webhook",
+		Verdict:          chaoskit.VerdictFail,
+		CriticalFailures: []chaoskit.ValidationFailure{{ValidatorName: "goroutine_limit", Occurrences: 1}},
+	}
+	if err := notifier.Notify(t.Context(), report); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+
+	var payload struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(<-bodies, &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	if strings.Contains(payload.Text, "goroutine_limit") {
+		t.Errorf("expected no failures in message, got %q", payload.Text)
+	}
+}
@@ -0,0 +1,54 @@
+package chaoskit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+)
+
+// faultIDKey is a private type for context key
+type faultIDKey struct{}
+
+// NewFaultID returns a new unique fault ID. Every injection gets one: it is
+// carried by the InjectionEvent (and so the event log) and, for injected
+// errors and panics, by the ChaosError, so logs and traces can be joined back
+// to the fault that caused them.
+func NewFaultID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+
+	return hex.EncodeToString(b[:])
+}
+
+// FaultID returns the fault ID of an injected error, or "" when err is not injected
+func FaultID(err error) string {
+	var chaosErr *ChaosError
+	if errors.As(err, &chaosErr) {
+		return chaosErr.FaultID
+	}
+
+	return ""
+}
+
+// WithFaultID attaches a fault ID to ctx, so code handling an injected fault
+// can pass it on to downstream calls, logs and spans:
+//
+//	if err := chaoskit.MaybeError(ctx); err != nil {
+//		ctx = chaoskit.WithFaultID(ctx, chaoskit.FaultID(err))
+//		...
+//	}
+func WithFaultID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, faultIDKey{}, id)
+}
+
+// FaultIDFromContext returns the fault ID attached with WithFaultID, if any
+func FaultIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(faultIDKey{}).(string)
+
+	return id
+}
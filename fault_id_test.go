@@ -0,0 +1,51 @@
+package chaoskit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaultID(t *testing.T) {
+	executor := NewExecutor()
+
+	var stepErr error
+	var propagated string
+	scenario := NewScenario("fault-ids").
+		WithTarget(&testTarget{}).
+		Inject("errors", &alwaysErrorInjector{}).
+		Inject("delays", &alwaysDelayInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			MaybeDelay(ctx)
+			if err := MaybeError(ctx); err != nil {
+				stepErr = fmt.Errorf("charge: %w", err)
+				propagated = FaultIDFromContext(WithFaultID(ctx, FaultID(stepErr)))
+			}
+
+			return nil
+		}).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	id := FaultID(stepErr)
+	require.NotEmpty(t, id)
+	assert.Equal(t, id, propagated)
+
+	// The event of the injected error carries the ID of the error; every other
+	// injection gets its own ID
+	timeline := executor.Reporter().Timeline()
+	require.Len(t, timeline, 2)
+	ids := make(map[string]string)
+	for _, event := range timeline {
+		require.NotEmpty(t, event.FaultID)
+		ids[event.Type] = event.FaultID
+	}
+	assert.Equal(t, id, ids[InjectionTypeError])
+	assert.NotEqual(t, id, ids[InjectionTypeDelay])
+
+	assert.Empty(t, FaultID(fmt.Errorf("plain")))
+	assert.Empty(t, FaultIDFromContext(WithFaultID(context.Background(), "")))
+}
@@ -0,0 +1,114 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// FaultKind is the kind of a typed fault (see Fault)
+type FaultKind string
+
+// Fault kinds
+const (
+	// FaultTimeout is an operation that did not complete in time
+	FaultTimeout FaultKind = "timeout"
+
+	// FaultUnavailable is a dependency that cannot be reached
+	FaultUnavailable FaultKind = "unavailable"
+
+	// FaultThrottled is a request rejected by rate limiting
+	FaultThrottled FaultKind = "throttled"
+
+	// FaultCorruption is data that failed an integrity check
+	FaultCorruption FaultKind = "corruption"
+)
+
+// faultMessages are the default messages of fault kinds
+var faultMessages = map[FaultKind]string{
+	FaultTimeout:     "chaos: operation timed out",
+	FaultUnavailable: "chaos: service unavailable",
+	FaultThrottled:   "chaos: request throttled",
+	FaultCorruption:  "chaos: data corrupted",
+}
+
+// Fault is a typed injected fault. Error injectors, validators and reports
+// recognize its kind, so faults need no ad-hoc errors.New("temporary error")
+// strings. Faults match ErrInjected with errors.Is; timeouts also match
+// context.DeadlineExceeded.
+type Fault struct {
+	Kind    FaultKind
+	Message string
+
+	// RetryAfter is the back-off requested by a throttled fault (0 = none)
+	RetryAfter time.Duration
+}
+
+// NewFault returns a fault of kind; an empty msg uses the default message of the kind
+func NewFault(kind FaultKind, msg string) *Fault {
+	if msg == "" {
+		msg = faultMessages[kind]
+	}
+	if msg == "" {
+		msg = "chaos: " + string(kind)
+	}
+
+	return &Fault{Kind: kind, Message: msg}
+}
+
+// TimeoutFault returns a timeout fault
+func TimeoutFault(msg string) *Fault {
+	return NewFault(FaultTimeout, msg)
+}
+
+// UnavailableFault returns an unavailable dependency fault
+func UnavailableFault(msg string) *Fault {
+	return NewFault(FaultUnavailable, msg)
+}
+
+// ThrottledFault returns a throttling fault asking to retry after retryAfter
+func ThrottledFault(msg string, retryAfter time.Duration) *Fault {
+	fault := NewFault(FaultThrottled, msg)
+	fault.RetryAfter = retryAfter
+
+	return fault
+}
+
+// CorruptionFault returns a data corruption fault
+func CorruptionFault(msg string) *Fault {
+	return NewFault(FaultCorruption, msg)
+}
+
+func (f *Fault) Error() string { return f.Message }
+
+// Is makes errors.Is match ErrInjected, faults of the same kind and,
+// for timeouts, context.DeadlineExceeded
+func (f *Fault) Is(target error) bool {
+	if target == ErrInjected {
+		return true
+	}
+	if target == context.DeadlineExceeded {
+		return f.Kind == FaultTimeout
+	}
+	if other, ok := target.(*Fault); ok {
+		return other.Kind == f.Kind
+	}
+
+	return false
+}
+
+// Timeout reports whether the fault is a timeout (as net.Error)
+func (f *Fault) Timeout() bool { return f.Kind == FaultTimeout }
+
+// Temporary reports whether retrying may succeed: true for all kinds except corruption
+func (f *Fault) Temporary() bool { return f.Kind != FaultCorruption }
+
+// FaultKindOf returns the kind of the fault err is or wraps, or "" for other errors
+func FaultKindOf(err error) FaultKind {
+	var fault *Fault
+	if errors.As(err, &fault) {
+		return fault.Kind
+	}
+
+	return ""
+}
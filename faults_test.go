@@ -0,0 +1,57 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timeoutFaultInjector fails every MaybeError call with a timeout fault
+type timeoutFaultInjector struct{ testMetricsInjector }
+
+func (i *timeoutFaultInjector) Name() string             { return "timeouts" }
+func (i *timeoutFaultInjector) ShouldReturnError() error { return TimeoutFault("") }
+
+func TestFaults(t *testing.T) {
+	throttled := ThrottledFault("", time.Second)
+	assert.Equal(t, "chaos: request throttled", throttled.Error())
+	assert.Equal(t, time.Second, throttled.RetryAfter)
+	assert.True(t, throttled.Temporary())
+	assert.False(t, CorruptionFault("checksum mismatch").Temporary())
+
+	err := fmt.Errorf("fetch: %w", TimeoutFault("upstream timed out"))
+	assert.True(t, IsInjected(err))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorIs(t, err, TimeoutFault(""))
+	assert.NotErrorIs(t, err, UnavailableFault(""))
+	assert.Equal(t, FaultTimeout, FaultKindOf(err))
+	assert.Empty(t, FaultKindOf(errors.New("timeout")))
+	assert.NotErrorIs(t, UnavailableFault(""), context.DeadlineExceeded)
+}
+
+func TestFaults_Report(t *testing.T) {
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+	scenario := NewScenario("faults").
+		WithTarget(&testTarget{}).
+		Inject("timeouts", &timeoutFaultInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			return MaybeError(ctx)
+		}).
+		Repeat(2).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 2)
+	assert.Equal(t, FaultTimeout, FaultKindOf(results[0].Error))
+	assert.Equal(t, FailureInjectedFault, results[0].FailureClass)
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Analysis.ByType[ErrorTypeTimeout])
+}
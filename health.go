@@ -0,0 +1,100 @@
+package chaoskit
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// DefaultHealthCheckInterval is how often injector health is checked by default
+const DefaultHealthCheckInterval = 5 * time.Second
+
+// healthCheckTimeout bounds a single HealthCheck call
+const healthCheckTimeout = 5 * time.Second
+
+// WithHealthCheckInterval sets how often the health of injectors implementing
+// HealthChecker is checked (DefaultHealthCheckInterval by default). Checks run
+// at iteration boundaries, the first one before the first iteration; a
+// negative interval disables them.
+func WithHealthCheckInterval(interval time.Duration) ExecutorOption {
+	return func(e *Executor) {
+		e.healthInterval = interval
+	}
+}
+
+// checkInjectorHealth polls the health of the started injectors of run when
+// the check interval has elapsed and disables the unhealthy ones: they are
+// stopped, left out of the following iterations and flagged in the report
+func (e *Executor) checkInjectorHealth(ctx context.Context, run *scenarioRun) {
+	interval := e.healthInterval
+	switch {
+	case interval < 0:
+		return
+	case interval == 0:
+		interval = DefaultHealthCheckInterval
+	}
+	if !run.healthCheckedAt.IsZero() && time.Since(run.healthCheckedAt) < interval {
+		return
+	}
+	run.healthCheckedAt = time.Now()
+
+	var unhealthy []Injector
+	for _, inj := range run.injectors.active {
+		checker, ok := inj.(HealthChecker)
+		if !ok {
+			continue
+		}
+		if err := e.healthCheck(ctx, checker); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			e.disableInjector(ctx, run, inj, err)
+			unhealthy = append(unhealthy, inj)
+		}
+	}
+	if len(unhealthy) == 0 {
+		return
+	}
+
+	healthy := func(inj Injector) bool { return !slices.Contains(unhealthy, inj) }
+	disabled := *run.scenario
+	disabled.injectors = slices.DeleteFunc(slices.Clone(disabled.injectors), func(inj Injector) bool { return !healthy(inj) })
+	disabled.scopes = make([]*Scope, 0, len(run.scenario.scopes))
+	for _, scope := range run.scenario.scopes {
+		disabled.scopes = append(disabled.scopes, &Scope{
+			name:      scope.name,
+			injectors: slices.DeleteFunc(slices.Clone(scope.injectors), func(inj Injector) bool { return !healthy(inj) }),
+		})
+	}
+	run.scenario = &disabled
+	run.injectors = &runInjectors{
+		active:  slices.DeleteFunc(run.injectors.active, func(inj Injector) bool { return !healthy(inj) }),
+		network: slices.DeleteFunc(run.injectors.network, func(inj Injector) bool { return !healthy(inj) }),
+	}
+}
+
+// healthCheck runs a health check, treating a panic as unhealthy
+func (e *Executor) healthCheck(ctx context.Context, checker HealthChecker) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	return callSafely(func() error { return checker.HealthCheck(ctx) })
+}
+
+// disableInjector stops an unhealthy injector and flags it in the report
+func (e *Executor) disableInjector(ctx context.Context, run *scenarioRun, inj Injector, cause error) {
+	if e.logger != nil {
+		e.logger.Warn("injector unhealthy, disabling",
+			slog.String("scenario", run.scenario.name),
+			slog.String("injector", inj.Name()),
+			slog.String("error", cause.Error()))
+	}
+
+	e.snapshotInjectorMetrics([]Injector{inj})
+	e.stopInjectors(ctx, []Injector{inj})
+	if slices.Contains(run.injectors.network, inj) {
+		e.teardownNetwork(ctx, run.scenario, []Injector{inj})
+	}
+	e.reporter.SetInjectorDisabled(inj.Name(), cause.Error())
+}
@@ -0,0 +1,82 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyInjector reports unhealthy once lost is set
+type flakyInjector struct {
+	lifecycleInjector
+	lost bool
+}
+
+func (i *flakyInjector) HealthCheck(ctx context.Context) error {
+	if i.lost {
+		return errors.New("connection lost")
+	}
+
+	return nil
+}
+
+func TestExecutor_DisablesUnhealthyInjector(t *testing.T) {
+	flaky := &flakyInjector{lifecycleInjector: lifecycleInjector{name: "flaky"}}
+	steady := &lifecycleInjector{name: "steady"}
+	executor := NewExecutor(WithHealthCheckInterval(1))
+
+	iteration := 0
+	scenario := NewScenario("health").
+		WithTarget(&testTarget{}).
+		Inject("flaky", flaky).
+		Scope("db", func(s *ScopeBuilder) { s.Inject("steady", steady) }).
+		Step("step", func(ctx context.Context, target Target) error {
+			if iteration++; iteration == 2 {
+				flaky.lost = true
+			}
+
+			return nil
+		}).
+		Repeat(4).
+		Build()
+
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 4)
+	assert.Equal(t, []string{"flaky", "steady"}, results[1].Injectors)
+	assert.Equal(t, []string{"steady"}, results[2].Injectors)
+	assert.Equal(t, InjectorStopped, flaky.lifecycle.State())
+	assert.Equal(t, InjectorStopped, steady.lifecycle.State())
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	var disabled []string
+	for _, summary := range report.Injectors {
+		if summary.Disabled != "" {
+			disabled = append(disabled, summary.Name+": "+summary.Disabled)
+		}
+	}
+	assert.Equal(t, []string{"flaky: connection lost"}, disabled)
+	assert.Contains(t, executor.Reporter().GenerateTextReport(report), "[DISABLED: connection lost]")
+}
+
+func TestExecutor_HealthChecksDisabled(t *testing.T) {
+	flaky := &flakyInjector{lifecycleInjector: lifecycleInjector{name: "flaky"}, lost: true}
+	executor := NewExecutor(WithHealthCheckInterval(-1))
+
+	scenario := NewScenario("health").
+		WithTarget(&testTarget{}).
+		Inject("flaky", flaky).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(2).
+		Build()
+
+	require.NoError(t, executor.Run(context.Background(), scenario))
+	for _, result := range executor.Reporter().Results() {
+		assert.Equal(t, []string{"flaky"}, result.Injectors)
+	}
+}
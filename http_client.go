@@ -0,0 +1,80 @@
+package chaoskit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+// WrapHTTPClient returns a copy of client whose transport applies the chaos of
+// the active injectors to every request: network latency and connection drops
+// of the ChaosNetworkProvider (see MaybeNetworkChaos) and errors of the
+// ChaosErrorProvider (see MaybeError). Dropped connections and injected errors
+// fail the request with a *ChaosError.
+//
+// Requests use the chaos of their context and fall back to ctx. The context
+// of Target.Setup carries no chaos: a client wrapped there only faults
+// requests built with the context of a step (http.NewRequestWithContext),
+// while Get, Post and other requests without it never fault. Wrap a client
+// inside a step to fault them too. A nil client wraps http.DefaultClient.
+func WrapHTTPClient(ctx context.Context, client *http.Client) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	wrapped := *client
+	wrapped.Transport = &chaosTransport{ctx: ctx, base: base}
+
+	return &wrapped
+}
+
+// chaosTransport applies chaos before delegating requests to base
+type chaosTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+func (t *chaosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := chaosCallContext(req.Context(), t.ctx)
+
+	host, port := requestHostPort(req)
+	if dropped := applyNetworkChaos(ctx, host, port); dropped != nil {
+		return nil, dropped
+	}
+	if err := MaybeError(ctx); err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// chaosCallContext returns the context of a call when it carries chaos,
+// otherwise the context a handle was wrapped with
+func chaosCallContext(call, wrapped context.Context) context.Context {
+	if call.Value(chaosKey{}) == nil && wrapped != nil {
+		return wrapped
+	}
+
+	return call
+}
+
+// requestHostPort returns the host and port a request is sent to
+func requestHostPort(req *http.Request) (string, int) {
+	host, portStr, err := net.SplitHostPort(req.URL.Host)
+	if err != nil {
+		host = req.URL.Hostname()
+		portStr = "80"
+		if req.URL.Scheme == "https" {
+			portStr = "443"
+		}
+	}
+	port, _ := strconv.Atoi(portStr)
+
+	return host, port
+}
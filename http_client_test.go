@@ -0,0 +1,94 @@
+package chaoskit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testNetworkInjector delays or drops every connection
+type testNetworkInjector struct {
+	testMetricsInjector
+	drop bool
+}
+
+func (i *testNetworkInjector) Name() string                                       { return "test-network" }
+func (i *testNetworkInjector) ShouldApplyNetworkChaos(host string, port int) bool { return true }
+func (i *testNetworkInjector) ShouldDropConnection(host string, port int) bool    { return i.drop }
+func (i *testNetworkInjector) GetNetworkLatency(host string, port int) (time.Duration, bool) {
+	return time.Millisecond, !i.drop
+}
+
+func TestWrapHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := WrapHTTPClient(context.Background(), server.Client())
+	get := func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+
+		return resp.Body.Close()
+	}
+	require.NoError(t, get(context.Background()), "no chaos outside of scenario runs")
+
+	run := func(injector Injector) (err, disabledErr error, timeline []InjectionEvent) {
+		executor := NewExecutor()
+		scenario := NewScenario("http").
+			WithTarget(&testTarget{}).
+			Inject("network", injector).
+			Step("get", func(ctx context.Context, target Target) error {
+				err = get(ctx)
+				disabledErr = get(WithChaosDisabled(ctx))
+				return nil
+			}).
+			Build()
+		require.NoError(t, executor.Run(context.Background(), scenario))
+
+		return err, disabledErr, executor.Reporter().Timeline()
+	}
+
+	err, disabledErr, timeline := run(&testNetworkInjector{})
+	assert.NoError(t, err)
+	assert.NoError(t, disabledErr)
+	require.Len(t, timeline, 1)
+	assert.Equal(t, InjectionTypeNetworkLatency, timeline[0].Type)
+
+	err, disabledErr, _ = run(&testNetworkInjector{drop: true})
+	var injected *ChaosError
+	require.ErrorAs(t, err, &injected)
+	assert.Equal(t, "test-network", injected.Injector)
+	assert.Equal(t, InjectionTypeNetworkDrop, injected.Kind)
+	assert.NoError(t, disabledErr)
+
+	// Without a request context, only a client wrapped in the step gets chaos
+	var plainErr, stepErr error
+	scenario := NewScenario("http-plain").
+		WithTarget(&testTarget{}).
+		Inject("network", &testNetworkInjector{drop: true}).
+		Step("get", func(ctx context.Context, target Target) error {
+			if resp, err := client.Get(server.URL); err == nil {
+				_ = resp.Body.Close()
+			} else {
+				plainErr = err
+			}
+			_, stepErr = WrapHTTPClient(ctx, server.Client()).Get(server.URL)
+			return nil
+		}).
+		Build()
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+	assert.NoError(t, plainErr)
+	assert.True(t, IsInjected(stepErr))
+
+	err, _, _ = run(&alwaysErrorInjector{})
+	assert.True(t, IsInjected(err))
+}
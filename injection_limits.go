@@ -0,0 +1,174 @@
+package chaoskit
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// InjectionLimit caps how often faults are injected, so a hot loop calling
+// MaybeDelay doesn't turn a 10% delay probability into a constant stall.
+// Faults above the limit are skipped as if the injector had decided against them.
+type InjectionLimit struct {
+	// PerSecond is the maximum number of injections per second of the run (0 = unlimited)
+	PerSecond int
+
+	// PerIteration is the maximum number of injections per iteration (0 = unlimited)
+	PerIteration int
+}
+
+// LimitInjector limits the faults injected by the named injector through the
+// context helpers (MaybeDelay, MaybeError, MaybePanic, MaybeCorrupt,
+// MaybeNetworkChaos). ShouldFailInjector and ChaosPointInjector limit
+// ShouldFail and configured chaos points.
+func (b *ScenarioBuilder) LimitInjector(name string, limit InjectionLimit) *ScenarioBuilder {
+	if b.scenario.injectorLimits == nil {
+		b.scenario.injectorLimits = make(map[string]InjectionLimit)
+	}
+	b.scenario.injectorLimits[name] = limit
+
+	return b
+}
+
+// Limit limits the faults injected at the point, whichever injector decides them
+func (c *ChaosPointConfig) Limit(limit InjectionLimit) *ChaosPointConfig {
+	c.limit = limit
+
+	return c
+}
+
+// injectionRatesKey is a private type for context key
+type injectionRatesKey struct{}
+
+// injectionRates counts injections per one-second window during a run
+type injectionRates struct {
+	mu      sync.Mutex
+	windows map[string]*rateWindow
+}
+
+// rateWindow is the injection count of the window starting at start
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+func newInjectionRates() *injectionRates {
+	return &injectionRates{windows: make(map[string]*rateWindow)}
+}
+
+// attachInjectionRates shares per-second injection counts between the iterations of a run
+func attachInjectionRates(ctx context.Context, rates *injectionRates) context.Context {
+	return context.WithValue(ctx, injectionRatesKey{}, rates)
+}
+
+// injectionRatesFromContext returns the run injection rates, or new ones
+func injectionRatesFromContext(ctx context.Context) *injectionRates {
+	if rates, ok := ctx.Value(injectionRatesKey{}).(*injectionRates); ok {
+		return rates
+	}
+
+	return newInjectionRates()
+}
+
+// injectionLimiter enforces the injection limits of a scenario within one iteration
+type injectionLimiter struct {
+	injectors map[string]InjectionLimit
+	points    map[string]*ChaosPointConfig
+	rates     *injectionRates
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// newInjectionLimiter returns the limiter of an iteration, or nil when the scenario has no limits
+func newInjectionLimiter(scenario *Scenario, rates *injectionRates) *injectionLimiter {
+	limited := len(scenario.injectorLimits) > 0
+	for _, point := range scenario.points {
+		limited = limited || point.limit != (InjectionLimit{})
+	}
+	if !limited {
+		return nil
+	}
+
+	return &injectionLimiter{
+		injectors: scenario.injectorLimits,
+		points:    scenario.points,
+		rates:     rates,
+		counts:    make(map[string]int),
+	}
+}
+
+// allow reports whether the injector may inject a fault now, at the chaos
+// point of ctx if any, and counts the injection when it may
+func (l *injectionLimiter) allow(ctx context.Context, injector string) bool {
+	if l == nil {
+		return true
+	}
+
+	type limitedKey struct {
+		key   string
+		limit InjectionLimit
+	}
+	var keys []limitedKey
+	if limit, ok := l.injectors[injector]; ok {
+		keys = append(keys, limitedKey{key: "injector:" + injector, limit: limit})
+	}
+	if point := ChaosPointFromContext(ctx); point != "" {
+		if config, ok := l.points[point]; ok && config.limit != (InjectionLimit{}) {
+			keys = append(keys, limitedKey{key: "point:" + point, limit: config.limit})
+		}
+	}
+	if len(keys) == 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rates.mu.Lock()
+	defer l.rates.mu.Unlock()
+
+	now := time.Now()
+	for _, k := range keys {
+		if k.limit.PerIteration > 0 && l.counts[k.key] >= k.limit.PerIteration {
+			GetLogger(ctx).Debug("injection skipped by iteration limit",
+				slog.String("injector", injector),
+				slog.String("limit", k.key),
+				slog.Int("per_iteration", k.limit.PerIteration))
+
+			return false
+		}
+		if k.limit.PerSecond > 0 {
+			window := l.rates.windows[k.key]
+			if window != nil && now.Sub(window.start) < time.Second && window.count >= k.limit.PerSecond {
+				GetLogger(ctx).Debug("injection skipped by rate limit",
+					slog.String("injector", injector),
+					slog.String("limit", k.key),
+					slog.Int("per_second", k.limit.PerSecond))
+
+				return false
+			}
+		}
+	}
+
+	for _, k := range keys {
+		l.counts[k.key]++
+		if k.limit.PerSecond > 0 {
+			window := l.rates.windows[k.key]
+			if window == nil || now.Sub(window.start) >= time.Second {
+				window = &rateWindow{start: now}
+				l.rates.windows[k.key] = window
+			}
+			window.count++
+		}
+	}
+
+	return true
+}
+
+// allowInjection applies the injection limits of the running scenario
+func allowInjection(ctx context.Context, injector string) bool {
+	chaos := GetChaos(ctx)
+
+	return chaos == nil || chaos.limiter.allow(ctx, injector)
+}
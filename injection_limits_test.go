@@ -0,0 +1,75 @@
+package chaoskit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// alwaysDelayInjector delays every MaybeDelay call
+type alwaysDelayInjector struct{ testMetricsInjector }
+
+func (i *alwaysDelayInjector) Name() string { return "always-delay" }
+func (i *alwaysDelayInjector) GetChaosDelay(ctx context.Context) (time.Duration, bool) {
+	return time.Microsecond, true
+}
+
+// countInjections counts timeline events per iteration and injector
+func countInjections(reporter *Reporter) map[int]map[string]int {
+	counts := make(map[int]map[string]int)
+	for _, event := range reporter.Timeline() {
+		if counts[event.Iteration] == nil {
+			counts[event.Iteration] = make(map[string]int)
+		}
+		counts[event.Iteration][event.Injector]++
+	}
+
+	return counts
+}
+
+func TestInjectionLimits(t *testing.T) {
+	executor := NewExecutor()
+	scenario := NewScenario("limits").
+		WithTarget(&testTarget{}).
+		Inject("delay", &alwaysDelayInjector{}).
+		LimitInjector("always-delay", InjectionLimit{PerIteration: 3}).
+		LimitInjector(ShouldFailInjector, InjectionLimit{PerSecond: 2}).
+		Step("step", func(ctx context.Context, target Target) error {
+			for i := 0; i < 10; i++ {
+				MaybeDelay(ctx)
+				ShouldFail(ctx, 1)
+			}
+			return nil
+		}).
+		Repeat(2).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	// The iteration limit resets every iteration, the rate limit spans them
+	assert.Equal(t, map[int]map[string]int{
+		1: {"always-delay": 3, ShouldFailInjector: 2},
+		2: {"always-delay": 3},
+	}, countInjections(executor.Reporter()))
+}
+
+func TestInjectionLimits_ChaosPoint(t *testing.T) {
+	executor := NewExecutor()
+	scenario := NewScenario("point-limits").
+		WithTarget(&testTarget{}).
+		Inject("delay", &alwaysDelayInjector{}).
+		WithChaosPoints(ChaosPoint("test.limits.loop").Limit(InjectionLimit{PerIteration: 1})).
+		Step("step", func(ctx context.Context, target Target) error {
+			for i := 0; i < 10; i++ {
+				MaybeDelayAt(ctx, "test.limits.loop")
+				MaybeDelay(ctx)
+			}
+			return nil
+		}).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	assert.Equal(t, map[int]map[string]int{1: {"always-delay": 11}}, countInjections(executor.Reporter()))
+}
@@ -0,0 +1,464 @@
+package injectors
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// FIS experiment statuses
+const (
+	FISStatusPending    = "pending"
+	FISStatusInitiating = "initiating"
+	FISStatusRunning    = "running"
+	FISStatusCompleted  = "completed"
+	FISStatusStopping   = "stopping"
+	FISStatusStopped    = "stopped"
+	FISStatusFailed     = "failed"
+	FISStatusCancelled  = "cancelled"
+)
+
+// DefaultFISTimeout bounds the wait for an experiment to start or stop
+const DefaultFISTimeout = 5 * time.Minute
+
+// fisPollInterval is how often the experiment state is polled while waiting
+const fisPollInterval = 2 * time.Second
+
+// ErrAWSCredentials is returned when no AWS credentials are configured
+var ErrAWSCredentials = errors.New("AWS credentials not set (AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY)")
+
+// AWSCredentials sign requests to AWS APIs
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// AWSCredentialsFromEnv reads AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN
+func AWSCredentialsFromEnv() (AWSCredentials, error) {
+	creds := AWSCredentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return AWSCredentials{}, ErrAWSCredentials
+	}
+
+	return creds, nil
+}
+
+// FISExperiment is an AWS Fault Injection Service experiment
+type FISExperiment struct {
+	ID         string             `json:"id"`
+	TemplateID string             `json:"experimentTemplateId"`
+	State      FISExperimentState `json:"state"`
+}
+
+// FISExperimentState is the state of an experiment
+type FISExperimentState struct {
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Active reports whether the experiment is starting or running
+func (e *FISExperiment) Active() bool {
+	return slices.Contains([]string{FISStatusPending, FISStatusInitiating, FISStatusRunning}, e.State.Status)
+}
+
+// Ended reports whether the experiment reached a final status
+func (e *FISExperiment) Ended() bool {
+	return slices.Contains([]string{FISStatusCompleted, FISStatusStopped, FISStatusFailed, FISStatusCancelled}, e.State.Status)
+}
+
+// FISClient calls the AWS Fault Injection Service API
+type FISClient struct {
+	endpoint   string
+	region     string
+	creds      AWSCredentials
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewFISClient creates a FIS client for region. An empty endpoint selects
+// https://fis.<region>.amazonaws.com; zero credentials are read from the
+// environment on every request (AWSCredentialsFromEnv).
+func NewFISClient(endpoint, region string, creds AWSCredentials) *FISClient {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://fis.%s.amazonaws.com", region)
+	}
+
+	return &FISClient{
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		region:     region,
+		creds:      creds,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		now:        time.Now,
+	}
+}
+
+// StartExperiment starts an experiment from a template
+func (c *FISClient) StartExperiment(ctx context.Context, templateID string) (*FISExperiment, error) {
+	token := make([]byte, 16)
+	_, _ = rand.Read(token)
+	body := map[string]any{
+		"experimentTemplateId": templateID,
+		"clientToken":          hex.EncodeToString(token),
+		"tags":                 map[string]string{"chaoskit": "true"},
+	}
+
+	return c.do(ctx, http.MethodPost, "/experiments", body)
+}
+
+// GetExperiment returns the current state of an experiment
+func (c *FISClient) GetExperiment(ctx context.Context, id string) (*FISExperiment, error) {
+	return c.do(ctx, http.MethodGet, "/experiments/"+url.PathEscape(id), nil)
+}
+
+// StopExperiment requests an experiment to stop
+func (c *FISClient) StopExperiment(ctx context.Context, id string) (*FISExperiment, error) {
+	return c.do(ctx, http.MethodDelete, "/experiments/"+url.PathEscape(id), nil)
+}
+
+// do sends a signed request and decodes the experiment of the response
+func (c *FISClient) do(ctx context.Context, method, path string, body any) (*FISExperiment, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	creds := c.creds
+	if creds.AccessKeyID == "" {
+		if creds, err = AWSCredentialsFromEnv(); err != nil {
+			return nil, err
+		}
+	}
+	signAWSRequest(req, payload, "fis", c.region, creds, c.now())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("FIS %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &apiErr)
+		if apiErr.Message == "" {
+			apiErr.Message = strings.TrimSpace(string(data))
+		}
+
+		return nil, fmt.Errorf("FIS %s %s: %s: %s", method, path, resp.Status, apiErr.Message)
+	}
+
+	var out struct {
+		Experiment FISExperiment `json:"experiment"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("FIS %s %s: decoding response: %w", method, path, err)
+	}
+
+	return &out.Experiment, nil
+}
+
+// signAWSRequest adds AWS Signature Version 4 headers to req
+func signAWSRequest(req *http.Request, payload []byte, service, region string, creds AWSCredentials, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	payloadHash := sha256.Sum256(payload)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	for _, part := range []string{region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery encodes query parameters sorted by key, as SigV4 expects
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		values := slices.Clone(query[key])
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, awsEscape(key)+"="+awsEscape(value))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// awsEscape percent-encodes everything but RFC 3986 unreserved characters
+func awsEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// AWSFISExperimentInjector runs an AWS FIS experiment template while injecting,
+// so infrastructure-level chaos is wrapped by the scenario validators and report
+type AWSFISExperimentInjector struct {
+	name         string
+	client       *FISClient
+	templateID   string
+	timeout      time.Duration
+	pollInterval time.Duration
+	experiment   *FISExperiment
+	mu           sync.Mutex
+	lifecycle    chaoskit.InjectorLifecycle
+}
+
+// AWSFISExperiment creates an injector starting an experiment from templateID
+// on Inject and stopping it on Stop, waiting up to timeout (DefaultFISTimeout
+// when zero) for the experiment to run or end
+func AWSFISExperiment(client *FISClient, templateID string, timeout time.Duration) *AWSFISExperimentInjector {
+	if timeout <= 0 {
+		timeout = DefaultFISTimeout
+	}
+
+	return &AWSFISExperimentInjector{
+		name:         "aws_fis_" + templateID,
+		client:       client,
+		templateID:   templateID,
+		timeout:      timeout,
+		pollInterval: fisPollInterval,
+	}
+}
+
+func (f *AWSFISExperimentInjector) Name() string {
+	return f.name
+}
+
+func (f *AWSFISExperimentInjector) Inject(ctx context.Context) (err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.lifecycle.Start(); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.lifecycle.Stop()
+		}
+	}()
+
+	experiment, err := f.client.StartExperiment(ctx, f.templateID)
+	if err != nil {
+		return fmt.Errorf("failed to start FIS experiment from template %s: %w", f.templateID, err)
+	}
+	f.experiment = experiment
+
+	experiment, err = f.wait(ctx, func(e *FISExperiment) bool { return e.State.Status == FISStatusRunning || e.Ended() })
+	if err == nil && experiment.State.Status != FISStatusRunning && experiment.State.Status != FISStatusCompleted {
+		err = fmt.Errorf("FIS experiment %s %s: %s", experiment.ID, experiment.State.Status, experiment.State.Reason)
+	}
+	if err != nil {
+		if f.experiment.Active() {
+			_, _ = f.client.StopExperiment(context.WithoutCancel(ctx), f.experiment.ID)
+		}
+
+		return err
+	}
+
+	chaoskit.GetLogger(ctx).Info("FIS experiment running",
+		slog.String("injector", f.name),
+		slog.String("template", f.templateID),
+		slog.String("experiment", experiment.ID))
+
+	return nil
+}
+
+func (f *AWSFISExperimentInjector) Stop(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.lifecycle.Stopped() || f.experiment == nil {
+		return nil
+	}
+
+	if f.experiment.Active() {
+		experiment, err := f.client.StopExperiment(ctx, f.experiment.ID)
+		if err != nil {
+			return fmt.Errorf("failed to stop FIS experiment %s: %w", f.experiment.ID, err)
+		}
+		f.experiment = experiment
+		if _, err := f.wait(ctx, (*FISExperiment).Ended); err != nil {
+			return err
+		}
+	}
+
+	f.lifecycle.Stop()
+	chaoskit.GetLogger(ctx).Info("FIS experiment stopped",
+		slog.String("injector", f.name),
+		slog.String("experiment", f.experiment.ID),
+		slog.String("status", f.experiment.State.Status))
+
+	return nil
+}
+
+// wait polls the experiment until done reports true or the timeout elapses
+func (f *AWSFISExperimentInjector) wait(ctx context.Context, done func(*FISExperiment) bool) (*FISExperiment, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+	for !done(f.experiment) {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for FIS experiment %s (%s): %w",
+				f.experiment.ID, f.experiment.State.Status, ctx.Err())
+		case <-ticker.C:
+		}
+
+		experiment, err := f.client.GetExperiment(ctx, f.experiment.ID)
+		if err != nil {
+			return nil, err
+		}
+		f.experiment = experiment
+	}
+
+	return f.experiment, nil
+}
+
+// State implements StatefulInjector
+func (f *AWSFISExperimentInjector) State() chaoskit.InjectorState {
+	return f.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: the experiment must not have failed
+// or been stopped outside the scenario
+func (f *AWSFISExperimentInjector) HealthCheck(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.lifecycle.State() != chaoskit.InjectorInjecting || f.experiment == nil {
+		return nil
+	}
+
+	experiment, err := f.client.GetExperiment(ctx, f.experiment.ID)
+	if err != nil {
+		return err
+	}
+	f.experiment = experiment
+	if experiment.Ended() && experiment.State.Status != FISStatusCompleted {
+		return fmt.Errorf("FIS experiment %s %s: %s", experiment.ID, experiment.State.Status, experiment.State.Reason)
+	}
+
+	return nil
+}
+
+// Type implements CategorizedInjector
+func (f *AWSFISExperimentInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeGlobal
+}
+
+// IsGlobal implements GlobalInjector
+func (f *AWSFISExperimentInjector) IsGlobal() bool {
+	return true
+}
+
+// GetMetrics implements MetricsProvider
+func (f *AWSFISExperimentInjector) GetMetrics() map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	metrics := map[string]interface{}{
+		"template": f.templateID,
+		"stopped":  f.lifecycle.Stopped(),
+	}
+	if f.experiment != nil {
+		metrics["experiment"] = f.experiment.ID
+		metrics["status"] = f.experiment.State.Status
+	}
+
+	return metrics
+}
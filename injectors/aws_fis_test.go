@@ -0,0 +1,159 @@
+package injectors
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestSignAWSRequest(t *testing.T) {
+	// Example request of the AWS Signature Version 4 documentation
+	req, _ := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	creds := AWSCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+
+	signAWSRequest(req, nil, "iam", "us-east-1", creds, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, " +
+		"SignedHeaders=content-type;host;x-amz-date, " +
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("unexpected Authorization header:\n got %s\nwant %s", got, want)
+	}
+}
+
+// fakeFIS is a FIS API serving one experiment that runs after a poll
+type fakeFIS struct {
+	mu      sync.Mutex
+	status  string
+	reason  string
+	stopped bool
+}
+
+func (f *fakeFIS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+		http.Error(w, `{"message":"missing signature"}`, http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/experiments":
+		var body struct {
+			TemplateID string `json:"experimentTemplateId"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.TemplateID != "EXT123" {
+			http.Error(w, `{"message":"template not found"}`, http.StatusNotFound)
+			return
+		}
+		f.status = FISStatusInitiating
+	case r.Method == http.MethodGet && r.URL.Path == "/experiments/EXP1":
+		if f.status == FISStatusInitiating {
+			f.status = FISStatusRunning
+		}
+		if f.status == FISStatusStopping {
+			f.status = FISStatusStopped
+		}
+	case r.Method == http.MethodDelete && r.URL.Path == "/experiments/EXP1":
+		f.stopped = true
+		f.status = FISStatusStopping
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"experiment": FISExperiment{
+			ID:         "EXP1",
+			TemplateID: "EXT123",
+			State:      FISExperimentState{Status: f.status, Reason: f.reason},
+		},
+	})
+}
+
+func (f *fakeFIS) set(status, reason string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.status = status
+	f.reason = reason
+}
+
+func TestAWSFISExperiment(t *testing.T) {
+	fake := &fakeFIS{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewFISClient(server.URL, "eu-west-1", AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	injector := AWSFISExperiment(client, "EXT123", time.Second)
+	injector.pollInterval = time.Millisecond
+	ctx := context.Background()
+
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject failed: %v", err)
+	}
+	if metrics := injector.GetMetrics(); metrics["status"] != FISStatusRunning || metrics["experiment"] != "EXP1" {
+		t.Errorf("expected running experiment EXP1, got %v", metrics)
+	}
+	if err := injector.HealthCheck(ctx); err != nil {
+		t.Errorf("expected healthy experiment, got %v", err)
+	}
+
+	if err := injector.Stop(ctx); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	if !fake.stopped {
+		t.Error("expected the experiment to be stopped")
+	}
+	if injector.State() != chaoskit.InjectorStopped {
+		t.Errorf("expected stopped injector, got %v", injector.State())
+	}
+}
+
+func TestAWSFISExperiment_HealthCheck(t *testing.T) {
+	fake := &fakeFIS{}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := NewFISClient(server.URL, "eu-west-1", AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	injector := AWSFISExperiment(client, "EXT123", time.Second)
+	injector.pollInterval = time.Millisecond
+	ctx := context.Background()
+
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject failed: %v", err)
+	}
+	defer func() { _ = injector.Stop(ctx) }()
+
+	fake.set(FISStatusFailed, "stop condition triggered")
+	err := injector.HealthCheck(ctx)
+	if err == nil || !strings.Contains(err.Error(), "stop condition triggered") {
+		t.Errorf("expected unhealthy experiment, got %v", err)
+	}
+}
+
+func TestAWSFISExperiment_StartError(t *testing.T) {
+	server := httptest.NewServer(&fakeFIS{})
+	defer server.Close()
+
+	client := NewFISClient(server.URL, "eu-west-1", AWSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret"})
+	injector := AWSFISExperiment(client, "missing", time.Second)
+
+	err := injector.Inject(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "template not found") {
+		t.Fatalf("expected start error, got %v", err)
+	}
+	if injector.State() != chaoskit.InjectorStopped {
+		t.Errorf("expected stopped injector after a failed start, got %v", injector.State())
+	}
+}
@@ -2,6 +2,7 @@ package injectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/rom8726/chaoskit"
@@ -49,3 +50,20 @@ func (c *CompositeInjector) Stop(ctx context.Context) error {
 
 	return nil
 }
+
+// HealthCheck implements HealthChecker: it fails when any of the combined
+// injectors that can check their health is unhealthy
+func (c *CompositeInjector) HealthCheck(ctx context.Context) error {
+	var errs []error
+	for _, inj := range c.injectors {
+		checker, ok := inj.(chaoskit.HealthChecker)
+		if !ok {
+			continue
+		}
+		if err := checker.HealthCheck(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", inj.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -12,13 +12,16 @@ import (
 	"github.com/rom8726/chaoskit"
 )
 
+// defaultCancellationDelay is how long after its creation a context is cancelled by default
+const defaultCancellationDelay = 10 * time.Millisecond
+
 // ContextCancellationInjector injects context cancellation with a given probability
 // It creates child contexts with cancel functions and randomly cancels them
 type ContextCancellationInjector struct {
 	name          string
 	probability   float64
 	mu            sync.Mutex
-	stopped       bool
+	lifecycle     chaoskit.InjectorLifecycle
 	cancelCount   int64                                  // Use atomic operations for concurrent access
 	cancellations map[context.Context]context.CancelFunc // track active cancellations
 	rng           *rand.Rand                             // Deterministic random generator from context
@@ -50,8 +53,8 @@ func (c *ContextCancellationInjector) Inject(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := c.lifecycle.Start(); err != nil {
+		return err
 	}
 
 	// Store deterministic random generator from context
@@ -68,7 +71,7 @@ func (c *ContextCancellationInjector) Stop(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if !c.stopped {
+	if c.lifecycle.Stop() {
 		// Cancel all tracked contexts
 		for ctx, cancel := range c.cancellations {
 			if ctx.Err() == nil {
@@ -77,7 +80,6 @@ func (c *ContextCancellationInjector) Stop(ctx context.Context) error {
 		}
 		c.cancellations = make(map[context.Context]context.CancelFunc)
 
-		c.stopped = true
 		chaoskit.GetLogger(ctx).Info("context cancellation injector stopped",
 			slog.String("injector", c.name),
 			slog.Int64("total_cancellations", atomic.LoadInt64(&c.cancelCount)))
@@ -86,12 +88,26 @@ func (c *ContextCancellationInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (c *ContextCancellationInjector) State() chaoskit.InjectorState {
+	return c.lifecycle.State()
+}
+
 // GetChaosContext creates a child context with cancellation support
 // Returns the child context and a cancel function
 // If probability triggers, the context will be cancelled
 func (c *ContextCancellationInjector) GetChaosContext(parent context.Context) (context.Context, context.CancelFunc) {
+	return c.GetChaosContextWithOptions(parent, chaoskit.CancelOptions{Probability: -1})
+}
+
+// GetChaosContextWithOptions is GetChaosContext honouring the options of a
+// chaoskit.MaybeCancelContext call (implements ChaosCancellationOptionsProvider)
+func (c *ContextCancellationInjector) GetChaosContextWithOptions(
+	parent context.Context,
+	opts chaoskit.CancelOptions,
+) (context.Context, context.CancelFunc) {
 	c.mu.Lock()
-	stopped := c.stopped
+	stopped := c.lifecycle.Stopped()
 	probability := c.probability
 	rng := c.rng
 	c.mu.Unlock()
@@ -104,43 +120,82 @@ func (c *ContextCancellationInjector) GetChaosContext(parent context.Context) (c
 	if rng == nil {
 		rng = rand.New(rand.NewSource(rand.Int63()))
 	}
+	if opts.Probability >= 0 {
+		probability = opts.Probability
+	}
+	after := opts.After
+	if after <= 0 {
+		// Cancel after a small delay to allow context to be used
+		after = defaultCancellationDelay
+	}
+
+	// Check probability for cancellation
+	triggered := rng.Float64() < probability
 
 	// Create child context with cancel
-	childCtx, cancel := context.WithCancel(parent)
+	var childCtx context.Context
+	var cancel context.CancelFunc
+	if triggered && opts.Deadline {
+		childCtx, cancel = context.WithTimeout(parent, after)
+	} else {
+		childCtx, cancel = context.WithCancel(parent)
+	}
 
 	// Track this cancellation
 	c.mu.Lock()
 	c.cancellations[childCtx] = cancel
 	c.mu.Unlock()
 
-	// Check probability for immediate cancellation
-	if rng.Float64() < probability {
+	untrack := func() {
+		c.mu.Lock()
+		delete(c.cancellations, childCtx)
+		c.mu.Unlock()
+	}
+
+	if triggered {
 		atomic.AddInt64(&c.cancelCount, 1)
+		attributes := map[string]any{"probability": probability, "after": after.String()}
+		if opts.Site != "" {
+			attributes["site"] = opts.Site
+		}
+		if opts.Deadline {
+			attributes["deadline"] = true
+		}
+		chaoskit.RecordInjection(parent, chaoskit.InjectionEvent{
+			Injector:   c.name,
+			Type:       chaoskit.InjectionTypeCancellation,
+			Attributes: attributes,
+		})
 
-		// Cancel immediately
-		go func() {
-			// Cancel after a small delay to allow context to be used
-			time.Sleep(10 * time.Millisecond)
-			cancel()
-
-			chaoskit.GetLogger(parent).Debug("context cancellation triggered",
-				slog.String("injector", c.name),
-				slog.Float64("probability", probability))
-
-			// Remove from tracking after cancellation
-			c.mu.Lock()
-			delete(c.cancellations, childCtx)
-			c.mu.Unlock()
-		}()
+		chaoskit.GetLogger(parent).Debug("context cancellation scheduled",
+			slog.String("injector", c.name),
+			slog.String("site", opts.Site),
+			slog.Duration("after", after),
+			slog.Bool("deadline", opts.Deadline),
+			slog.Float64("probability", probability))
+
+		// Deadlines expire by themselves, other contexts are cancelled after the delay
+		if !opts.Deadline {
+			timer := time.AfterFunc(after, func() {
+				cancel()
+
+				// Remove from tracking after cancellation
+				untrack()
+			})
+
+			return childCtx, func() {
+				timer.Stop()
+				cancel()
+				untrack()
+			}
+		}
 	}
 
 	return childCtx, func() {
 		cancel()
 
 		// Remove from tracking
-		c.mu.Lock()
-		delete(c.cancellations, childCtx)
-		c.mu.Unlock()
+		untrack()
 	}
 }
 
@@ -171,6 +226,14 @@ func (c *ContextCancellationInjector) GetMetrics() map[string]interface{} {
 		"probability":          c.probability,
 		"total_cancellations":  atomic.LoadInt64(&c.cancelCount),
 		"active_cancellations": len(c.cancellations),
-		"stopped":              c.stopped,
+		"stopped":              c.lifecycle.Stopped(),
 	}
 }
+
+// ScaleIntensity implements IntensityScaler
+func (c *ContextCancellationInjector) ScaleIntensity(factor float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probability = scaleProbability(c.probability, factor)
+}
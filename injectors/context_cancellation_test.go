@@ -2,8 +2,12 @@ package injectors
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/rom8726/chaoskit"
 )
 
 func TestContextCancellation_ProbabilityClamp(t *testing.T) {
@@ -74,3 +78,46 @@ func TestContextCancellation_StopCancelsActivesAndMetrics(t *testing.T) {
 
 	cancel() // no-op if already cancelled
 }
+
+func TestContextCancellation_CallSiteOptions(t *testing.T) {
+	inj := NewContextCancellationInjector(0.0)
+
+	var deadlineErr, defaultErr, cancelErr error
+	timeline := runInjectorScenario(t, "cancel-options", inj, func(ctx context.Context) {
+		deadlineCtx, cancel := chaoskit.MaybeCancelContext(ctx,
+			chaoskit.CancelProbability(1), chaoskit.CancelAfter(time.Millisecond), chaoskit.CancelAsDeadline())
+		defer cancel()
+		cancelCtx, cancelNamed := chaoskit.MaybeCancelContext(ctx,
+			chaoskit.CancelProbability(1), chaoskit.CancelSite("checkout.charge"))
+		defer cancelNamed()
+		defaultCtx, cancelDefault := chaoskit.MaybeCancelContext(ctx)
+		defer cancelDefault()
+
+		<-deadlineCtx.Done()
+		<-cancelCtx.Done()
+		deadlineErr, cancelErr, defaultErr = deadlineCtx.Err(), cancelCtx.Err(), defaultCtx.Err()
+	})
+
+	if !errors.Is(deadlineErr, context.DeadlineExceeded) {
+		t.Fatalf("deadline context error = %v, want %v", deadlineErr, context.DeadlineExceeded)
+	}
+	if !errors.Is(cancelErr, context.Canceled) {
+		t.Fatalf("cancelled context error = %v, want %v", cancelErr, context.Canceled)
+	}
+	if defaultErr != nil {
+		t.Fatalf("context without override was cancelled: %v", defaultErr)
+	}
+
+	if len(timeline) != 2 {
+		t.Fatalf("got %d injection events, want 2", len(timeline))
+	}
+	if site, _ := timeline[0].Attributes["site"].(string); !strings.HasPrefix(site, "context_cancellation_test.go:") {
+		t.Fatalf("site = %q, want the caller file:line", site)
+	}
+	if timeline[0].Attributes["deadline"] != true {
+		t.Fatalf("deadline attribute missing: %v", timeline[0].Attributes)
+	}
+	if site := timeline[1].Attributes["site"]; site != "checkout.charge" {
+		t.Fatalf("site = %v, want checkout.charge", site)
+	}
+}
@@ -0,0 +1,163 @@
+package injectors
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// ContextValueInjector corrupts context values passed through
+// chaoskit.MaybeCorruptContextValue with a given probability, replacing them
+// with zero values or, in garbage mode, with random values of the same type.
+type ContextValueInjector struct {
+	name        string
+	probability float64
+	keys        []any
+	garbage     bool
+	mu          sync.Mutex
+	lifecycle   chaoskit.InjectorLifecycle
+	corrupted   int64
+	rng         *rand.Rand // Deterministic random generator from context
+}
+
+// ContextValueZero creates an injector replacing the values of keys with zero
+// values. Without keys, every key passed to MaybeCorruptContextValue is eligible.
+func ContextValueZero(probability float64, keys ...any) *ContextValueInjector {
+	return &ContextValueInjector{
+		name:        fmt.Sprintf("context_value_zero_%.2f", probability),
+		probability: probability,
+		keys:        keys,
+	}
+}
+
+// ContextValueGarbage creates an injector replacing the values of keys with
+// random values of the same type (strings, numbers and booleans; other types
+// get zero values). Without keys, every key passed to MaybeCorruptContextValue is eligible.
+func ContextValueGarbage(probability float64, keys ...any) *ContextValueInjector {
+	return &ContextValueInjector{
+		name:        fmt.Sprintf("context_value_garbage_%.2f", probability),
+		probability: probability,
+		keys:        keys,
+		garbage:     true,
+	}
+}
+
+func (c *ContextValueInjector) Name() string {
+	return c.name
+}
+
+func (c *ContextValueInjector) Inject(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.lifecycle.Start(); err != nil {
+		return err
+	}
+
+	// Store deterministic random generator from context
+	c.rng = chaoskit.GetRand(ctx)
+
+	return nil
+}
+
+func (c *ContextValueInjector) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lifecycle.Stop()
+
+	return nil
+}
+
+// State implements StatefulInjector
+func (c *ContextValueInjector) State() chaoskit.InjectorState {
+	return c.lifecycle.State()
+}
+
+// ShouldCorruptContextValue returns true if the value of key should be corrupted based on probability
+func (c *ContextValueInjector) ShouldCorruptContextValue(key any) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lifecycle.Stopped() {
+		return false
+	}
+	if len(c.keys) > 0 && !slices.Contains(c.keys, key) {
+		return false
+	}
+
+	if c.random().Float64() < c.probability {
+		c.corrupted++
+
+		return true
+	}
+
+	return false
+}
+
+// CorruptContextValue returns the replacement of value
+func (c *ContextValueInjector) CorruptContextValue(key, value any) any {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v := reflect.ValueOf(value)
+	if !c.garbage {
+		return reflect.Zero(v.Type()).Interface()
+	}
+
+	rng := c.random()
+	garbage := reflect.New(v.Type()).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		garbage.SetString(fmt.Sprintf("chaos-%016x", rng.Uint64()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		garbage.SetInt(rng.Int63())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		garbage.SetUint(rng.Uint64())
+	case reflect.Float32, reflect.Float64:
+		garbage.SetFloat(rng.NormFloat64() * 1e6)
+	case reflect.Bool:
+		garbage.SetBool(!v.Bool())
+	}
+
+	return garbage.Interface()
+}
+
+// random returns the stored generator, or a new one before Inject (caller must hold c.mu)
+func (c *ContextValueInjector) random() *rand.Rand {
+	if c.rng == nil {
+		c.rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	return c.rng
+}
+
+// Type implements CategorizedInjector
+func (c *ContextValueInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeContext // Works via MaybeCorruptContextValue() in user code
+}
+
+// GetMetrics implements MetricsProvider
+func (c *ContextValueInjector) GetMetrics() map[string]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return map[string]interface{}{
+		"probability":      c.probability,
+		"corrupted_values": c.corrupted,
+		"stopped":          c.lifecycle.Stopped(),
+	}
+}
+
+// ScaleIntensity implements IntensityScaler
+func (c *ContextValueInjector) ScaleIntensity(factor float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probability = scaleProbability(c.probability, factor)
+}
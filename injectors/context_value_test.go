@@ -0,0 +1,55 @@
+package injectors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rom8726/chaoskit"
+)
+
+type tenantKey struct{}
+type traceKey struct{}
+
+func TestContextValueInjector(t *testing.T) {
+	tests := []struct {
+		name     string
+		injector *ContextValueInjector
+		check    func(tenant, trace any) bool
+	}{
+		{
+			name:     "zero",
+			injector: ContextValueZero(1.0, tenantKey{}),
+			check:    func(tenant, trace any) bool { return tenant == "" && trace == "trace-1" },
+		},
+		{
+			name:     "garbage",
+			injector: ContextValueGarbage(1.0),
+			check: func(tenant, trace any) bool {
+				return strings.HasPrefix(tenant.(string), "chaos-") && strings.HasPrefix(trace.(string), "chaos-")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tenant, trace any
+			runInjectorScenario(t, "context-values", tt.injector, func(ctx context.Context) {
+				ctx = context.WithValue(ctx, tenantKey{}, "tenant-1")
+				ctx = context.WithValue(ctx, traceKey{}, "trace-1")
+				ctx = chaoskit.MaybeCorruptContextValue(ctx, tenantKey{})
+				ctx = chaoskit.MaybeCorruptContextValue(ctx, traceKey{})
+				tenant, trace = ctx.Value(tenantKey{}), ctx.Value(traceKey{})
+			})
+
+			if !tt.check(tenant, trace) {
+				t.Fatalf("unexpected values: tenant = %q, trace = %q", tenant, trace)
+			}
+		})
+	}
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "tenant-1")
+	if got := chaoskit.MaybeCorruptContextValue(ctx, tenantKey{}).Value(tenantKey{}); got != "tenant-1" {
+		t.Fatalf("MaybeCorruptContextValue() outside of a run = %q, want tenant-1", got)
+	}
+}
@@ -0,0 +1,118 @@
+package injectors
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// CorruptionInjector corrupts values passed through chaoskit.MaybeCorrupt
+// with a given probability. The corruption itself is defined at the call site.
+type CorruptionInjector struct {
+	name        string
+	probability float64
+	mu          sync.Mutex
+	lifecycle   chaoskit.InjectorLifecycle
+	corrupted   int64
+	rng         *rand.Rand // Deterministic random generator from context
+}
+
+// CorruptionProbability creates a new value corruption injector
+func CorruptionProbability(probability float64) *CorruptionInjector {
+	return &CorruptionInjector{
+		name:        fmt.Sprintf("corruption_injector_%.2f", probability),
+		probability: probability,
+	}
+}
+
+func (c *CorruptionInjector) Name() string {
+	return c.name
+}
+
+func (c *CorruptionInjector) Inject(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.lifecycle.Start(); err != nil {
+		return err
+	}
+
+	// Store deterministic random generator from context
+	c.rng = chaoskit.GetRand(ctx)
+
+	return nil
+}
+
+func (c *CorruptionInjector) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lifecycle.Stop()
+
+	return nil
+}
+
+// State implements StatefulInjector
+func (c *CorruptionInjector) State() chaoskit.InjectorState {
+	return c.lifecycle.State()
+}
+
+// ShouldCorrupt returns true if a value should be corrupted based on probability
+func (c *CorruptionInjector) ShouldCorrupt() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lifecycle.Stopped() {
+		return false
+	}
+
+	// Use stored generator (should be set during Inject)
+	rng := c.rng
+	if rng == nil {
+		rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	if rng.Float64() < c.probability {
+		c.corrupted++
+
+		return true
+	}
+
+	return false
+}
+
+// GetCorruptionProbability returns the configured corruption probability
+func (c *CorruptionInjector) GetCorruptionProbability() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.probability
+}
+
+// Type implements CategorizedInjector
+func (c *CorruptionInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeContext // Works via MaybeCorrupt() in user code
+}
+
+// GetMetrics implements MetricsProvider
+func (c *CorruptionInjector) GetMetrics() map[string]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return map[string]interface{}{
+		"probability":      c.probability,
+		"corrupted_values": c.corrupted,
+		"stopped":          c.lifecycle.Stopped(),
+	}
+}
+
+// ScaleIntensity implements IntensityScaler
+func (c *CorruptionInjector) ScaleIntensity(factor float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probability = scaleProbability(c.probability, factor)
+}
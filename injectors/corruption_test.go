@@ -0,0 +1,30 @@
+package injectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestCorruptionInjector_MaybeCorrupt(t *testing.T) {
+	c := CorruptionProbability(1.0)
+
+	var got int
+	runInjectorScenario(t, "corruption", c, func(ctx context.Context) {
+		got = chaoskit.MaybeCorrupt(ctx, 100, func(v int) int { return -v })
+	})
+
+	if got != -100 {
+		t.Fatalf("MaybeCorrupt() = %d, want -100", got)
+	}
+	if n := c.GetMetrics()["corrupted_values"].(int64); n != 1 {
+		t.Fatalf("corrupted_values = %d, want 1", n)
+	}
+	if c.ShouldCorrupt() {
+		t.Fatalf("should not corrupt when stopped")
+	}
+	if got := chaoskit.MaybeCorrupt(context.Background(), 100, func(v int) int { return -v }); got != 100 {
+		t.Fatalf("MaybeCorrupt() outside of a run = %d, want 100", got)
+	}
+}
@@ -11,12 +11,12 @@ import (
 
 // CPUStressInjector creates CPU load
 type CPUStressInjector struct {
-	name    string
-	workers int
-	mu      sync.Mutex
-	stopCh  chan struct{}
-	stopped bool
-	wg      sync.WaitGroup
+	name      string
+	workers   int
+	mu        sync.Mutex
+	stopCh    chan struct{}
+	lifecycle chaoskit.InjectorLifecycle
+	wg        sync.WaitGroup
 }
 
 // CPUStress creates a CPU stress injector
@@ -24,7 +24,6 @@ func CPUStress(workers int) *CPUStressInjector {
 	return &CPUStressInjector{
 		name:    fmt.Sprintf("cpu_stress_%d", workers),
 		workers: workers,
-		stopCh:  make(chan struct{}),
 	}
 }
 
@@ -36,16 +35,18 @@ func (c *CPUStressInjector) Inject(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := c.lifecycle.Start(); err != nil {
+		return err
 	}
 
 	// Start CPU stress workers
+	stopCh := make(chan struct{})
+	c.stopCh = stopCh
 	for i := 0; i < c.workers; i++ {
 		c.wg.Add(1)
 		go func(id int) {
 			defer c.wg.Done()
-			c.stressWorker(id)
+			c.stressWorker(id, stopCh)
 		}(i)
 	}
 
@@ -56,10 +57,10 @@ func (c *CPUStressInjector) Inject(ctx context.Context) error {
 	return nil
 }
 
-func (c *CPUStressInjector) stressWorker(id int) {
+func (c *CPUStressInjector) stressWorker(id int, stopCh <-chan struct{}) {
 	for {
 		select {
-		case <-c.stopCh:
+		case <-stopCh:
 			return
 		default:
 			// Busy loop to create CPU load
@@ -72,15 +73,19 @@ func (c *CPUStressInjector) Stop(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if !c.stopped {
+	if c.lifecycle.Stop() {
 		close(c.stopCh)
-		c.stopped = true
 		c.wg.Wait()
 	}
 
 	return nil
 }
 
+// State implements StatefulInjector
+func (c *CPUStressInjector) State() chaoskit.InjectorState {
+	return c.lifecycle.State()
+}
+
 // Type implements CategorizedInjector
 func (c *CPUStressInjector) Type() chaoskit.InjectorType {
 	return chaoskit.InjectorTypeGlobal
@@ -98,6 +103,6 @@ func (c *CPUStressInjector) GetMetrics() map[string]interface{} {
 
 	return map[string]interface{}{
 		"workers": c.workers,
-		"stopped": c.stopped,
+		"stopped": c.lifecycle.Stopped(),
 	}
 }
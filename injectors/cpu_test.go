@@ -2,8 +2,11 @@ package injectors
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/rom8726/chaoskit"
 )
 
 func TestCPUStress_StartStopAndMetrics(t *testing.T) {
@@ -31,3 +34,26 @@ func TestCPUStress_StartStopAndMetrics(t *testing.T) {
 		t.Fatalf("expected stopped=true in metrics")
 	}
 }
+
+func TestCPUStress_Restart(t *testing.T) {
+	cpu := CPUStress(1)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := cpu.Inject(ctx); err != nil {
+			t.Fatalf("inject %d: %v", i, err)
+		}
+		if err := cpu.Inject(ctx); !errors.Is(err, chaoskit.ErrInjectorActive) {
+			t.Fatalf("expected ErrInjectorActive, got %v", err)
+		}
+		if cpu.State() != chaoskit.InjectorInjecting {
+			t.Fatalf("expected injecting, got %s", cpu.State())
+		}
+		if err := cpu.Stop(ctx); err != nil {
+			t.Fatalf("stop %d: %v", i, err)
+		}
+		if cpu.State() != chaoskit.InjectorStopped {
+			t.Fatalf("expected stopped, got %s", cpu.State())
+		}
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rom8726/chaoskit"
@@ -21,13 +23,15 @@ const (
 	IntervalMode
 )
 
-// DelayInjector introduces random delays during execution
+// DelayInjector introduces random delays during execution.
+// The state read by GetChaosDelay is atomic, so MaybeDelay in tight loops
+// takes no injector lock and allocates nothing.
 type DelayInjector struct {
 	name        string
 	minDelay    time.Duration
 	maxDelay    time.Duration
 	interval    time.Duration
-	probability float64 // for ProbabilityMode
+	probability atomic.Uint64 // float64 bits, for ProbabilityMode
 	mode        DelayMode
 
 	// For IntervalMode synchronization
@@ -35,24 +39,34 @@ type DelayInjector struct {
 	initOnce    sync.Once     // ensures delayCond is initialized only once
 	activeDelay time.Duration // current delay to apply (protected by delayMu)
 	delayMu     sync.Mutex    // mutex for delay state
-	mu          sync.Mutex
+	mu          sync.Mutex    // serializes Inject and Stop
 	stopCh      chan struct{}
-	stopped     bool
-	delayCount  int64
-	rng         *rand.Rand // Deterministic random generator from context
+	lifecycle   chaoskit.InjectorLifecycle
+	delayCount  atomic.Int64
+	rng         atomic.Pointer[rand.Rand] // deterministic generator from context once injected
+}
+
+// newDelayInjector creates a delay injector with a pre-seeded generator,
+// used until Inject stores the run's deterministic one
+func newDelayInjector(name string, min, max time.Duration, mode DelayMode) *DelayInjector {
+	d := &DelayInjector{
+		name:     name,
+		minDelay: min,
+		maxDelay: max,
+		mode:     mode,
+	}
+	d.rng.Store(chaoskit.NewRand(rand.Int63()))
+
+	return d
 }
 
 // RandomDelay creates a delay injector with probability-based delays (default mode)
 // Each call to MaybeDelay() has a chance to apply a delay based on probability
 func RandomDelay(min, max time.Duration) *DelayInjector {
-	return &DelayInjector{
-		name:        fmt.Sprintf("delay_injector_prob_%v_%v", min, max),
-		minDelay:    min,
-		maxDelay:    max,
-		probability: 1.0, // 100% chance by default
-		mode:        ProbabilityMode,
-		stopCh:      make(chan struct{}),
-	}
+	d := newDelayInjector(fmt.Sprintf("delay_injector_prob_%v_%v", min, max), min, max, ProbabilityMode)
+	d.setProbability(1.0) // 100% chance by default
+
+	return d
 }
 
 // RandomDelayWithProbability creates a delay injector with probability-based delays
@@ -65,27 +79,17 @@ func RandomDelayWithProbability(min, max time.Duration, probability float64) *De
 		probability = 1
 	}
 
-	return &DelayInjector{
-		name:        fmt.Sprintf("delay_injector_prob_%v_%v_%.2f", min, max, probability),
-		minDelay:    min,
-		maxDelay:    max,
-		probability: probability,
-		mode:        ProbabilityMode,
-		stopCh:      make(chan struct{}),
-	}
+	d := newDelayInjector(fmt.Sprintf("delay_injector_prob_%v_%v_%.2f", min, max, probability), min, max, ProbabilityMode)
+	d.setProbability(probability)
+
+	return d
 }
 
 // RandomDelayWithInterval creates a delay injector with interval-based delays
 // The background goroutine periodically injects delays that block MaybeDelay() calls
 func RandomDelayWithInterval(min, max, interval time.Duration) *DelayInjector {
-	di := &DelayInjector{
-		name:     fmt.Sprintf("delay_injector_interval_%v_%v_%v", min, max, interval),
-		minDelay: min,
-		maxDelay: max,
-		interval: interval,
-		mode:     IntervalMode,
-		stopCh:   make(chan struct{}),
-	}
+	di := newDelayInjector(fmt.Sprintf("delay_injector_interval_%v_%v_%v", min, max, interval), min, max, IntervalMode)
+	di.interval = interval
 	// Initialize delayCond in constructor - this is safe and simple
 	di.delayCond = sync.NewCond(&di.delayMu)
 
@@ -100,12 +104,15 @@ func (d *DelayInjector) Inject(ctx context.Context) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	if d.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := d.lifecycle.Start(); err != nil {
+		return err
 	}
 
-	// Store deterministic random generator from context
-	d.rng = chaoskit.GetRand(ctx)
+	// Use the deterministic generator of the run; without one, keep the
+	// pre-seeded generator, as GetRand's fallback is not safe for concurrent use
+	if chaoskit.HasRand(ctx) {
+		d.rng.Store(chaoskit.GetRand(ctx))
+	}
 
 	if d.mode == IntervalMode {
 		// Ensure delayCond is initialized using sync.Once - thread-safe
@@ -120,26 +127,27 @@ func (d *DelayInjector) Inject(ctx context.Context) error {
 			slog.Duration("max_delay", d.maxDelay),
 			slog.Duration("interval", d.interval))
 		// Start a background goroutine that periodically injects delays
-		go d.delayLoop(ctx)
+		d.stopCh = make(chan struct{})
+		go d.delayLoop(ctx, d.stopCh)
 	} else {
 		chaoskit.GetLogger(ctx).Info("delay injector started",
 			slog.String("injector", d.name),
 			slog.String("mode", "probability"),
 			slog.Duration("min_delay", d.minDelay),
 			slog.Duration("max_delay", d.maxDelay),
-			slog.Float64("probability", d.probability))
+			slog.Float64("probability", d.loadProbability()))
 	}
 
 	return nil
 }
 
-func (d *DelayInjector) delayLoop(ctx context.Context) {
+func (d *DelayInjector) delayLoop(ctx context.Context, stopCh <-chan struct{}) {
 	ticker := time.NewTicker(d.interval)
 	defer ticker.Stop()
 
 	for {
 		select {
-		case <-d.stopCh:
+		case <-stopCh:
 			return
 		case <-ctx.Done():
 			return
@@ -175,10 +183,7 @@ func (d *DelayInjector) delayLoop(ctx context.Context) {
 }
 
 func (d *DelayInjector) calculateDelay() time.Duration {
-	d.mu.Lock()
-	defer d.mu.Unlock()
-
-	if d.stopped {
+	if d.lifecycle.Stopped() {
 		return 0
 	}
 
@@ -187,55 +192,64 @@ func (d *DelayInjector) calculateDelay() time.Duration {
 	}
 
 	delta := d.maxDelay - d.minDelay
-	rng := d.rng
-	if rng == nil {
-		rng = rand.New(rand.NewSource(rand.Int63()))
-	}
-	delay := d.minDelay + time.Duration(rng.Int63n(int64(delta)))
 
-	return delay
+	return d.minDelay + time.Duration(d.rng.Load().Int63n(int64(delta)))
+}
+
+func (d *DelayInjector) loadProbability() float64 {
+	return math.Float64frombits(d.probability.Load())
+}
+
+func (d *DelayInjector) setProbability(p float64) {
+	d.probability.Store(math.Float64bits(p))
 }
 
 func (d *DelayInjector) Stop(ctx context.Context) error {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
-	if !d.stopped {
-		close(d.stopCh)
-		d.stopped = true
+	if d.lifecycle.Stop() {
+		if d.stopCh != nil {
+			close(d.stopCh)
+			d.stopCh = nil
+		}
 		chaoskit.GetLogger(ctx).Info("delay injector stopped",
 			slog.String("injector", d.name),
-			slog.Int64("total_delays", d.delayCount))
+			slog.Int64("total_delays", d.delayCount.Load()))
 	}
 
 	return nil
 }
 
+// State implements StatefulInjector
+func (d *DelayInjector) State() chaoskit.InjectorState {
+	return d.lifecycle.State()
+}
+
 // GetDelayCount returns the number of delays injected
 func (d *DelayInjector) GetDelayCount() int64 {
-	d.mu.Lock()
-	defer d.mu.Unlock()
-
-	return d.delayCount
+	return d.delayCount.Load()
 }
 
 // BeforeStep injects a delay before step execution
 func (d *DelayInjector) BeforeStep(ctx context.Context) error {
-	if d.stopped {
+	if d.lifecycle.Stopped() {
 		return nil
 	}
 
 	delay := d.calculateDelay()
 	if delay > 0 {
-		d.mu.Lock()
-		d.delayCount++
-		count := d.delayCount
-		d.mu.Unlock()
+		count := d.delayCount.Add(1)
 
 		chaoskit.GetLogger(ctx).Debug("injecting delay before step",
 			slog.String("injector", d.name),
 			slog.Int64("step_count", count),
 			slog.Duration("delay", delay))
+		chaoskit.RecordInjection(ctx, chaoskit.InjectionEvent{
+			Injector: d.name,
+			Type:     chaoskit.InjectionTypeDelay,
+			Delay:    delay,
+		})
 		time.Sleep(delay)
 	}
 
@@ -251,17 +265,11 @@ func (d *DelayInjector) AfterStep(ctx context.Context, err error) error {
 // In ProbabilityMode: returns delay based on random probability
 // In IntervalMode: blocks until background goroutine signals a delay should be applied
 func (d *DelayInjector) GetChaosDelay(ctx context.Context) (time.Duration, bool) {
-	d.mu.Lock()
-	stopped := d.stopped
-	mode := d.mode
-	probability := d.probability
-	d.mu.Unlock()
-
-	if stopped {
+	if d.lifecycle.Stopped() {
 		return 0, false
 	}
 
-	if mode == IntervalMode {
+	if d.mode == IntervalMode {
 		// Use sync.Once for thread-safe initialization
 		d.initOnce.Do(func() {
 			d.delayCond = sync.NewCond(&d.delayMu)
@@ -277,9 +285,7 @@ func (d *DelayInjector) GetChaosDelay(ctx context.Context) (time.Duration, bool)
 			d.delayMu.Unlock()
 
 			// Increment counter when delay is actually applied
-			d.mu.Lock()
-			d.delayCount++
-			d.mu.Unlock()
+			d.delayCount.Add(1)
 
 			chaoskit.GetLogger(ctx).Debug("interval delay applied in user code",
 				slog.String("injector", d.name),
@@ -323,9 +329,7 @@ func (d *DelayInjector) GetChaosDelay(ctx context.Context) (time.Duration, bool)
 					d.activeDelay = 0 // Consume delay
 
 					// Increment counter when delay is actually applied
-					d.mu.Lock()
-					d.delayCount++
-					d.mu.Unlock()
+					d.delayCount.Add(1)
 
 					delayReceived <- delay
 
@@ -354,19 +358,10 @@ func (d *DelayInjector) GetChaosDelay(ctx context.Context) (time.Duration, bool)
 	}
 
 	// ProbabilityMode: use random generator
-	d.mu.Lock()
-	rng := d.rng
-	d.mu.Unlock()
-	if rng == nil {
-		rng = rand.New(rand.NewSource(rand.Int63()))
-	}
-
-	if rng.Float64() < probability {
+	if d.rng.Load().Float64() < d.loadProbability() {
 		delay := d.calculateDelay()
 		if delay > 0 {
-			d.mu.Lock()
-			d.delayCount++
-			d.mu.Unlock()
+			d.delayCount.Add(1)
 
 			return delay, true
 		}
@@ -386,17 +381,14 @@ func (d *DelayInjector) Type() chaoskit.InjectorType {
 
 // GetMetrics implements MetricsProvider
 func (d *DelayInjector) GetMetrics() map[string]interface{} {
-	d.mu.Lock()
-	defer d.mu.Unlock()
-
 	return map[string]interface{}{
 		"mode":        d.mode.String(),
 		"min_delay":   d.minDelay.String(),
 		"max_delay":   d.maxDelay.String(),
-		"probability": d.probability,
+		"probability": d.loadProbability(),
 		"interval":    d.interval.String(),
-		"delay_count": d.delayCount,
-		"stopped":     d.stopped,
+		"delay_count": d.delayCount.Load(),
+		"stopped":     d.lifecycle.Stopped(),
 	}
 }
 
@@ -411,3 +403,8 @@ func (m DelayMode) String() string {
 		return "unknown"
 	}
 }
+
+// ScaleIntensity implements IntensityScaler (probability mode only)
+func (d *DelayInjector) ScaleIntensity(factor float64) {
+	d.setProbability(scaleProbability(d.loadProbability(), factor))
+}
@@ -2,8 +2,11 @@ package injectors
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/rom8726/chaoskit"
 )
 
 func TestDelay_ProbabilityMode_GetChaosDelay(t *testing.T) {
@@ -58,3 +61,88 @@ func TestDelay_IntervalMode_AppliesDelay(t *testing.T) {
 		t.Fatalf("expected no delay after stop, got %v %v", d, ok)
 	}
 }
+
+func TestDelay_ProbabilityMode_HotPath(t *testing.T) {
+	di := RandomDelayWithProbability(time.Millisecond, 2*time.Millisecond, 0.5)
+	ctx := context.Background()
+
+	// Usable before Inject and without allocations per call
+	allocs := testing.AllocsPerRun(1000, func() {
+		di.GetChaosDelay(ctx)
+	})
+	if allocs != 0 {
+		t.Fatalf("expected no allocations per call, got %v", allocs)
+	}
+
+	done := make(chan struct{})
+	for g := 0; g < 4; g++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for i := 0; i < 1000; i++ {
+				if d, ok := di.GetChaosDelay(ctx); ok && (d < time.Millisecond || d >= 2*time.Millisecond) {
+					t.Errorf("delay %v out of range", d)
+
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		di.ScaleIntensity(0.5)
+		done <- struct{}{}
+	}()
+	for g := 0; g < 5; g++ {
+		<-done
+	}
+	if di.GetDelayCount() == 0 {
+		t.Fatalf("expected delays to be applied")
+	}
+}
+
+// Run with -race: injecting without a run generator must keep the pre-seeded
+// generator, which is safe for concurrent use
+func TestDelay_ProbabilityMode_ConcurrentAfterInject(t *testing.T) {
+	di := RandomDelayWithProbability(time.Millisecond, 2*time.Millisecond, 0.5)
+	if err := di.Inject(context.Background()); err != nil {
+		t.Fatalf("inject err: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				di.GetChaosDelay(context.Background())
+			}
+		}()
+	}
+	wg.Wait()
+	if di.GetDelayCount() == 0 {
+		t.Fatalf("expected delays to be applied")
+	}
+}
+
+func TestDelay_ProbabilityMode_RunRand(t *testing.T) {
+	delays := func() []time.Duration {
+		di := RandomDelayWithProbability(time.Millisecond, time.Second, 1.0)
+		if err := di.Inject(chaoskit.AttachRand(context.Background(), chaoskit.NewRand(42))); err != nil {
+			t.Fatalf("inject err: %v", err)
+		}
+
+		var delays []time.Duration
+		for i := 0; i < 5; i++ {
+			d, _ := di.GetChaosDelay(context.Background())
+			delays = append(delays, d)
+		}
+
+		return delays
+	}
+
+	first, second := delays(), delays()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected the run generator to make delays deterministic, got %v and %v", first, second)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package injectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// DefaultEBPFInterface is the network interface of EBPFNetworkInjector by default
+const DefaultEBPFInterface = "lo"
+
+// maxEBPFDelay is the delay limit of the fq qdisc horizon, beyond which it drops packets
+const maxEBPFDelay = 10 * time.Second
+
+// maxEBPFPorts bounds the ports matched by the eBPF program
+const maxEBPFPorts = 64
+
+// ErrEBPFUnsupported is returned by EBPFNetworkInjector.SetupNetwork when
+// chaoskit is built without -tags ebpf or for another OS than Linux
+var ErrEBPFUnsupported = errors.New("ebpf network injector requires Linux and -tags ebpf")
+
+// EBPFNetworkConfig configures EBPFNetworkInjector
+type EBPFNetworkConfig struct {
+	// Interface is the network interface whose egress traffic is affected
+	// (DefaultEBPFInterface when empty). On the loopback interface both
+	// directions of local connections are affected.
+	Interface string
+
+	// Ports are the TCP and UDP ports whose packets are affected, as source
+	// or destination port (required)
+	Ports []int
+
+	// Delay is added to the departure time of packets. Delays need the fq
+	// qdisc: it is added as root qdisc of the interface when it has none.
+	Delay time.Duration
+
+	// Drop is the probability of dropping a packet
+	Drop float64
+}
+
+// ebpfAttachment is an eBPF program attached to an interface
+type ebpfAttachment interface {
+	// configure enables or disables the faults of the program
+	configure(enabled bool, delay time.Duration, drop float64) error
+
+	// counters returns the numbers of delayed and dropped packets
+	counters() (delayed, dropped uint64, err error)
+
+	detach() error
+}
+
+// EBPFNetworkInjector adds latency or drops to the traffic of chosen ports
+// with an eBPF program attached to the egress of a network interface (tc
+// clsact), without a proxy or code changes. It is Linux-only, built with
+// -tags ebpf, and needs CAP_BPF (or CAP_SYS_ADMIN) and CAP_NET_ADMIN.
+//
+// SetupNetwork attaches the program, Inject and Stop turn its faults on and
+// off, TeardownNetwork detaches it and removes the qdiscs it added.
+type EBPFNetworkInjector struct {
+	name       string
+	config     EBPFNetworkConfig
+	mu         sync.Mutex
+	lifecycle  chaoskit.InjectorLifecycle
+	attachment ebpfAttachment
+}
+
+// EBPFNetwork creates an eBPF network injector
+func EBPFNetwork(cfg EBPFNetworkConfig) *EBPFNetworkInjector {
+	if cfg.Interface == "" {
+		cfg.Interface = DefaultEBPFInterface
+	}
+
+	return &EBPFNetworkInjector{
+		name:   fmt.Sprintf("ebpf_network_%s", cfg.Interface),
+		config: cfg,
+	}
+}
+
+func (e *EBPFNetworkInjector) Name() string {
+	return e.name
+}
+
+// SetupNetwork implements NetworkInjectorLifecycle: it attaches the eBPF
+// program with its faults off
+func (e *EBPFNetworkInjector) SetupNetwork(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.attachment != nil {
+		return nil
+	}
+	if err := e.validate(); err != nil {
+		return err
+	}
+
+	attachment, err := attachEBPF(e.config.Interface, e.config.Ports, e.config.Delay > 0)
+	if err != nil {
+		return fmt.Errorf("failed to attach eBPF program to %s: %w", e.config.Interface, err)
+	}
+	e.attachment = attachment
+	chaoskit.GetLogger(ctx).Info("eBPF program attached",
+		slog.String("injector", e.name),
+		slog.String("interface", e.config.Interface),
+		slog.Any("ports", e.config.Ports))
+
+	return nil
+}
+
+// TeardownNetwork implements NetworkInjectorLifecycle: it detaches the eBPF program
+func (e *EBPFNetworkInjector) TeardownNetwork(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.attachment == nil {
+		return nil
+	}
+
+	err := e.attachment.detach()
+	e.attachment = nil
+	if err != nil {
+		return fmt.Errorf("failed to detach eBPF program from %s: %w", e.config.Interface, err)
+	}
+
+	return nil
+}
+
+func (e *EBPFNetworkInjector) Inject(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.attachment == nil {
+		return fmt.Errorf("eBPF program is not attached to %s (SetupNetwork not called)", e.config.Interface)
+	}
+	if err := e.lifecycle.Start(); err != nil {
+		return err
+	}
+
+	return e.attachment.configure(true, e.config.Delay, e.config.Drop)
+}
+
+func (e *EBPFNetworkInjector) Stop(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.lifecycle.Stop() || e.attachment == nil {
+		return nil
+	}
+
+	return e.attachment.configure(false, 0, 0)
+}
+
+// State implements StatefulInjector
+func (e *EBPFNetworkInjector) State() chaoskit.InjectorState {
+	return e.lifecycle.State()
+}
+
+// Type implements CategorizedInjector
+func (e *EBPFNetworkInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeGlobal
+}
+
+// IsGlobal implements GlobalInjector
+func (e *EBPFNetworkInjector) IsGlobal() bool {
+	return true
+}
+
+// ScaleIntensity implements IntensityScaler: it scales the drop probability
+func (e *EBPFNetworkInjector) ScaleIntensity(factor float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.config.Drop = scaleProbability(e.config.Drop, factor)
+	if e.attachment != nil && e.lifecycle.State() == chaoskit.InjectorInjecting {
+		_ = e.attachment.configure(true, e.config.Delay, e.config.Drop)
+	}
+}
+
+// GetMetrics implements MetricsProvider
+func (e *EBPFNetworkInjector) GetMetrics() map[string]interface{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	metrics := map[string]interface{}{
+		"interface":        e.config.Interface,
+		"ports":            e.config.Ports,
+		"delay_ms":         e.config.Delay.Milliseconds(),
+		"drop_probability": e.config.Drop,
+		"attached":         e.attachment != nil,
+		"stopped":          e.lifecycle.Stopped(),
+	}
+	if e.attachment != nil {
+		if delayed, dropped, err := e.attachment.counters(); err == nil {
+			metrics["delayed_packets"] = delayed
+			metrics["dropped_packets"] = dropped
+		}
+	}
+
+	return metrics
+}
+
+func (e *EBPFNetworkInjector) validate() error {
+	var errs []error
+	if len(e.config.Ports) == 0 {
+		errs = append(errs, errors.New("at least one port is required"))
+	}
+	if len(e.config.Ports) > maxEBPFPorts {
+		errs = append(errs, fmt.Errorf("at most %d ports are supported, got %d", maxEBPFPorts, len(e.config.Ports)))
+	}
+	for _, port := range e.config.Ports {
+		if port <= 0 || port > 65535 {
+			errs = append(errs, fmt.Errorf("invalid port %d", port))
+		}
+	}
+	if e.config.Delay < 0 || e.config.Delay >= maxEBPFDelay {
+		errs = append(errs, fmt.Errorf("delay must be between 0 and %s, got %s", maxEBPFDelay, e.config.Delay))
+	}
+	if e.config.Drop < 0 || e.config.Drop > 1 {
+		errs = append(errs, fmt.Errorf("drop must be between 0 and 1, got %v", e.config.Drop))
+	}
+	if e.config.Delay == 0 && e.config.Drop == 0 {
+		errs = append(errs, errors.New("delay or drop is required"))
+	}
+
+	return errors.Join(errs...)
+}
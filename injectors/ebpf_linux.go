@@ -0,0 +1,665 @@
+//go:build linux && ebpf
+
+package injectors
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"runtime"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// eBPF instruction classes, sizes, modes, operations and sources
+const (
+	bpfLD     = 0x00
+	bpfLDX    = 0x01
+	bpfST     = 0x02
+	bpfSTX    = 0x03
+	bpfJMP    = 0x05
+	bpfALU64  = 0x07
+	bpfW      = 0x00
+	bpfH      = 0x08
+	bpfB      = 0x10
+	bpfDW     = 0x18
+	bpfIMM    = 0x00
+	bpfMEM    = 0x60
+	bpfATOMIC = 0xc0
+	bpfK      = 0x00
+	bpfX      = 0x08
+	bpfADD    = 0x00
+	bpfAND    = 0x50
+	bpfLSH    = 0x60
+	bpfMOV    = 0xb0
+	bpfJA     = 0x00
+	bpfJEQ    = 0x10
+	bpfJGE    = 0x30
+	bpfJNE    = 0x50
+	bpfCALL   = 0x80
+	bpfEXIT   = 0x90
+)
+
+// eBPF helpers called by the program
+const (
+	bpfFuncMapLookupElem = 1
+	bpfFuncKtimeGetNs    = 5
+	bpfFuncGetPrandomU32 = 7
+	bpfFuncSkbLoadBytes  = 26
+)
+
+// Fields of struct __sk_buff and tc actions
+const (
+	skbProtocolOffset = 16
+	skbTstampOffset   = 152
+	tcActOK           = 0
+	tcActShot         = 2
+)
+
+// Layout of the config map value: delay (ns), drop threshold (of 2^32),
+// enabled flag, delayed and dropped packet counters
+const (
+	ebpfConfigDelay     = 0
+	ebpfConfigThreshold = 8
+	ebpfConfigEnabled   = 12
+	ebpfConfigDelayed   = 16
+	ebpfConfigDropped   = 24
+	ebpfConfigSize      = 32
+)
+
+// tc handles and attributes not defined by x/sys/unix
+const (
+	tcHRoot            = 0xFFFFFFFF
+	tcHClsact          = 0xFFFFFFF1
+	tcHClsactHandle    = 0xFFFF0000
+	tcHClsactEgress    = 0xFFFFFFF3
+	tcaBPFFd           = 6
+	tcaBPFName         = 7
+	tcaBPFFlags        = 8
+	tcaBPFFlagActDir   = 1
+	ebpfFilterPriority = 0xC4A0
+	ebpfFilterHandle   = 1
+	sizeofTcMsg        = 20
+)
+
+// Capabilities required to load and attach the program
+const (
+	capNetAdmin = 12
+	capSysAdmin = 21
+	capBPF      = 39
+)
+
+// bpfInsn is an eBPF instruction; jump targets are resolved from labels
+type bpfInsn struct {
+	code   uint8
+	dst    uint8
+	src    uint8
+	off    int16
+	imm    int32
+	target string
+}
+
+// bpfProgram assembles eBPF instructions with labeled jump targets
+type bpfProgram struct {
+	insns  []bpfInsn
+	labels map[string]int
+}
+
+func (p *bpfProgram) emit(insns ...bpfInsn) {
+	p.insns = append(p.insns, insns...)
+}
+
+func (p *bpfProgram) label(name string) {
+	if p.labels == nil {
+		p.labels = make(map[string]int)
+	}
+	p.labels[name] = len(p.insns)
+}
+
+// bytes encodes the program for BPF_PROG_LOAD
+func (p *bpfProgram) bytes() ([]byte, error) {
+	bigEndian := binary.NativeEndian.Uint16([]byte{0, 1}) == 1
+	code := make([]byte, 0, len(p.insns)*8)
+	for i, insn := range p.insns {
+		if insn.target != "" {
+			at, ok := p.labels[insn.target]
+			if !ok {
+				return nil, fmt.Errorf("undefined label %q", insn.target)
+			}
+			insn.off = int16(at - i - 1)
+		}
+		regs := insn.src<<4 | insn.dst
+		if bigEndian {
+			regs = insn.dst<<4 | insn.src
+		}
+		code = append(code, insn.code, regs)
+		code = binary.NativeEndian.AppendUint16(code, uint16(insn.off))
+		code = binary.NativeEndian.AppendUint32(code, uint32(insn.imm))
+	}
+
+	return code, nil
+}
+
+func movImm(dst uint8, imm int32) bpfInsn {
+	return bpfInsn{code: bpfALU64 | bpfMOV | bpfK, dst: dst, imm: imm}
+}
+
+func movReg(dst, src uint8) bpfInsn {
+	return bpfInsn{code: bpfALU64 | bpfMOV | bpfX, dst: dst, src: src}
+}
+
+func aluImm(op, dst uint8, imm int32) bpfInsn {
+	return bpfInsn{code: bpfALU64 | op | bpfK, dst: dst, imm: imm}
+}
+
+func addReg(dst, src uint8) bpfInsn {
+	return bpfInsn{code: bpfALU64 | bpfADD | bpfX, dst: dst, src: src}
+}
+
+func load(size, dst, src uint8, off int16) bpfInsn {
+	return bpfInsn{code: bpfLDX | bpfMEM | size, dst: dst, src: src, off: off}
+}
+
+func store(size, dst, src uint8, off int16) bpfInsn {
+	return bpfInsn{code: bpfSTX | bpfMEM | size, dst: dst, src: src, off: off}
+}
+
+func storeImm(size, dst uint8, off int16, imm int32) bpfInsn {
+	return bpfInsn{code: bpfST | bpfMEM | size, dst: dst, off: off, imm: imm}
+}
+
+// atomicAdd adds src to the 64-bit value at dst+off
+func atomicAdd(dst, src uint8, off int16) bpfInsn {
+	return bpfInsn{code: bpfSTX | bpfATOMIC | bpfDW, dst: dst, src: src, off: off, imm: bpfADD}
+}
+
+func jumpImm(op, dst uint8, imm int32, target string) bpfInsn {
+	return bpfInsn{code: bpfJMP | op | bpfK, dst: dst, imm: imm, target: target}
+}
+
+func jumpReg(op, dst, src uint8, target string) bpfInsn {
+	return bpfInsn{code: bpfJMP | op | bpfX, dst: dst, src: src, target: target}
+}
+
+func jump(target string) bpfInsn {
+	return bpfInsn{code: bpfJMP | bpfJA, target: target}
+}
+
+func call(helper int32) bpfInsn {
+	return bpfInsn{code: bpfJMP | bpfCALL, imm: helper}
+}
+
+func exit() bpfInsn {
+	return bpfInsn{code: bpfJMP | bpfEXIT}
+}
+
+// loadMapFd loads the address of the map fd into dst (two instructions)
+func loadMapFd(dst uint8, fd int) []bpfInsn {
+	return []bpfInsn{
+		{code: bpfLD | bpfIMM | bpfDW, dst: dst, src: unix.BPF_PSEUDO_MAP_FD, imm: int32(fd)},
+		{},
+	}
+}
+
+// networkOrder returns the value a 16-bit load reads from v in network byte order
+func networkOrder(v uint16) int32 {
+	return int32(binary.NativeEndian.Uint16(binary.BigEndian.AppendUint16(nil, v)))
+}
+
+// ebpfProgram returns the tc classifier: packets of IPv4 and IPv6 TCP or UDP
+// with one of ports as source or destination port are dropped with the drop
+// threshold of the config map, or get its delay added to their departure time
+func ebpfProgram(mapFd int, ports []int) *bpfProgram {
+	// r6: skb, r7: config map value, r8: transport header offset, then delay
+	p := &bpfProgram{}
+	p.emit(
+		movReg(6, 1),
+		storeImm(bpfW, 10, -4, 0),
+	)
+	p.emit(loadMapFd(1, mapFd)...)
+	p.emit(
+		movReg(2, 10),
+		aluImm(bpfADD, 2, -4),
+		call(bpfFuncMapLookupElem),
+		jumpImm(bpfJEQ, 0, 0, "pass"),
+		movReg(7, 0),
+		load(bpfW, 1, 7, ebpfConfigEnabled),
+		jumpImm(bpfJEQ, 1, 0, "pass"),
+		load(bpfW, 2, 6, skbProtocolOffset),
+		jumpImm(bpfJEQ, 2, networkOrder(unix.ETH_P_IP), "ipv4"),
+		jumpImm(bpfJEQ, 2, networkOrder(unix.ETH_P_IPV6), "ipv6"),
+		jump("pass"),
+	)
+
+	// IPv4: the transport header follows the variable-length IP header
+	p.label("ipv4")
+	p.emit(
+		movReg(1, 6),
+		movImm(2, 14),
+		movReg(3, 10),
+		aluImm(bpfADD, 3, -64),
+		movImm(4, 20),
+		call(bpfFuncSkbLoadBytes),
+		jumpImm(bpfJNE, 0, 0, "pass"),
+		load(bpfB, 1, 10, -64+9),
+		jumpImm(bpfJEQ, 1, unix.IPPROTO_TCP, "ipv4_l4"),
+		jumpImm(bpfJNE, 1, unix.IPPROTO_UDP, "pass"),
+	)
+	p.label("ipv4_l4")
+	p.emit(
+		load(bpfB, 8, 10, -64),
+		aluImm(bpfAND, 8, 0x0f),
+		aluImm(bpfLSH, 8, 2),
+		aluImm(bpfADD, 8, 14),
+		jump("ports"),
+	)
+
+	// IPv6: TCP or UDP directly after the fixed header (no extension headers)
+	p.label("ipv6")
+	p.emit(
+		movReg(1, 6),
+		movImm(2, 14),
+		movReg(3, 10),
+		aluImm(bpfADD, 3, -64),
+		movImm(4, 40),
+		call(bpfFuncSkbLoadBytes),
+		jumpImm(bpfJNE, 0, 0, "pass"),
+		load(bpfB, 1, 10, -64+6),
+		jumpImm(bpfJEQ, 1, unix.IPPROTO_TCP, "ipv6_l4"),
+		jumpImm(bpfJNE, 1, unix.IPPROTO_UDP, "pass"),
+	)
+	p.label("ipv6_l4")
+	p.emit(movImm(8, 14+40))
+
+	p.label("ports")
+	p.emit(
+		movReg(1, 6),
+		movReg(2, 8),
+		movReg(3, 10),
+		aluImm(bpfADD, 3, -8),
+		movImm(4, 4),
+		call(bpfFuncSkbLoadBytes),
+		jumpImm(bpfJNE, 0, 0, "pass"),
+		load(bpfH, 1, 10, -8),
+		load(bpfH, 2, 10, -6),
+	)
+	for _, port := range ports {
+		p.emit(
+			jumpImm(bpfJEQ, 1, networkOrder(uint16(port)), "match"),
+			jumpImm(bpfJEQ, 2, networkOrder(uint16(port)), "match"),
+		)
+	}
+	p.emit(jump("pass"))
+
+	p.label("match")
+	p.emit(
+		load(bpfW, 1, 7, ebpfConfigThreshold),
+		jumpImm(bpfJEQ, 1, 0, "delay"),
+		call(bpfFuncGetPrandomU32),
+		load(bpfW, 1, 7, ebpfConfigThreshold),
+		jumpReg(bpfJGE, 0, 1, "delay"),
+		movImm(1, 1),
+		atomicAdd(7, 1, ebpfConfigDropped),
+		movImm(0, tcActShot),
+		exit(),
+	)
+
+	// Delays move the earliest departure time, which the fq qdisc enforces
+	p.label("delay")
+	p.emit(
+		load(bpfDW, 8, 7, ebpfConfigDelay),
+		jumpImm(bpfJEQ, 8, 0, "pass"),
+		call(bpfFuncKtimeGetNs),
+		addReg(0, 8),
+		load(bpfDW, 1, 6, skbTstampOffset),
+		jumpReg(bpfJGE, 1, 0, "pass"),
+		store(bpfDW, 6, 0, skbTstampOffset),
+		movImm(1, 1),
+		atomicAdd(7, 1, ebpfConfigDelayed),
+	)
+
+	p.label("pass")
+	p.emit(
+		movImm(0, tcActOK),
+		exit(),
+	)
+
+	return p
+}
+
+// bpfPointer is a pointer field of union bpf_attr (64-bit platforms only,
+// checked by attachEBPF)
+type bpfPointer struct {
+	ptr unsafe.Pointer
+}
+
+type bpfMapCreateAttr struct {
+	mapType    uint32
+	keySize    uint32
+	valueSize  uint32
+	maxEntries uint32
+	mapFlags   uint32
+	innerMapFd uint32
+	numaNode   uint32
+	mapName    [unix.BPF_OBJ_NAME_LEN]byte
+}
+
+type bpfMapElemAttr struct {
+	mapFd uint32
+	_     uint32
+	key   bpfPointer
+	value bpfPointer
+	flags uint64
+}
+
+type bpfProgLoadAttr struct {
+	progType    uint32
+	insnCnt     uint32
+	insns       bpfPointer
+	license     bpfPointer
+	logLevel    uint32
+	logSize     uint32
+	logBuf      bpfPointer
+	kernVersion uint32
+	progFlags   uint32
+	progName    [unix.BPF_OBJ_NAME_LEN]byte
+}
+
+// bpfSyscall calls bpf(2) with attr
+func bpfSyscall(cmd uintptr, attr unsafe.Pointer, size uintptr) (int, error) {
+	fd, _, errno := unix.Syscall(unix.SYS_BPF, cmd, uintptr(attr), size)
+	if errno != 0 {
+		return -1, errno
+	}
+
+	return int(fd), nil
+}
+
+// tcEBPF is the eBPF program attached to the egress of an interface
+type tcEBPF struct {
+	ifindex     int
+	mapFd       int
+	progFd      int
+	ownsClsact  bool
+	ownsFq      bool
+	filterAdded bool
+}
+
+func attachEBPF(iface string, ports []int, delay bool) (ebpfAttachment, error) {
+	if unsafe.Sizeof(uintptr(0)) != 8 {
+		return nil, errors.New("ebpf network injector requires a 64-bit platform")
+	}
+	if err := checkEBPFCapabilities(); err != nil {
+		return nil, err
+	}
+	link, err := net.InterfaceByName(iface)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &tcEBPF{ifindex: link.Index, mapFd: -1, progFd: -1}
+	if err := t.attach(ports, delay); err != nil {
+		return nil, errors.Join(err, t.detach())
+	}
+
+	return t, nil
+}
+
+func (t *tcEBPF) attach(ports []int, delay bool) error {
+	mapAttr := bpfMapCreateAttr{
+		mapType:    unix.BPF_MAP_TYPE_ARRAY,
+		keySize:    4,
+		valueSize:  ebpfConfigSize,
+		maxEntries: 1,
+	}
+	copy(mapAttr.mapName[:], "chaoskit_cfg")
+	mapFd, err := bpfSyscall(unix.BPF_MAP_CREATE, unsafe.Pointer(&mapAttr), unsafe.Sizeof(mapAttr))
+	if err != nil {
+		return fmt.Errorf("failed to create eBPF map: %w", err)
+	}
+	t.mapFd = mapFd
+
+	code, err := ebpfProgram(mapFd, ports).bytes()
+	if err != nil {
+		return err
+	}
+	license := []byte("GPL\x00")
+	verifierLog := make([]byte, 64*1024)
+	progAttr := bpfProgLoadAttr{
+		progType: unix.BPF_PROG_TYPE_SCHED_CLS,
+		insnCnt:  uint32(len(code) / 8),
+		insns:    bpfPointer{ptr: unsafe.Pointer(&code[0])},
+		license:  bpfPointer{ptr: unsafe.Pointer(&license[0])},
+		logLevel: 1,
+		logSize:  uint32(len(verifierLog)),
+		logBuf:   bpfPointer{ptr: unsafe.Pointer(&verifierLog[0])},
+	}
+	copy(progAttr.progName[:], "chaoskit_net")
+	progFd, err := bpfSyscall(unix.BPF_PROG_LOAD, unsafe.Pointer(&progAttr), unsafe.Sizeof(progAttr))
+	runtime.KeepAlive(code)
+	runtime.KeepAlive(license)
+	if err != nil {
+		return fmt.Errorf("failed to load eBPF program: %w: %s", err, unix.ByteSliceToString(verifierLog))
+	}
+	t.progFd = progFd
+
+	if delay {
+		err := tcRequest(unix.RTM_NEWQDISC, unix.NLM_F_CREATE|unix.NLM_F_EXCL, t.ifindex, 0, tcHRoot, 0,
+			netlinkAttr(unix.TCA_KIND, []byte("fq\x00")))
+		switch {
+		case err == nil:
+			t.ownsFq = true
+		case errors.Is(err, unix.EEXIST):
+			// A root qdisc configured by the host is kept; delays work when it is fq
+		default:
+			return fmt.Errorf("failed to add fq qdisc for delays (sch_fq module loaded?): %w", err)
+		}
+	}
+
+	err = tcRequest(unix.RTM_NEWQDISC, unix.NLM_F_CREATE|unix.NLM_F_EXCL, t.ifindex, tcHClsactHandle, tcHClsact, 0,
+		netlinkAttr(unix.TCA_KIND, []byte("clsact\x00")))
+	switch {
+	case err == nil:
+		t.ownsClsact = true
+	case !errors.Is(err, unix.EEXIST):
+		return fmt.Errorf("failed to add clsact qdisc: %w", err)
+	}
+
+	options := netlinkAttr(tcaBPFFd, binary.NativeEndian.AppendUint32(nil, uint32(progFd)))
+	options = append(options, netlinkAttr(tcaBPFName, []byte("chaoskit\x00"))...)
+	options = append(options, netlinkAttr(tcaBPFFlags, binary.NativeEndian.AppendUint32(nil, tcaBPFFlagActDir))...)
+	attrs := netlinkAttr(unix.TCA_KIND, []byte("bpf\x00"))
+	attrs = append(attrs, netlinkAttr(unix.TCA_OPTIONS, options)...)
+	if err := tcRequest(unix.RTM_NEWTFILTER, unix.NLM_F_CREATE|unix.NLM_F_EXCL, t.ifindex,
+		ebpfFilterHandle, tcHClsactEgress, t.filterInfo(), attrs); err != nil {
+		return fmt.Errorf("failed to add bpf filter: %w", err)
+	}
+	t.filterAdded = true
+
+	return nil
+}
+
+// filterInfo returns the priority and protocol (all) of the filter
+func (t *tcEBPF) filterInfo() uint32 {
+	return ebpfFilterPriority<<16 | uint32(networkOrder(unix.ETH_P_ALL))
+}
+
+func (t *tcEBPF) configure(enabled bool, delay time.Duration, drop float64) error {
+	value := make([]byte, ebpfConfigSize)
+	if err := t.lookup(value); err != nil {
+		return err
+	}
+
+	threshold := uint32(0)
+	if drop > 0 {
+		threshold = uint32(min(drop*(1<<32), math.MaxUint32))
+	}
+	binary.NativeEndian.PutUint64(value[ebpfConfigDelay:], uint64(delay.Nanoseconds()))
+	binary.NativeEndian.PutUint32(value[ebpfConfigThreshold:], threshold)
+	binary.NativeEndian.PutUint32(value[ebpfConfigEnabled:], 0)
+	if enabled {
+		binary.NativeEndian.PutUint32(value[ebpfConfigEnabled:], 1)
+	}
+
+	key := uint32(0)
+	attr := bpfMapElemAttr{
+		mapFd: uint32(t.mapFd),
+		key:   bpfPointer{ptr: unsafe.Pointer(&key)},
+		value: bpfPointer{ptr: unsafe.Pointer(&value[0])},
+	}
+	_, err := bpfSyscall(unix.BPF_MAP_UPDATE_ELEM, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(value)
+	if err != nil {
+		return fmt.Errorf("failed to configure eBPF program: %w", err)
+	}
+
+	return nil
+}
+
+func (t *tcEBPF) counters() (uint64, uint64, error) {
+	value := make([]byte, ebpfConfigSize)
+	if err := t.lookup(value); err != nil {
+		return 0, 0, err
+	}
+
+	return binary.NativeEndian.Uint64(value[ebpfConfigDelayed:]), binary.NativeEndian.Uint64(value[ebpfConfigDropped:]), nil
+}
+
+// lookup reads the config map value into value
+func (t *tcEBPF) lookup(value []byte) error {
+	key := uint32(0)
+	attr := bpfMapElemAttr{
+		mapFd: uint32(t.mapFd),
+		key:   bpfPointer{ptr: unsafe.Pointer(&key)},
+		value: bpfPointer{ptr: unsafe.Pointer(&value[0])},
+	}
+	_, err := bpfSyscall(unix.BPF_MAP_LOOKUP_ELEM, unsafe.Pointer(&attr), unsafe.Sizeof(attr))
+	runtime.KeepAlive(value)
+	if err != nil {
+		return fmt.Errorf("failed to read eBPF map: %w", err)
+	}
+
+	return nil
+}
+
+// detach removes the filter and the qdiscs added by attach and closes the program
+func (t *tcEBPF) detach() error {
+	var errs []error
+	switch {
+	case t.ownsClsact:
+		// Deleting the clsact qdisc deletes the filter
+		if err := tcRequest(unix.RTM_DELQDISC, 0, t.ifindex, tcHClsactHandle, tcHClsact, 0,
+			netlinkAttr(unix.TCA_KIND, []byte("clsact\x00"))); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete clsact qdisc: %w", err))
+		}
+	case t.filterAdded:
+		if err := tcRequest(unix.RTM_DELTFILTER, 0, t.ifindex, ebpfFilterHandle, tcHClsactEgress, t.filterInfo(),
+			netlinkAttr(unix.TCA_KIND, []byte("bpf\x00"))); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete bpf filter: %w", err))
+		}
+	}
+	if t.ownsFq {
+		if err := tcRequest(unix.RTM_DELQDISC, 0, t.ifindex, 0, tcHRoot, 0,
+			netlinkAttr(unix.TCA_KIND, []byte("fq\x00"))); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete fq qdisc: %w", err))
+		}
+	}
+	if t.progFd >= 0 {
+		_ = unix.Close(t.progFd)
+	}
+	if t.mapFd >= 0 {
+		_ = unix.Close(t.mapFd)
+	}
+	t.ownsClsact, t.ownsFq, t.filterAdded = false, false, false
+	t.progFd, t.mapFd = -1, -1
+
+	return errors.Join(errs...)
+}
+
+// netlinkAttr encodes a netlink attribute, padded to 4 bytes
+func netlinkAttr(attrType uint16, data []byte) []byte {
+	length := unix.SizeofNlAttr + len(data)
+	attr := binary.NativeEndian.AppendUint16(nil, uint16(length))
+	attr = binary.NativeEndian.AppendUint16(attr, attrType)
+	attr = append(attr, data...)
+
+	return append(attr, make([]byte, (4-length%4)%4)...)
+}
+
+// tcRequest sends an rtnetlink traffic control request and waits for its acknowledgement
+func tcRequest(msgType uint16, flags uint16, ifindex int, handle, parent, info uint32, attrs []byte) error {
+	sock, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_ROUTE)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = unix.Close(sock) }()
+
+	length := unix.SizeofNlMsghdr + sizeofTcMsg + len(attrs)
+	msg := binary.NativeEndian.AppendUint32(nil, uint32(length))
+	msg = binary.NativeEndian.AppendUint16(msg, msgType)
+	msg = binary.NativeEndian.AppendUint16(msg, flags|unix.NLM_F_REQUEST|unix.NLM_F_ACK)
+	msg = binary.NativeEndian.AppendUint32(msg, 1) // sequence
+	msg = binary.NativeEndian.AppendUint32(msg, 0) // port ID, filled in by the kernel
+	msg = append(msg, unix.AF_UNSPEC, 0, 0, 0)
+	msg = binary.NativeEndian.AppendUint32(msg, uint32(ifindex))
+	msg = binary.NativeEndian.AppendUint32(msg, handle)
+	msg = binary.NativeEndian.AppendUint32(msg, parent)
+	msg = binary.NativeEndian.AppendUint32(msg, info)
+	msg = append(msg, attrs...)
+
+	if err := unix.Sendto(sock, msg, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return err
+	}
+
+	reply := make([]byte, 4096)
+	for {
+		n, _, err := unix.Recvfrom(sock, reply, 0)
+		if err != nil {
+			return err
+		}
+		messages, err := syscall.ParseNetlinkMessage(reply[:n])
+		if err != nil {
+			return err
+		}
+		for _, m := range messages {
+			if m.Header.Type != unix.NLMSG_ERROR || len(m.Data) < 4 {
+				continue
+			}
+			if errno := int32(binary.NativeEndian.Uint32(m.Data)); errno != 0 {
+				return unix.Errno(-errno)
+			}
+
+			return nil
+		}
+	}
+}
+
+// checkEBPFCapabilities fails when the process can't load and attach the program
+func checkEBPFCapabilities() error {
+	header := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+	var data [2]unix.CapUserData
+	if err := unix.Capget(&header, &data[0]); err != nil {
+		return fmt.Errorf("failed to read capabilities: %w", err)
+	}
+
+	has := func(capability uint) bool {
+		return data[capability/32].Effective&(1<<(capability%32)) != 0
+	}
+	var missing []string
+	if !has(capBPF) && !has(capSysAdmin) {
+		missing = append(missing, "CAP_BPF (or CAP_SYS_ADMIN)")
+	}
+	if !has(capNetAdmin) {
+		missing = append(missing, "CAP_NET_ADMIN")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing capabilities %v", missing)
+	}
+
+	return nil
+}
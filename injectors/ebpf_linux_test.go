@@ -0,0 +1,72 @@
+//go:build linux && ebpf
+
+package injectors
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestEBPFNetwork_DropLoopback(t *testing.T) {
+	listener, err := net.Listen("tcp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+	_, portText, _ := net.SplitHostPort(listener.Addr().String())
+	port, _ := strconv.Atoi(portText)
+
+	ctx := context.Background()
+	injector := EBPFNetwork(EBPFNetworkConfig{Ports: []int{port}, Drop: 1})
+	if err := injector.SetupNetwork(ctx); err != nil {
+		t.Skipf("eBPF unavailable: %v", err)
+	}
+	defer func() {
+		if err := injector.TeardownNetwork(ctx); err != nil {
+			t.Errorf("TeardownNetwork() error = %v", err)
+		}
+	}()
+
+	dial := func() error {
+		conn, err := net.DialTimeout("tcp4", listener.Addr().String(), 300*time.Millisecond)
+		if err == nil {
+			_ = conn.Close()
+		}
+
+		return err
+	}
+
+	// Attached but not injecting: traffic flows
+	if err := dial(); err != nil {
+		t.Fatalf("dial before Inject failed: %v", err)
+	}
+
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject() error = %v", err)
+	}
+	if err := dial(); err == nil {
+		t.Error("dial should time out while packets are dropped")
+	}
+	if dropped := injector.GetMetrics()["dropped_packets"]; dropped == nil || dropped.(uint64) == 0 {
+		t.Errorf("dropped_packets = %v, want > 0", dropped)
+	}
+
+	if err := injector.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if err := dial(); err != nil {
+		t.Errorf("dial after Stop failed: %v", err)
+	}
+}
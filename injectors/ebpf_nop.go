@@ -0,0 +1,10 @@
+//go:build !linux || !ebpf
+
+package injectors
+
+// This file provides a stub when building without -tags ebpf or for another
+// OS than Linux, so EBPFNetworkInjector fails in SetupNetwork.
+
+func attachEBPF(iface string, ports []int, delay bool) (ebpfAttachment, error) {
+	return nil, ErrEBPFUnsupported
+}
@@ -0,0 +1,34 @@
+package injectors
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEBPFNetwork_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  EBPFNetworkConfig
+		want string
+	}{
+		{"no ports", EBPFNetworkConfig{Drop: 0.1}, "at least one port"},
+		{"invalid port", EBPFNetworkConfig{Ports: []int{70000}, Drop: 0.1}, "invalid port 70000"},
+		{"no fault", EBPFNetworkConfig{Ports: []int{5432}}, "delay or drop"},
+		{"drop", EBPFNetworkConfig{Ports: []int{5432}, Drop: 2}, "drop must be between"},
+		{"delay beyond the fq horizon", EBPFNetworkConfig{Ports: []int{5432}, Delay: time.Minute}, "delay must be between"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := EBPFNetwork(tt.cfg).SetupNetwork(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("SetupNetwork() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	if err := EBPFNetwork(EBPFNetworkConfig{Ports: []int{5432}, Drop: 0.1}).Inject(context.Background()); err == nil {
+		t.Error("Inject() before SetupNetwork should fail")
+	}
+}
@@ -16,10 +16,11 @@ type ErrorInjector struct {
 	name        string
 	probability float64
 	errorMsg    string
+	fault       chaoskit.FaultKind
 	errorCount  int64
 
-	mu      sync.Mutex
-	stopped bool
+	mu        sync.Mutex
+	lifecycle chaoskit.InjectorLifecycle
 
 	rng *rand.Rand // Deterministic random generator from context
 }
@@ -32,6 +33,16 @@ func ErrorWithProbability(errorMsg string, probability float64) *ErrorInjector {
 	}
 }
 
+// FaultWithProbability creates an error injector returning typed faults of kind
+// (see chaoskit.Fault) with their default message
+func FaultWithProbability(kind chaoskit.FaultKind, probability float64) *ErrorInjector {
+	return &ErrorInjector{
+		name:        fmt.Sprintf("fault_injector_%s", kind),
+		probability: probability,
+		fault:       kind,
+	}
+}
+
 func (e *ErrorInjector) Name() string {
 	return e.name
 }
@@ -40,8 +51,8 @@ func (e *ErrorInjector) Inject(ctx context.Context) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	if e.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := e.lifecycle.Start(); err != nil {
+		return err
 	}
 
 	// Store deterministic random generator from context
@@ -54,8 +65,7 @@ func (e *ErrorInjector) Stop(ctx context.Context) error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	if !e.stopped {
-		e.stopped = true
+	if e.lifecycle.Stop() {
 		chaoskit.GetLogger(ctx).Info("delay injector stopped",
 			slog.String("injector", e.name))
 	}
@@ -63,6 +73,11 @@ func (e *ErrorInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (e *ErrorInjector) State() chaoskit.InjectorState {
+	return e.lifecycle.State()
+}
+
 // BeforeStep injects a delay before step execution
 func (e *ErrorInjector) BeforeStep(context.Context) error {
 	return nil
@@ -89,24 +104,41 @@ func (e *ErrorInjector) GetMetrics() map[string]interface{} {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	return map[string]interface{}{
+	metrics := map[string]interface{}{
 		"probability": e.probability,
 		"error_count": e.errorCount,
-		"stopped":     e.stopped,
+		"stopped":     e.lifecycle.Stopped(),
+	}
+	if e.fault != "" {
+		metrics["fault"] = string(e.fault)
 	}
+
+	return metrics
 }
 
 func (e *ErrorInjector) ShouldReturnError() error {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	if e.stopped {
+	if e.lifecycle.Stopped() {
 		return nil
 	}
 
 	if e.rng.Float64() < e.probability {
+		if e.fault != "" {
+			return chaoskit.NewFault(e.fault, e.errorMsg)
+		}
+
 		return errors.New(e.errorMsg)
 	}
 
 	return nil
 }
+
+// ScaleIntensity implements IntensityScaler
+func (e *ErrorInjector) ScaleIntensity(factor float64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.probability = scaleProbability(e.probability, factor)
+}
@@ -29,10 +29,11 @@ type FailpointPanicInjector struct {
 	interval    time.Duration
 	window      time.Duration
 
-	mu      sync.Mutex
-	stopCh  chan struct{}
-	stopped bool
-	active  map[string]bool
+	mu        sync.Mutex
+	stopCh    chan struct{}
+	lifecycle chaoskit.InjectorLifecycle
+	active    map[string]bool
+	rng       *rand.Rand
 }
 
 // FailpointPanic creates a new failpoint-based panic injector.
@@ -46,7 +47,6 @@ func FailpointPanic(names []string, probability float64, window time.Duration) *
 		probability: probability,
 		interval:    window,
 		window:      window,
-		stopCh:      make(chan struct{}),
 		active:      make(map[string]bool),
 	}
 }
@@ -57,12 +57,17 @@ func (f *FailpointPanicInjector) Inject(ctx context.Context) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	if f.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := f.lifecycle.Start(); err != nil {
+		return err
 	}
 
+	// Use the run generator so seeded scenarios toggle failpoints reproducibly
+	f.rng = chaoskit.GetRand(ctx)
+
 	// Probe runtime availability (distinguish missing build tag).
 	if err := enableFailpoint("chaoskit_runtime_probe", `panic("probe")`); errors.Is(err, ErrFailpointDisabled) {
+		f.lifecycle.Stop()
+
 		return ErrFailpointDisabled
 	} else if err == nil {
 		_ = disableFailpoint("chaoskit_runtime_probe")
@@ -73,12 +78,14 @@ func (f *FailpointPanicInjector) Inject(ctx context.Context) error {
 	if interval <= 0 {
 		interval = 250 * time.Millisecond
 	}
+	stopCh := make(chan struct{})
+	f.stopCh = stopCh
 	go func() {
 		ticker := time.NewTicker(interval)
 		defer ticker.Stop()
 		for {
 			select {
-			case <-f.stopCh:
+			case <-stopCh:
 				return
 			case <-ticker.C:
 				f.tickOnce()
@@ -93,7 +100,7 @@ func (f *FailpointPanicInjector) tickOnce() {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 	for _, fp := range f.failpoints {
-		if rand.Float64() < f.probability && !f.active[fp] {
+		if f.rng.Float64() < f.probability && !f.active[fp] {
 			// Enable panic action for this failpoint for a window
 			action := fmt.Sprintf(`panic("chaoskit failpoint: %s")`, fp)
 			if err := enableFailpoint(fp, action); err == nil {
@@ -116,9 +123,8 @@ func (f *FailpointPanicInjector) tickOnce() {
 func (f *FailpointPanicInjector) Stop(ctx context.Context) error {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	if !f.stopped {
+	if f.lifecycle.Stop() {
 		close(f.stopCh)
-		f.stopped = true
 		for fp, en := range f.active {
 			if en {
 				_ = disableFailpoint(fp)
@@ -130,6 +136,11 @@ func (f *FailpointPanicInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (f *FailpointPanicInjector) State() chaoskit.InjectorState {
+	return f.lifecycle.State()
+}
+
 // Type implements CategorizedInjector
 func (f *FailpointPanicInjector) Type() chaoskit.InjectorType {
 	return chaoskit.InjectorTypeGlobal // Works globally via failpoint runtime
@@ -154,6 +165,14 @@ func (f *FailpointPanicInjector) GetMetrics() map[string]interface{} {
 		"window":           f.window.String(),
 		"active_count":     activeCount,
 		"total_failpoints": len(f.failpoints),
-		"stopped":          f.stopped,
+		"stopped":          f.lifecycle.Stopped(),
 	}
 }
+
+// ScaleIntensity implements IntensityScaler
+func (f *FailpointPanicInjector) ScaleIntensity(factor float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.probability = scaleProbability(f.probability, factor)
+}
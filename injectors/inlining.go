@@ -0,0 +1,21 @@
+package injectors
+
+import "runtime"
+
+// InliningDisabled reports whether the binary was built with inlining
+// disabled (-gcflags=all=-l), as monkey patch injectors require
+func InliningDisabled() bool {
+	pc := inliningProbe()
+	frame, _ := runtime.CallersFrames([]uintptr{pc + 1}).Next()
+
+	// Runtime frames of inlined functions have no Func
+	return frame.Func != nil
+}
+
+// inliningProbe returns its own program counter; it is small enough to be
+// inlined into its caller unless inlining is disabled
+func inliningProbe() uintptr {
+	pc, _, _, _ := runtime.Caller(0)
+
+	return pc
+}
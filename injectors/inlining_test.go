@@ -0,0 +1,22 @@
+package injectors
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+func TestInliningDisabled(t *testing.T) {
+	want := false
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "-gcflags" && strings.Contains(setting.Value, "all=-l") {
+				want = true
+			}
+		}
+	}
+
+	if got := InliningDisabled(); got != want {
+		t.Errorf("InliningDisabled() = %v, want %v (from build settings)", got, want)
+	}
+}
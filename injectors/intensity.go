@@ -0,0 +1,6 @@
+package injectors
+
+// scaleProbability multiplies a fault probability by factor, keeping it within [0, 1]
+func scaleProbability(probability, factor float64) float64 {
+	return min(max(probability*factor, 0), 1)
+}
@@ -0,0 +1,330 @@
+package injectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/kube"
+)
+
+// IstioAPIVersion is the API version of the VirtualServices managed by IstioFaultInjector
+const IstioAPIVersion = "networking.istio.io/v1beta1"
+
+// DefaultIstioAbortStatus is the HTTP status of aborted requests by default
+const DefaultIstioAbortStatus = http.StatusServiceUnavailable
+
+// istioManagedLabel marks the VirtualServices created by the injector
+const istioManagedLabel = "app.kubernetes.io/managed-by"
+
+// IstioFaultConfig configures the faults injected by Istio into the requests
+// to a mesh service. Percentages are of requests, from 0 to 100.
+type IstioFaultConfig struct {
+	// VirtualService names the VirtualService routing the host (default: the
+	// first label of the host); it is created for the run when missing
+	VirtualService string
+
+	DelayPercent float64
+	Delay        time.Duration
+
+	AbortPercent float64
+	AbortStatus  int // DefaultIstioAbortStatus when zero
+}
+
+// IstioFaultInjector injects delays and aborts into the requests to a service
+// of an Istio mesh through the fault section of its VirtualService, which
+// reaches services ToxiProxy can't be put in front of. The fault is applied
+// by SetupNetwork and removed by TeardownNetwork: the http routes of an
+// existing VirtualService are restored, one created for the run is deleted.
+type IstioFaultInjector struct {
+	name      string
+	client    *kube.Client
+	namespace string
+	host      string
+	config    IstioFaultConfig
+	original  json.RawMessage // spec.http of the patched VirtualService
+	created   bool
+	applied   bool
+	mu        sync.Mutex
+	lifecycle chaoskit.InjectorLifecycle
+}
+
+// IstioFault creates an injector adding the faults of cfg to the routes of
+// host (e.g. "reviews" or "reviews.shop.svc.cluster.local") in namespace
+func IstioFault(client *kube.Client, namespace, host string, cfg IstioFaultConfig) *IstioFaultInjector {
+	shortHost, _, _ := strings.Cut(host, ".")
+	if cfg.VirtualService == "" {
+		cfg.VirtualService = shortHost
+	}
+	if cfg.AbortStatus == 0 {
+		cfg.AbortStatus = DefaultIstioAbortStatus
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	return &IstioFaultInjector{
+		name:      "istio_fault_" + shortHost,
+		client:    client,
+		namespace: namespace,
+		host:      host,
+		config:    cfg,
+	}
+}
+
+func (i *IstioFaultInjector) Name() string {
+	return i.name
+}
+
+// SetupNetwork implements NetworkInjectorLifecycle: it adds the fault to
+// every http route of the VirtualService, creating it when missing
+func (i *IstioFaultInjector) SetupNetwork(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.applied {
+		return nil
+	}
+	if err := i.validate(); err != nil {
+		return err
+	}
+
+	var vs struct {
+		Metadata struct {
+			ResourceVersion string `json:"resourceVersion"`
+		} `json:"metadata"`
+		Spec struct {
+			HTTP []map[string]any `json:"http"`
+		} `json:"spec"`
+	}
+	err := i.client.Do(ctx, http.MethodGet, i.path(), "", nil, &vs)
+	switch {
+	case kube.IsNotFound(err):
+		if err := i.create(ctx); err != nil {
+			return err
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get VirtualService %s/%s: %w", i.namespace, i.config.VirtualService, err)
+	case len(vs.Spec.HTTP) == 0:
+		return fmt.Errorf("VirtualService %s/%s has no http routes to inject faults into",
+			i.namespace, i.config.VirtualService)
+	default:
+		original, err := json.Marshal(vs.Spec.HTTP)
+		if err != nil {
+			return err
+		}
+		for _, route := range vs.Spec.HTTP {
+			route["fault"] = i.fault()
+		}
+		// The resource version fails the patch if the VirtualService was
+		// changed since it was read, so no concurrent change is restored over
+		patch := map[string]any{
+			"metadata": map[string]any{"resourceVersion": vs.Metadata.ResourceVersion},
+			"spec":     map[string]any{"http": vs.Spec.HTTP},
+		}
+		if err := i.client.Do(ctx, http.MethodPatch, i.path(), kube.MergePatch, patch, nil); err != nil {
+			return fmt.Errorf("failed to patch VirtualService %s/%s: %w", i.namespace, i.config.VirtualService, err)
+		}
+		i.original = original
+	}
+	i.applied = true
+
+	chaoskit.GetLogger(ctx).Info("istio fault applied",
+		slog.String("injector", i.name),
+		slog.String("virtual_service", i.namespace+"/"+i.config.VirtualService),
+		slog.Bool("created", i.created),
+		slog.Float64("delay_percent", i.config.DelayPercent),
+		slog.Float64("abort_percent", i.config.AbortPercent))
+
+	return nil
+}
+
+// create creates a VirtualService routing the host to itself with the fault
+func (i *IstioFaultInjector) create(ctx context.Context) error {
+	vs := map[string]any{
+		"apiVersion": IstioAPIVersion,
+		"kind":       "VirtualService",
+		"metadata": map[string]any{
+			"name":      i.config.VirtualService,
+			"namespace": i.namespace,
+			"labels":    map[string]string{istioManagedLabel: "chaoskit"},
+		},
+		"spec": map[string]any{
+			"hosts": []string{i.host},
+			"http": []map[string]any{{
+				"fault": i.fault(),
+				"route": []map[string]any{{"destination": map[string]any{"host": i.host}}},
+			}},
+		},
+	}
+	path := fmt.Sprintf("/apis/%s/namespaces/%s/virtualservices", IstioAPIVersion, url.PathEscape(i.namespace))
+	if err := i.client.Do(ctx, http.MethodPost, path, "application/json", vs, nil); err != nil {
+		return fmt.Errorf("failed to create VirtualService %s/%s: %w", i.namespace, i.config.VirtualService, err)
+	}
+	i.created = true
+
+	return nil
+}
+
+// TeardownNetwork implements NetworkInjectorLifecycle: it restores the http
+// routes of the VirtualService, or deletes it when it was created for the run
+func (i *IstioFaultInjector) TeardownNetwork(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !i.applied {
+		return nil
+	}
+
+	var err error
+	if i.created {
+		err = i.client.Do(ctx, http.MethodDelete, i.path(), "", nil, nil)
+	} else {
+		patch := map[string]any{"spec": map[string]any{"http": i.original}}
+		err = i.client.Do(ctx, http.MethodPatch, i.path(), kube.MergePatch, patch, nil)
+	}
+	if err != nil && !kube.IsNotFound(err) {
+		return fmt.Errorf("failed to remove fault from VirtualService %s/%s: %w",
+			i.namespace, i.config.VirtualService, err)
+	}
+	i.applied = false
+	i.created = false
+	i.original = nil
+
+	chaoskit.GetLogger(ctx).Info("istio fault removed",
+		slog.String("injector", i.name),
+		slog.String("virtual_service", i.namespace+"/"+i.config.VirtualService))
+
+	return nil
+}
+
+func (i *IstioFaultInjector) Inject(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.lifecycle.Start()
+}
+
+func (i *IstioFaultInjector) Stop(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.lifecycle.Stop()
+
+	return nil
+}
+
+// State implements StatefulInjector
+func (i *IstioFaultInjector) State() chaoskit.InjectorState {
+	return i.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: the fault must still be in the
+// VirtualService, which a GitOps controller may have reverted
+func (i *IstioFaultInjector) HealthCheck(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.lifecycle.State() != chaoskit.InjectorInjecting || !i.applied {
+		return nil
+	}
+
+	var vs struct {
+		Spec struct {
+			HTTP []map[string]any `json:"http"`
+		} `json:"spec"`
+	}
+	if err := i.client.Do(ctx, http.MethodGet, i.path(), "", nil, &vs); err != nil {
+		return err
+	}
+	for _, route := range vs.Spec.HTTP {
+		if _, ok := route["fault"]; !ok {
+			return fmt.Errorf("fault removed from VirtualService %s/%s by another client",
+				i.namespace, i.config.VirtualService)
+		}
+	}
+
+	return nil
+}
+
+// Type implements CategorizedInjector
+func (i *IstioFaultInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeGlobal
+}
+
+// IsGlobal implements GlobalInjector
+func (i *IstioFaultInjector) IsGlobal() bool {
+	return true
+}
+
+// GetMetrics implements MetricsProvider
+func (i *IstioFaultInjector) GetMetrics() map[string]interface{} {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return map[string]interface{}{
+		"host":            i.host,
+		"virtual_service": i.namespace + "/" + i.config.VirtualService,
+		"delay_percent":   i.config.DelayPercent,
+		"delay_ms":        i.config.Delay.Milliseconds(),
+		"abort_percent":   i.config.AbortPercent,
+		"abort_status":    i.config.AbortStatus,
+		"applied":         i.applied,
+		"created":         i.created,
+	}
+}
+
+func (i *IstioFaultInjector) validate() error {
+	var errs []error
+	if i.host == "" {
+		errs = append(errs, errors.New("host is required"))
+	}
+	if i.config.DelayPercent <= 0 && i.config.AbortPercent <= 0 {
+		errs = append(errs, errors.New("a delay or abort percentage is required"))
+	}
+	if i.config.DelayPercent < 0 || i.config.DelayPercent > 100 || i.config.AbortPercent < 0 || i.config.AbortPercent > 100 {
+		errs = append(errs, errors.New("percentages must be between 0 and 100"))
+	}
+	if i.config.DelayPercent > 0 && i.config.Delay < time.Millisecond {
+		errs = append(errs, errors.New("delay must be at least 1ms"))
+	}
+	if i.config.AbortStatus < 200 || i.config.AbortStatus > 599 {
+		errs = append(errs, fmt.Errorf("invalid abort status %d", i.config.AbortStatus))
+	}
+
+	return errors.Join(errs...)
+}
+
+// fault returns the fault section of an http route
+func (i *IstioFaultInjector) fault() map[string]any {
+	fault := make(map[string]any)
+	if i.config.DelayPercent > 0 {
+		fault["delay"] = map[string]any{
+			"percentage": map[string]any{"value": i.config.DelayPercent},
+			// Durations are seconds in the protobuf JSON form
+			"fixedDelay": strconv.FormatFloat(i.config.Delay.Seconds(), 'f', -1, 64) + "s",
+		}
+	}
+	if i.config.AbortPercent > 0 {
+		fault["abort"] = map[string]any{
+			"percentage": map[string]any{"value": i.config.AbortPercent},
+			"httpStatus": i.config.AbortStatus,
+		}
+	}
+
+	return fault
+}
+
+func (i *IstioFaultInjector) path() string {
+	return fmt.Sprintf("/apis/%s/namespaces/%s/virtualservices/%s",
+		IstioAPIVersion, url.PathEscape(i.namespace), url.PathEscape(i.config.VirtualService))
+}
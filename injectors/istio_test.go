@@ -0,0 +1,249 @@
+package injectors
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/kube"
+)
+
+// fakeIstio serves the VirtualServices of the "shop" namespace
+type fakeIstio struct {
+	mu       sync.Mutex
+	services map[string]map[string]any
+	version  int
+}
+
+func newFakeIstio() *fakeIstio {
+	return &fakeIstio{services: make(map[string]map[string]any)}
+}
+
+func (f *fakeIstio) handler() http.Handler {
+	const prefix = "/apis/networking.istio.io/v1beta1/namespaces/shop/virtualservices"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+prefix+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		vs, ok := f.services[r.PathValue("name")]
+		if !ok {
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(vs)
+	})
+	mux.HandleFunc("POST "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		var vs map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&vs)
+		name := vs["metadata"].(map[string]any)["name"].(string)
+		f.services[name] = vs
+		f.bump(name)
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("PATCH "+prefix+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		vs, ok := f.services[r.PathValue("name")]
+		if !ok || r.Header.Get("Content-Type") != kube.MergePatch {
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+			return
+		}
+		var patch struct {
+			Metadata struct {
+				ResourceVersion string `json:"resourceVersion"`
+			} `json:"metadata"`
+			Spec map[string]any `json:"spec"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&patch)
+		metadata := vs["metadata"].(map[string]any)
+		if v := patch.Metadata.ResourceVersion; v != "" && v != metadata["resourceVersion"] {
+			http.Error(w, `{"message":"the object has been modified"}`, http.StatusConflict)
+			return
+		}
+		spec := vs["spec"].(map[string]any)
+		for k, v := range patch.Spec {
+			spec[k] = v
+		}
+		f.bump(r.PathValue("name"))
+	})
+	mux.HandleFunc("DELETE "+prefix+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		delete(f.services, r.PathValue("name"))
+	})
+
+	return mux
+}
+
+func (f *fakeIstio) bump(name string) {
+	f.version++
+	f.services[name]["metadata"].(map[string]any)["resourceVersion"] = strconv.Itoa(f.version)
+}
+
+// routes returns the http routes of a VirtualService, or nil when it doesn't exist
+func (f *fakeIstio) routes(name string) []any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	vs, ok := f.services[name]
+	if !ok {
+		return nil
+	}
+	routes, _ := vs["spec"].(map[string]any)["http"].([]any)
+
+	return routes
+}
+
+func TestIstioFault_PatchesAndRestoresVirtualService(t *testing.T) {
+	fake := newFakeIstio()
+	fake.services["reviews"] = map[string]any{
+		"metadata": map[string]any{"name": "reviews", "resourceVersion": "1"},
+		"spec": map[string]any{
+			"hosts": []any{"reviews"},
+			"http": []any{
+				map[string]any{"match": []any{map[string]any{"uri": map[string]any{"prefix": "/v2"}}},
+					"route": []any{map[string]any{"destination": map[string]any{"host": "reviews", "subset": "v2"}}}},
+				map[string]any{"route": []any{map[string]any{"destination": map[string]any{"host": "reviews", "subset": "v1"}}}},
+			},
+		},
+	}
+	original, _ := json.Marshal(fake.routes("reviews"))
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	ctx := context.Background()
+	injector := IstioFault(kube.NewClient(server.URL, "", nil), "shop", "reviews.shop.svc.cluster.local",
+		IstioFaultConfig{DelayPercent: 50, Delay: 1500 * time.Millisecond, AbortPercent: 10})
+	if err := injector.SetupNetwork(ctx); err != nil {
+		t.Fatalf("SetupNetwork failed: %v", err)
+	}
+
+	routes := fake.routes("reviews")
+	if len(routes) != 2 {
+		t.Fatalf("expected 2 routes, got %d", len(routes))
+	}
+	for _, route := range routes {
+		fault, ok := route.(map[string]any)["fault"].(map[string]any)
+		if !ok {
+			t.Fatalf("route without fault: %v", route)
+		}
+		delay := fault["delay"].(map[string]any)
+		abort := fault["abort"].(map[string]any)
+		if delay["fixedDelay"] != "1.5s" || delay["percentage"].(map[string]any)["value"] != 50.0 {
+			t.Errorf("unexpected delay: %v", delay)
+		}
+		if abort["httpStatus"] != 503.0 || abort["percentage"].(map[string]any)["value"] != 10.0 {
+			t.Errorf("unexpected abort: %v", abort)
+		}
+	}
+
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject failed: %v", err)
+	}
+	if err := injector.HealthCheck(ctx); err != nil {
+		t.Errorf("unexpected health check error: %v", err)
+	}
+	if err := injector.Stop(ctx); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+	if err := injector.TeardownNetwork(ctx); err != nil {
+		t.Fatalf("TeardownNetwork failed: %v", err)
+	}
+
+	restored, _ := json.Marshal(fake.routes("reviews"))
+	if string(restored) != string(original) {
+		t.Errorf("routes not restored:\n got %s\nwant %s", restored, original)
+	}
+}
+
+func TestIstioFault_CreatesAndDeletesVirtualService(t *testing.T) {
+	fake := newFakeIstio()
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	ctx := context.Background()
+	injector := IstioFault(kube.NewClient(server.URL, "", nil), "shop", "ratings",
+		IstioFaultConfig{AbortPercent: 100, AbortStatus: http.StatusBadGateway})
+	if err := injector.SetupNetwork(ctx); err != nil {
+		t.Fatalf("SetupNetwork failed: %v", err)
+	}
+
+	routes := fake.routes("ratings")
+	if len(routes) != 1 {
+		t.Fatalf("expected a created VirtualService with 1 route, got %v", routes)
+	}
+	fault := routes[0].(map[string]any)["fault"].(map[string]any)
+	if _, ok := fault["delay"]; ok {
+		t.Errorf("unexpected delay in %v", fault)
+	}
+	if fault["abort"].(map[string]any)["httpStatus"] != 502.0 {
+		t.Errorf("unexpected abort: %v", fault["abort"])
+	}
+	if metrics := injector.GetMetrics(); metrics["created"] != true {
+		t.Errorf("expected created metric, got %v", metrics)
+	}
+
+	if err := injector.TeardownNetwork(ctx); err != nil {
+		t.Fatalf("TeardownNetwork failed: %v", err)
+	}
+	if _, ok := fake.services["ratings"]; ok {
+		t.Error("created VirtualService not deleted")
+	}
+}
+
+func TestIstioFault_HealthCheckDetectsRevert(t *testing.T) {
+	fake := newFakeIstio()
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	ctx := context.Background()
+	injector := IstioFault(kube.NewClient(server.URL, "", nil), "shop", "ratings",
+		IstioFaultConfig{DelayPercent: 100, Delay: time.Second})
+	if err := injector.SetupNetwork(ctx); err != nil {
+		t.Fatalf("SetupNetwork failed: %v", err)
+	}
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject failed: %v", err)
+	}
+	if injector.State() != chaoskit.InjectorInjecting {
+		t.Fatalf("unexpected state %v", injector.State())
+	}
+
+	fake.mu.Lock()
+	delete(fake.services["ratings"]["spec"].(map[string]any)["http"].([]any)[0].(map[string]any), "fault")
+	fake.mu.Unlock()
+
+	if err := injector.HealthCheck(ctx); err == nil {
+		t.Error("expected health check error after the fault was reverted")
+	}
+}
+
+func TestIstioFault_Validation(t *testing.T) {
+	tests := map[string]IstioFaultConfig{
+		"no fault":       {},
+		"percent > 100":  {AbortPercent: 120},
+		"no delay":       {DelayPercent: 10},
+		"invalid status": {AbortPercent: 10, AbortStatus: 42},
+	}
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			injector := IstioFault(kube.NewClient("http://127.0.0.1:0", "", nil), "shop", "reviews", cfg)
+			if err := injector.SetupNetwork(context.Background()); err == nil {
+				t.Error("expected a validation error")
+			}
+		})
+	}
+}
@@ -14,8 +14,7 @@ type MemoryPressureInjector struct {
 	name      string
 	sizeMB    int
 	mu        sync.Mutex
-	stopCh    chan struct{}
-	stopped   bool
+	lifecycle chaoskit.InjectorLifecycle
 	allocated [][]byte
 }
 
@@ -24,7 +23,6 @@ func MemoryPressure(sizeMB int) *MemoryPressureInjector {
 	return &MemoryPressureInjector{
 		name:   fmt.Sprintf("memory_pressure_%dMB", sizeMB),
 		sizeMB: sizeMB,
-		stopCh: make(chan struct{}),
 	}
 }
 
@@ -36,8 +34,8 @@ func (m *MemoryPressureInjector) Inject(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := m.lifecycle.Start(); err != nil {
+		return err
 	}
 
 	// Allocate memory in chunks
@@ -62,15 +60,18 @@ func (m *MemoryPressureInjector) Stop(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if !m.stopped {
-		close(m.stopCh)
-		m.stopped = true
+	if m.lifecycle.Stop() {
 		m.allocated = nil // Release memory
 	}
 
 	return nil
 }
 
+// State implements StatefulInjector
+func (m *MemoryPressureInjector) State() chaoskit.InjectorState {
+	return m.lifecycle.State()
+}
+
 // Type implements CategorizedInjector
 func (m *MemoryPressureInjector) Type() chaoskit.InjectorType {
 	return chaoskit.InjectorTypeGlobal
@@ -88,7 +89,7 @@ func (m *MemoryPressureInjector) GetMetrics() map[string]interface{} {
 
 	return map[string]interface{}{
 		"size_mb":  m.sizeMB,
-		"stopped":  m.stopped,
+		"stopped":  m.lifecycle.Stopped(),
 		"released": m.allocated == nil,
 	}
 }
@@ -0,0 +1,208 @@
+package injectors
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// DefaultDisconnectDuration is how long a consumer stays disconnected by default
+const DefaultDisconnectDuration = time.Second
+
+// MessagingRule configures the faults of the NATS subjects, AMQP queues or
+// routing keys matching Pattern. Probabilities are per publish or delivery.
+type MessagingRule struct {
+	// Pattern uses NATS wildcards: * matches one dot-separated token and >
+	// the remaining tokens; empty matches every subject
+	Pattern string
+
+	PublishFailure float64
+	Redelivery     float64
+	Reorder        float64
+	Disconnect     float64
+
+	// DisconnectDuration is how long a disconnected consumer stays offline
+	// (DefaultDisconnectDuration when zero)
+	DisconnectDuration time.Duration
+}
+
+// MessagingChaosInjector injects publish failures, redeliveries, out-of-order
+// deliveries and consumer disconnects into the publishers and consumers
+// wrapped with chaoskit.WrapPublish, WrapMessageHandler and WrapDeliveries.
+// The first rule matching a subject applies.
+type MessagingChaosInjector struct {
+	name      string
+	rules     []MessagingRule
+	mu        sync.Mutex
+	lifecycle chaoskit.InjectorLifecycle
+	faults    map[string]int64
+	rng       *rand.Rand // Deterministic random generator from context
+}
+
+// MessagingChaos creates a messaging chaos injector from per-subject rules
+func MessagingChaos(rules ...MessagingRule) *MessagingChaosInjector {
+	name := "messaging_chaos"
+	if len(rules) > 0 && rules[0].Pattern != "" {
+		name += "_" + rules[0].Pattern
+	}
+	for i := range rules {
+		if rules[i].DisconnectDuration <= 0 {
+			rules[i].DisconnectDuration = DefaultDisconnectDuration
+		}
+	}
+
+	return &MessagingChaosInjector{
+		name:   name,
+		rules:  rules,
+		faults: make(map[string]int64),
+	}
+}
+
+func (m *MessagingChaosInjector) Name() string {
+	return m.name
+}
+
+func (m *MessagingChaosInjector) Inject(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.lifecycle.Start(); err != nil {
+		return err
+	}
+
+	// Store deterministic random generator from context
+	m.rng = chaoskit.GetRand(ctx)
+
+	return nil
+}
+
+func (m *MessagingChaosInjector) Stop(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.lifecycle.Stop()
+
+	return nil
+}
+
+// State implements StatefulInjector
+func (m *MessagingChaosInjector) State() chaoskit.InjectorState {
+	return m.lifecycle.State()
+}
+
+// GetMessagingFault implements ChaosMessagingProvider
+func (m *MessagingChaosInjector) GetMessagingFault(
+	op chaoskit.MessagingOp,
+	subject string,
+) (chaoskit.MessagingFault, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lifecycle.Stopped() {
+		return chaoskit.MessagingFault{}, false
+	}
+	rule, ok := m.rule(subject)
+	if !ok {
+		return chaoskit.MessagingFault{}, false
+	}
+
+	// Use stored generator (should be set during Inject)
+	rng := m.rng
+	if rng == nil {
+		rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	var fault chaoskit.MessagingFault
+	switch op {
+	case chaoskit.MessagingPublish:
+		if rng.Float64() < rule.PublishFailure {
+			fault.Kind = chaoskit.InjectionTypePublishFailure
+		}
+	case chaoskit.MessagingDeliver:
+		switch {
+		case rng.Float64() < rule.Disconnect:
+			fault = chaoskit.MessagingFault{Kind: chaoskit.InjectionTypeConsumerDisconnect, Duration: rule.DisconnectDuration}
+		case rng.Float64() < rule.Reorder:
+			fault.Kind = chaoskit.InjectionTypeReorder
+		case rng.Float64() < rule.Redelivery:
+			fault.Kind = chaoskit.InjectionTypeRedelivery
+		}
+	}
+	if fault.Kind == "" {
+		return fault, false
+	}
+	m.faults[fault.Kind]++
+
+	return fault, true
+}
+
+// rule returns the first rule matching subject
+func (m *MessagingChaosInjector) rule(subject string) (MessagingRule, bool) {
+	for _, rule := range m.rules {
+		if matchSubject(rule.Pattern, subject) {
+			return rule, true
+		}
+	}
+
+	return MessagingRule{}, false
+}
+
+// matchSubject matches a dot-separated subject against a pattern with NATS
+// wildcards (* one token, > the remaining tokens)
+func matchSubject(pattern, subject string) bool {
+	if pattern == "" || pattern == ">" {
+		return true
+	}
+
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) || (token != "*" && token != subjectTokens[i]) {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(subjectTokens)
+}
+
+// Type implements CategorizedInjector
+func (m *MessagingChaosInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeContext // Works via the messaging wrappers in user code
+}
+
+// GetMetrics implements MetricsProvider
+func (m *MessagingChaosInjector) GetMetrics() map[string]interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics := map[string]interface{}{
+		"rules":   len(m.rules),
+		"stopped": m.lifecycle.Stopped(),
+	}
+	for kind, count := range m.faults {
+		metrics[kind] = count
+	}
+
+	return metrics
+}
+
+// ScaleIntensity implements IntensityScaler
+func (m *MessagingChaosInjector) ScaleIntensity(factor float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.rules {
+		rule := &m.rules[i]
+		rule.PublishFailure = scaleProbability(rule.PublishFailure, factor)
+		rule.Redelivery = scaleProbability(rule.Redelivery, factor)
+		rule.Reorder = scaleProbability(rule.Reorder, factor)
+		rule.Disconnect = scaleProbability(rule.Disconnect, factor)
+	}
+}
@@ -0,0 +1,89 @@
+package injectors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestMatchSubject(t *testing.T) {
+	tests := []struct {
+		pattern, subject string
+		want             bool
+	}{
+		{"", "orders.created", true},
+		{">", "orders", true},
+		{"orders", "orders", true},
+		{"orders", "orders.created", false},
+		{"orders.*", "orders.created", true},
+		{"orders.*", "orders.created.eu", false},
+		{"orders.>", "orders.created.eu", true},
+		{"orders.>", "orders", false},
+		{"*.created", "payments.created", true},
+		{"orders.*.eu", "orders.paid.us", false},
+	}
+	for _, tt := range tests {
+		if got := matchSubject(tt.pattern, tt.subject); got != tt.want {
+			t.Errorf("matchSubject(%q, %q) = %v, want %v", tt.pattern, tt.subject, got, tt.want)
+		}
+	}
+}
+
+func TestMessagingChaos_Rules(t *testing.T) {
+	injector := MessagingChaos(
+		MessagingRule{Pattern: "orders.*", PublishFailure: 1, Disconnect: 1},
+		MessagingRule{Pattern: "payments", Redelivery: 1},
+	)
+	if err := injector.Inject(context.Background()); err != nil {
+		t.Fatalf("Inject failed: %v", err)
+	}
+
+	if fault, ok := injector.GetMessagingFault(chaoskit.MessagingPublish, "orders.created"); !ok ||
+		fault.Kind != chaoskit.InjectionTypePublishFailure {
+		t.Errorf("expected a publish failure, got %+v", fault)
+	}
+	fault, ok := injector.GetMessagingFault(chaoskit.MessagingDeliver, "orders.created")
+	if !ok || fault.Kind != chaoskit.InjectionTypeConsumerDisconnect || fault.Duration != DefaultDisconnectDuration {
+		t.Errorf("expected a consumer disconnect, got %+v", fault)
+	}
+	if fault, ok := injector.GetMessagingFault(chaoskit.MessagingDeliver, "payments"); !ok ||
+		fault.Kind != chaoskit.InjectionTypeRedelivery {
+		t.Errorf("expected a redelivery, got %+v", fault)
+	}
+	if _, ok := injector.GetMessagingFault(chaoskit.MessagingPublish, "payments"); ok {
+		t.Error("unexpected publish fault without a publish failure probability")
+	}
+	if _, ok := injector.GetMessagingFault(chaoskit.MessagingDeliver, "audit"); ok {
+		t.Error("unexpected fault for a subject without a rule")
+	}
+
+	metrics := injector.GetMetrics()
+	if metrics[chaoskit.InjectionTypePublishFailure] != int64(1) || metrics[chaoskit.InjectionTypeRedelivery] != int64(1) {
+		t.Errorf("unexpected metrics %v", metrics)
+	}
+
+	injector.ScaleIntensity(0)
+	if _, ok := injector.GetMessagingFault(chaoskit.MessagingPublish, "orders.created"); ok {
+		t.Error("unexpected fault at zero intensity")
+	}
+
+	if err := injector.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+}
+
+func TestMessagingChaos_StoppedInjectsNothing(t *testing.T) {
+	injector := MessagingChaos(MessagingRule{Reorder: 1, DisconnectDuration: time.Minute})
+	if err := injector.Inject(context.Background()); err != nil {
+		t.Fatalf("Inject failed: %v", err)
+	}
+	if fault, ok := injector.GetMessagingFault(chaoskit.MessagingDeliver, "any"); !ok || fault.Kind != chaoskit.InjectionTypeReorder {
+		t.Errorf("expected a reorder, got %+v", fault)
+	}
+	_ = injector.Stop(context.Background())
+	if _, ok := injector.GetMessagingFault(chaoskit.MessagingDeliver, "any"); ok {
+		t.Error("unexpected fault after Stop")
+	}
+}
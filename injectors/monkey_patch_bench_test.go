@@ -0,0 +1,89 @@
+//go:build !disable_monkey_patching
+
+package injectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// Benchmarks of calls to patched functions when no chaos is injected, the
+// overhead a patch adds to every call of its target. Run with inlining
+// disabled, like the patches themselves:
+//
+//	go test -gcflags=all=-l -run '^$' -bench MonkeyPatch ./injectors/
+
+var (
+	benchCtxFunc = func(ctx context.Context) error {
+		return nil
+	}
+
+	benchResultFunc = func(n int) (int, error) {
+		return n, nil
+	}
+)
+
+func BenchmarkMonkeyPatch_Unpatched(b *testing.B) {
+	ctx := context.Background()
+	for i := 0; i < b.N; i++ {
+		_ = benchCtxFunc(ctx)
+	}
+}
+
+func BenchmarkMonkeyPatch_ErrorTrampoline(b *testing.B) {
+	benchmarkPatched(b, MonkeyPatchError([]ErrorPatchTarget{
+		{Func: &benchCtxFunc, Error: errors.New("injected"), Probability: 0},
+	}), func() { _ = benchCtxFunc(context.Background()) })
+}
+
+func BenchmarkMonkeyPatch_ErrorReflect(b *testing.B) {
+	benchmarkPatched(b, MonkeyPatchError([]ErrorPatchTarget{
+		{Func: &benchResultFunc, Error: errors.New("injected"), Probability: 0},
+	}), func() { _, _ = benchResultFunc(1) })
+}
+
+func BenchmarkMonkeyPatch_DelayTrampoline(b *testing.B) {
+	benchmarkPatched(b, MonkeyPatchDelay([]DelayPatchTarget{
+		{Func: &benchCtxFunc, MinDelay: time.Millisecond, MaxDelay: time.Millisecond, Probability: 0},
+	}), func() { _ = benchCtxFunc(context.Background()) })
+}
+
+func BenchmarkMonkeyPatch_PanicTrampoline(b *testing.B) {
+	benchmarkPatched(b, MonkeyPatchPanic([]PatchTarget{
+		{Func: &benchCtxFunc, Probability: 0},
+	}), func() { _ = benchCtxFunc(context.Background()) })
+}
+
+func BenchmarkMonkeyPatch_Timeout(b *testing.B) {
+	benchmarkPatched(b, MonkeyPatchTimeout([]TimeoutPatchTarget{
+		{Func: &benchCtxFunc, Timeout: time.Second, Probability: 0},
+	}), func() { _ = benchCtxFunc(context.Background()) })
+}
+
+func BenchmarkMonkeyPatch_ValueCorruption(b *testing.B) {
+	benchmarkPatched(b, MonkeyPatchValueCorruption([]ValueCorruptionPatchTarget{
+		{Func: &benchResultFunc, CorruptFunc: func(n int, err error) (int, error) { return -n, err }, Probability: 0},
+	}), func() { _, _ = benchResultFunc(1) })
+}
+
+// benchmarkPatched measures call while injector is injected
+func benchmarkPatched(b *testing.B, injector interface {
+	Inject(ctx context.Context) error
+	Stop(ctx context.Context) error
+}, call func()) {
+	b.Helper()
+
+	ctx := context.Background()
+	if err := injector.Inject(ctx); err != nil {
+		b.Fatalf("inject: %v", err)
+	}
+	defer func() { _ = injector.Stop(ctx) }()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		call()
+	}
+}
@@ -2,14 +2,34 @@ package injectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"reflect"
+	"slices"
 	"sync"
 
 	"github.com/rom8726/chaoskit"
 )
 
+// ErrFunctionPatched is returned when patching a function patched by
+// another run, e.g. by a parallel test
+var ErrFunctionPatched = errors.New("function is already monkey patched by another run")
+
+// activePatches holds the applied patches by function variable address.
+// Patches of one run (see chaoskit.ExecutionID) stack and are restored in
+// reverse order; patches of other runs, or applied outside runs, are refused,
+// as restoring interleaved patches would leave stale replacements behind.
+var activePatches = struct {
+	mu     sync.Mutex
+	byFunc map[uintptr][]*patchClaim
+}{byFunc: make(map[uintptr][]*patchClaim)}
+
+// patchClaim is an applied patch of a function (shared by copies of its handle)
+type patchClaim struct {
+	owner string
+}
+
 // PatchHandle represents a handle to a patched function
 type PatchHandle struct {
 	Func        interface{}   // Pointer to function
@@ -17,6 +37,8 @@ type PatchHandle struct {
 	RestoreFunc func()        // Function to restore original
 	Patched     bool          // Whether currently patched
 	Data        interface{}   // Optional additional data (e.g., counters)
+
+	claim *patchClaim
 }
 
 // PatchManager manages a collection of patches
@@ -72,42 +94,78 @@ func CreatePatch(funcPtr interface{}) (PatchHandle, error) {
 	}, nil
 }
 
-// ApplyPatch applies a replacement function to a patch handle
-// replacementFunc is called with original args and should return modified results
-func ApplyPatch(handle *PatchHandle, replacementFunc func(args []reflect.Value) []reflect.Value) error {
-	funcVal := reflect.ValueOf(handle.Func)
-	elem := funcVal.Elem()
+// claimFunction reserves funcPtr for a patch of the run owner, failing with
+// ErrFunctionPatched while patches of other runs are applied
+func claimFunction(funcPtr interface{}, owner string) (*patchClaim, error) {
+	addr := reflect.ValueOf(funcPtr).Pointer()
 
-	originalType := reflect.TypeOf(handle.Original.Interface())
-	originalNumIn := originalType.NumIn()
-	originalNumOut := originalType.NumOut()
+	activePatches.mu.Lock()
+	defer activePatches.mu.Unlock()
 
-	// Create replacement function type
-	inTypes := make([]reflect.Type, originalNumIn)
-	for i := 0; i < originalNumIn; i++ {
-		inTypes[i] = originalType.In(i)
+	for _, claim := range activePatches.byFunc[addr] {
+		if owner == "" || claim.owner != owner {
+			return nil, fmt.Errorf("%w: %s", ErrFunctionPatched, reflect.TypeOf(funcPtr).Elem())
+		}
 	}
+	claim := &patchClaim{owner: owner}
+	activePatches.byFunc[addr] = append(activePatches.byFunc[addr], claim)
+
+	return claim, nil
+}
 
-	outTypes := make([]reflect.Type, originalNumOut)
-	for i := 0; i < originalNumOut; i++ {
-		outTypes[i] = originalType.Out(i)
+// releaseFunction removes the claim of a restored patch of funcPtr
+func releaseFunction(funcPtr interface{}, claim *patchClaim) {
+	addr := reflect.ValueOf(funcPtr).Pointer()
+
+	activePatches.mu.Lock()
+	defer activePatches.mu.Unlock()
+
+	claims := slices.DeleteFunc(activePatches.byFunc[addr], func(c *patchClaim) bool { return c == claim })
+	if len(claims) == 0 {
+		delete(activePatches.byFunc, addr)
+	} else {
+		activePatches.byFunc[addr] = claims
 	}
+}
 
-	replacementType := reflect.FuncOf(inTypes, outTypes, originalType.IsVariadic())
+// ApplyPatch applies a replacement function to a patch handle
+// replacementFunc is called with original args and should return modified results.
+// It fails with ErrFunctionPatched while the function is patched (see ApplyPatchContext).
+func ApplyPatch(handle *PatchHandle, replacementFunc func(args []reflect.Value) []reflect.Value) error {
+	return ApplyPatchContext(context.Background(), handle, replacementFunc)
+}
 
-	// Store original for restoration
-	originalCopy := handle.Original
+// ApplyPatchContext is ApplyPatch within the chaoskit run of ctx: patches of
+// the same run may stack on a function, while patches of other runs (e.g.
+// parallel tests) fail with ErrFunctionPatched instead of corrupting each other
+func ApplyPatchContext(
+	ctx context.Context,
+	handle *PatchHandle,
+	replacementFunc func(args []reflect.Value) []reflect.Value,
+) error {
+	sig := signatureOf(handle.Original.Type())
+
+	return setPatch(ctx, handle, reflect.MakeFunc(sig.typ, replacementFunc))
+}
+
+// applyInterceptPatch patches handle with a trampoline calling intercept
+// around the original (see interceptTrampoline)
+func applyInterceptPatch(ctx context.Context, handle *PatchHandle, intercept patchIntercept) error {
+	return setPatch(ctx, handle, interceptTrampoline(ctx, handle.Original, intercept))
+}
 
-	// Create replacement implementation
-	replacement := reflect.MakeFunc(replacementType, func(args []reflect.Value) []reflect.Value {
-		// Call replacement function which should handle the logic
-		return replacementFunc(args)
-	})
+// setPatch claims the function of handle for the run of ctx and replaces it
+func setPatch(ctx context.Context, handle *PatchHandle, replacement reflect.Value) error {
+	claim, err := claimFunction(handle.Func, chaoskit.ExecutionID(ctx))
+	if err != nil {
+		return err
+	}
+	handle.claim = claim
 
-	// Create restore function
+	// Store original for restoration
+	elem := reflect.ValueOf(handle.Func).Elem()
+	originalCopy := handle.Original
 	handle.RestoreFunc = func() {
-		funcVal := reflect.ValueOf(handle.Func)
-		elem := funcVal.Elem()
 		elem.Set(originalCopy)
 	}
 
@@ -127,6 +185,7 @@ func RestorePatch(handle *PatchHandle) error {
 	if handle.RestoreFunc != nil {
 		handle.RestoreFunc()
 		handle.Patched = false
+		releaseFunction(handle.Func, handle.claim)
 	}
 
 	return nil
@@ -195,6 +254,15 @@ func (pm *PatchManager) RollbackPatches(upTo int) {
 	}
 }
 
+// DropRestored forgets restored patches, so a restarted injector indexes
+// only the patches of its current run
+func (pm *PatchManager) DropRestored() {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	pm.patches = slices.DeleteFunc(pm.patches, func(handle PatchHandle) bool { return !handle.Patched })
+}
+
 // GetActivePatchCount returns the number of active patches
 func (pm *PatchManager) GetActivePatchCount() int {
 	pm.mu.Lock()
@@ -210,6 +278,16 @@ func (pm *PatchManager) GetActivePatchCount() int {
 	return count
 }
 
+// CheckActive returns an error unless want patches are active, e.g. when a
+// patch was restored behind the injector's back
+func (pm *PatchManager) CheckActive(want int) error {
+	if active := pm.GetActivePatchCount(); active < want {
+		return fmt.Errorf("only %d of %d patches are active", active, want)
+	}
+
+	return nil
+}
+
 // GetPatches returns all patches
 func (pm *PatchManager) GetPatches() []PatchHandle {
 	pm.mu.Lock()
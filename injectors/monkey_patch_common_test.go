@@ -2,8 +2,11 @@ package injectors
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
+
+	"github.com/rom8726/chaoskit"
 )
 
 // Test functions for testing
@@ -122,6 +125,7 @@ func TestApplyPatch(t *testing.T) {
 	if err != nil {
 		t.Fatalf("ApplyPatch() error = %v", err)
 	}
+	defer RestorePatch(&handle)
 
 	if !handle.Patched {
 		t.Error("ApplyPatch() Patched should be true after applying")
@@ -254,3 +258,49 @@ func TestPatchManagerRollback(t *testing.T) {
 		t.Errorf("GetActivePatchCount() after rollback = %d, want 1", pm.GetActivePatchCount())
 	}
 }
+
+var testFuncShared = func() int {
+	return 7
+}
+
+func TestApplyPatchContext_RunOwnership(t *testing.T) {
+	var innerErr, outsideErr error
+	scenario := chaoskit.NewScenario("outer").
+		WithTarget(nopTarget{}).
+		Inject("panic", MonkeyPatchPanic([]PatchTarget{{Func: &testFuncShared, Probability: 1}})).
+		Inject("delay", MonkeyPatchDelay([]DelayPatchTarget{{Func: &testFuncShared, Probability: 0}})).
+		Step("parallel-run", func(ctx context.Context, target chaoskit.Target) error {
+			inner := chaoskit.NewScenario("inner").
+				WithTarget(nopTarget{}).
+				Inject("panic", MonkeyPatchPanic([]PatchTarget{{Func: &testFuncShared, Probability: 0}})).
+				Step("noop", func(ctx context.Context, target chaoskit.Target) error { return nil }).
+				Build()
+			innerErr = chaoskit.NewExecutor().Run(context.Background(), inner)
+
+			handle, _ := CreatePatch(&testFuncShared)
+			outsideErr = ApplyPatch(&handle, func(args []reflect.Value) []reflect.Value { return nil })
+
+			return nil
+		}).
+		Build()
+
+	if err := chaoskit.NewExecutor().Run(context.Background(), scenario); err != nil {
+		t.Fatalf("Run() error = %v (patches of one run should stack)", err)
+	}
+	if !errors.Is(innerErr, ErrFunctionPatched) {
+		t.Errorf("other run error = %v, want %v", innerErr, ErrFunctionPatched)
+	}
+	if !errors.Is(outsideErr, ErrFunctionPatched) {
+		t.Errorf("ApplyPatch() outside runs error = %v, want %v", outsideErr, ErrFunctionPatched)
+	}
+	if got := testFuncShared(); got != 7 {
+		t.Errorf("testFuncShared() after run = %d, want 7 (stacked patches restored)", got)
+	}
+
+	// The function is free again
+	handle, _ := CreatePatch(&testFuncShared)
+	if err := ApplyPatch(&handle, func(args []reflect.Value) []reflect.Value { return handle.Original.Call(args) }); err != nil {
+		t.Fatalf("ApplyPatch() after run error = %v", err)
+	}
+	_ = RestorePatch(&handle)
+}
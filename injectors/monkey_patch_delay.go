@@ -5,8 +5,9 @@ import (
 	"fmt"
 	"log/slog"
 	"math/rand"
-	"reflect"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rom8726/chaoskit"
@@ -38,7 +39,7 @@ type MonkeyPatchDelayInjector struct {
 	patchManager *PatchManager
 	delayCounts  map[interface{}]*int64 // Map from function pointer to delay count
 	mu           sync.Mutex
-	stopped      bool
+	lifecycle    chaoskit.InjectorLifecycle
 }
 
 // DelayPatchTarget defines a function to patch and delay parameters
@@ -85,13 +86,21 @@ func (m *MonkeyPatchDelayInjector) Name() string {
 	return m.name
 }
 
-func (m *MonkeyPatchDelayInjector) Inject(ctx context.Context) error {
+func (m *MonkeyPatchDelayInjector) Inject(ctx context.Context) (err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := m.lifecycle.Start(); err != nil {
+		return err
 	}
+	// A failed start leaves nothing patched, so it can be injected again
+	defer func() {
+		if err != nil {
+			m.patchManager.RestoreAllPatches(ctx, nil)
+			m.lifecycle.Stop()
+		}
+	}()
+	m.patchManager.DropRestored()
 
 	// Validate and prepare patches
 	for i, target := range m.targets {
@@ -117,8 +126,8 @@ func (m *MonkeyPatchDelayInjector) Inject(ctx context.Context) error {
 		}
 
 		// Initialize delay counter
-		var count int64
-		m.delayCounts[target.Func] = &count
+		count := new(int64)
+		m.delayCounts[target.Func] = count
 
 		// Apply patch with delay logic
 		funcName := GetFuncName(target.Func, target.FuncName)
@@ -126,41 +135,34 @@ func (m *MonkeyPatchDelayInjector) Inject(ctx context.Context) error {
 		minDelay := target.MinDelay
 		maxDelay := target.MaxDelay
 		delayBefore := target.DelayBefore
-		originalCopy := handle.Original
 		rng := chaoskit.GetRand(ctx) // Get deterministic generator from context
 		if rng == nil {
 			rng = rand.New(rand.NewSource(rand.Int63()))
 		}
 
-		if err := ApplyPatch(&handle, func(args []reflect.Value) []reflect.Value {
-			if rng.Float64() < probability {
-				delay := m.calculateDelay(minDelay, maxDelay, rng)
-
-				chaoskit.GetLogger(ctx).Debug("monkey patch delay triggered",
-					slog.String("injector", m.name),
-					slog.String("function", funcName),
-					slog.Duration("delay", delay),
-					slog.Float64("probability", probability))
-
-				if delayBefore {
-					time.Sleep(delay)
-				}
-
-				results := originalCopy.Call(args)
+		if err := applyInterceptPatch(ctx, &handle, func(context.Context) (func(), error) {
+			if rng.Float64() >= probability {
+				return nil, nil
+			}
+			delay := m.calculateDelay(minDelay, maxDelay, rng)
 
-				if !delayBefore {
-					time.Sleep(delay)
-				}
+			chaoskit.GetLogger(ctx).Debug("monkey patch delay triggered",
+				slog.String("injector", m.name),
+				slog.String("function", funcName),
+				slog.Duration("delay", delay),
+				slog.Float64("probability", probability))
 
-				// Increment counter
-				m.mu.Lock()
-				*m.delayCounts[target.Func]++
-				m.mu.Unlock()
+			if delayBefore {
+				time.Sleep(delay)
+				atomic.AddInt64(count, 1)
 
-				return results
+				return nil, nil
 			}
 
-			return originalCopy.Call(args)
+			return func() {
+				time.Sleep(delay)
+				atomic.AddInt64(count, 1)
+			}, nil
 		}); err != nil {
 			m.patchManager.RollbackPatches(i)
 			delete(m.delayCounts, target.Func)
@@ -181,8 +183,10 @@ func (m *MonkeyPatchDelayInjector) Inject(ctx context.Context) error {
 	chaoskit.GetLogger(ctx).Info("monkey patch delay injector started",
 		slog.String("injector", m.name),
 		slog.Int("targets_patched", len(m.targets)))
-	chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
-		slog.String("injector", m.name))
+	if !InliningDisabled() {
+		chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
+			slog.String("injector", m.name))
+	}
 
 	return nil
 }
@@ -191,13 +195,13 @@ func (m *MonkeyPatchDelayInjector) Stop(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if !m.stopped {
+	if m.lifecycle.Stop() {
 		m.patchManager.RestoreAllPatches(ctx, func(handle PatchHandle) string {
 			for _, target := range m.targets {
 				if target.Func == handle.Func {
 					delayCount := int64(0)
 					if countPtr, ok := m.delayCounts[target.Func]; ok {
-						delayCount = *countPtr
+						delayCount = atomic.LoadInt64(countPtr)
 					}
 					name := GetFuncName(target.Func, target.FuncName)
 					chaoskit.GetLogger(ctx).Debug("monkey patch restored",
@@ -211,7 +215,6 @@ func (m *MonkeyPatchDelayInjector) Stop(ctx context.Context) error {
 
 			return ""
 		})
-		m.stopped = true
 		chaoskit.GetLogger(ctx).Info("monkey patch delay injector stopped",
 			slog.String("injector", m.name),
 			slog.String("status", "patches restored"))
@@ -220,6 +223,23 @@ func (m *MonkeyPatchDelayInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (m *MonkeyPatchDelayInjector) State() chaoskit.InjectorState {
+	return m.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: every target must still be patched
+func (m *MonkeyPatchDelayInjector) HealthCheck(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return m.patchManager.CheckActive(len(m.targets))
+}
+
 // calculateDelay calculates a random delay between min and max
 func (m *MonkeyPatchDelayInjector) calculateDelay(min, max time.Duration, rng *rand.Rand) time.Duration {
 	if max <= min {
@@ -244,14 +264,14 @@ func (m *MonkeyPatchDelayInjector) GetMetrics() map[string]interface{} {
 
 	totalDelays := int64(0)
 	for _, countPtr := range m.delayCounts {
-		totalDelays += *countPtr
+		totalDelays += atomic.LoadInt64(countPtr)
 	}
 
 	return map[string]interface{}{
 		"total_targets":  len(m.targets),
 		"active_patches": m.patchManager.GetActivePatchCount(),
 		"total_delays":   totalDelays,
-		"stopped":        m.stopped,
+		"stopped":        m.lifecycle.Stopped(),
 	}
 }
 
@@ -262,7 +282,7 @@ func (m *MonkeyPatchDelayInjector) GetDelayCount() int64 {
 
 	total := int64(0)
 	for _, countPtr := range m.delayCounts {
-		total += *countPtr
+		total += atomic.LoadInt64(countPtr)
 	}
 
 	return total
@@ -274,8 +294,20 @@ func (m *MonkeyPatchDelayInjector) GetDelayCountForTarget(targetFunc interface{}
 	defer m.mu.Unlock()
 
 	if countPtr, ok := m.delayCounts[targetFunc]; ok {
-		return *countPtr, true
+		return atomic.LoadInt64(countPtr), true
 	}
 
 	return 0, false
 }
+
+// ScaleIntensity implements IntensityScaler; it applies to patches made by later Inject calls
+func (m *MonkeyPatchDelayInjector) ScaleIntensity(factor float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The targets may be shared with the caller
+	m.targets = slices.Clone(m.targets)
+	for i := range m.targets {
+		m.targets[i].Probability = scaleProbability(m.targets[i].Probability, factor)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"log/slog"
 	"math/rand"
 	"reflect"
+	"slices"
 	"sync"
+	"sync/atomic"
 
 	"github.com/rom8726/chaoskit"
 )
@@ -32,7 +34,7 @@ type MonkeyPatchErrorInjector struct {
 	patchManager *PatchManager
 	errorCounts  map[interface{}]*int64 // Map from function pointer to error count
 	mu           sync.Mutex
-	stopped      bool
+	lifecycle    chaoskit.InjectorLifecycle
 }
 
 // ErrorPatchTarget defines a function to patch and error injection parameters
@@ -77,13 +79,21 @@ func (m *MonkeyPatchErrorInjector) Name() string {
 	return m.name
 }
 
-func (m *MonkeyPatchErrorInjector) Inject(ctx context.Context) error {
+func (m *MonkeyPatchErrorInjector) Inject(ctx context.Context) (err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := m.lifecycle.Start(); err != nil {
+		return err
 	}
+	// A failed start leaves nothing patched, so it can be injected again
+	defer func() {
+		if err != nil {
+			m.patchManager.RestoreAllPatches(ctx, nil)
+			m.lifecycle.Stop()
+		}
+	}()
+	m.patchManager.DropRestored()
 
 	// Validate and prepare patches
 	for i, target := range m.targets {
@@ -109,10 +119,9 @@ func (m *MonkeyPatchErrorInjector) Inject(ctx context.Context) error {
 			return fmt.Errorf("invalid target %d: function must return error as last return value", i)
 		}
 
-		lastOut := funcType.Out(funcType.NumOut() - 1)
-		errorType := reflect.TypeOf((*error)(nil)).Elem()
-		if !lastOut.Implements(errorType) {
-			return fmt.Errorf("invalid target %d: function last return value must be error, got %v", i, lastOut)
+		if !signatureOf(funcType).returnsError {
+			return fmt.Errorf("invalid target %d: function last return value must be error, got %v",
+				i, funcType.Out(funcType.NumOut()-1))
 		}
 
 		handle, err := CreatePatch(target.Func)
@@ -121,61 +130,40 @@ func (m *MonkeyPatchErrorInjector) Inject(ctx context.Context) error {
 		}
 
 		// Initialize error counter
-		var count int64
-		m.errorCounts[target.Func] = &count
+		count := new(int64)
+		m.errorCounts[target.Func] = count
 
 		// Apply patch with error logic
 		funcName := GetFuncName(target.Func, target.FuncName)
 		probability := target.Probability
-		originalCopy := handle.Original
-		originalType := reflect.TypeOf(originalCopy.Interface())
-		originalNumOut := originalType.NumOut()
-
-		// Build outTypes for error replacement
-		outTypes := make([]reflect.Type, originalNumOut)
-		for j := 0; j < originalNumOut; j++ {
-			outTypes[j] = originalType.Out(j)
-		}
-
 		rng := chaoskit.GetRand(ctx) // Get deterministic generator from context
 		if rng == nil {
 			rng = rand.New(rand.NewSource(rand.Int63()))
 		}
 
-		if err := ApplyPatch(&handle, func(args []reflect.Value) []reflect.Value {
-			if rng.Float64() < probability {
-				// Inject error instead of calling original function
-				m.mu.Lock()
-				*m.errorCounts[target.Func]++
-				m.mu.Unlock()
-
-				// Generate error
-				var err error
-				if target.ErrorFunc != nil {
-					err = target.ErrorFunc()
-				} else {
-					err = target.Error
-				}
-
-				chaoskit.GetLogger(ctx).Debug("monkey patch error triggered",
-					slog.String("injector", m.name),
-					slog.String("function", funcName),
-					slog.String("error", err.Error()),
-					slog.Float64("probability", probability))
-
-				// Build return values: return zero values for all except last (error)
-				results := make([]reflect.Value, originalNumOut)
-				for j := 0; j < originalNumOut-1; j++ {
-					results[j] = reflect.Zero(outTypes[j])
-				}
-				// Set error as last return value
-				results[originalNumOut-1] = reflect.ValueOf(err)
-
-				return results
+		if err := applyInterceptPatch(ctx, &handle, func(callCtx context.Context) (func(), error) {
+			if rng.Float64() >= probability {
+				// No error injection, call original function
+				return nil, nil
 			}
+			atomic.AddInt64(count, 1)
+
+			// Generate error
+			var err error
+			if target.ErrorFunc != nil {
+				err = target.ErrorFunc()
+			} else {
+				err = target.Error
+			}
+
+			chaoskit.GetLogger(ctx).Debug("monkey patch error triggered",
+				slog.String("injector", m.name),
+				slog.String("function", funcName),
+				slog.String("error", err.Error()),
+				slog.Float64("probability", probability))
 
-			// No error injection, call original function
-			return originalCopy.Call(args)
+			// Returned with zero values for all other results
+			return nil, chaoskit.NewChaosError(callCtx, m.name, chaoskit.InjectionTypeError, err)
 		}); err != nil {
 			m.patchManager.RollbackPatches(i)
 			delete(m.errorCounts, target.Func)
@@ -194,8 +182,10 @@ func (m *MonkeyPatchErrorInjector) Inject(ctx context.Context) error {
 	chaoskit.GetLogger(ctx).Info("monkey patch error injector started",
 		slog.String("injector", m.name),
 		slog.Int("targets_patched", len(m.targets)))
-	chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
-		slog.String("injector", m.name))
+	if !InliningDisabled() {
+		chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
+			slog.String("injector", m.name))
+	}
 
 	return nil
 }
@@ -204,13 +194,13 @@ func (m *MonkeyPatchErrorInjector) Stop(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if !m.stopped {
+	if m.lifecycle.Stop() {
 		m.patchManager.RestoreAllPatches(ctx, func(handle PatchHandle) string {
 			for _, target := range m.targets {
 				if target.Func == handle.Func {
 					errorCount := int64(0)
 					if countPtr, ok := m.errorCounts[target.Func]; ok {
-						errorCount = *countPtr
+						errorCount = atomic.LoadInt64(countPtr)
 					}
 					name := GetFuncName(target.Func, target.FuncName)
 					chaoskit.GetLogger(ctx).Debug("monkey patch restored",
@@ -224,7 +214,6 @@ func (m *MonkeyPatchErrorInjector) Stop(ctx context.Context) error {
 
 			return ""
 		})
-		m.stopped = true
 		chaoskit.GetLogger(ctx).Info("monkey patch error injector stopped",
 			slog.String("injector", m.name),
 			slog.String("status", "patches restored"))
@@ -233,6 +222,23 @@ func (m *MonkeyPatchErrorInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (m *MonkeyPatchErrorInjector) State() chaoskit.InjectorState {
+	return m.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: every target must still be patched
+func (m *MonkeyPatchErrorInjector) HealthCheck(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return m.patchManager.CheckActive(len(m.targets))
+}
+
 func (m *MonkeyPatchErrorInjector) getErrorDescription(target ErrorPatchTarget) string {
 	if target.ErrorFunc != nil {
 		return "dynamic error"
@@ -253,14 +259,14 @@ func (m *MonkeyPatchErrorInjector) GetMetrics() map[string]interface{} {
 
 	totalErrors := int64(0)
 	for _, countPtr := range m.errorCounts {
-		totalErrors += *countPtr
+		totalErrors += atomic.LoadInt64(countPtr)
 	}
 
 	return map[string]interface{}{
 		"total_targets":  len(m.targets),
 		"active_patches": m.patchManager.GetActivePatchCount(),
 		"total_errors":   totalErrors,
-		"stopped":        m.stopped,
+		"stopped":        m.lifecycle.Stopped(),
 	}
 }
 
@@ -271,8 +277,20 @@ func (m *MonkeyPatchErrorInjector) GetErrorCount() int64 {
 
 	total := int64(0)
 	for _, countPtr := range m.errorCounts {
-		total += *countPtr
+		total += atomic.LoadInt64(countPtr)
 	}
 
 	return total
 }
+
+// ScaleIntensity implements IntensityScaler; it applies to patches made by later Inject calls
+func (m *MonkeyPatchErrorInjector) ScaleIntensity(factor float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The targets may be shared with the caller
+	m.targets = slices.Clone(m.targets)
+	for i := range m.targets {
+		m.targets[i].Probability = scaleProbability(m.targets[i].Probability, factor)
+	}
+}
@@ -138,9 +138,12 @@ func TestMonkeyPatchErrorInjector_ErrorInjection(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error, but got nil")
 	}
-	if err != nil && err.Error() != injectedErr.Error() {
+	if err != nil && !errors.Is(err, injectedErr) {
 		t.Errorf("Error = %v, want %v", err, injectedErr)
 	}
+	if !chaoskit.IsInjected(err) {
+		t.Errorf("IsInjected(%v) = false, want true", err)
+	}
 
 	injector.Stop(ctx)
 }
@@ -288,3 +291,72 @@ func TestMonkeyPatchErrorInjector_Type(t *testing.T) {
 		t.Errorf("Type() = %v, want InjectorTypeHybrid", injector.Type())
 	}
 }
+
+func TestMonkeyPatchErrorInjector_Restart(t *testing.T) {
+	injectedErr := errors.New("injected error")
+	injector := MonkeyPatchError([]ErrorPatchTarget{
+		{
+			Func:        &testErrorFunc,
+			Error:       injectedErr,
+			Probability: 1.0,
+		},
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := injector.Inject(ctx); err != nil {
+			t.Fatalf("Inject() #%d error = %v", i, err)
+		}
+		if err := testErrorFunc(); !errors.Is(err, injectedErr) {
+			t.Errorf("#%d: error = %v, want %v", i, err, injectedErr)
+		}
+		if err := injector.Stop(ctx); err != nil {
+			t.Fatalf("Stop() #%d error = %v", i, err)
+		}
+		if err := testErrorFunc(); err != nil {
+			t.Errorf("#%d: original not restored, error = %v", i, err)
+		}
+		if got := injector.patchManager.GetPatches(); len(got) != 1 {
+			t.Errorf("#%d: %d patches tracked, want 1", i, len(got))
+		}
+	}
+}
+
+func TestMonkeyPatchErrorInjector_FailedInjectRestores(t *testing.T) {
+	injector := MonkeyPatchError([]ErrorPatchTarget{
+		{Func: &testErrorFunc, Error: errors.New("test"), Probability: 1.0},
+		{Func: &testErrorFuncWithResult, Error: errors.New("test"), Probability: 2.0},
+	})
+
+	ctx := context.Background()
+	if err := injector.Inject(ctx); err == nil {
+		t.Fatal("Inject() with an invalid target succeeded")
+	}
+	if err := testErrorFunc(); err != nil {
+		t.Errorf("first target left patched, error = %v", err)
+	}
+	if injector.State() != chaoskit.InjectorStopped {
+		t.Errorf("State() = %s, want stopped", injector.State())
+	}
+}
+
+func TestMonkeyPatchErrorInjector_HealthCheck(t *testing.T) {
+	injector := MonkeyPatchError([]ErrorPatchTarget{
+		{Func: &testErrorFunc, Error: errors.New("test"), Probability: 1.0},
+	})
+
+	ctx := context.Background()
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject() error = %v", err)
+	}
+	defer func() { _ = injector.Stop(ctx) }()
+	if err := injector.HealthCheck(ctx); err != nil {
+		t.Fatalf("HealthCheck() = %v", err)
+	}
+
+	// Restored behind the injector's back
+	injector.patchManager.RestoreAllPatches(ctx, nil)
+	if err := injector.HealthCheck(ctx); err == nil {
+		t.Fatal("expected an unhealthy injector after its patch was restored")
+	}
+}
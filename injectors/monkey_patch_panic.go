@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"math/rand"
-	"reflect"
+	"slices"
 	"sync"
 
 	"github.com/rom8726/chaoskit"
@@ -36,7 +36,7 @@ type MonkeyPatchPanicInjector struct {
 	targets      []PatchTarget
 	patchManager *PatchManager
 	mu           sync.Mutex
-	stopped      bool
+	lifecycle    chaoskit.InjectorLifecycle
 }
 
 // PatchTarget defines a function to patch and panic probability
@@ -76,13 +76,21 @@ func (m *MonkeyPatchPanicInjector) Name() string {
 	return m.name
 }
 
-func (m *MonkeyPatchPanicInjector) Inject(ctx context.Context) error {
+func (m *MonkeyPatchPanicInjector) Inject(ctx context.Context) (err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := m.lifecycle.Start(); err != nil {
+		return err
 	}
+	// A failed start leaves nothing patched, so it can be injected again
+	defer func() {
+		if err != nil {
+			m.patchManager.RestoreAllPatches(ctx, nil)
+			m.lifecycle.Stop()
+		}
+	}()
+	m.patchManager.DropRestored()
 
 	// Validate and prepare patches
 	for i, target := range m.targets {
@@ -103,13 +111,12 @@ func (m *MonkeyPatchPanicInjector) Inject(ctx context.Context) error {
 		funcName := GetFuncName(target.Func, target.FuncName)
 		panicMsg := m.getPanicMessage(target)
 		probability := target.Probability
-		originalCopy := handle.Original
 		rng := chaoskit.GetRand(ctx) // Get deterministic generator from context
 		if rng == nil {
 			rng = rand.New(rand.NewSource(rand.Int63()))
 		}
 
-		if err := ApplyPatch(&handle, func(args []reflect.Value) []reflect.Value {
+		if err := applyInterceptPatch(ctx, &handle, func(context.Context) (func(), error) {
 			if rng.Float64() < probability {
 				chaoskit.GetLogger(ctx).Debug("monkey patch panic triggered",
 					slog.String("injector", m.name),
@@ -118,7 +125,7 @@ func (m *MonkeyPatchPanicInjector) Inject(ctx context.Context) error {
 				panic(panicMsg)
 			}
 
-			return originalCopy.Call(args)
+			return nil, nil
 		}); err != nil {
 			// Rollback already applied patches
 			m.patchManager.RollbackPatches(i)
@@ -136,8 +143,10 @@ func (m *MonkeyPatchPanicInjector) Inject(ctx context.Context) error {
 	chaoskit.GetLogger(ctx).Info("monkey patch panic injector started",
 		slog.String("injector", m.name),
 		slog.Int("targets_patched", len(m.targets)))
-	chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
-		slog.String("injector", m.name))
+	if !InliningDisabled() {
+		chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
+			slog.String("injector", m.name))
+	}
 
 	return nil
 }
@@ -146,7 +155,7 @@ func (m *MonkeyPatchPanicInjector) Stop(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if !m.stopped {
+	if m.lifecycle.Stop() {
 		m.patchManager.RestoreAllPatches(ctx, func(handle PatchHandle) string {
 			for _, target := range m.targets {
 				if target.Func == handle.Func {
@@ -156,7 +165,6 @@ func (m *MonkeyPatchPanicInjector) Stop(ctx context.Context) error {
 
 			return ""
 		})
-		m.stopped = true
 		chaoskit.GetLogger(ctx).Info("monkey patch panic injector stopped",
 			slog.String("injector", m.name),
 			slog.String("status", "patches restored"))
@@ -165,6 +173,23 @@ func (m *MonkeyPatchPanicInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (m *MonkeyPatchPanicInjector) State() chaoskit.InjectorState {
+	return m.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: every target must still be patched
+func (m *MonkeyPatchPanicInjector) HealthCheck(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return m.patchManager.CheckActive(len(m.targets))
+}
+
 func (m *MonkeyPatchPanicInjector) getPanicMessage(target PatchTarget) string {
 	if target.PanicMessage != "" {
 		return target.PanicMessage
@@ -186,6 +211,18 @@ func (m *MonkeyPatchPanicInjector) GetMetrics() map[string]interface{} {
 	return map[string]interface{}{
 		"total_targets":  len(m.targets),
 		"active_patches": m.patchManager.GetActivePatchCount(),
-		"stopped":        m.stopped,
+		"stopped":        m.lifecycle.Stopped(),
+	}
+}
+
+// ScaleIntensity implements IntensityScaler; it applies to patches made by later Inject calls
+func (m *MonkeyPatchPanicInjector) ScaleIntensity(factor float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The targets may be shared with the caller
+	m.targets = slices.Clone(m.targets)
+	for i := range m.targets {
+		m.targets[i].Probability = scaleProbability(m.targets[i].Probability, factor)
 	}
 }
@@ -134,6 +134,7 @@ func TestMonkeyPatchPanicInjector_PanicInjection(t *testing.T) {
 	if err := injector.Inject(ctx); err != nil {
 		t.Fatalf("Inject() error = %v", err)
 	}
+	defer injector.Stop(ctx)
 
 	// Function should panic
 	defer func() {
@@ -233,7 +234,10 @@ func TestMonkeyPatchPanicInjector_CustomPanicMessage(t *testing.T) {
 	})
 
 	ctx := context.Background()
-	injector.Inject(ctx)
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject() error = %v", err)
+	}
+	defer injector.Stop(ctx)
 
 	defer func() {
 		if r := recover(); r != customMsg {
@@ -6,7 +6,9 @@ import (
 	"log/slog"
 	"math/rand"
 	"reflect"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rom8726/chaoskit"
@@ -33,7 +35,7 @@ type MonkeyPatchTimeoutInjector struct {
 	patchManager  *PatchManager
 	timeoutCounts map[interface{}]*int64 // Map from function pointer to timeout count
 	mu            sync.Mutex
-	stopped       bool
+	lifecycle     chaoskit.InjectorLifecycle
 }
 
 // TimeoutPatchTarget defines a function to patch and timeout parameters
@@ -78,13 +80,21 @@ func (m *MonkeyPatchTimeoutInjector) Name() string {
 	return m.name
 }
 
-func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
+func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) (err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := m.lifecycle.Start(); err != nil {
+		return err
 	}
+	// A failed start leaves nothing patched, so it can be injected again
+	defer func() {
+		if err != nil {
+			m.patchManager.RestoreAllPatches(ctx, nil)
+			m.lifecycle.Stop()
+		}
+	}()
+	m.patchManager.DropRestored()
 
 	// Validate and prepare patches
 	for i, target := range m.targets {
@@ -106,10 +116,9 @@ func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
 			return fmt.Errorf("invalid target %d: function must accept context.Context as first parameter", i)
 		}
 
-		firstParam := funcType.In(0)
-		contextType := reflect.TypeOf((*context.Context)(nil)).Elem()
-		if !firstParam.Implements(contextType) {
-			return fmt.Errorf("invalid target %d: function first parameter must be context.Context, got %v", i, firstParam)
+		sig := signatureOf(funcType)
+		if !sig.contextFirst {
+			return fmt.Errorf("invalid target %d: function first parameter must be context.Context, got %v", i, funcType.In(0))
 		}
 
 		handle, err := CreatePatch(target.Func)
@@ -118,8 +127,8 @@ func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
 		}
 
 		// Initialize timeout counter
-		var count int64
-		m.timeoutCounts[target.Func] = &count
+		count := new(int64)
+		m.timeoutCounts[target.Func] = count
 
 		// Apply patch with timeout logic
 		funcName := GetFuncName(target.Func, target.FuncName)
@@ -130,27 +139,21 @@ func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
 			returnError = context.DeadlineExceeded
 		}
 		originalCopy := handle.Original
-		originalType := reflect.TypeOf(originalCopy.Interface())
-		originalNumOut := originalType.NumOut()
-
-		// Build outTypes for error replacement
-		outTypes := make([]reflect.Type, originalNumOut)
-		for j := 0; j < originalNumOut; j++ {
-			outTypes[j] = originalType.Out(j)
-		}
 
 		rng := chaoskit.GetRand(ctx) // Get deterministic generator from context
 		if rng == nil {
 			rng = rand.New(rand.NewSource(rand.Int63()))
 		}
 
-		if err := ApplyPatch(&handle, func(args []reflect.Value) []reflect.Value {
+		if err := ApplyPatchContext(ctx, &handle, func(args []reflect.Value) []reflect.Value {
 			if rng.Float64() < probability {
 				// Apply timeout: wrap context with timeout
 				ctx := args[0].Interface().(context.Context)
 				timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
 				defer cancel()
 
+				injected := chaoskit.NewChaosError(ctx, m.name, chaoskit.InjectionTypeTimeout, returnError)
+
 				// Replace context in args
 				newArgs := make([]reflect.Value, len(args))
 				copy(newArgs, args)
@@ -166,7 +169,7 @@ func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
 				done := make(chan []reflect.Value, 1)
 
 				go func() {
-					done <- originalCopy.Call(newArgs)
+					done <- sig.call(originalCopy, newArgs)
 				}()
 
 				// Wait for either completion or timeout
@@ -177,15 +180,13 @@ func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
 					// Check if context was cancelled during execution
 					if timeoutCtx.Err() == context.DeadlineExceeded {
 						// Function exceeded timeout, inject error
-						m.mu.Lock()
-						*m.timeoutCounts[target.Func]++
-						m.mu.Unlock()
+						atomic.AddInt64(count, 1)
 
 						// Replace error in results if function returns error
 						for j := range results {
-							if results[j].Type().Implements(reflect.TypeOf((*error)(nil)).Elem()) {
+							if results[j].Type().Implements(errorType) {
 								// Replace error with timeout error
-								errVal := reflect.ValueOf(returnError)
+								errVal := reflect.ValueOf(injected)
 								results[j] = errVal
 
 								break
@@ -199,29 +200,22 @@ func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
 					}
 				case <-timeoutCtx.Done():
 					// Timeout occurred before function completed
-					m.mu.Lock()
-					*m.timeoutCounts[target.Func]++
-					m.mu.Unlock()
+					atomic.AddInt64(count, 1)
 
 					chaoskit.GetLogger(ctx).Debug("timeout occurred",
 						slog.String("injector", m.name),
 						slog.String("function", funcName),
 						slog.String("error", returnError.Error()))
 
-					// Build return values: return zero values for all except error
-					results = make([]reflect.Value, originalNumOut)
-					for j := 0; j < originalNumOut-1; j++ {
-						results[j] = reflect.Zero(outTypes[j])
-					}
-					// Set timeout error as last return value (error)
-					results[originalNumOut-1] = reflect.ValueOf(returnError)
+					// Return zero values for all except the timeout error (last return value)
+					results = sig.errorResults(injected)
 				}
 
 				return results
 			}
 
 			// No timeout, call original function directly
-			return originalCopy.Call(args)
+			return sig.call(originalCopy, args)
 		}); err != nil {
 			m.patchManager.RollbackPatches(i)
 			delete(m.timeoutCounts, target.Func)
@@ -240,8 +234,10 @@ func (m *MonkeyPatchTimeoutInjector) Inject(ctx context.Context) error {
 	chaoskit.GetLogger(ctx).Info("monkey patch timeout injector started",
 		slog.String("injector", m.name),
 		slog.Int("targets_patched", len(m.targets)))
-	chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
-		slog.String("injector", m.name))
+	if !InliningDisabled() {
+		chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
+			slog.String("injector", m.name))
+	}
 
 	return nil
 }
@@ -250,13 +246,13 @@ func (m *MonkeyPatchTimeoutInjector) Stop(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if !m.stopped {
+	if m.lifecycle.Stop() {
 		m.patchManager.RestoreAllPatches(ctx, func(handle PatchHandle) string {
 			for _, target := range m.targets {
 				if target.Func == handle.Func {
 					timeoutCount := int64(0)
 					if countPtr, ok := m.timeoutCounts[target.Func]; ok {
-						timeoutCount = *countPtr
+						timeoutCount = atomic.LoadInt64(countPtr)
 					}
 					name := GetFuncName(target.Func, target.FuncName)
 					chaoskit.GetLogger(ctx).Debug("monkey patch restored",
@@ -270,7 +266,6 @@ func (m *MonkeyPatchTimeoutInjector) Stop(ctx context.Context) error {
 
 			return ""
 		})
-		m.stopped = true
 		chaoskit.GetLogger(ctx).Info("monkey patch timeout injector stopped",
 			slog.String("injector", m.name),
 			slog.String("status", "patches restored"))
@@ -279,6 +274,23 @@ func (m *MonkeyPatchTimeoutInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (m *MonkeyPatchTimeoutInjector) State() chaoskit.InjectorState {
+	return m.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: every target must still be patched
+func (m *MonkeyPatchTimeoutInjector) HealthCheck(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return m.patchManager.CheckActive(len(m.targets))
+}
+
 // Type implements CategorizedInjector
 func (m *MonkeyPatchTimeoutInjector) Type() chaoskit.InjectorType {
 	return chaoskit.InjectorTypeHybrid
@@ -291,14 +303,14 @@ func (m *MonkeyPatchTimeoutInjector) GetMetrics() map[string]interface{} {
 
 	totalTimeouts := int64(0)
 	for _, countPtr := range m.timeoutCounts {
-		totalTimeouts += *countPtr
+		totalTimeouts += atomic.LoadInt64(countPtr)
 	}
 
 	return map[string]interface{}{
 		"total_targets":  len(m.targets),
 		"active_patches": m.patchManager.GetActivePatchCount(),
 		"total_timeouts": totalTimeouts,
-		"stopped":        m.stopped,
+		"stopped":        m.lifecycle.Stopped(),
 	}
 }
 
@@ -309,8 +321,20 @@ func (m *MonkeyPatchTimeoutInjector) GetTimeoutCount() int64 {
 
 	total := int64(0)
 	for _, countPtr := range m.timeoutCounts {
-		total += *countPtr
+		total += atomic.LoadInt64(countPtr)
 	}
 
 	return total
 }
+
+// ScaleIntensity implements IntensityScaler; it applies to patches made by later Inject calls
+func (m *MonkeyPatchTimeoutInjector) ScaleIntensity(factor float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The targets may be shared with the caller
+	m.targets = slices.Clone(m.targets)
+	for i := range m.targets {
+		m.targets[i].Probability = scaleProbability(m.targets[i].Probability, factor)
+	}
+}
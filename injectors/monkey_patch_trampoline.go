@@ -0,0 +1,191 @@
+package injectors
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+var (
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// patchSignature is the reflected signature of a patched function type,
+// computed once per type (see signatureOf)
+type patchSignature struct {
+	typ reflect.Type
+
+	// contextFirst reports a context.Context first parameter
+	contextFirst bool
+
+	// returnsError reports an error last result
+	returnsError bool
+
+	variadic bool
+
+	// zero holds the zero value of every result
+	zero []reflect.Value
+
+	// shape is the unnamed func type of a trampoline without reflection, or nil
+	shape reflect.Type
+}
+
+// trampolineShapes are the function types patched without reflect.MakeFunc
+var trampolineShapes = []reflect.Type{
+	reflect.TypeOf((func())(nil)),
+	reflect.TypeOf((func() error)(nil)),
+	reflect.TypeOf((func(context.Context))(nil)),
+	reflect.TypeOf((func(context.Context) error)(nil)),
+}
+
+// signatures caches patchSignature by function type
+var signatures sync.Map
+
+// signatureOf returns the signature of the function type t
+func signatureOf(t reflect.Type) *patchSignature {
+	if sig, ok := signatures.Load(t); ok {
+		return sig.(*patchSignature)
+	}
+
+	sig := &patchSignature{
+		typ:          t,
+		contextFirst: t.NumIn() > 0 && t.In(0).Implements(contextType),
+		returnsError: t.NumOut() > 0 && t.Out(t.NumOut()-1).Implements(errorType),
+		variadic:     t.IsVariadic(),
+		zero:         make([]reflect.Value, t.NumOut()),
+	}
+	for i := range sig.zero {
+		sig.zero[i] = reflect.Zero(t.Out(i))
+	}
+	for _, shape := range trampolineShapes {
+		if t.ConvertibleTo(shape) {
+			sig.shape = shape
+
+			break
+		}
+	}
+
+	actual, _ := signatures.LoadOrStore(t, sig)
+
+	return actual.(*patchSignature)
+}
+
+// callContext returns the context.Context first argument of a call, or fallback
+func (s *patchSignature) callContext(args []reflect.Value, fallback context.Context) context.Context {
+	if s.contextFirst {
+		if ctx, ok := args[0].Interface().(context.Context); ok && ctx != nil {
+			return ctx
+		}
+	}
+
+	return fallback
+}
+
+// call calls fn with the arguments of a reflect.MakeFunc call
+func (s *patchSignature) call(fn reflect.Value, args []reflect.Value) []reflect.Value {
+	if s.variadic {
+		return fn.CallSlice(args)
+	}
+
+	return fn.Call(args)
+}
+
+// errorResults returns zero results with err as the last one
+func (s *patchSignature) errorResults(err error) []reflect.Value {
+	results := slices.Clone(s.zero)
+	results[len(results)-1] = reflect.ValueOf(err)
+
+	return results
+}
+
+// patchIntercept decides the chaos of one call of a patched function. after,
+// if not nil, runs once the original returned; a non-nil error is returned
+// by the call instead of calling the original (functions returning error only).
+type patchIntercept func(ctx context.Context) (after func(), err error)
+
+// interceptTrampoline builds the replacement of original calling intercept
+// around it. Common signatures (see trampolineShapes) get a plain closure,
+// so calls not injected cost no reflection; the others go through
+// reflect.MakeFunc. fallback is the context of calls without one.
+func interceptTrampoline(
+	fallback context.Context,
+	original reflect.Value,
+	intercept patchIntercept,
+) reflect.Value {
+	sig := signatureOf(original.Type())
+
+	var trampoline any
+	if sig.shape != nil {
+		switch fn := original.Convert(sig.shape).Interface().(type) {
+		case func():
+			trampoline = func() {
+				after, _ := intercept(fallback)
+				fn()
+				if after != nil {
+					after()
+				}
+			}
+		case func() error:
+			trampoline = func() error {
+				after, err := intercept(fallback)
+				if err != nil {
+					return err
+				}
+				err = fn()
+				if after != nil {
+					after()
+				}
+
+				return err
+			}
+		case func(context.Context):
+			trampoline = func(ctx context.Context) {
+				after, _ := intercept(contextOr(ctx, fallback))
+				fn(ctx)
+				if after != nil {
+					after()
+				}
+			}
+		case func(context.Context) error:
+			trampoline = func(ctx context.Context) error {
+				after, err := intercept(contextOr(ctx, fallback))
+				if err != nil {
+					return err
+				}
+				err = fn(ctx)
+				if after != nil {
+					after()
+				}
+
+				return err
+			}
+		}
+	}
+	if trampoline != nil {
+		return reflect.ValueOf(trampoline).Convert(sig.typ)
+	}
+
+	return reflect.MakeFunc(sig.typ, func(args []reflect.Value) []reflect.Value {
+		after, err := intercept(sig.callContext(args, fallback))
+		if err != nil {
+			return sig.errorResults(err)
+		}
+		results := sig.call(original, args)
+		if after != nil {
+			after()
+		}
+
+		return results
+	})
+}
+
+// contextOr returns ctx, or fallback when ctx is nil
+func contextOr(ctx, fallback context.Context) context.Context {
+	if ctx == nil {
+		return fallback
+	}
+
+	return ctx
+}
@@ -0,0 +1,75 @@
+//go:build !disable_monkey_patching
+
+package injectors
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type namedErrorFunc func(ctx context.Context) error
+
+var (
+	trampolineNamed    namedErrorFunc = func(ctx context.Context) error { return nil }
+	trampolineVariadic                = func(prefix string, parts ...string) (string, error) {
+		for _, part := range parts {
+			prefix += part
+		}
+
+		return prefix, nil
+	}
+)
+
+func TestSignatureOf(t *testing.T) {
+	sig := signatureOf(reflect.TypeOf(trampolineNamed))
+	if !sig.contextFirst || !sig.returnsError || sig.shape == nil {
+		t.Fatalf("unexpected signature of %T: %+v", trampolineNamed, sig)
+	}
+	if signatureOf(reflect.TypeOf(trampolineNamed)) != sig {
+		t.Fatal("expected the signature to be cached")
+	}
+
+	sig = signatureOf(reflect.TypeOf(trampolineVariadic))
+	if sig.contextFirst || !sig.returnsError || !sig.variadic || sig.shape != nil {
+		t.Fatalf("unexpected signature of %T: %+v", trampolineVariadic, sig)
+	}
+}
+
+func TestInterceptTrampoline(t *testing.T) {
+	injected := errors.New("injected")
+
+	var inject bool
+	afterCalls := 0
+	intercept := func(ctx context.Context) (func(), error) {
+		if inject {
+			return nil, injected
+		}
+
+		return func() { afterCalls++ }, nil
+	}
+
+	// Named type of a trampoline shape: a plain closure converted to the named type
+	named := interceptTrampoline(context.Background(), reflect.ValueOf(trampolineNamed), intercept).
+		Interface().(namedErrorFunc)
+	if err := named(context.Background()); err != nil || afterCalls != 1 {
+		t.Fatalf("expected the original to be called, got %v (after called %d times)", err, afterCalls)
+	}
+	inject = true
+	if err := named(context.Background()); !errors.Is(err, injected) {
+		t.Fatalf("expected the injected error, got %v", err)
+	}
+
+	// Other signatures go through reflect.MakeFunc
+	inject = false
+	variadic := interceptTrampoline(context.Background(), reflect.ValueOf(trampolineVariadic), intercept).
+		Interface().(func(string, ...string) (string, error))
+	if got, err := variadic("a", "b", "c"); got != "abc" || err != nil {
+		t.Fatalf("expected abc, got %q, %v", got, err)
+	}
+	inject = true
+	if got, err := variadic("a", "b"); got != "" || !errors.Is(err, injected) {
+		t.Fatalf("expected zero results and the injected error, got %q, %v", got, err)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"log/slog"
 	"math/rand"
 	"reflect"
+	"slices"
 	"sync"
+	"sync/atomic"
 
 	"github.com/rom8726/chaoskit"
 )
@@ -32,7 +34,7 @@ type MonkeyPatchValueCorruptionInjector struct {
 	patchManager     *PatchManager
 	corruptionCounts map[interface{}]*int64 // Map from function pointer to corruption count
 	mu               sync.Mutex
-	stopped          bool
+	lifecycle        chaoskit.InjectorLifecycle
 }
 
 // ValueCorruptionPatchTarget defines a function to patch and value corruption parameters
@@ -76,13 +78,21 @@ func (m *MonkeyPatchValueCorruptionInjector) Name() string {
 }
 
 //nolint:lll
-func (m *MonkeyPatchValueCorruptionInjector) Inject(ctx context.Context) error {
+func (m *MonkeyPatchValueCorruptionInjector) Inject(ctx context.Context) (err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := m.lifecycle.Start(); err != nil {
+		return err
 	}
+	// A failed start leaves nothing patched, so it can be injected again
+	defer func() {
+		if err != nil {
+			m.patchManager.RestoreAllPatches(ctx, nil)
+			m.lifecycle.Stop()
+		}
+	}()
+	m.patchManager.DropRestored()
 
 	// Validate and prepare patches
 	for i, target := range m.targets {
@@ -146,13 +156,14 @@ func (m *MonkeyPatchValueCorruptionInjector) Inject(ctx context.Context) error {
 		}
 
 		// Initialize corruption counter
-		var count int64
-		m.corruptionCounts[target.Func] = &count
+		count := new(int64)
+		m.corruptionCounts[target.Func] = count
 
 		// Apply patch with corruption logic
 		funcName := GetFuncName(target.Func, target.FuncName)
 		probability := target.Probability
 		originalCopy := handle.Original
+		sig := signatureOf(funcType)
 
 		// Store corruption function value
 		corruptCopy := reflect.ValueOf(target.CorruptFunc)
@@ -162,16 +173,14 @@ func (m *MonkeyPatchValueCorruptionInjector) Inject(ctx context.Context) error {
 			rng = rand.New(rand.NewSource(rand.Int63()))
 		}
 
-		if err := ApplyPatch(&handle, func(args []reflect.Value) []reflect.Value {
+		if err := ApplyPatchContext(ctx, &handle, func(args []reflect.Value) []reflect.Value {
 			// Call original function first
-			originalResults := originalCopy.Call(args)
+			originalResults := sig.call(originalCopy, args)
 
 			// Check probability
 			if rng.Float64() < probability {
 				// Corrupt return values
-				m.mu.Lock()
-				*m.corruptionCounts[target.Func]++
-				m.mu.Unlock()
+				atomic.AddInt64(count, 1)
 
 				// Call corrupt function with original results
 				corruptedResults := corruptCopy.Call(originalResults)
@@ -203,8 +212,10 @@ func (m *MonkeyPatchValueCorruptionInjector) Inject(ctx context.Context) error {
 	chaoskit.GetLogger(ctx).Info("monkey patch value corruption injector started",
 		slog.String("injector", m.name),
 		slog.Int("targets_patched", len(m.targets)))
-	chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
-		slog.String("injector", m.name))
+	if !InliningDisabled() {
+		chaoskit.GetLogger(ctx).Warn("monkey patching requires -gcflags=all=-l for correct operation",
+			slog.String("injector", m.name))
+	}
 
 	return nil
 }
@@ -213,13 +224,13 @@ func (m *MonkeyPatchValueCorruptionInjector) Stop(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if !m.stopped {
+	if m.lifecycle.Stop() {
 		m.patchManager.RestoreAllPatches(ctx, func(handle PatchHandle) string {
 			for _, target := range m.targets {
 				if target.Func == handle.Func {
 					corruptionCount := int64(0)
 					if countPtr, ok := m.corruptionCounts[target.Func]; ok {
-						corruptionCount = *countPtr
+						corruptionCount = atomic.LoadInt64(countPtr)
 					}
 					name := GetFuncName(target.Func, target.FuncName)
 					chaoskit.GetLogger(ctx).Debug("monkey patch restored",
@@ -233,7 +244,6 @@ func (m *MonkeyPatchValueCorruptionInjector) Stop(ctx context.Context) error {
 
 			return ""
 		})
-		m.stopped = true
 		chaoskit.GetLogger(ctx).Info("monkey patch value corruption injector stopped",
 			slog.String("injector", m.name),
 			slog.String("status", "patches restored"))
@@ -242,6 +252,23 @@ func (m *MonkeyPatchValueCorruptionInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (m *MonkeyPatchValueCorruptionInjector) State() chaoskit.InjectorState {
+	return m.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: every target must still be patched
+func (m *MonkeyPatchValueCorruptionInjector) HealthCheck(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return m.patchManager.CheckActive(len(m.targets))
+}
+
 // Type implements CategorizedInjector
 func (m *MonkeyPatchValueCorruptionInjector) Type() chaoskit.InjectorType {
 	return chaoskit.InjectorTypeHybrid
@@ -254,14 +281,14 @@ func (m *MonkeyPatchValueCorruptionInjector) GetMetrics() map[string]interface{}
 
 	totalCorruptions := int64(0)
 	for _, countPtr := range m.corruptionCounts {
-		totalCorruptions += *countPtr
+		totalCorruptions += atomic.LoadInt64(countPtr)
 	}
 
 	return map[string]interface{}{
 		"total_targets":     len(m.targets),
 		"active_patches":    m.patchManager.GetActivePatchCount(),
 		"total_corruptions": totalCorruptions,
-		"stopped":           m.stopped,
+		"stopped":           m.lifecycle.Stopped(),
 	}
 }
 
@@ -272,8 +299,20 @@ func (m *MonkeyPatchValueCorruptionInjector) GetCorruptionCount() int64 {
 
 	total := int64(0)
 	for _, countPtr := range m.corruptionCounts {
-		total += *countPtr
+		total += atomic.LoadInt64(countPtr)
 	}
 
 	return total
 }
+
+// ScaleIntensity implements IntensityScaler; it applies to patches made by later Inject calls
+func (m *MonkeyPatchValueCorruptionInjector) ScaleIntensity(factor float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The targets may be shared with the caller
+	m.targets = slices.Clone(m.targets)
+	for i := range m.targets {
+		m.targets[i].Probability = scaleProbability(m.targets[i].Probability, factor)
+	}
+}
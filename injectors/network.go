@@ -1,9 +1,13 @@
+//go:build !no_toxiproxy
+
 package injectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sort"
 	"sync"
 	"time"
 
@@ -24,6 +28,34 @@ func NewToxiProxyClient(host string) *ToxiProxyClient {
 	}
 }
 
+// proxyHealth checks that the ToxiProxy server is reachable and the proxy enabled
+func (c *ToxiProxyClient) proxyHealth(proxyName string) (*toxiproxy.Proxy, error) {
+	proxy, err := c.client.Proxy(proxyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proxy %s: %w", proxyName, err)
+	}
+	if !proxy.Enabled {
+		return nil, fmt.Errorf("proxy %s is disabled", proxyName)
+	}
+
+	return proxy, nil
+}
+
+// toxicHealth checks that the toxic is still active on an enabled proxy
+func (c *ToxiProxyClient) toxicHealth(proxyName, toxicName string) error {
+	proxy, err := c.proxyHealth(proxyName)
+	if err != nil {
+		return err
+	}
+	for _, toxic := range proxy.ActiveToxics {
+		if toxic.Name == toxicName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("toxic %s is no longer on proxy %s", toxicName, proxyName)
+}
+
 // ToxiProxyLatencyInjector adds network latency via ToxiProxy
 type ToxiProxyLatencyInjector struct {
 	name      string
@@ -34,7 +66,7 @@ type ToxiProxyLatencyInjector struct {
 	toxicName string
 	proxy     *toxiproxy.Proxy
 	mu        sync.Mutex
-	stopped   bool
+	lifecycle chaoskit.InjectorLifecycle
 }
 
 // ToxiProxyLatency creates a latency injector
@@ -57,13 +89,18 @@ func (t *ToxiProxyLatencyInjector) Name() string {
 	return t.name
 }
 
-func (t *ToxiProxyLatencyInjector) Inject(ctx context.Context) error {
+func (t *ToxiProxyLatencyInjector) Inject(ctx context.Context) (err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := t.lifecycle.Start(); err != nil {
+		return err
 	}
+	defer func() {
+		if err != nil {
+			t.lifecycle.Stop()
+		}
+	}()
 
 	// Get the proxy
 	proxy, err := t.client.client.Proxy(t.proxyName)
@@ -94,7 +131,7 @@ func (t *ToxiProxyLatencyInjector) Stop(ctx context.Context) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped || t.proxy == nil {
+	if t.lifecycle.Stopped() || t.proxy == nil {
 		return nil
 	}
 
@@ -102,7 +139,7 @@ func (t *ToxiProxyLatencyInjector) Stop(ctx context.Context) error {
 		return fmt.Errorf("failed to remove toxic: %w", err)
 	}
 
-	t.stopped = true
+	t.lifecycle.Stop()
 	chaoskit.GetLogger(ctx).Info("toxiproxy latency removed",
 		slog.String("injector", t.name),
 		slog.String("proxy", t.proxyName))
@@ -110,6 +147,23 @@ func (t *ToxiProxyLatencyInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (t *ToxiProxyLatencyInjector) State() chaoskit.InjectorState {
+	return t.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: the toxic must still be on the proxy
+func (t *ToxiProxyLatencyInjector) HealthCheck(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return t.client.toxicHealth(t.proxyName, t.toxicName)
+}
+
 // ToxiProxyBandwidthInjector limits bandwidth via ToxiProxy
 type ToxiProxyBandwidthInjector struct {
 	name      string
@@ -119,7 +173,7 @@ type ToxiProxyBandwidthInjector struct {
 	toxicName string
 	proxy     *toxiproxy.Proxy
 	mu        sync.Mutex
-	stopped   bool
+	lifecycle chaoskit.InjectorLifecycle
 }
 
 // ToxiProxyBandwidth creates a bandwidth limiter injector
@@ -137,13 +191,18 @@ func (t *ToxiProxyBandwidthInjector) Name() string {
 	return t.name
 }
 
-func (t *ToxiProxyBandwidthInjector) Inject(ctx context.Context) error {
+func (t *ToxiProxyBandwidthInjector) Inject(ctx context.Context) (err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := t.lifecycle.Start(); err != nil {
+		return err
 	}
+	defer func() {
+		if err != nil {
+			t.lifecycle.Stop()
+		}
+	}()
 
 	proxy, err := t.client.client.Proxy(t.proxyName)
 	if err != nil {
@@ -170,7 +229,7 @@ func (t *ToxiProxyBandwidthInjector) Stop(ctx context.Context) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped || t.proxy == nil {
+	if t.lifecycle.Stopped() || t.proxy == nil {
 		return nil
 	}
 
@@ -178,7 +237,7 @@ func (t *ToxiProxyBandwidthInjector) Stop(ctx context.Context) error {
 		return fmt.Errorf("failed to remove toxic: %w", err)
 	}
 
-	t.stopped = true
+	t.lifecycle.Stop()
 	chaoskit.GetLogger(ctx).Info("toxiproxy bandwidth limit removed",
 		slog.String("injector", t.name),
 		slog.String("proxy", t.proxyName))
@@ -186,6 +245,23 @@ func (t *ToxiProxyBandwidthInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (t *ToxiProxyBandwidthInjector) State() chaoskit.InjectorState {
+	return t.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: the toxic must still be on the proxy
+func (t *ToxiProxyBandwidthInjector) HealthCheck(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return t.client.toxicHealth(t.proxyName, t.toxicName)
+}
+
 // ToxiProxyTimeoutInjector injects connection timeouts
 type ToxiProxyTimeoutInjector struct {
 	name      string
@@ -195,7 +271,7 @@ type ToxiProxyTimeoutInjector struct {
 	toxicName string
 	proxy     *toxiproxy.Proxy
 	mu        sync.Mutex
-	stopped   bool
+	lifecycle chaoskit.InjectorLifecycle
 }
 
 // ToxiProxyTimeout creates a timeout injector
@@ -213,13 +289,18 @@ func (t *ToxiProxyTimeoutInjector) Name() string {
 	return t.name
 }
 
-func (t *ToxiProxyTimeoutInjector) Inject(ctx context.Context) error {
+func (t *ToxiProxyTimeoutInjector) Inject(ctx context.Context) (err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := t.lifecycle.Start(); err != nil {
+		return err
 	}
+	defer func() {
+		if err != nil {
+			t.lifecycle.Stop()
+		}
+	}()
 
 	proxy, err := t.client.client.Proxy(t.proxyName)
 	if err != nil {
@@ -246,7 +327,7 @@ func (t *ToxiProxyTimeoutInjector) Stop(ctx context.Context) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped || t.proxy == nil {
+	if t.lifecycle.Stopped() || t.proxy == nil {
 		return nil
 	}
 
@@ -254,7 +335,7 @@ func (t *ToxiProxyTimeoutInjector) Stop(ctx context.Context) error {
 		return fmt.Errorf("failed to remove toxic: %w", err)
 	}
 
-	t.stopped = true
+	t.lifecycle.Stop()
 	chaoskit.GetLogger(ctx).Info("toxiproxy timeout removed",
 		slog.String("injector", t.name),
 		slog.String("proxy", t.proxyName))
@@ -262,6 +343,23 @@ func (t *ToxiProxyTimeoutInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (t *ToxiProxyTimeoutInjector) State() chaoskit.InjectorState {
+	return t.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: the toxic must still be on the proxy
+func (t *ToxiProxyTimeoutInjector) HealthCheck(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return t.client.toxicHealth(t.proxyName, t.toxicName)
+}
+
 // ToxiProxySlicerInjector creates intermittent connection drops
 type ToxiProxySlicerInjector struct {
 	name          string
@@ -273,7 +371,7 @@ type ToxiProxySlicerInjector struct {
 	toxicName     string
 	proxy         *toxiproxy.Proxy
 	mu            sync.Mutex
-	stopped       bool
+	lifecycle     chaoskit.InjectorLifecycle
 }
 
 // ToxiProxySlicer creates a slicer injector (intermittent drops)
@@ -298,13 +396,18 @@ func (t *ToxiProxySlicerInjector) Name() string {
 	return t.name
 }
 
-func (t *ToxiProxySlicerInjector) Inject(ctx context.Context) error {
+func (t *ToxiProxySlicerInjector) Inject(ctx context.Context) (err error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := t.lifecycle.Start(); err != nil {
+		return err
 	}
+	defer func() {
+		if err != nil {
+			t.lifecycle.Stop()
+		}
+	}()
 
 	proxy, err := t.client.client.Proxy(t.proxyName)
 	if err != nil {
@@ -335,7 +438,7 @@ func (t *ToxiProxySlicerInjector) Stop(ctx context.Context) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.stopped || t.proxy == nil {
+	if t.lifecycle.Stopped() || t.proxy == nil {
 		return nil
 	}
 
@@ -343,7 +446,7 @@ func (t *ToxiProxySlicerInjector) Stop(ctx context.Context) error {
 		return fmt.Errorf("failed to remove toxic: %w", err)
 	}
 
-	t.stopped = true
+	t.lifecycle.Stop()
 	chaoskit.GetLogger(ctx).Info("toxiproxy slicer removed",
 		slog.String("injector", t.name),
 		slog.String("proxy", t.proxyName))
@@ -351,6 +454,23 @@ func (t *ToxiProxySlicerInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (t *ToxiProxySlicerInjector) State() chaoskit.InjectorState {
+	return t.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: the toxic must still be on the proxy
+func (t *ToxiProxySlicerInjector) HealthCheck(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+
+	return t.client.toxicHealth(t.proxyName, t.toxicName)
+}
+
 // ProxyConfig configures a ToxiProxy proxy
 type ProxyConfig struct {
 	Name     string
@@ -401,14 +521,18 @@ func (m *ToxiProxyManager) CreateProxy(cfg ProxyConfig) error {
 	return nil
 }
 
-// DeleteProxy deletes a proxy
+// DeleteProxy deletes a proxy. Proxies not created by this manager
+// (e.g. left over from a crashed run) are looked up on the server.
 func (m *ToxiProxyManager) DeleteProxy(name string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	proxy, exists := m.proxies[name]
 	if !exists {
-		return fmt.Errorf("proxy %s not found", name)
+		var err error
+		if proxy, err = m.client.client.Proxy(name); err != nil {
+			return fmt.Errorf("proxy %s not found: %w", name, err)
+		}
 	}
 
 	if err := proxy.Delete(); err != nil {
@@ -473,3 +597,101 @@ func (m *ToxiProxyManager) ListProxies() []string {
 
 	return names
 }
+
+// ToxicConfig configures a ToxiProxy toxic
+type ToxicConfig struct {
+	// Name defaults to <type>_<stream> on the server
+	Name string
+
+	// Type is the toxic type (latency, bandwidth, timeout, slicer, ...)
+	Type string
+
+	// Stream is upstream or downstream (default)
+	Stream string
+
+	// Toxicity is the probability of the toxic applying to a connection (0 means 1)
+	Toxicity float32
+
+	// Attributes are the type-specific toxic attributes
+	Attributes map[string]any
+}
+
+// Proxies returns all proxies on the server with their toxics, sorted by name,
+// including proxies not created by this manager
+func (m *ToxiProxyManager) Proxies() ([]*toxiproxy.Proxy, error) {
+	proxies, err := m.client.client.Proxies()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list proxies: %w", err)
+	}
+
+	out := make([]*toxiproxy.Proxy, 0, len(proxies))
+	for _, proxy := range proxies {
+		out = append(out, proxy)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+
+	return out, nil
+}
+
+// AddToxic adds a toxic to a proxy on the server
+func (m *ToxiProxyManager) AddToxic(proxyName string, cfg ToxicConfig) (*toxiproxy.Toxic, error) {
+	proxy, err := m.client.client.Proxy(proxyName)
+	if err != nil {
+		return nil, fmt.Errorf("proxy %s not found: %w", proxyName, err)
+	}
+
+	toxicity := cfg.Toxicity
+	if toxicity == 0 {
+		toxicity = 1
+	}
+
+	toxic, err := proxy.AddToxic(cfg.Name, cfg.Type, cfg.Stream, toxicity, toxiproxy.Attributes(cfg.Attributes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to add %s toxic to proxy %s: %w", cfg.Type, proxyName, err)
+	}
+
+	slog.Info("toxiproxy toxic added",
+		slog.String("proxy", proxyName),
+		slog.String("toxic", toxic.Name),
+		slog.String("type", toxic.Type))
+
+	return toxic, nil
+}
+
+// RemoveToxic removes a toxic from a proxy on the server
+func (m *ToxiProxyManager) RemoveToxic(proxyName, toxicName string) error {
+	proxy, err := m.client.client.Proxy(proxyName)
+	if err != nil {
+		return fmt.Errorf("proxy %s not found: %w", proxyName, err)
+	}
+
+	if err := proxy.RemoveToxic(toxicName); err != nil {
+		return fmt.Errorf("failed to remove toxic %s from proxy %s: %w", toxicName, proxyName, err)
+	}
+
+	slog.Info("toxiproxy toxic removed",
+		slog.String("proxy", proxyName),
+		slog.String("toxic", toxicName))
+
+	return nil
+}
+
+// ClearToxics removes all toxics of a proxy and returns their names
+func (m *ToxiProxyManager) ClearToxics(proxyName string) ([]string, error) {
+	proxy, err := m.client.client.Proxy(proxyName)
+	if err != nil {
+		return nil, fmt.Errorf("proxy %s not found: %w", proxyName, err)
+	}
+
+	var removed []string
+	var errs []error
+	for _, toxic := range proxy.ActiveToxics {
+		if err := proxy.RemoveToxic(toxic.Name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove toxic %s: %w", toxic.Name, err))
+			continue
+		}
+		removed = append(removed, toxic.Name)
+	}
+
+	return removed, errors.Join(errs...)
+}
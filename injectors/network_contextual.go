@@ -1,3 +1,5 @@
+//go:build !no_toxiproxy
+
 package injectors
 
 import (
@@ -21,7 +23,7 @@ type ContextualNetworkInjector struct {
 	applyRate    float64                // 0.0-1.0 probability of applying chaos
 	hostPatterns map[string]NetworkRule // host patterns with specific rules
 	mu           sync.RWMutex
-	stopped      bool
+	lifecycle    chaoskit.InjectorLifecycle
 	rng          *rand.Rand // Deterministic random generator from context
 }
 
@@ -72,10 +74,6 @@ func (c *ContextualNetworkInjector) SetupNetwork(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.stopped {
-		return fmt.Errorf("injector already stopped")
-	}
-
 	// Create proxy if it doesn't exist
 	if err := c.manager.CreateProxy(c.proxyConfig); err != nil {
 		// Proxy might already exist, try to get it
@@ -97,7 +95,7 @@ func (c *ContextualNetworkInjector) TeardownNetwork(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.stopped {
+	if c.lifecycle.Stopped() {
 		return nil
 	}
 
@@ -116,8 +114,8 @@ func (c *ContextualNetworkInjector) Inject(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if c.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := c.lifecycle.Start(); err != nil {
+		return err
 	}
 
 	// Store deterministic random generator from context
@@ -134,8 +132,7 @@ func (c *ContextualNetworkInjector) Stop(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if !c.stopped {
-		c.stopped = true
+	if c.lifecycle.Stop() {
 		chaoskit.GetLogger(ctx).Info("contextual network injector stopped",
 			slog.String("injector", c.name))
 	}
@@ -143,10 +140,25 @@ func (c *ContextualNetworkInjector) Stop(ctx context.Context) error {
 	return nil
 }
 
+// State implements StatefulInjector
+func (c *ContextualNetworkInjector) State() chaoskit.InjectorState {
+	return c.lifecycle.State()
+}
+
+// HealthCheck implements HealthChecker: the proxy must still be enabled
+func (c *ContextualNetworkInjector) HealthCheck(ctx context.Context) error {
+	if c.lifecycle.State() != chaoskit.InjectorInjecting {
+		return nil
+	}
+	_, err := c.manager.client.proxyHealth(c.proxyConfig.Name)
+
+	return err
+}
+
 // ShouldApplyNetworkChaos implements ChaosNetworkProvider
 func (c *ContextualNetworkInjector) ShouldApplyNetworkChaos(host string, port int) bool {
 	c.mu.RLock()
-	stopped := c.stopped
+	stopped := c.lifecycle.Stopped()
 	applyRate := c.applyRate
 	rng := c.rng
 	hostPatterns := make(map[string]NetworkRule)
@@ -182,7 +194,7 @@ func (c *ContextualNetworkInjector) ShouldApplyNetworkChaos(host string, port in
 // GetNetworkLatency implements ChaosNetworkProvider
 func (c *ContextualNetworkInjector) GetNetworkLatency(host string, port int) (time.Duration, bool) {
 	c.mu.RLock()
-	stopped := c.stopped
+	stopped := c.lifecycle.Stopped()
 	rng := c.rng
 	hostPatterns := make(map[string]NetworkRule)
 	for k, v := range c.hostPatterns {
@@ -218,7 +230,7 @@ func (c *ContextualNetworkInjector) GetNetworkLatency(host string, port int) (ti
 // ShouldDropConnection implements ChaosNetworkProvider
 func (c *ContextualNetworkInjector) ShouldDropConnection(host string, port int) bool {
 	c.mu.RLock()
-	stopped := c.stopped
+	stopped := c.lifecycle.Stopped()
 	rng := c.rng
 	hostPatterns := make(map[string]NetworkRule)
 	for k, v := range c.hostPatterns {
@@ -280,6 +292,14 @@ func (c *ContextualNetworkInjector) GetMetrics() map[string]interface{} {
 	return map[string]interface{}{
 		"apply_rate":    c.applyRate,
 		"host_patterns": len(c.hostPatterns),
-		"stopped":       c.stopped,
+		"stopped":       c.lifecycle.Stopped(),
 	}
 }
+
+// ScaleIntensity implements IntensityScaler
+func (c *ContextualNetworkInjector) ScaleIntensity(factor float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.applyRate = scaleProbability(c.applyRate, factor)
+}
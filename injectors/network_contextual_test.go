@@ -1,3 +1,5 @@
+//go:build !no_toxiproxy
+
 package injectors
 
 import (
@@ -0,0 +1,178 @@
+//go:build !no_toxiproxy
+
+package injectors
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	toxiproxy "github.com/Shopify/toxiproxy/v2/client"
+)
+
+// fakeToxiProxy is an in-memory ToxiProxy API covering the calls of ToxiProxyManager
+type fakeToxiProxy struct {
+	mu      sync.Mutex
+	proxies map[string]*toxiproxy.Proxy
+}
+
+func (f *fakeToxiProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	writeJSON := func(v any) { _ = json.NewEncoder(w).Encode(v) }
+	notFound := func() { http.Error(w, `{"error":"proxy not found","status":404}`, http.StatusNotFound) }
+
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodGet:
+		writeJSON(f.proxies)
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		proxy := &toxiproxy.Proxy{}
+		_ = json.NewDecoder(r.Body).Decode(proxy)
+		proxy.ActiveToxics = toxiproxy.Toxics{}
+		f.proxies[proxy.Name] = proxy
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(proxy)
+	case len(parts) == 2:
+		proxy, ok := f.proxies[parts[1]]
+		if !ok {
+			notFound()
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(f.proxies, parts[1])
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(proxy)
+	case len(parts) == 3 && r.Method == http.MethodPost:
+		proxy, ok := f.proxies[parts[1]]
+		if !ok {
+			notFound()
+			return
+		}
+		toxic := toxiproxy.Toxic{}
+		_ = json.NewDecoder(r.Body).Decode(&toxic)
+		proxy.ActiveToxics = append(proxy.ActiveToxics, toxic)
+		writeJSON(toxic)
+	case len(parts) == 4 && r.Method == http.MethodDelete:
+		proxy, ok := f.proxies[parts[1]]
+		if !ok {
+			notFound()
+			return
+		}
+		var kept toxiproxy.Toxics
+		for _, toxic := range proxy.ActiveToxics {
+			if toxic.Name != parts[3] {
+				kept = append(kept, toxic)
+			}
+		}
+		proxy.ActiveToxics = kept
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestToxiProxyManager_ServerProxies(t *testing.T) {
+	fake := &fakeToxiProxy{proxies: map[string]*toxiproxy.Proxy{
+		// Left over from a crashed run
+		"leftover": {Name: "leftover", Listen: "127.0.0.1:20001", Upstream: "db:5432", Enabled: true,
+			ActiveToxics: toxiproxy.Toxics{{Name: "latency_1", Type: "latency"}}},
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	manager := NewToxiProxyManager(NewToxiProxyClient(server.URL))
+	if err := manager.CreateProxy(ProxyConfig{Name: "api", Listen: "127.0.0.1:20002", Upstream: "api:80"}); err != nil {
+		t.Fatalf("CreateProxy: %v", err)
+	}
+
+	proxies, err := manager.Proxies()
+	if err != nil {
+		t.Fatalf("Proxies: %v", err)
+	}
+	if len(proxies) != 2 || proxies[0].Name != "api" || proxies[1].Name != "leftover" {
+		t.Fatalf("expected proxies api and leftover, got %+v", proxies)
+	}
+	if len(proxies[1].ActiveToxics) != 1 {
+		t.Fatalf("expected the leftover toxic, got %+v", proxies[1].ActiveToxics)
+	}
+
+	toxic, err := manager.AddToxic("api", ToxicConfig{Name: "slow", Type: "latency", Attributes: map[string]any{"latency": 100}})
+	if err != nil {
+		t.Fatalf("AddToxic: %v", err)
+	}
+	if toxic.Name != "slow" || toxic.Toxicity != 1 {
+		t.Fatalf("expected toxic slow with toxicity 1, got %+v", toxic)
+	}
+	if err := manager.RemoveToxic("api", "slow"); err != nil {
+		t.Fatalf("RemoveToxic: %v", err)
+	}
+
+	removed, err := manager.ClearToxics("leftover")
+	if err != nil {
+		t.Fatalf("ClearToxics: %v", err)
+	}
+	if len(removed) != 1 || removed[0] != "latency_1" {
+		t.Fatalf("expected latency_1 removed, got %v", removed)
+	}
+
+	// Not created by this manager
+	if err := manager.DeleteProxy("leftover"); err != nil {
+		t.Fatalf("DeleteProxy: %v", err)
+	}
+	if err := manager.DeleteProxy("missing"); err == nil {
+		t.Fatalf("expected an error deleting a missing proxy")
+	}
+	if _, err := manager.AddToxic("missing", ToxicConfig{Type: "latency"}); err == nil {
+		t.Fatalf("expected an error adding a toxic to a missing proxy")
+	}
+
+	proxies, err = manager.Proxies()
+	if err != nil {
+		t.Fatalf("Proxies: %v", err)
+	}
+	if len(proxies) != 1 || len(proxies[0].ActiveToxics) != 0 {
+		t.Fatalf("expected only api without toxics, got %+v", proxies)
+	}
+}
+
+func TestToxiProxyLatency_HealthCheck(t *testing.T) {
+	fake := &fakeToxiProxy{proxies: map[string]*toxiproxy.Proxy{
+		"db": {Name: "db", Listen: "127.0.0.1:20003", Upstream: "db:5432", Enabled: true},
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	injector := ToxiProxyLatency(NewToxiProxyClient(server.URL), "db", 100*time.Millisecond, 0)
+	ctx := context.Background()
+	if err := injector.HealthCheck(ctx); err != nil {
+		t.Fatalf("HealthCheck() before Inject = %v", err)
+	}
+	if err := injector.Inject(ctx); err != nil {
+		t.Fatalf("Inject: %v", err)
+	}
+	if err := injector.HealthCheck(ctx); err != nil {
+		t.Fatalf("HealthCheck() = %v", err)
+	}
+
+	// Toxics cleared by someone else
+	if _, err := NewToxiProxyManager(NewToxiProxyClient(server.URL)).ClearToxics("db"); err != nil {
+		t.Fatalf("ClearToxics: %v", err)
+	}
+	if err := injector.HealthCheck(ctx); err == nil {
+		t.Fatal("expected an unhealthy injector after its toxic was removed")
+	}
+
+	server.Close()
+	if err := injector.HealthCheck(ctx); err == nil {
+		t.Fatal("expected an unhealthy injector without a ToxiProxy server")
+	}
+}
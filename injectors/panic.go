@@ -14,7 +14,7 @@ type PanicInjector struct {
 	name        string
 	probability float64
 	mu          sync.Mutex
-	stopped     bool
+	lifecycle   chaoskit.InjectorLifecycle
 	rng         *rand.Rand // Deterministic random generator from context
 }
 
@@ -34,8 +34,8 @@ func (p *PanicInjector) Inject(ctx context.Context) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	if p.stopped {
-		return fmt.Errorf("injector already stopped")
+	if err := p.lifecycle.Start(); err != nil {
+		return err
 	}
 
 	// Store deterministic random generator from context
@@ -48,17 +48,22 @@ func (p *PanicInjector) Stop(ctx context.Context) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	p.stopped = true
+	p.lifecycle.Stop()
 
 	return nil
 }
 
+// State implements StatefulInjector
+func (p *PanicInjector) State() chaoskit.InjectorState {
+	return p.lifecycle.State()
+}
+
 // ShouldChaosPanic returns true if panic should be triggered based on probability
 func (p *PanicInjector) ShouldChaosPanic() bool {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	if p.stopped {
+	if p.lifecycle.Stopped() {
 		return false
 	}
 
@@ -91,6 +96,14 @@ func (p *PanicInjector) GetMetrics() map[string]interface{} {
 
 	return map[string]interface{}{
 		"probability": p.probability,
-		"stopped":     p.stopped,
+		"stopped":     p.lifecycle.Stopped(),
 	}
 }
+
+// ScaleIntensity implements IntensityScaler
+func (p *PanicInjector) ScaleIntensity(factor float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.probability = scaleProbability(p.probability, factor)
+}
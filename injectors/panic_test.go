@@ -57,3 +57,17 @@ func TestPanicInjector_ShouldChaosPanicRespectsStopped(t *testing.T) {
 		t.Fatalf("should not panic when stopped")
 	}
 }
+
+func TestPanicInjector_ScaleIntensity(t *testing.T) {
+	injector := PanicProbability(0.4)
+
+	injector.ScaleIntensity(0.5)
+	if got := injector.GetPanicProbability(); got != 0.2 {
+		t.Fatalf("probability after scaling by 0.5 = %v, want 0.2", got)
+	}
+
+	injector.ScaleIntensity(10)
+	if got := injector.GetPanicProbability(); got != 1 {
+		t.Fatalf("probability after scaling by 10 = %v, want 1", got)
+	}
+}
@@ -0,0 +1,500 @@
+package injectors
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// DefaultS3StaleWindow is how long reads may miss a write by default
+const DefaultS3StaleWindow = 5 * time.Second
+
+// S3 fault kinds, the types of their injection events
+const (
+	S3FaultSlowDown         = "s3_slow_down"
+	S3FaultPartialRead      = "s3_partial_read"
+	S3FaultStaleRead        = "s3_stale_read"
+	S3FaultMultipartFailure = "s3_multipart_failure"
+)
+
+// maxS3TrackedWrites bounds the writes remembered for stale reads
+const maxS3TrackedWrites = 10000
+
+var (
+	listContentsPattern = regexp.MustCompile(`(?s)<Contents>.*?</Contents>`)
+	listKeyPattern      = regexp.MustCompile(`(?s)<Key>(.*?)</Key>`)
+)
+
+// S3FaultConfig configures the faults of S3FaultInjector. Probabilities are
+// per request, or per listed key for stale listings.
+type S3FaultConfig struct {
+	// SlowDown rejects requests with 503 SlowDown, as S3 does when a prefix
+	// exceeds its request rate
+	SlowDown float64
+
+	// PartialRead ends GetObject bodies early with io.ErrUnexpectedEOF
+	PartialRead float64
+
+	// Staleness makes reads of objects written within StaleWindow miss the
+	// write, as eventually consistent stores do: GetObject and HeadObject
+	// return 404 NoSuchKey and listings leave the key out
+	Staleness   float64
+	StaleWindow time.Duration // DefaultS3StaleWindow when zero
+
+	// MultipartFailure fails UploadPart with 500 InternalError and
+	// CompleteMultipartUpload with an error in a 200 response, as S3 does
+	MultipartFailure float64
+}
+
+// S3FaultInjector injects faults into the requests of S3-compatible clients
+// whose HTTP client is wrapped with WrapS3Client, for chaos testing backup,
+// archival and artifact pipelines without a mock of the storage.
+type S3FaultInjector struct {
+	name      string
+	config    S3FaultConfig
+	mu        sync.Mutex
+	lifecycle chaoskit.InjectorLifecycle
+	writes    map[string]time.Time // object (host and path) -> last write
+	faults    map[string]int64
+	rng       *rand.Rand // Deterministic random generator from context
+}
+
+// S3Fault creates an S3 fault injector
+func S3Fault(cfg S3FaultConfig) *S3FaultInjector {
+	if cfg.StaleWindow <= 0 {
+		cfg.StaleWindow = DefaultS3StaleWindow
+	}
+
+	return &S3FaultInjector{
+		name:   "s3_fault",
+		config: cfg,
+		writes: make(map[string]time.Time),
+		faults: make(map[string]int64),
+	}
+}
+
+func (s *S3FaultInjector) Name() string {
+	return s.name
+}
+
+func (s *S3FaultInjector) Inject(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.lifecycle.Start(); err != nil {
+		return err
+	}
+
+	// Store deterministic random generator from context
+	s.rng = chaoskit.GetRand(ctx)
+
+	return nil
+}
+
+func (s *S3FaultInjector) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lifecycle.Stop()
+	clear(s.writes)
+
+	return nil
+}
+
+// State implements StatefulInjector
+func (s *S3FaultInjector) State() chaoskit.InjectorState {
+	return s.lifecycle.State()
+}
+
+// Type implements CategorizedInjector
+func (s *S3FaultInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeContext // Works via WrapS3Client in user code
+}
+
+// GetMetrics implements MetricsProvider
+func (s *S3FaultInjector) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metrics := map[string]interface{}{
+		"slow_down_probability":    s.config.SlowDown,
+		"partial_read_probability": s.config.PartialRead,
+		"staleness_probability":    s.config.Staleness,
+		"multipart_probability":    s.config.MultipartFailure,
+		"stopped":                  s.lifecycle.Stopped(),
+	}
+	for kind, count := range s.faults {
+		metrics[kind] = count
+	}
+
+	return metrics
+}
+
+// ScaleIntensity implements IntensityScaler
+func (s *S3FaultInjector) ScaleIntensity(factor float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.config.SlowDown = scaleProbability(s.config.SlowDown, factor)
+	s.config.PartialRead = scaleProbability(s.config.PartialRead, factor)
+	s.config.Staleness = scaleProbability(s.config.Staleness, factor)
+	s.config.MultipartFailure = scaleProbability(s.config.MultipartFailure, factor)
+}
+
+// chance draws a fault with probability p; the caller holds s.mu
+func (s *S3FaultInjector) chance(p float64) bool {
+	if p <= 0 {
+		return false
+	}
+
+	// Use stored generator (should be set during Inject)
+	if s.rng == nil {
+		s.rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	return s.rng.Float64() < p
+}
+
+// before returns the fault applied instead of sending req, if any
+func (s *S3FaultInjector) before(req *http.Request, op s3Operation) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lifecycle.State() != chaoskit.InjectorInjecting {
+		return ""
+	}
+
+	var kind string
+	switch {
+	case s.chance(s.config.SlowDown):
+		kind = S3FaultSlowDown
+	case (op == s3UploadPart || op == s3CompleteMultipart) && s.chance(s.config.MultipartFailure):
+		kind = S3FaultMultipartFailure
+	case op == s3Read && s.stale(objectID(req)) && s.chance(s.config.Staleness):
+		kind = S3FaultStaleRead
+	default:
+		return ""
+	}
+	s.faults[kind]++
+
+	return kind
+}
+
+// after tracks a completed request and returns the fault applied to its
+// response: a partial read or a stale listing
+func (s *S3FaultInjector) after(req *http.Request, op s3Operation, resp *http.Response) (string, func(*http.Response) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lifecycle.State() != chaoskit.InjectorInjecting || resp.StatusCode >= http.StatusMultipleChoices {
+		return "", nil
+	}
+
+	switch op {
+	case s3Write, s3CompleteMultipart:
+		if len(s.writes) >= maxS3TrackedWrites {
+			s.pruneWrites()
+		}
+		s.writes[objectID(req)] = time.Now()
+	case s3Read, s3List:
+		if op == s3Read && req.Method == http.MethodGet && s.chance(s.config.PartialRead) {
+			s.faults[S3FaultPartialRead]++
+
+			return S3FaultPartialRead, s.truncate
+		}
+		// Listings without parameters look like object reads (ListObjects v1)
+		if req.Method == http.MethodGet && s.config.Staleness > 0 && len(s.writes) > 0 &&
+			strings.Contains(resp.Header.Get("Content-Type"), "xml") {
+			return S3FaultStaleRead, func(resp *http.Response) error { return s.hideRecentKeys(req, resp) }
+		}
+	}
+
+	return "", nil
+}
+
+// stale reports whether the object was written within the stale window; the caller holds s.mu
+func (s *S3FaultInjector) stale(id string) bool {
+	written, ok := s.writes[id]
+
+	return ok && time.Since(written) < s.config.StaleWindow
+}
+
+// pruneWrites forgets the writes older than the stale window; the caller holds s.mu
+func (s *S3FaultInjector) pruneWrites() {
+	for id, written := range s.writes {
+		if time.Since(written) >= s.config.StaleWindow {
+			delete(s.writes, id)
+		}
+	}
+}
+
+// truncate makes the body of resp end at a random offset
+func (s *S3FaultInjector) truncate(resp *http.Response) error {
+	s.mu.Lock()
+	limit := int64(0)
+	if resp.ContentLength > 1 {
+		limit = s.rng.Int63n(resp.ContentLength)
+	}
+	s.mu.Unlock()
+
+	resp.Body = &partialBody{ReadCloser: resp.Body, remaining: limit}
+
+	return nil
+}
+
+// hideRecentKeys removes the keys written within the stale window from a
+// listing response, each with the staleness probability. Other XML responses
+// are left unchanged.
+func (s *S3FaultInjector) hideRecentKeys(req *http.Request, resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(body, []byte("<ListBucketResult")) {
+		root := req.URL.Host + strings.TrimSuffix(req.URL.Path, "/") + "/"
+		hidden := 0
+		body = listContentsPattern.ReplaceAllFunc(body, func(entry []byte) []byte {
+			match := listKeyPattern.FindSubmatch(entry)
+			if match == nil {
+				return entry
+			}
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			if s.stale(root+html.UnescapeString(string(match[1]))) && s.chance(s.config.Staleness) {
+				hidden++
+				s.faults[S3FaultStaleRead]++
+
+				return nil
+			}
+
+			return entry
+		})
+		if hidden > 0 {
+			chaoskit.RecordInjection(req.Context(), chaoskit.InjectionEvent{
+				Injector:   s.name,
+				Type:       S3FaultStaleRead,
+				Attributes: map[string]any{"operation": "ListObjects", "url": req.URL.Redacted(), "hidden_keys": hidden},
+			})
+		}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return nil
+}
+
+// partialBody ends a body with io.ErrUnexpectedEOF after remaining bytes
+type partialBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *partialBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+
+	return n, err
+}
+
+// s3Operation classifies S3 requests
+type s3Operation int
+
+const (
+	s3Other s3Operation = iota
+	s3Read
+	s3List
+	s3Write
+	s3UploadPart
+	s3CompleteMultipart
+)
+
+func classifyS3Request(req *http.Request) s3Operation {
+	query := req.URL.Query()
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		for _, param := range []string{"list-type", "prefix", "delimiter", "marker", "continuation-token"} {
+			if query.Has(param) {
+				return s3List
+			}
+		}
+
+		return s3Read
+	case http.MethodPut:
+		if query.Has("uploadId") {
+			return s3UploadPart
+		}
+
+		return s3Write
+	case http.MethodPost:
+		if query.Has("uploadId") {
+			return s3CompleteMultipart
+		}
+	}
+
+	return s3Other
+}
+
+// objectID identifies the object of a request across path and virtual-hosted style
+func objectID(req *http.Request) string {
+	return req.URL.Host + req.URL.Path
+}
+
+// WrapS3Client returns a copy of client, for the HTTP client option of an
+// S3 SDK (aws-sdk-go-v2 s3.Options.HTTPClient, minio-go Options.Transport
+// with its Transport), applying the faults of the S3FaultInjectors active in
+// the context of each request. A nil client wraps http.DefaultClient.
+func WrapS3Client(client *http.Client) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	wrapped := *client
+	wrapped.Transport = &s3Transport{base: base}
+
+	return &wrapped
+}
+
+// s3Transport applies the faults of the S3 injectors of the request context
+type s3Transport struct {
+	base http.RoundTripper
+}
+
+func (t *s3Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	injectors := activeS3Injectors(req.Context())
+	if len(injectors) == 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	op := classifyS3Request(req)
+	for _, inj := range injectors {
+		if kind := inj.before(req, op); kind != "" {
+			// A RoundTripper closes the request body, even when not sending it
+			if req.Body != nil {
+				_ = req.Body.Close()
+			}
+
+			return inj.faultResponse(req, kind), nil
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	for _, inj := range injectors {
+		kind, apply := inj.after(req, op, resp)
+		if apply == nil {
+			continue
+		}
+		if err := apply(resp); err != nil {
+			return nil, err
+		}
+		if kind == S3FaultPartialRead {
+			chaoskit.RecordInjection(req.Context(), chaoskit.InjectionEvent{
+				Injector:   inj.name,
+				Type:       kind,
+				Attributes: map[string]any{"operation": "GetObject", "url": req.URL.Redacted()},
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// activeS3Injectors returns the S3 injectors applying to ctx
+func activeS3Injectors(ctx context.Context) []*S3FaultInjector {
+	chaos := chaoskit.GetChaos(ctx)
+	if chaos == nil {
+		return nil
+	}
+
+	var injectors []*S3FaultInjector
+	for _, inj := range chaos.Injectors() {
+		if s3, ok := inj.(*S3FaultInjector); ok {
+			injectors = append(injectors, s3)
+		}
+	}
+
+	return injectors
+}
+
+// faultResponse returns the S3 error response of a fault, carrying its fault
+// ID as request ID so SDK errors can be joined to the injection event
+func (s *S3FaultInjector) faultResponse(req *http.Request, kind string) *http.Response {
+	faultID := chaoskit.NewFaultID()
+	status, code, message := http.StatusServiceUnavailable, "SlowDown", "Please reduce your request rate."
+	operation := "request"
+	switch kind {
+	case S3FaultMultipartFailure:
+		status, code, message = http.StatusInternalServerError, "InternalError",
+			"We encountered an internal error. Please try again."
+		operation = "UploadPart"
+		if classifyS3Request(req) == s3CompleteMultipart {
+			// CompleteMultipartUpload reports failures in a 200 response
+			status = http.StatusOK
+			operation = "CompleteMultipartUpload"
+		}
+	case S3FaultStaleRead:
+		status, code, message = http.StatusNotFound, "NoSuchKey", "The specified key does not exist."
+		operation = "GetObject"
+	}
+	chaoskit.RecordInjection(req.Context(), chaoskit.InjectionEvent{
+		Injector:   s.name,
+		Type:       kind,
+		FaultID:    faultID,
+		Attributes: map[string]any{"operation": operation, "url": req.URL.Redacted(), "status": status},
+	})
+
+	body := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<Error><Code>%s</Code><Message>%s</Message><Resource>%s</Resource><RequestId>%s</RequestId></Error>`,
+		code, message, html.EscapeString(req.URL.Path), faultID)
+	if req.Method == http.MethodHead {
+		body = ""
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/xml")
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	header.Set("X-Amz-Request-Id", faultID)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,157 @@
+package injectors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeS3 stores objects of path-style requests (/bucket/key) and lists a bucket
+type fakeS3 struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	requests int
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests++
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[bucket+"/"+key] = body
+	case r.Method == http.MethodPost:
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = fmt.Fprintf(w, "<CompleteMultipartUploadResult><Key>%s</Key></CompleteMultipartUploadResult>", key)
+	case r.Method == http.MethodGet && key == "":
+		keys := make([]string, 0, len(f.objects))
+		for k := range f.objects {
+			keys = append(keys, strings.TrimPrefix(k, bucket+"/"))
+		}
+		sort.Strings(keys)
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = fmt.Fprint(w, "<ListBucketResult>")
+		for _, k := range keys {
+			_, _ = fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>1</Size></Contents>", k)
+		}
+		_, _ = fmt.Fprint(w, "</ListBucketResult>")
+	case r.Method == http.MethodGet:
+		body, ok := f.objects[bucket+"/"+key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(body)
+	}
+}
+
+func s3Do(ctx context.Context, t *testing.T, client *http.Client, method, url string, body []byte) (*http.Response, []byte, error) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+
+	return resp, data, err
+}
+
+func TestS3Fault_SlowDownAndMultipart(t *testing.T) {
+	fake := &fakeS3{objects: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := WrapS3Client(server.Client())
+
+	var slowDown, part, complete *http.Response
+	var completeBody []byte
+	timeline := runInjectorScenario(t, "s3", S3Fault(S3FaultConfig{SlowDown: 1}), func(ctx context.Context) {
+		slowDown, _, _ = s3Do(ctx, t, client, http.MethodPut, server.URL+"/backups/a", []byte("a"))
+	})
+	if slowDown.StatusCode != http.StatusServiceUnavailable || fake.requests != 0 {
+		t.Fatalf("expected a 503 without a request to the server, got %d after %d requests", slowDown.StatusCode, fake.requests)
+	}
+	if len(timeline) != 1 || timeline[0].Type != S3FaultSlowDown || timeline[0].FaultID != slowDown.Header.Get("X-Amz-Request-Id") {
+		t.Errorf("unexpected timeline %+v", timeline)
+	}
+
+	runInjectorScenario(t, "s3", S3Fault(S3FaultConfig{MultipartFailure: 1}), func(ctx context.Context) {
+		part, _, _ = s3Do(ctx, t, client, http.MethodPut, server.URL+"/backups/big?partNumber=1&uploadId=u1", []byte("part"))
+		complete, completeBody, _ = s3Do(ctx, t, client, http.MethodPost, server.URL+"/backups/big?uploadId=u1", nil)
+		if resp, _, _ := s3Do(ctx, t, client, http.MethodPut, server.URL+"/backups/small", []byte("s")); resp.StatusCode != http.StatusOK {
+			t.Errorf("PutObject should not fail, got %d", resp.StatusCode)
+		}
+	})
+	if part.StatusCode != http.StatusInternalServerError {
+		t.Errorf("UploadPart status = %d, want 500", part.StatusCode)
+	}
+	if complete.StatusCode != http.StatusOK || !bytes.Contains(completeBody, []byte("<Code>InternalError</Code>")) {
+		t.Errorf("CompleteMultipartUpload = %d %s, want an error in a 200 response", complete.StatusCode, completeBody)
+	}
+}
+
+func TestS3Fault_PartialRead(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{"backups/a": bytes.Repeat([]byte("x"), 1024)}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := WrapS3Client(server.Client())
+
+	var data []byte
+	var err error
+	injector := S3Fault(S3FaultConfig{PartialRead: 1})
+	runInjectorScenario(t, "s3", injector, func(ctx context.Context) {
+		_, data, err = s3Do(ctx, t, client, http.MethodGet, server.URL+"/backups/a", nil)
+	})
+	if !errors.Is(err, io.ErrUnexpectedEOF) || len(data) >= 1024 {
+		t.Errorf("expected a partial read, got %d bytes and error %v", len(data), err)
+	}
+	if n := injector.GetMetrics()[S3FaultPartialRead]; n != int64(1) {
+		t.Errorf("partial reads = %v, want 1", n)
+	}
+
+	// Outside of a run the client is unchanged
+	if _, data, err := s3Do(context.Background(), t, client, http.MethodGet, server.URL+"/backups/a", nil); err != nil || len(data) != 1024 {
+		t.Errorf("unexpected read outside of a run: %d bytes, %v", len(data), err)
+	}
+}
+
+func TestS3Fault_StaleReads(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{"backups/old": []byte("old")}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	client := WrapS3Client(server.Client())
+
+	var get, getOld *http.Response
+	var listing []byte
+	runInjectorScenario(t, "s3", S3Fault(S3FaultConfig{Staleness: 1}), func(ctx context.Context) {
+		s3Do(ctx, t, client, http.MethodPut, server.URL+"/backups/new", []byte("new"))
+		get, _, _ = s3Do(ctx, t, client, http.MethodGet, server.URL+"/backups/new", nil)
+		getOld, _, _ = s3Do(ctx, t, client, http.MethodGet, server.URL+"/backups/old", nil)
+		_, listing, _ = s3Do(ctx, t, client, http.MethodGet, server.URL+"/backups?list-type=2", nil)
+	})
+	if get.StatusCode != http.StatusNotFound {
+		t.Errorf("read after write status = %d, want 404", get.StatusCode)
+	}
+	if getOld.StatusCode != http.StatusOK {
+		t.Errorf("read of an old object status = %d, want 200", getOld.StatusCode)
+	}
+	if bytes.Contains(listing, []byte("<Key>new</Key>")) || !bytes.Contains(listing, []byte("<Key>old</Key>")) {
+		t.Errorf("expected the new key to be left out of the listing: %s", listing)
+	}
+}
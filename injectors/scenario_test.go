@@ -0,0 +1,35 @@
+package injectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rom8726/chaoskit"
+)
+
+type nopTarget struct{}
+
+func (nopTarget) Name() string                       { return "nop" }
+func (nopTarget) Setup(ctx context.Context) error    { return nil }
+func (nopTarget) Teardown(ctx context.Context) error { return nil }
+
+// runInjectorScenario runs step once in scenario name with injector active
+// and returns the timeline
+func runInjectorScenario(t *testing.T, name string, injector chaoskit.Injector, step func(ctx context.Context)) []chaoskit.InjectionEvent {
+	t.Helper()
+
+	executor := chaoskit.NewExecutor()
+	scenario := chaoskit.NewScenario(name).
+		WithTarget(nopTarget{}).
+		Inject(name, injector).
+		Step("step", func(ctx context.Context, target chaoskit.Target) error {
+			step(ctx)
+			return nil
+		}).
+		Build()
+	if err := executor.Run(context.Background(), scenario); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	return executor.Reporter().Timeline()
+}
@@ -0,0 +1,367 @@
+package injectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// DefaultSecretsDelay is the latency added to slowed secret reads by default
+const DefaultSecretsDelay = 2 * time.Second
+
+// Secrets fault kinds, the types of their injection events
+const (
+	SecretsFaultSealed    = "secrets_sealed"
+	SecretsFaultForbidden = "secrets_forbidden"
+	SecretsFaultLatency   = "secrets_latency"
+	SecretsFaultRotated   = "secrets_rotated"
+)
+
+// Errors wrapped by the faults of SecretsFaultInjector, for errors.Is in
+// credential-refresh and cache-fallback code
+var (
+	// ErrSecretStoreSealed is a read from a sealed store (Vault 503)
+	ErrSecretStoreSealed = errors.New("secret store is sealed")
+
+	// ErrSecretAccessDenied is a read the store's policy denies (Vault 403)
+	ErrSecretAccessDenied = errors.New("permission denied")
+
+	// ErrSecretRotated is the use of credentials rotated since they were read
+	ErrSecretRotated = errors.New("credentials were rotated")
+)
+
+// SecretsFaultConfig configures the faults of SecretsFaultInjector.
+// Probabilities are per read through a wrapped store, or per CheckSecret call
+// for rotations.
+type SecretsFaultConfig struct {
+	// Sealed fails reads with ErrSecretStoreSealed. The store stays sealed
+	// for SealDuration (only the failed read when zero).
+	Sealed       float64
+	SealDuration time.Duration
+
+	// Forbidden fails reads with ErrSecretAccessDenied, as an expired token
+	// or a revoked policy does
+	Forbidden float64
+
+	// Latency delays reads by Delay
+	Latency float64
+	Delay   time.Duration // DefaultSecretsDelay when zero
+
+	// Rotation makes CheckSecret report the credentials of a secret as
+	// rotated until the secret is read again
+	Rotation float64
+}
+
+// SecretsFaultInjector simulates Vault and secret manager failures in the
+// stores wrapped with WrapSecretStore, exercising credential-refresh and
+// cache-fallback code paths that otherwise only break in real incidents.
+type SecretsFaultInjector struct {
+	name        string
+	config      SecretsFaultConfig
+	mu          sync.Mutex
+	lifecycle   chaoskit.InjectorLifecycle
+	sealedUntil time.Time
+	read        map[string]bool   // secrets read through a wrapped store
+	rotated     map[string]string // rotated secret -> fault ID of the rotation
+	faults      map[string]int64
+	rng         *rand.Rand // Deterministic random generator from context
+}
+
+// SecretsFault creates a secrets fault injector
+func SecretsFault(cfg SecretsFaultConfig) *SecretsFaultInjector {
+	if cfg.Delay <= 0 {
+		cfg.Delay = DefaultSecretsDelay
+	}
+
+	return &SecretsFaultInjector{
+		name:    "secrets_fault",
+		config:  cfg,
+		read:    make(map[string]bool),
+		rotated: make(map[string]string),
+		faults:  make(map[string]int64),
+	}
+}
+
+func (s *SecretsFaultInjector) Name() string {
+	return s.name
+}
+
+func (s *SecretsFaultInjector) Inject(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.lifecycle.Start(); err != nil {
+		return err
+	}
+
+	// Store deterministic random generator from context
+	s.rng = chaoskit.GetRand(ctx)
+
+	return nil
+}
+
+func (s *SecretsFaultInjector) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lifecycle.Stop()
+	s.sealedUntil = time.Time{}
+	clear(s.read)
+	clear(s.rotated)
+
+	return nil
+}
+
+// State implements StatefulInjector
+func (s *SecretsFaultInjector) State() chaoskit.InjectorState {
+	return s.lifecycle.State()
+}
+
+// Type implements CategorizedInjector
+func (s *SecretsFaultInjector) Type() chaoskit.InjectorType {
+	return chaoskit.InjectorTypeContext // Works via WrapSecretStore and CheckSecret in user code
+}
+
+// GetMetrics implements MetricsProvider
+func (s *SecretsFaultInjector) GetMetrics() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metrics := map[string]interface{}{
+		"sealed_probability":    s.config.Sealed,
+		"forbidden_probability": s.config.Forbidden,
+		"latency_probability":   s.config.Latency,
+		"rotation_probability":  s.config.Rotation,
+		"rotated_secrets":       len(s.rotated),
+		"stopped":               s.lifecycle.Stopped(),
+	}
+	for kind, count := range s.faults {
+		metrics[kind] = count
+	}
+
+	return metrics
+}
+
+// ScaleIntensity implements IntensityScaler
+func (s *SecretsFaultInjector) ScaleIntensity(factor float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.config.Sealed = scaleProbability(s.config.Sealed, factor)
+	s.config.Forbidden = scaleProbability(s.config.Forbidden, factor)
+	s.config.Latency = scaleProbability(s.config.Latency, factor)
+	s.config.Rotation = scaleProbability(s.config.Rotation, factor)
+}
+
+// chance draws a fault with probability p; the caller holds s.mu
+func (s *SecretsFaultInjector) chance(p float64) bool {
+	if p <= 0 {
+		return false
+	}
+
+	// Use stored generator (should be set during Inject)
+	if s.rng == nil {
+		s.rng = rand.New(rand.NewSource(rand.Int63()))
+	}
+
+	return s.rng.Float64() < p
+}
+
+// beforeRead returns the delay and the fault applied to a read of a secret
+func (s *SecretsFaultInjector) beforeRead() (time.Duration, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lifecycle.State() != chaoskit.InjectorInjecting {
+		return 0, ""
+	}
+
+	var delay time.Duration
+	if s.chance(s.config.Latency) {
+		delay = s.config.Delay
+		s.faults[SecretsFaultLatency]++
+	}
+
+	var kind string
+	switch {
+	case time.Now().Before(s.sealedUntil):
+		kind = SecretsFaultSealed
+	case s.chance(s.config.Sealed):
+		kind = SecretsFaultSealed
+		s.sealedUntil = time.Now().Add(s.config.SealDuration)
+	case s.chance(s.config.Forbidden):
+		kind = SecretsFaultForbidden
+	default:
+		return delay, ""
+	}
+	s.faults[kind]++
+
+	return delay, kind
+}
+
+// afterRead marks a secret as read, ending its rotation
+func (s *SecretsFaultInjector) afterRead(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.read[name] = true
+	delete(s.rotated, name)
+}
+
+// check returns the fault ID of the rotation of a secret, and whether the
+// rotation is new
+func (s *SecretsFaultInjector) check(name string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lifecycle.State() != chaoskit.InjectorInjecting {
+		return "", false
+	}
+	if faultID, ok := s.rotated[name]; ok {
+		return faultID, false
+	}
+	if !s.read[name] || !s.chance(s.config.Rotation) {
+		return "", false
+	}
+
+	faultID := chaoskit.NewFaultID()
+	s.rotated[name] = faultID
+	s.faults[SecretsFaultRotated]++
+
+	return faultID, true
+}
+
+// SecretStore reads secrets from Vault or a secret manager. S is the type of
+// a secret of the client, e.g. *api.Secret for Vault or the output of
+// GetSecretValue for AWS Secrets Manager.
+type SecretStore[S any] interface {
+	GetSecret(ctx context.Context, name string) (S, error)
+}
+
+// SecretStoreFunc adapts a function to SecretStore
+type SecretStoreFunc[S any] func(ctx context.Context, name string) (S, error)
+
+// GetSecret calls f(ctx, name)
+func (f SecretStoreFunc[S]) GetSecret(ctx context.Context, name string) (S, error) {
+	return f(ctx, name)
+}
+
+// WrapSecretStore returns store with the faults of the SecretsFaultInjectors
+// active in the context of each read: reads are delayed, or fail with a
+// *chaoskit.ChaosError wrapping ErrSecretStoreSealed or ErrSecretAccessDenied
+// without reaching the store.
+//
+//	store := injectors.WrapSecretStore[*api.Secret](injectors.SecretStoreFunc[*api.Secret](
+//	    func(ctx context.Context, path string) (*api.Secret, error) {
+//	        return vault.Logical().ReadWithContext(ctx, path)
+//	    }))
+func WrapSecretStore[S any](store SecretStore[S]) SecretStore[S] {
+	return &secretStore[S]{store: store}
+}
+
+// secretStore applies the faults of the secrets injectors of the read context
+type secretStore[S any] struct {
+	store SecretStore[S]
+}
+
+func (w *secretStore[S]) GetSecret(ctx context.Context, name string) (S, error) {
+	var zero S
+	injectors := activeSecretsInjectors(ctx)
+	for _, inj := range injectors {
+		delay, kind := inj.beforeRead()
+		if delay > 0 {
+			chaoskit.RecordInjection(ctx, chaoskit.InjectionEvent{
+				Injector:   inj.name,
+				Type:       SecretsFaultLatency,
+				Attributes: map[string]any{"secret": name, "delay": delay.String()},
+			})
+
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+
+				return zero, ctx.Err()
+			}
+		}
+		if kind == "" {
+			continue
+		}
+
+		cause := ErrSecretAccessDenied
+		if kind == SecretsFaultSealed {
+			cause = ErrSecretStoreSealed
+		}
+		injected := chaoskit.NewChaosError(ctx, inj.name, kind, fmt.Errorf("read secret %s: %w", name, cause))
+		chaoskit.RecordInjection(ctx, chaoskit.InjectionEvent{
+			Injector:   inj.name,
+			Type:       kind,
+			FaultID:    injected.FaultID,
+			Attributes: map[string]any{"secret": name},
+		})
+
+		return zero, injected
+	}
+
+	secret, err := w.store.GetSecret(ctx, name)
+	if err == nil {
+		for _, inj := range injectors {
+			inj.afterRead(name)
+		}
+	}
+
+	return secret, err
+}
+
+// CheckSecret returns a *chaoskit.ChaosError wrapping ErrSecretRotated when
+// the active SecretsFaultInjectors rotated the credentials of secret name
+// since it was last read through a wrapped store. Call it where the
+// credentials are used (e.g. before connecting with a cached password), so
+// the caller takes its refresh path; the error repeats until the secret is
+// read again.
+func CheckSecret(ctx context.Context, name string) error {
+	for _, inj := range activeSecretsInjectors(ctx) {
+		faultID, rotated := inj.check(name)
+		if faultID == "" {
+			continue
+		}
+
+		injected := chaoskit.NewChaosError(ctx, inj.name, SecretsFaultRotated, fmt.Errorf("secret %s: %w", name, ErrSecretRotated))
+		injected.FaultID = faultID
+		if rotated {
+			chaoskit.RecordInjection(ctx, chaoskit.InjectionEvent{
+				Injector:   inj.name,
+				Type:       SecretsFaultRotated,
+				FaultID:    faultID,
+				Attributes: map[string]any{"secret": name},
+			})
+		}
+
+		return injected
+	}
+
+	return nil
+}
+
+// activeSecretsInjectors returns the secrets injectors applying to ctx
+func activeSecretsInjectors(ctx context.Context) []*SecretsFaultInjector {
+	chaos := chaoskit.GetChaos(ctx)
+	if chaos == nil {
+		return nil
+	}
+
+	var injectors []*SecretsFaultInjector
+	for _, inj := range chaos.Injectors() {
+		if secrets, ok := inj.(*SecretsFaultInjector); ok {
+			injectors = append(injectors, secrets)
+		}
+	}
+
+	return injectors
+}
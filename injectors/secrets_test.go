@@ -0,0 +1,104 @@
+package injectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// countingStore returns the name of a secret as its value and counts reads
+func countingStore(reads *int) SecretStore[string] {
+	return WrapSecretStore[string](SecretStoreFunc[string](func(ctx context.Context, name string) (string, error) {
+		*reads++
+		return "value-of-" + name, nil
+	}))
+}
+
+func TestSecretsFault_SealedAndForbidden(t *testing.T) {
+	var reads int
+	store := countingStore(&reads)
+
+	var sealed, stillSealed error
+	timeline := runInjectorScenario(t, "secrets", SecretsFault(SecretsFaultConfig{Sealed: 1, SealDuration: time.Minute}), func(ctx context.Context) {
+		_, sealed = store.GetSecret(ctx, "db/creds")
+		_, stillSealed = store.GetSecret(ctx, "db/creds")
+	})
+	if !errors.Is(sealed, ErrSecretStoreSealed) || !errors.Is(stillSealed, ErrSecretStoreSealed) || !chaoskit.IsInjected(sealed) {
+		t.Fatalf("expected sealed store errors, got %v and %v", sealed, stillSealed)
+	}
+	if reads != 0 {
+		t.Errorf("a sealed store should not be read, got %d reads", reads)
+	}
+	var chaosErr *chaoskit.ChaosError
+	if !errors.As(sealed, &chaosErr) || len(timeline) != 2 || timeline[0].FaultID != chaosErr.FaultID {
+		t.Errorf("unexpected timeline %+v", timeline)
+	}
+
+	var forbidden error
+	runInjectorScenario(t, "secrets", SecretsFault(SecretsFaultConfig{Forbidden: 1}), func(ctx context.Context) {
+		_, forbidden = store.GetSecret(ctx, "db/creds")
+	})
+	if !errors.Is(forbidden, ErrSecretAccessDenied) {
+		t.Errorf("expected a permission denied error, got %v", forbidden)
+	}
+
+	// Outside of a run the store is unchanged
+	if value, err := store.GetSecret(context.Background(), "db/creds"); err != nil || value != "value-of-db/creds" {
+		t.Errorf("unexpected read outside of a run: %q, %v", value, err)
+	}
+}
+
+func TestSecretsFault_Latency(t *testing.T) {
+	var reads int
+	store := countingStore(&reads)
+
+	var elapsed time.Duration
+	var err error
+	injector := SecretsFault(SecretsFaultConfig{Latency: 1, Delay: 20 * time.Millisecond})
+	runInjectorScenario(t, "secrets", injector, func(ctx context.Context) {
+		start := time.Now()
+		_, err = store.GetSecret(ctx, "api/key")
+		elapsed = time.Since(start)
+	})
+	if err != nil || reads != 1 || elapsed < 20*time.Millisecond {
+		t.Errorf("expected a delayed read, got %v after %s (%d reads)", err, elapsed, reads)
+	}
+	if n := injector.GetMetrics()[SecretsFaultLatency]; n != int64(1) {
+		t.Errorf("delayed reads = %v, want 1", n)
+	}
+}
+
+func TestSecretsFault_Rotation(t *testing.T) {
+	var reads int
+	store := countingStore(&reads)
+
+	var unread, rotated, again, refreshed error
+	timeline := runInjectorScenario(t, "secrets", SecretsFault(SecretsFaultConfig{Rotation: 1}), func(ctx context.Context) {
+		unread = CheckSecret(ctx, "db/creds")
+		_, _ = store.GetSecret(ctx, "db/creds")
+		rotated = CheckSecret(ctx, "db/creds")
+		again = CheckSecret(ctx, "db/creds")
+		_, _ = store.GetSecret(ctx, "db/creds")
+		refreshed = CheckSecret(ctx, "db/creds")
+	})
+	if unread != nil {
+		t.Errorf("a secret never read should not be rotated, got %v", unread)
+	}
+	if !errors.Is(rotated, ErrSecretRotated) || !errors.Is(again, ErrSecretRotated) {
+		t.Fatalf("expected rotated credentials until the next read, got %v and %v", rotated, again)
+	}
+	var first, second *chaoskit.ChaosError
+	if errors.As(rotated, &first) && errors.As(again, &second) && first.FaultID != second.FaultID {
+		t.Errorf("errors of one rotation should share a fault ID, got %s and %s", first.FaultID, second.FaultID)
+	}
+	// The secret is rotated again by the check after the refresh
+	if !errors.Is(refreshed, ErrSecretRotated) {
+		t.Errorf("expected a new rotation after the refresh, got %v", refreshed)
+	}
+	if len(timeline) != 2 {
+		t.Errorf("expected one event per rotation, got %+v", timeline)
+	}
+}
@@ -0,0 +1,90 @@
+package chaoskit
+
+import (
+	"context"
+	"io"
+)
+
+// ChaosReader returns a reader applying the chaos of the active injectors to
+// every Read of r: delays (see MaybeDelay), truncation by an injected error
+// (see MaybeError; the read stops halfway and returns a *ChaosError) and
+// corruption of one byte (see MaybeCorrupt). Outside of a scenario run it
+// reads r unchanged.
+func ChaosReader(ctx context.Context, r io.Reader) io.Reader {
+	return &chaosReader{ctx: ctx, r: r}
+}
+
+// ChaosWriter returns a writer applying the chaos of the active injectors to
+// every Write to w: delays, truncation by an injected error (half of the data
+// is written, then a *ChaosError is returned) and corruption of one byte of
+// the written data (the caller's buffer is left untouched).
+func ChaosWriter(ctx context.Context, w io.Writer) io.Writer {
+	return &chaosWriter{ctx: ctx, w: w}
+}
+
+type chaosReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *chaosReader) Read(p []byte) (int, error) {
+	MaybeDelay(c.ctx)
+
+	injected := MaybeError(c.ctx)
+	if injected != nil {
+		p = p[:len(p)/2]
+	}
+
+	n, err := c.r.Read(p)
+	if n > 0 {
+		copy(p, MaybeCorrupt(c.ctx, p[:n], c.corrupt))
+	}
+	if injected != nil {
+		return n, injected
+	}
+
+	return n, err
+}
+
+func (c *chaosReader) corrupt(data []byte) []byte {
+	return corruptByte(c.ctx, data)
+}
+
+type chaosWriter struct {
+	ctx context.Context
+	w   io.Writer
+}
+
+func (c *chaosWriter) Write(p []byte) (int, error) {
+	MaybeDelay(c.ctx)
+
+	data := p
+	injected := MaybeError(c.ctx)
+	if injected != nil {
+		data = data[:len(data)/2]
+	}
+	data = MaybeCorrupt(c.ctx, data, c.corrupt)
+
+	n, err := c.w.Write(data)
+	if err == nil && injected != nil {
+		err = injected
+	}
+
+	return n, err
+}
+
+func (c *chaosWriter) corrupt(data []byte) []byte {
+	corrupted := make([]byte, len(data))
+	copy(corrupted, data)
+
+	return corruptByte(c.ctx, corrupted)
+}
+
+// corruptByte flips the bits of one random byte of data in place
+func corruptByte(ctx context.Context, data []byte) []byte {
+	if len(data) > 0 {
+		data[GetRand(ctx).Intn(len(data))] ^= 0xFF
+	}
+
+	return data
+}
@@ -0,0 +1,69 @@
+package chaoskit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// alwaysCorruptInjector corrupts every MaybeCorrupt call
+type alwaysCorruptInjector struct{ testMetricsInjector }
+
+func (i *alwaysCorruptInjector) Name() string                      { return "always-corrupt" }
+func (i *alwaysCorruptInjector) ShouldCorrupt() bool               { return true }
+func (i *alwaysCorruptInjector) GetCorruptionProbability() float64 { return 1 }
+
+func TestChaosReaderWriter(t *testing.T) {
+	const data = "0123456789"
+
+	// Unchanged outside of scenario runs
+	read, err := io.ReadAll(ChaosReader(context.Background(), strings.NewReader(data)))
+	require.NoError(t, err)
+	assert.Equal(t, data, string(read))
+
+	runStep := func(injector Injector, fn func(ctx context.Context)) {
+		scenario := NewScenario("io").
+			WithTarget(&testTarget{}).
+			Inject("chaos", injector).
+			Step("step", func(ctx context.Context, target Target) error {
+				fn(ctx)
+				return nil
+			}).
+			Build()
+		require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+	}
+
+	runStep(&alwaysErrorInjector{}, func(ctx context.Context) {
+		buf := make([]byte, len(data))
+		n, err := ChaosReader(ctx, strings.NewReader(data)).Read(buf)
+		assert.Equal(t, 5, n, "truncated read")
+		assert.True(t, IsInjected(err))
+
+		var out bytes.Buffer
+		n, err = ChaosWriter(ctx, &out).Write([]byte(data))
+		assert.Equal(t, 5, n, "truncated write")
+		assert.True(t, IsInjected(err))
+		assert.Equal(t, "01234", out.String())
+	})
+
+	runStep(&alwaysCorruptInjector{}, func(ctx context.Context) {
+		buf := make([]byte, len(data))
+		n, err := ChaosReader(ctx, strings.NewReader(data)).Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, len(data), n)
+		assert.NotEqual(t, data, string(buf))
+
+		src := []byte(data)
+		var out bytes.Buffer
+		n, err = ChaosWriter(ctx, &out).Write(src)
+		require.NoError(t, err)
+		assert.Equal(t, len(data), n)
+		assert.NotEqual(t, data, out.String())
+		assert.Equal(t, data, string(src), "the caller's buffer is not modified")
+	})
+}
@@ -0,0 +1,54 @@
+package chaoskit
+
+import "context"
+
+// IterationRunner wraps the execution of each iteration, e.g. to run it as a
+// subtest. run executes the iteration; a runner not calling it skips the
+// iteration, which is then neither recorded nor validated.
+type IterationRunner func(ctx context.Context, iteration int, seed int64, run func(context.Context) ExecutionResult)
+
+// WithIterationRunner runs every iteration through runner. Each iteration then
+// draws from its own random generator seeded with IterationSeed(run seed,
+// iteration) instead of sharing the generator of the run, so an iteration
+// reproduces on its own, with the scenario seed, whether or not the iterations
+// before it ran.
+func WithIterationRunner(runner IterationRunner) ExecutorOption {
+	return func(e *Executor) {
+		e.iterationRunner = runner
+	}
+}
+
+// IterationSeed returns the seed of an iteration under WithIterationRunner
+func IterationSeed(seed int64, iteration int) int64 {
+	return seed + int64(iteration)
+}
+
+// runSeedKey is a private type for context key
+type runSeedKey struct{}
+
+// attachRunSeed attaches the seed of the Executor.Run call to ctx
+func attachRunSeed(ctx context.Context, seed int64) context.Context {
+	return context.WithValue(ctx, runSeedKey{}, seed)
+}
+
+// runIteration executes an iteration, through the iteration runner if any.
+// It reports false when the runner skipped the iteration.
+func (e *Executor) runIteration(ctx context.Context, scenario *Scenario, iteration int) (ExecutionResult, bool) {
+	if e.iterationRunner == nil {
+		return e.executeOnce(ctx, scenario, iteration), true
+	}
+
+	runSeed, _ := ctx.Value(runSeedKey{}).(int64)
+	seed := IterationSeed(runSeed, iteration)
+
+	var result ExecutionResult
+	ran := false
+	e.iterationRunner(ctx, iteration, seed, func(ctx context.Context) ExecutionResult {
+		result = e.executeOnce(attachSeededRand(ctx, seed), scenario, iteration)
+		ran = true
+
+		return result
+	})
+
+	return result, ran
+}
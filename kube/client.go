@@ -0,0 +1,167 @@
+// Package kube is a minimal client of the Kubernetes REST API shared by the
+// operator and the injectors managing cluster resources, so chaoskit needs no
+// client-go dependency.
+package kube
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// serviceAccountDir holds the credentials mounted into pods
+const serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+// Content types of PATCH requests
+const (
+	JSONPatch  = "application/json-patch+json"
+	MergePatch = "application/merge-patch+json"
+	ApplyPatch = "application/apply-patch+yaml"
+)
+
+// ErrNotInCluster is returned by InClusterClient outside a Kubernetes pod
+var ErrNotInCluster = errors.New("not running in a Kubernetes cluster (KUBERNETES_SERVICE_HOST not set)")
+
+// StatusError is an error response of the API server
+type StatusError struct {
+	Method  string
+	Path    string
+	Code    int
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s: %s", e.Method, e.Path, e.Code, http.StatusText(e.Code), e.Message)
+}
+
+// IsNotFound reports whether err is a 404 response of the API server
+func IsNotFound(err error) bool {
+	var status *StatusError
+
+	return errors.As(err, &status) && status.Code == http.StatusNotFound
+}
+
+// Client sends requests to the Kubernetes API server
+type Client struct {
+	host       string
+	token      func() (string, error)
+	httpClient *http.Client
+}
+
+// NewClient creates a client of the API server at host authenticating with a
+// bearer token (none when empty, e.g. behind kubectl proxy)
+func NewClient(host, token string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &Client{
+		host:       strings.TrimRight(host, "/"),
+		token:      func() (string, error) { return token, nil },
+		httpClient: httpClient,
+	}
+}
+
+// InClusterClient creates a client from the service account of the pod.
+// The token is re-read on every request, as the kubelet rotates it.
+func InClusterClient() (*Client, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, ErrNotInCluster
+	}
+
+	ca, err := os.ReadFile(serviceAccountDir + "/ca.crt")
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates in %s/ca.crt", serviceAccountDir)
+	}
+
+	return &Client{
+		host: "https://" + net.JoinHostPort(host, port),
+		token: func() (string, error) {
+			token, err := os.ReadFile(serviceAccountDir + "/token")
+			return strings.TrimSpace(string(token)), err
+		},
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+		},
+	}, nil
+}
+
+// InClusterNamespace returns the namespace of the pod, or "" outside a cluster
+func InClusterNamespace() string {
+	namespace, err := os.ReadFile(serviceAccountDir + "/namespace")
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(namespace))
+}
+
+// Do sends a request with a JSON body (none when nil) and decodes a JSON
+// response into out (discarded when nil). Error responses are returned as
+// *StatusError.
+func (c *Client) Do(ctx context.Context, method, path, contentType string, body, out any) error {
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.host+path, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	token, err := c.token()
+	if err != nil {
+		return fmt.Errorf("reading service account token: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		var status struct {
+			Message string `json:"message"`
+		}
+		data, _ := io.ReadAll(resp.Body)
+		_ = json.Unmarshal(data, &status)
+		if status.Message == "" {
+			status.Message = strings.TrimSpace(string(data))
+		}
+
+		return &StatusError{Method: method, Path: path, Code: resp.StatusCode, Message: status.Message}
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
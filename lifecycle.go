@@ -0,0 +1,77 @@
+package chaoskit
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// InjectorState is the lifecycle state of an injector:
+// Created → Injecting → Stopped, and Stopped → Injecting again when an
+// injector is restarted, e.g. by a later phase or another run of its scenario
+type InjectorState int32
+
+const (
+	// InjectorCreated is the state of an injector never injected
+	InjectorCreated InjectorState = iota
+	// InjectorInjecting is the state between Inject and Stop
+	InjectorInjecting
+	// InjectorStopped is the state after Stop, until the next Inject
+	InjectorStopped
+)
+
+func (s InjectorState) String() string {
+	switch s {
+	case InjectorCreated:
+		return "created"
+	case InjectorInjecting:
+		return "injecting"
+	case InjectorStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
+}
+
+// StatefulInjector is an injector reporting its lifecycle state
+type StatefulInjector interface {
+	Injector
+	State() InjectorState
+}
+
+// ErrInjectorActive is returned when injecting an injector already injecting
+var ErrInjectorActive = errors.New("injector already injecting")
+
+// InjectorLifecycle tracks the state of an injector; the zero value is
+// InjectorCreated. Injectors call Start in Inject and Stop in Stop, under
+// their own lock when they hold resources, and read State lock-free.
+type InjectorLifecycle struct {
+	state atomic.Int32
+}
+
+// State returns the current state
+func (l *InjectorLifecycle) State() InjectorState {
+	return InjectorState(l.state.Load())
+}
+
+// Start moves to InjectorInjecting, failing with ErrInjectorActive while already injecting
+func (l *InjectorLifecycle) Start() error {
+	for {
+		state := l.state.Load()
+		if InjectorState(state) == InjectorInjecting {
+			return ErrInjectorActive
+		}
+		if l.state.CompareAndSwap(state, int32(InjectorInjecting)) {
+			return nil
+		}
+	}
+}
+
+// Stop moves to InjectorStopped, reporting whether the injector was injecting
+func (l *InjectorLifecycle) Stop() bool {
+	return InjectorState(l.state.Swap(int32(InjectorStopped))) == InjectorInjecting
+}
+
+// Stopped reports InjectorStopped, in which injectors inject no faults
+func (l *InjectorLifecycle) Stopped() bool {
+	return l.State() == InjectorStopped
+}
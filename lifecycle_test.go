@@ -0,0 +1,26 @@
+package chaoskit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectorLifecycle(t *testing.T) {
+	var lifecycle InjectorLifecycle
+	assert.Equal(t, InjectorCreated, lifecycle.State())
+	assert.False(t, lifecycle.Stopped())
+
+	require.NoError(t, lifecycle.Start())
+	assert.Equal(t, InjectorInjecting, lifecycle.State())
+	assert.ErrorIs(t, lifecycle.Start(), ErrInjectorActive)
+
+	assert.True(t, lifecycle.Stop())
+	assert.True(t, lifecycle.Stopped())
+	assert.False(t, lifecycle.Stop(), "stopping twice")
+
+	// Stopped injectors may be restarted
+	require.NoError(t, lifecycle.Start())
+	assert.Equal(t, "injecting", lifecycle.State().String())
+}
@@ -0,0 +1,367 @@
+package chaoskit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// MessagingOp is the messaging operation a fault applies to
+type MessagingOp string
+
+// Messaging operations of a ChaosMessagingProvider
+const (
+	MessagingPublish MessagingOp = "publish"
+	MessagingDeliver MessagingOp = "deliver"
+)
+
+// MessagingFault is a fault of a publish or delivery. Kind is
+// InjectionTypePublishFailure for publishes, InjectionTypeRedelivery,
+// InjectionTypeReorder or InjectionTypeConsumerDisconnect for deliveries, and
+// empty when no fault applies.
+type MessagingFault struct {
+	Kind string
+
+	// Duration is how long a disconnected consumer stays offline
+	Duration time.Duration
+}
+
+// messagingReorderHold bounds how long a reordered delivery waits for the
+// next one before it is delivered anyway
+const messagingReorderHold = 100 * time.Millisecond
+
+// MaybePublishError returns a *ChaosError when the active messaging injectors
+// fail a publish on subject (a NATS subject or an AMQP routing key), for
+// publishers not wrapped with WrapPublish, such as Kafka producers
+func MaybePublishError(ctx context.Context, subject string) error {
+	if _, err := applyMessagingChaos(ctx, MessagingPublish, subject); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MaybeDeliveryFault returns the fault the active messaging injectors apply
+// to a delivery from subject (a NATS subject or an AMQP queue), for consumers
+// not wrapped with WrapMessageHandler or WrapDeliveries
+func MaybeDeliveryFault(ctx context.Context, subject string) MessagingFault {
+	fault, _ := applyMessagingChaos(ctx, MessagingDeliver, subject)
+
+	return fault
+}
+
+// applyMessagingChaos returns the fault of a publish or delivery and the
+// *ChaosError describing it, if any
+func applyMessagingChaos(ctx context.Context, op MessagingOp, subject string) (MessagingFault, *ChaosError) {
+	chaos := GetChaos(ctx)
+	if chaos == nil {
+		return MessagingFault{}, nil
+	}
+
+	chaos.mu.RLock()
+	messagingFunc := chaos.messagingFunc
+	chaos.mu.RUnlock()
+
+	if messagingFunc == nil {
+		return MessagingFault{}, nil
+	}
+
+	return messagingFunc(ctx, op, subject)
+}
+
+// messagingChaosFunc returns the messagingFunc of a chaos context: the first
+// provider applying a fault to a call wins
+func messagingChaosFunc(
+	chaos *ChaosContext,
+	providers []ChaosMessagingProvider,
+	decisions *iterationDecisions,
+) func(context.Context, MessagingOp, string) (MessagingFault, *ChaosError) {
+	return func(ctx context.Context, op MessagingOp, subject string) (MessagingFault, *ChaosError) {
+		call := decisions.next(traceMessaging)
+		var fault MessagingFault
+		var injector string
+		if decision, replaying := decisions.replayed(traceMessaging, call); replaying {
+			fault = MessagingFault{Kind: decision.Fault, Duration: decision.Delay}
+			injector = decision.Injector
+		} else {
+			for _, provider := range providers {
+				if f, ok := provider.GetMessagingFault(op, subject); ok && f.Kind != "" {
+					fault, injector = f, provider.Name()
+
+					break
+				}
+			}
+		}
+		if fault.Kind == "" || !chaos.limiter.allow(ctx, injector) {
+			return MessagingFault{}, nil
+		}
+
+		decisions.record(Decision{Helper: traceMessaging, Call: call, Injector: injector, Fault: fault.Kind, Delay: fault.Duration})
+		injected := NewChaosError(ctx, injector, fault.Kind, messagingFaultError(op, subject, fault))
+		attributes := map[string]any{"operation": string(op), "subject": subject}
+		if fault.Duration > 0 {
+			attributes["duration"] = fault.Duration.String()
+		}
+		GetLogger(ctx).Debug("messaging fault injected",
+			slog.String("fault", fault.Kind),
+			slog.String("subject", subject),
+			slog.String("fault_id", injected.FaultID))
+		RecordInjection(ctx, InjectionEvent{
+			Injector:   injector,
+			Type:       fault.Kind,
+			FaultID:    injected.FaultID,
+			Attributes: attributes,
+		})
+
+		return fault, injected
+	}
+}
+
+// messagingFaultError describes a messaging fault
+func messagingFaultError(op MessagingOp, subject string, fault MessagingFault) error {
+	switch fault.Kind {
+	case InjectionTypePublishFailure:
+		return fmt.Errorf("publish to %s failed", subject)
+	case InjectionTypeConsumerDisconnect:
+		return fmt.Errorf("consumer of %s disconnected for %s", subject, fault.Duration)
+	default:
+		return fmt.Errorf("%s of %s: %s", op, subject, fault.Kind)
+	}
+}
+
+// WrapPublish returns publish failing with a *ChaosError, without publishing,
+// when the active messaging injectors fail the publish. It fits publish
+// functions such as (*nats.Conn).Publish:
+//
+//	publish := chaoskit.WrapPublish(ctx, nc.Publish)
+//	err := publish("orders.created", data)
+//
+// As with WrapHTTPClient, ctx is the context whose chaos applies, so a
+// function wrapped once in Target.Setup serves every iteration.
+func WrapPublish[M any](ctx context.Context, publish func(subject string, msg M) error) func(subject string, msg M) error {
+	return func(subject string, msg M) error {
+		if err := MaybePublishError(ctx, subject); err != nil {
+			return err
+		}
+
+		return publish(subject, msg)
+	}
+}
+
+// WrapMessageHandler returns handler with the delivery faults of the active
+// messaging injectors, for callbacks of NATS subscriptions:
+//
+//	nc.Subscribe("orders.*", chaoskit.WrapMessageHandler(ctx,
+//		func(m *nats.Msg) string { return m.Subject }, handleOrder))
+//
+// A redelivered message is handled twice. A reordered one is handled after
+// the next message, or after a short hold when none arrives. A disconnected
+// consumer drops the messages it receives while offline: core NATS loses
+// them, JetStream redelivers them once their ack wait expires. Handler calls
+// are serialized.
+func WrapMessageHandler[M any](ctx context.Context, subject func(M) string, handler func(M)) func(M) {
+	var (
+		mu           sync.Mutex
+		held         *heldMessage[M]
+		offlineUntil time.Time
+	)
+	release := func() {
+		if held != nil {
+			held.timer.Stop()
+			msg := held.msg
+			held = nil
+			handler(msg)
+		}
+	}
+
+	return func(msg M) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if time.Now().Before(offlineUntil) {
+			return
+		}
+
+		fault := MaybeDeliveryFault(ctx, subject(msg))
+		switch fault.Kind {
+		case InjectionTypeConsumerDisconnect:
+			release()
+			offlineUntil = time.Now().Add(fault.Duration)
+
+			return
+		case InjectionTypeReorder:
+			if held == nil {
+				h := &heldMessage[M]{msg: msg}
+				h.timer = time.AfterFunc(messagingReorderHold, func() {
+					mu.Lock()
+					defer mu.Unlock()
+
+					if held == h {
+						held = nil
+						handler(h.msg)
+					}
+				})
+				held = h
+
+				return
+			}
+		}
+
+		handler(msg)
+		if fault.Kind == InjectionTypeRedelivery {
+			handler(duplicateDelivery(msg))
+		}
+		release()
+	}
+}
+
+// heldMessage is a reordered message waiting for the next one
+type heldMessage[M any] struct {
+	msg   M
+	timer *time.Timer
+}
+
+// WrapDeliveries returns a channel forwarding deliveries with the delivery
+// faults of the active messaging injectors, for AMQP consumers:
+//
+//	deliveries, err := ch.Consume("orders", "", false, false, false, false, nil)
+//	for d := range chaoskit.WrapDeliveries(ctx, "orders", deliveries) { ... }
+//
+// A redelivered delivery is forwarded twice. A reordered one is forwarded
+// after the next delivery, or after a short hold when none arrives. A
+// disconnected consumer receives nothing while offline, then the deliveries
+// received meanwhile, as the broker requeues unacknowledged messages.
+// Copies and requeued deliveries have their Redelivered field set; the
+// acknowledgements of a copy are discarded (through its Acknowledger field),
+// so acknowledging it does not fail the channel with an unknown delivery tag.
+// The returned channel is closed when deliveries is or when ctx is done;
+// deliveries not forwarded by then are left unacknowledged for the broker
+// to requeue.
+func WrapDeliveries[M any](ctx context.Context, queue string, deliveries <-chan M) <-chan M {
+	out := make(chan M)
+	go forwardDeliveries(ctx, queue, deliveries, out)
+
+	return out
+}
+
+func forwardDeliveries[M any](ctx context.Context, queue string, in <-chan M, out chan<- M) {
+	defer close(out)
+
+	// send forwards a message unless the consumer stopped receiving
+	send := func(msgs ...M) bool {
+		for _, msg := range msgs {
+			select {
+			case out <- msg:
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		return true
+	}
+
+	var (
+		held      []M
+		holdTimer <-chan time.Time
+		offline   []M
+		reconnect <-chan time.Time
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-in:
+			// Deliveries still held belong to a closed channel; the broker
+			// requeues them
+			if !ok {
+				return
+			}
+			if reconnect != nil {
+				offline = append(offline, msg)
+
+				continue
+			}
+
+			fault := MaybeDeliveryFault(ctx, queue)
+			switch fault.Kind {
+			case InjectionTypeConsumerDisconnect:
+				offline = append(append(offline, held...), msg)
+				held, holdTimer = nil, nil
+				reconnect = time.After(fault.Duration)
+
+				continue
+			case InjectionTypeReorder:
+				if len(held) == 0 {
+					held = []M{msg}
+					holdTimer = time.After(messagingReorderHold)
+
+					continue
+				}
+			}
+
+			if !send(msg) {
+				return
+			}
+			if fault.Kind == InjectionTypeRedelivery && !send(duplicateDelivery(msg)) {
+				return
+			}
+			if !send(held...) {
+				return
+			}
+			held, holdTimer = nil, nil
+		case <-holdTimer:
+			if !send(held...) {
+				return
+			}
+			held, holdTimer = nil, nil
+		case <-reconnect:
+			for _, m := range offline {
+				if !send(markRedelivered(m)) {
+					return
+				}
+			}
+			offline, reconnect = nil, nil
+		}
+	}
+}
+
+// markRedelivered sets the Redelivered field of a struct message (AMQP deliveries)
+func markRedelivered[M any](msg M) M {
+	v := reflect.ValueOf(&msg).Elem()
+	if v.Kind() != reflect.Struct {
+		return msg
+	}
+	if f := v.FieldByName("Redelivered"); f.IsValid() && f.CanSet() && f.Kind() == reflect.Bool {
+		f.SetBool(true)
+	}
+
+	return msg
+}
+
+// duplicateDelivery returns the redelivered copy of a message. The
+// acknowledgements of a struct copy with an Acknowledger field (AMQP
+// deliveries) are discarded, as the original owns the delivery tag.
+func duplicateDelivery[M any](msg M) M {
+	msg = markRedelivered(msg)
+	v := reflect.ValueOf(&msg).Elem()
+	if v.Kind() != reflect.Struct {
+		return msg
+	}
+	ack := reflect.ValueOf(discardAcknowledger{})
+	if f := v.FieldByName("Acknowledger"); f.IsValid() && f.CanSet() &&
+		f.Kind() == reflect.Interface && ack.Type().Implements(f.Type()) {
+		f.Set(ack)
+	}
+
+	return msg
+}
+
+// discardAcknowledger implements the AMQP Acknowledger of duplicated deliveries
+type discardAcknowledger struct{}
+
+func (discardAcknowledger) Ack(tag uint64, multiple bool) error           { return nil }
+func (discardAcknowledger) Nack(tag uint64, multiple, requeue bool) error { return nil }
+func (discardAcknowledger) Reject(tag uint64, requeue bool) error         { return nil }
@@ -0,0 +1,217 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMessagingInjector applies its faults to successive calls, in order
+type testMessagingInjector struct {
+	testMetricsInjector
+	mu     sync.Mutex
+	faults []string
+}
+
+func (i *testMessagingInjector) Name() string { return "test-messaging" }
+
+func (i *testMessagingInjector) GetMessagingFault(op MessagingOp, subject string) (MessagingFault, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if len(i.faults) == 0 {
+		return MessagingFault{}, false
+	}
+	kind := i.faults[0]
+	i.faults = i.faults[1:]
+
+	return MessagingFault{Kind: kind, Duration: 20 * time.Millisecond}, kind != ""
+}
+
+// runMessaging runs step once with the messaging faults applied in order
+func runMessaging(t *testing.T, step func(ctx context.Context), faults ...string) []InjectionEvent {
+	t.Helper()
+
+	executor := NewExecutor()
+	scenario := NewScenario("messaging").
+		WithTarget(&testTarget{}).
+		Inject("messaging", &testMessagingInjector{faults: faults}).
+		Step("step", func(ctx context.Context, target Target) error {
+			step(ctx)
+			return nil
+		}).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	return executor.Reporter().Timeline()
+}
+
+func TestWrapPublish(t *testing.T) {
+	var published []string
+	publish := func(subject string, data []byte) error {
+		published = append(published, subject)
+		return nil
+	}
+
+	var errs []error
+	timeline := runMessaging(t, func(ctx context.Context) {
+		wrapped := WrapPublish(ctx, publish)
+		errs = append(errs, wrapped("orders.created", nil), wrapped("orders.paid", nil))
+	}, InjectionTypePublishFailure, "")
+
+	var injected *ChaosError
+	require.ErrorAs(t, errs[0], &injected)
+	assert.Equal(t, InjectionTypePublishFailure, injected.Kind)
+	assert.NoError(t, errs[1])
+	assert.Equal(t, []string{"orders.paid"}, published)
+	require.Len(t, timeline, 1)
+	assert.Equal(t, "orders.created", timeline[0].Attributes["subject"])
+
+	require.NoError(t, WrapPublish(context.Background(), publish)("orders.created", nil), "no chaos outside of scenario runs")
+}
+
+func TestWrapMessageHandler(t *testing.T) {
+	type msg struct{ subject, body string }
+
+	tests := []struct {
+		name   string
+		faults []string
+		want   []string
+	}{
+		{"redelivery", []string{InjectionTypeRedelivery}, []string{"a", "a", "b", "c"}},
+		{"reorder", []string{InjectionTypeReorder}, []string{"b", "a", "c"}},
+		{"disconnect", []string{"", InjectionTypeConsumerDisconnect}, []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled []string
+			runMessaging(t, func(ctx context.Context) {
+				handler := WrapMessageHandler(ctx, func(m msg) string { return m.subject },
+					func(m msg) { handled = append(handled, m.body) })
+				for _, body := range []string{"a", "b", "c"} {
+					handler(msg{subject: "orders", body: body})
+				}
+			}, tt.faults...)
+			assert.Equal(t, tt.want, handled)
+		})
+	}
+}
+
+// testAcknowledger records the acknowledged delivery tags
+type testAcknowledger struct {
+	acked []uint64
+}
+
+func (a *testAcknowledger) Ack(tag uint64, multiple bool) error {
+	a.acked = append(a.acked, tag)
+	return nil
+}
+func (a *testAcknowledger) Nack(tag uint64, multiple, requeue bool) error {
+	return errors.New("unexpected nack")
+}
+func (a *testAcknowledger) Reject(tag uint64, requeue bool) error {
+	return errors.New("unexpected reject")
+}
+
+// testDelivery mirrors the fields of an AMQP delivery used by WrapDeliveries
+type testDelivery struct {
+	Acknowledger interface {
+		Ack(tag uint64, multiple bool) error
+		Nack(tag uint64, multiple, requeue bool) error
+		Reject(tag uint64, requeue bool) error
+	}
+	DeliveryTag uint64
+	Redelivered bool
+}
+
+func (d testDelivery) Ack() error { return d.Acknowledger.Ack(d.DeliveryTag, false) }
+
+func TestWrapDeliveries(t *testing.T) {
+	consume := func(faults ...string) ([]testDelivery, *testAcknowledger) {
+		ack := &testAcknowledger{}
+		var received []testDelivery
+		runMessaging(t, func(ctx context.Context) {
+			in := make(chan testDelivery, 3)
+			for tag := uint64(1); tag <= 3; tag++ {
+				in <- testDelivery{Acknowledger: ack, DeliveryTag: tag}
+			}
+			close(in)
+			for d := range WrapDeliveries(ctx, "orders", in) {
+				received = append(received, d)
+				require.NoError(t, d.Ack())
+			}
+		}, faults...)
+
+		return received, ack
+	}
+
+	received, ack := consume(InjectionTypeRedelivery)
+	require.Len(t, received, 4)
+	assert.False(t, received[0].Redelivered)
+	assert.True(t, received[1].Redelivered)
+	assert.Equal(t, uint64(1), received[1].DeliveryTag)
+	assert.Equal(t, []uint64{1, 2, 3}, ack.acked, "acknowledgements of the copy are discarded")
+
+	received, _ = consume(InjectionTypeReorder)
+	tags := make([]uint64, 0, len(received))
+	for _, d := range received {
+		tags = append(tags, d.DeliveryTag)
+	}
+	assert.Equal(t, []uint64{2, 1, 3}, tags)
+}
+
+func TestWrapDeliveries_Disconnect(t *testing.T) {
+	var received []testDelivery
+	var elapsed time.Duration
+	runMessaging(t, func(ctx context.Context) {
+		in := make(chan testDelivery)
+		out := WrapDeliveries(ctx, "orders", in)
+		start := time.Now()
+		go func() {
+			for tag := uint64(1); tag <= 3; tag++ {
+				in <- testDelivery{DeliveryTag: tag}
+			}
+		}()
+		for d := range out {
+			received = append(received, d)
+			if len(received) == 3 {
+				elapsed = time.Since(start)
+				close(in)
+			}
+		}
+	}, InjectionTypeConsumerDisconnect)
+
+	require.Len(t, received, 3)
+	for i, d := range received {
+		assert.Equal(t, uint64(i+1), d.DeliveryTag)
+		assert.True(t, d.Redelivered, "deliveries received while offline are requeued")
+	}
+	assert.GreaterOrEqual(t, elapsed, 20*time.Millisecond)
+}
+
+func TestWrapDeliveries_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan testDelivery, 2)
+	in <- testDelivery{DeliveryTag: 1}
+	in <- testDelivery{DeliveryTag: 2}
+	out := WrapDeliveries(ctx, "orders", in)
+
+	// The consumer stops receiving while the channel stays open
+	cancel()
+	closed := make(chan struct{})
+	go func() {
+		for range out {
+		}
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("output not closed when ctx is done")
+	}
+}
@@ -2,74 +2,90 @@ package chaoskit
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
-// MetricsCollector collects execution metrics
+// ResultExporter receives execution results and injector metrics as they arrive
+// during a run (see WithExporters). exporters.PrometheusExporter and
+// exporters.OTelMetricsExporter implement it.
+type ResultExporter interface {
+	RecordExecution(result ExecutionResult)
+	RecordInjectorMetrics(injectorName string, metrics map[string]any)
+}
+
+// ValidatorMetricsExporter is an optional ResultExporter extension
+// receiving the outcome of every validator check
+type ValidatorMetricsExporter interface {
+	RecordValidatorMetrics(validatorName string, failed bool, warning bool)
+}
+
+// MetricsCollector collects execution metrics. It takes no lock: counters
+// are atomics and injector metrics live in a sync.Map keyed by injector, so
+// parallel iterations and injectors reporting metrics don't serialize on it
+// (and don't skew the latencies they measure).
 type MetricsCollector struct {
-	mu              sync.RWMutex
-	totalExecutions int
-	successCount    int
-	failureCount    int
-	totalDuration   time.Duration
-	injectorMetrics map[string]map[string]interface{} // injector name -> metrics
+	successCount    atomic.Int64
+	failureCount    atomic.Int64
+	totalDuration   atomic.Int64 // nanoseconds
+	injectorMetrics sync.Map     // injector name -> map[string]interface{}
 }
 
 // NewMetricsCollector creates a new metrics collector
 func NewMetricsCollector() *MetricsCollector {
-	return &MetricsCollector{
-		injectorMetrics: make(map[string]map[string]interface{}),
-	}
+	return &MetricsCollector{}
 }
 
 // RecordExecution records an execution result
 func (m *MetricsCollector) RecordExecution(result ExecutionResult) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	m.totalExecutions++
-	m.totalDuration += result.Duration
+	m.totalDuration.Add(int64(result.Duration))
 
 	if result.Success {
-		m.successCount++
+		m.successCount.Add(1)
 	} else {
-		m.failureCount++
+		m.failureCount.Add(1)
 	}
 }
 
-// Stats returns current statistics
+// Stats returns current statistics. While executions are being recorded,
+// the counters are read one by one, so the average may lag them slightly.
 func (m *MetricsCollector) Stats() map[string]any {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
+	successCount := m.successCount.Load()
+	failureCount := m.failureCount.Load()
+	totalExecutions := successCount + failureCount
 
 	avgDuration := time.Duration(0)
-	if m.totalExecutions > 0 {
-		avgDuration = m.totalDuration / time.Duration(m.totalExecutions)
+	if totalExecutions > 0 {
+		avgDuration = time.Duration(m.totalDuration.Load() / totalExecutions)
 	}
 
+	injectorMetrics := make(map[string]map[string]interface{})
+	m.injectorMetrics.Range(func(name, metrics any) bool {
+		injectorMetrics[name.(string)] = metrics.(map[string]interface{})
+
+		return true
+	})
+
 	return map[string]any{
-		"total_executions": m.totalExecutions,
-		"success_count":    m.successCount,
-		"failure_count":    m.failureCount,
+		"total_executions": int(totalExecutions),
+		"success_count":    int(successCount),
+		"failure_count":    int(failureCount),
 		"avg_duration_ms":  avgDuration.Milliseconds(),
-		"injector_metrics": m.injectorMetrics,
+		"injector_metrics": injectorMetrics,
 	}
 }
 
 // RecordInjectorMetrics records metrics from an injector
 func (m *MetricsCollector) RecordInjectorMetrics(injectorName string, metrics map[string]interface{}) {
-	m.mu.Lock()
-	defer m.mu.Unlock()
-
-	m.injectorMetrics[injectorName] = metrics
+	m.injectorMetrics.Store(injectorName, metrics)
 }
 
 // GetInjectorMetrics returns metrics for a specific injector
 func (m *MetricsCollector) GetInjectorMetrics(injectorName string) (map[string]interface{}, bool) {
-	m.mu.RLock()
-	defer m.mu.RUnlock()
-
-	metrics, ok := m.injectorMetrics[injectorName]
+	metrics, ok := m.injectorMetrics.Load(injectorName)
+	if !ok {
+		return nil, false
+	}
 
-	return metrics, ok
+	return metrics.(map[string]interface{}), true
 }
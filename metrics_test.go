@@ -0,0 +1,54 @@
+package chaoskit
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsCollector_Concurrent(t *testing.T) {
+	m := NewMetricsCollector()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			name := fmt.Sprintf("injector-%d", g)
+			for i := 0; i < 1000; i++ {
+				m.RecordExecution(ExecutionResult{Success: i%4 != 0, Duration: 2 * time.Millisecond})
+				m.RecordInjectorMetrics(name, map[string]interface{}{"calls": i})
+				_ = m.Stats()
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	stats := m.Stats()
+	assert.Equal(t, 8000, stats["total_executions"])
+	assert.Equal(t, 6000, stats["success_count"])
+	assert.Equal(t, 2000, stats["failure_count"])
+	assert.Equal(t, int64(2), stats["avg_duration_ms"])
+	assert.Len(t, stats["injector_metrics"], 8)
+
+	metrics, ok := m.GetInjectorMetrics("injector-3")
+	require.True(t, ok)
+	assert.Equal(t, 999, metrics["calls"])
+	_, ok = m.GetInjectorMetrics("missing")
+	assert.False(t, ok)
+}
+
+func BenchmarkMetricsCollector_RecordExecution(b *testing.B) {
+	m := NewMetricsCollector()
+	result := ExecutionResult{Success: true, Duration: time.Millisecond}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			m.RecordExecution(result)
+		}
+	})
+}
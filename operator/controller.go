@@ -0,0 +1,387 @@
+// Package operator runs ChaosKitScenario custom resources on a schedule
+// inside a Kubernetes cluster. The controller polls the resources, runs due
+// scenarios in-process or sharded across chaoskit agents, stores the report
+// of the last run in a ConfigMap and exposes the verdict as status
+// conditions, so chaos experiments can be managed with GitOps.
+//
+// Manifests of the CRD, RBAC rules and operator deployment are in
+// deploy/operator.
+package operator
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+	"github.com/rom8726/chaoskit/distributed"
+	"github.com/rom8726/chaoskit/kube"
+)
+
+// DefaultResyncInterval is how often scenarios are reconciled by default
+const DefaultResyncInterval = 30 * time.Second
+
+// reportRetention bounds the results kept for the report of a run
+const reportRetention = 100
+
+// maxReportSize keeps report ConfigMaps under the 1 MiB object limit
+const maxReportSize = 900 << 10
+
+// Controller reconciles ChaosKitScenario resources
+type Controller struct {
+	client       *kube.Client
+	namespace    string
+	resync       time.Duration
+	logger       *slog.Logger
+	executorOpts []chaoskit.ExecutorOption
+	coordOpts    []distributed.CoordinatorOption
+	now          func() time.Time
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// Option configures a Controller
+type Option func(*Controller)
+
+// WithNamespace watches a single namespace instead of all namespaces
+func WithNamespace(namespace string) Option {
+	return func(c *Controller) {
+		c.namespace = namespace
+	}
+}
+
+// WithResyncInterval sets how often scenarios are reconciled
+// (DefaultResyncInterval by default)
+func WithResyncInterval(interval time.Duration) Option {
+	return func(c *Controller) {
+		c.resync = interval
+	}
+}
+
+// WithLogger sets the logger of the controller and its runs
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Controller) {
+		c.logger = logger
+	}
+}
+
+// WithExecutorOptions adds executor options (exporters, redactor, artifacts)
+// applied to every in-process run
+func WithExecutorOptions(opts ...chaoskit.ExecutorOption) Option {
+	return func(c *Controller) {
+		c.executorOpts = append(c.executorOpts, opts...)
+	}
+}
+
+// WithCoordinatorOptions adds coordinator options (e.g. the agent token of
+// distributed.WithToken) applied to every run sharded across spec.workers
+func WithCoordinatorOptions(opts ...distributed.CoordinatorOption) Option {
+	return func(c *Controller) {
+		c.coordOpts = append(c.coordOpts, opts...)
+	}
+}
+
+// NewController creates a controller using client
+func NewController(client *kube.Client, opts ...Option) *Controller {
+	c := &Controller{
+		client:  client,
+		resync:  DefaultResyncInterval,
+		logger:  slog.Default(),
+		now:     time.Now,
+		running: make(map[string]context.CancelFunc),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Run reconciles scenarios every resync interval until ctx is done, then
+// waits for the runs in progress to record their cancellation
+func (c *Controller) Run(ctx context.Context) error {
+	c.logger.Info("operator started",
+		slog.String("namespace", cmp.Or(c.namespace, "(all)")),
+		slog.Duration("resync", c.resync))
+
+	ticker := time.NewTicker(c.resync)
+	defer ticker.Stop()
+	for {
+		if err := c.Reconcile(ctx); err != nil && ctx.Err() == nil {
+			c.logger.Warn("reconcile failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			c.wg.Wait()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile starts the scenarios that are due and updates the status of the
+// others. Runs of deleted scenarios are stopped.
+func (c *Controller) Reconcile(ctx context.Context) error {
+	scenarios, err := listScenarios(ctx, c.client, c.namespace)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(scenarios))
+	var errs []error
+	for i := range scenarios {
+		keys = append(keys, scenarios[i].key())
+		if err := c.reconcile(ctx, &scenarios[i]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", scenarios[i].key(), err))
+		}
+	}
+
+	c.mu.Lock()
+	for key, cancel := range c.running {
+		if !slices.Contains(keys, key) {
+			c.logger.Info("scenario deleted, stopping its run", slog.String("scenario", key))
+			cancel()
+		}
+	}
+	c.mu.Unlock()
+
+	return errors.Join(errs...)
+}
+
+func (c *Controller) reconcile(ctx context.Context, s *ChaosKitScenario) error {
+	if c.isRunning(s.key()) {
+		return nil
+	}
+
+	status := s.Status
+	status.Conditions = slices.Clone(status.Conditions)
+	schedule, err := ParseSchedule(s.Spec.Schedule)
+	if err == nil {
+		_, err = config.Parse([]byte(s.Spec.Scenario))
+	}
+	if err != nil {
+		if status.Phase == PhaseInvalid && status.Message == err.Error() &&
+			status.ObservedGeneration == s.Metadata.Generation {
+			return nil
+		}
+		status.Phase = PhaseInvalid
+		status.Message = err.Error()
+		status.NextRunTime = nil
+		status.ObservedGeneration = s.Metadata.Generation
+
+		return patchStatus(ctx, c.client, s, status)
+	}
+
+	if status.Phase == PhaseRunning {
+		// The operator was restarted while the scenario was running
+		status.Phase = PhaseFailed
+		status.Message = "run interrupted by an operator restart"
+		status.Conditions = setCondition(status.Conditions, c.condition(ConditionRunning, false, "Interrupted", status.Message))
+	}
+
+	last := s.Metadata.CreationTimestamp
+	if status.LastRunTime != nil {
+		last = *status.LastRunTime
+	}
+	next := schedule.Next(last)
+	if !s.Spec.Suspend && !next.IsZero() && !c.now().Before(next) {
+		return c.start(ctx, s, status)
+	}
+
+	if status.Phase == "" || status.Phase == PhaseInvalid {
+		status.Phase = PhaseScheduled
+		status.Message = ""
+	}
+	status.NextRunTime = nil
+	if !next.IsZero() && !s.Spec.Suspend {
+		status.NextRunTime = &next
+	}
+	status.ObservedGeneration = s.Metadata.Generation
+	if statusEqual(s.Status, status) {
+		return nil
+	}
+
+	return patchStatus(ctx, c.client, s, status)
+}
+
+// start records the run in the status and runs the scenario in the background
+func (c *Controller) start(ctx context.Context, s *ChaosKitScenario, status ScenarioStatus) error {
+	startedAt := c.now().UTC().Truncate(time.Second)
+	status.Phase = PhaseRunning
+	status.LastRunTime = &startedAt
+	status.NextRunTime = nil
+	status.Message = ""
+	status.ObservedGeneration = s.Metadata.Generation
+	status.Conditions = setCondition(status.Conditions, c.condition(ConditionRunning, true, "Scheduled", ""))
+	// Without a recorded start time the scenario would be started again
+	if err := patchStatus(ctx, c.client, s, status); err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	c.mu.Lock()
+	c.running[s.key()] = cancel
+	c.mu.Unlock()
+	c.logger.Info("scenario run started", slog.String("scenario", s.key()))
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer func() {
+			cancel()
+			c.mu.Lock()
+			delete(c.running, s.key())
+			c.mu.Unlock()
+		}()
+
+		c.execute(runCtx, s, status)
+	}()
+
+	return nil
+}
+
+// execute runs the scenario and records its report and verdict
+func (c *Controller) execute(ctx context.Context, s *ChaosKitScenario, status ScenarioStatus) {
+	logger := c.logger.With(slog.String("scenario", s.key()))
+	report, reporter, err := c.runScenario(ctx, s, logger)
+
+	status.Conditions = setCondition(status.Conditions, c.condition(ConditionRunning, false, "Finished", ""))
+	if err != nil {
+		status.Phase = PhaseFailed
+		status.Message = err.Error()
+		status.Conditions = setCondition(status.Conditions, c.condition(ConditionPassed, false, "RunFailed", err.Error()))
+	} else {
+		status.Phase = PhaseCompleted
+		status.Verdict = report.Verdict.String()
+		status.Iterations = report.TotalIterations
+		status.SuccessRate = report.SuccessRate
+		status.Message = report.Summary
+		status.Conditions = setCondition(status.Conditions,
+			c.condition(ConditionPassed, report.Verdict == chaoskit.VerdictPass, verdictReason(report.Verdict), report.Summary))
+
+		name := cmp.Or(s.Spec.ReportConfigMap, s.Metadata.Name+"-report")
+		if err := applyConfigMap(context.WithoutCancel(ctx), c.client, s, name, reportData(reporter, report)); err != nil {
+			logger.Warn("failed to store report", slog.String("configmap", name), slog.String("error", err.Error()))
+			status.Message = fmt.Sprintf("%s (report not stored: %v)", status.Message, err)
+		} else {
+			status.ReportConfigMap = name
+		}
+	}
+	if schedule, err := ParseSchedule(s.Spec.Schedule); err == nil {
+		if next := schedule.Next(*status.LastRunTime); !next.IsZero() && !s.Spec.Suspend {
+			status.NextRunTime = &next
+		}
+	}
+
+	// The status is recorded even when the run was canceled by a shutdown
+	if err := patchStatus(context.WithoutCancel(ctx), c.client, s, status); err != nil {
+		logger.Warn("failed to update status", slog.String("error", err.Error()))
+	}
+	logger.Info("scenario run finished",
+		slog.String("phase", status.Phase),
+		slog.String("verdict", status.Verdict))
+}
+
+// runScenario runs the scenario in-process, or on the agents of spec.workers
+func (c *Controller) runScenario(
+	ctx context.Context,
+	s *ChaosKitScenario,
+	logger *slog.Logger,
+) (*chaoskit.Report, *chaoskit.Reporter, error) {
+	if len(s.Spec.Workers) > 0 {
+		opts := append([]distributed.CoordinatorOption{distributed.WithCoordinatorLogger(logger)}, c.coordOpts...)
+		coordinator := distributed.NewCoordinator(s.Spec.Workers, opts...)
+		report, err := coordinator.Run(ctx, []byte(s.Spec.Scenario))
+
+		return report, coordinator.Reporter(), err
+	}
+
+	cfg, err := config.Parse([]byte(s.Spec.Scenario))
+	if err != nil {
+		return nil, nil, err
+	}
+	scenario, err := cfg.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts := append([]chaoskit.ExecutorOption{
+		chaoskit.WithSlogLogger(logger),
+		chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure),
+		chaoskit.WithResultRetention(reportRetention),
+	}, c.executorOpts...)
+	executor := chaoskit.NewExecutor(opts...)
+	if err := executor.Run(ctx, scenario); err != nil {
+		if ctx.Err() != nil {
+			return nil, nil, err
+		}
+		logger.Warn("scenario run error", slog.String("error", err.Error()))
+	}
+
+	report, err := executor.Reporter().GetVerdict(cfg.SuccessThresholds())
+
+	return report, executor.Reporter(), err
+}
+
+// reportData returns the ConfigMap data of a report: its JSON and text forms,
+// leaving out the JSON form when both exceed the ConfigMap size limit
+func reportData(reporter *chaoskit.Reporter, report *chaoskit.Report) map[string]string {
+	text := reporter.GenerateTextReport(report)
+	data := map[string]string{"report.txt": text}
+	if encoded, err := json.MarshalIndent(report, "", "  "); err == nil && len(encoded)+len(text) <= maxReportSize {
+		data["report.json"] = string(encoded)
+	}
+	if len(text) > maxReportSize {
+		data["report.txt"] = text[:maxReportSize] + "\n... (truncated)\n"
+	}
+
+	return data
+}
+
+// verdictReason returns a condition reason (CamelCase) for a verdict
+func verdictReason(verdict chaoskit.Verdict) string {
+	switch verdict {
+	case chaoskit.VerdictPass:
+		return "Pass"
+	case chaoskit.VerdictUnstable:
+		return "Unstable"
+	default:
+		return "Fail"
+	}
+}
+
+func (c *Controller) condition(typ string, status bool, reason, message string) Condition {
+	value := "False"
+	if status {
+		value = "True"
+	}
+
+	return Condition{Type: typ, Status: value, Reason: reason, Message: message, LastTransitionTime: c.now().UTC().Truncate(time.Second)}
+}
+
+func (c *Controller) isRunning(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.running[key]
+
+	return ok
+}
+
+// statusEqual compares statuses as they are stored by the API server
+func statusEqual(a, b ScenarioStatus) bool {
+	left, _ := json.Marshal(a)
+	right, _ := json.Marshal(b)
+
+	return string(left) == string(right)
+}
@@ -0,0 +1,238 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rom8726/chaoskit/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	from := time.Date(2026, 3, 14, 10, 17, 30, 0, time.UTC) // Saturday
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2026, 3, 14, 10, 30, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2026, 3, 15, 2, 0, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2026, 3, 16, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 13 * 0", time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)}, // day of month or Sunday
+		{"0 0 * * 7", time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"5,45 10 * * *", time.Date(2026, 3, 14, 10, 45, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 3, 14, 11, 0, 0, 0, time.UTC)},
+		{"@every 6h", from.Add(6 * time.Hour)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* * * * MON", "*/0 * * * *", "5-1 * * * *", "@every 10s"} {
+		_, err := ParseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+// fakeAPIServer serves ChaosKitScenarios and ConfigMaps of the "chaos" namespace
+type fakeAPIServer struct {
+	mu         sync.Mutex
+	scenarios  map[string]*ChaosKitScenario
+	configMaps map[string]map[string]any
+	patches    int
+}
+
+func newFakeAPIServer(scenarios ...ChaosKitScenario) *fakeAPIServer {
+	f := &fakeAPIServer{scenarios: make(map[string]*ChaosKitScenario), configMaps: make(map[string]map[string]any)}
+	for i := range scenarios {
+		f.scenarios[scenarios[i].Metadata.Name] = &scenarios[i]
+	}
+
+	return f
+}
+
+func (f *fakeAPIServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /apis/chaoskit.io/v1alpha1/chaoskitscenarios", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		items := make([]ChaosKitScenario, 0, len(f.scenarios))
+		for _, s := range f.scenarios {
+			items = append(items, *s)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"items": items})
+	})
+	mux.HandleFunc("PATCH /apis/chaoskit.io/v1alpha1/namespaces/chaos/chaoskitscenarios/{name}/status",
+		func(w http.ResponseWriter, r *http.Request) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			s, ok := f.scenarios[r.PathValue("name")]
+			if !ok || r.Header.Get("Content-Type") != "application/json-patch+json" {
+				http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+				return
+			}
+			var patch []struct {
+				Op    string         `json:"op"`
+				Path  string         `json:"path"`
+				Value ScenarioStatus `json:"value"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil || len(patch) != 1 || patch[0].Path != "/status" {
+				http.Error(w, `{"message":"bad patch"}`, http.StatusBadRequest)
+				return
+			}
+			s.Status = patch[0].Value
+			f.patches++
+			_ = json.NewEncoder(w).Encode(s)
+		})
+	mux.HandleFunc("PATCH /api/v1/namespaces/chaos/configmaps/{name}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if r.URL.Query().Get("fieldManager") != fieldManager {
+			http.Error(w, `{"message":"fieldManager is required for apply patch"}`, http.StatusBadRequest)
+			return
+		}
+		var configMap map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&configMap)
+		f.configMaps[r.PathValue("name")] = configMap
+		_ = json.NewEncoder(w).Encode(configMap)
+	})
+
+	return mux
+}
+
+func (f *fakeAPIServer) scenario(name string) ChaosKitScenario {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return *f.scenarios[name]
+}
+
+func newScenario(name, schedule, document string, created time.Time) ChaosKitScenario {
+	return ChaosKitScenario{
+		APIVersion: Group + "/" + Version,
+		Kind:       Kind,
+		Metadata:   ObjectMeta{Name: name, Namespace: "chaos", UID: "uid-" + name, Generation: 1, CreationTimestamp: created},
+		Spec:       ScenarioSpec{Scenario: document, Schedule: schedule},
+	}
+}
+
+const nightlyScenario = `
+name: nightly
+target:
+  type: noop
+repeat: 3
+`
+
+func TestController_RunsDueScenario(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	fake := newFakeAPIServer(newScenario("nightly", "@every 1h", nightlyScenario, now.Add(-2*time.Hour)))
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	controller := NewController(kube.NewClient(server.URL, "", nil))
+	controller.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, controller.Reconcile(ctx))
+	controller.wg.Wait()
+
+	s := fake.scenario("nightly")
+	assert.Equal(t, PhaseCompleted, s.Status.Phase)
+	assert.Equal(t, "PASS", s.Status.Verdict)
+	assert.Equal(t, 3, s.Status.Iterations)
+	require.NotNil(t, s.Status.LastRunTime)
+	assert.True(t, s.Status.LastRunTime.Equal(now))
+	require.NotNil(t, s.Status.NextRunTime)
+	assert.True(t, s.Status.NextRunTime.Equal(now.Add(time.Hour)))
+	assert.Equal(t, "nightly-report", s.Status.ReportConfigMap)
+
+	conditions := make(map[string]Condition)
+	for _, c := range s.Status.Conditions {
+		conditions[c.Type] = c
+	}
+	assert.Equal(t, "False", conditions[ConditionRunning].Status)
+	assert.Equal(t, "True", conditions[ConditionPassed].Status)
+	assert.Equal(t, "Pass", conditions[ConditionPassed].Reason)
+
+	configMap := fake.configMaps["nightly-report"]
+	require.NotNil(t, configMap)
+	data, _ := configMap["data"].(map[string]any)
+	assert.Contains(t, data["report.txt"], "nightly")
+	assert.Contains(t, data["report.json"], `"verdict": "PASS"`)
+	metadata, _ := configMap["metadata"].(map[string]any)
+	owners, _ := metadata["ownerReferences"].([]any)
+	require.Len(t, owners, 1)
+	assert.Equal(t, "uid-nightly", owners[0].(map[string]any)["uid"])
+
+	// Not due again until the next run time; the status is left unchanged
+	patches := fake.patches
+	require.NoError(t, controller.Reconcile(ctx))
+	controller.wg.Wait()
+	assert.Equal(t, patches, fake.patches)
+	assert.Equal(t, PhaseCompleted, fake.scenario("nightly").Status.Phase)
+}
+
+func TestController_SchedulesAndValidates(t *testing.T) {
+	now := time.Date(2026, 3, 14, 10, 17, 0, 0, time.UTC)
+	fake := newFakeAPIServer(
+		newScenario("later", "0 2 * * *", nightlyScenario, now.Add(-time.Minute)),
+		newScenario("bad-schedule", "every day", nightlyScenario, now),
+		newScenario("bad-scenario", "@daily", "name: [", now),
+	)
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	controller := NewController(kube.NewClient(server.URL, "", nil))
+	controller.now = func() time.Time { return now }
+
+	require.NoError(t, controller.Reconcile(context.Background()))
+	controller.wg.Wait()
+
+	later := fake.scenario("later")
+	assert.Equal(t, PhaseScheduled, later.Status.Phase)
+	require.NotNil(t, later.Status.NextRunTime)
+	assert.Equal(t, time.Date(2026, 3, 15, 2, 0, 0, 0, time.UTC), *later.Status.NextRunTime)
+	assert.Nil(t, later.Status.LastRunTime)
+
+	for _, name := range []string{"bad-schedule", "bad-scenario"} {
+		s := fake.scenario(name)
+		assert.Equal(t, PhaseInvalid, s.Status.Phase, name)
+		assert.NotEmpty(t, s.Status.Message, name)
+	}
+}
+
+func TestController_InterruptedRun(t *testing.T) {
+	now := time.Date(2026, 3, 14, 10, 17, 0, 0, time.UTC)
+	started := now.Add(-10 * time.Minute)
+	s := newScenario("interrupted", "@every 1h", nightlyScenario, now.Add(-time.Hour))
+	s.Status = ScenarioStatus{Phase: PhaseRunning, LastRunTime: &started}
+	fake := newFakeAPIServer(s)
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	controller := NewController(kube.NewClient(server.URL, "", nil))
+	controller.now = func() time.Time { return now }
+
+	require.NoError(t, controller.Reconcile(context.Background()))
+
+	status := fake.scenario("interrupted").Status
+	assert.Equal(t, PhaseFailed, status.Phase)
+	assert.Contains(t, status.Message, "interrupted")
+	require.Len(t, status.Conditions, 1)
+	assert.Equal(t, "Interrupted", status.Conditions[0].Reason)
+}
@@ -0,0 +1,69 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/rom8726/chaoskit/kube"
+)
+
+// fieldManager owns the fields the operator applies
+const fieldManager = "chaoskit-operator"
+
+// listScenarios lists the ChaosKitScenarios of a namespace, or of all
+// namespaces when namespace is empty
+func listScenarios(ctx context.Context, client *kube.Client, namespace string) ([]ChaosKitScenario, error) {
+	path := fmt.Sprintf("/apis/%s/%s/%s", Group, Version, Resource)
+	if namespace != "" {
+		path = fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s", Group, Version, url.PathEscape(namespace), Resource)
+	}
+
+	var list struct {
+		Items []ChaosKitScenario `json:"items"`
+	}
+	if err := client.Do(ctx, http.MethodGet, path, "", nil, &list); err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// patchStatus replaces the status of a ChaosKitScenario. A JSON patch is
+// used rather than a merge patch so fields left out of status are cleared.
+func patchStatus(ctx context.Context, client *kube.Client, s *ChaosKitScenario, status ScenarioStatus) error {
+	path := fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s/%s/status",
+		Group, Version, url.PathEscape(s.Metadata.Namespace), Resource, url.PathEscape(s.Metadata.Name))
+	patch := []map[string]any{{"op": "add", "path": "/status", "value": status}}
+
+	return client.Do(ctx, http.MethodPatch, path, kube.JSONPatch, patch, nil)
+}
+
+// applyConfigMap creates or updates a ConfigMap owned by s (server-side
+// apply), so it is deleted together with the scenario
+func applyConfigMap(ctx context.Context, client *kube.Client, s *ChaosKitScenario, name string, data map[string]string) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/configmaps/%s?fieldManager=%s&force=true",
+		url.PathEscape(s.Metadata.Namespace), url.PathEscape(name), fieldManager)
+	controller := true
+	configMap := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": s.Metadata.Namespace,
+			"labels":    map[string]string{"chaoskit.io/scenario": s.Metadata.Name},
+			"ownerReferences": []map[string]any{{
+				"apiVersion": Group + "/" + Version,
+				"kind":       Kind,
+				"name":       s.Metadata.Name,
+				"uid":        s.Metadata.UID,
+				"controller": &controller,
+			}},
+		},
+		"data": data,
+	}
+
+	// JSON is valid YAML, as apply patches expect
+	return client.Do(ctx, http.MethodPatch, path, kube.ApplyPatch, configMap, nil)
+}
@@ -0,0 +1,115 @@
+package operator
+
+import "time"
+
+// API group, version and resource of the ChaosKitScenario custom resource
+// (see deploy/operator/crd.yaml)
+const (
+	Group    = "chaoskit.io"
+	Version  = "v1alpha1"
+	Kind     = "ChaosKitScenario"
+	Resource = "chaoskitscenarios"
+)
+
+// Phases of a ChaosKitScenario
+const (
+	PhaseScheduled = "Scheduled"
+	PhaseRunning   = "Running"
+	PhaseCompleted = "Completed"
+	PhaseFailed    = "Failed"
+	PhaseInvalid   = "Invalid"
+)
+
+// Condition types of a ChaosKitScenario
+const (
+	// ConditionRunning is True while a run is in progress
+	ConditionRunning = "Running"
+
+	// ConditionPassed is True when the last run passed its thresholds
+	ConditionPassed = "Passed"
+)
+
+// ChaosKitScenario runs a scenario on a schedule inside the cluster
+type ChaosKitScenario struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Metadata   ObjectMeta     `json:"metadata"`
+	Spec       ScenarioSpec   `json:"spec"`
+	Status     ScenarioStatus `json:"status,omitempty"`
+}
+
+// ObjectMeta is the part of the Kubernetes object metadata used by the operator
+type ObjectMeta struct {
+	Name              string    `json:"name"`
+	Namespace         string    `json:"namespace,omitempty"`
+	UID               string    `json:"uid,omitempty"`
+	Generation        int64     `json:"generation,omitempty"`
+	CreationTimestamp time.Time `json:"creationTimestamp,omitempty"`
+}
+
+// ScenarioSpec is the desired state of a ChaosKitScenario
+type ScenarioSpec struct {
+	// Scenario is the scenario document in YAML or JSON (see package config)
+	Scenario string `json:"scenario"`
+
+	// Schedule is a cron expression in UTC or a descriptor such as
+	// "@every 6h" (see ParseSchedule)
+	Schedule string `json:"schedule"`
+
+	// Suspend skips scheduled runs; a run in progress continues
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Workers are chaoskit agent addresses the scenario is sharded across
+	// (see package distributed); the operator runs it itself when empty
+	Workers []string `json:"workers,omitempty"`
+
+	// ReportConfigMap names the ConfigMap receiving the report of the last
+	// run (<name>-report by default)
+	ReportConfigMap string `json:"reportConfigMap,omitempty"`
+}
+
+// ScenarioStatus is the observed state of a ChaosKitScenario
+type ScenarioStatus struct {
+	Phase              string      `json:"phase,omitempty"`
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	LastRunTime        *time.Time  `json:"lastRunTime,omitempty"`
+	NextRunTime        *time.Time  `json:"nextRunTime,omitempty"`
+	Verdict            string      `json:"verdict,omitempty"`
+	Iterations         int         `json:"iterations,omitempty"`
+	SuccessRate        float64     `json:"successRate,omitempty"`
+	ReportConfigMap    string      `json:"reportConfigMap,omitempty"`
+	Message            string      `json:"message,omitempty"`
+	Conditions         []Condition `json:"conditions,omitempty"`
+}
+
+// Condition is a Kubernetes status condition
+type Condition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// setCondition adds or updates a condition, keeping its transition time when
+// the status is unchanged
+func setCondition(conditions []Condition, c Condition) []Condition {
+	for i, existing := range conditions {
+		if existing.Type != c.Type {
+			continue
+		}
+		if existing.Status == c.Status {
+			c.LastTransitionTime = existing.LastTransitionTime
+		}
+		conditions[i] = c
+
+		return conditions
+	}
+
+	return append(conditions, c)
+}
+
+// key identifies a scenario across namespaces
+func (s *ChaosKitScenario) key() string {
+	return s.Metadata.Namespace + "/" + s.Metadata.Name
+}
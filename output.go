@@ -0,0 +1,109 @@
+package chaoskit
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// DefaultOutputTail is the default number of output bytes kept for a failed iteration
+const DefaultOutputTail = 4096
+
+// outputKey is a private type for context key
+type outputKey struct{}
+
+// OutputCapturer is implemented by targets producing output outside of step
+// calls, e.g. a subprocess started in Setup. With WithOutputCapture the
+// executor calls SetOutput before Setup; the writer routes everything written
+// to it (typically the process stdout and stderr) to the current iteration.
+type OutputCapturer interface {
+	SetOutput(w io.Writer)
+}
+
+// WithOutputCapture captures target output per iteration and attaches
+// the last tailBytes of it to failed results (ExecutionResult.Output).
+// Output is written by OutputCapturer targets or via IterationOutput.
+// A non-positive tailBytes uses DefaultOutputTail.
+func WithOutputCapture(tailBytes int) ExecutorOption {
+	return func(e *Executor) {
+		if tailBytes <= 0 {
+			tailBytes = DefaultOutputTail
+		}
+		e.outputTail = tailBytes
+	}
+}
+
+// IterationOutput returns the writer capturing output of the current iteration,
+// or io.Discard if output capture is disabled. Targets and steps can point
+// their loggers at it:
+//
+//	logger := slog.New(slog.NewTextHandler(chaoskit.IterationOutput(ctx), nil))
+func IterationOutput(ctx context.Context) io.Writer {
+	if buf := outputFromContext(ctx); buf != nil {
+		return buf
+	}
+
+	return io.Discard
+}
+
+// attachOutput attaches an output buffer to context
+func attachOutput(ctx context.Context, buf *tailBuffer) context.Context {
+	return context.WithValue(ctx, outputKey{}, buf)
+}
+
+func outputFromContext(ctx context.Context) *tailBuffer {
+	if v := ctx.Value(outputKey{}); v != nil {
+		if buf, ok := v.(*tailBuffer); ok {
+			return buf
+		}
+	}
+
+	return nil
+}
+
+// tailBuffer is a concurrency-safe writer keeping the last max bytes written
+type tailBuffer struct {
+	mu        sync.Mutex
+	max       int
+	buf       []byte
+	truncated bool
+}
+
+func newTailBuffer(max int) *tailBuffer {
+	return &tailBuffer{max: max}
+}
+
+// Write implements io.Writer
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if over := len(b.buf) - b.max; over > 0 {
+		b.buf = append(b.buf[:0], b.buf[over:]...)
+		b.truncated = true
+	}
+
+	return len(p), nil
+}
+
+// Reset discards buffered output
+func (b *tailBuffer) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = b.buf[:0]
+	b.truncated = false
+}
+
+// String returns the buffered output, prefixed with "..." if older output was discarded
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.truncated {
+		return "..." + string(b.buf)
+	}
+
+	return string(b.buf)
+}
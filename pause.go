@@ -0,0 +1,75 @@
+package chaoskit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// Pause holds the scenario being run at the next iteration boundary until
+// Resume is called: its injectors are stopped while paused and started
+// again on resume, and results recorded so far are kept. Time spent paused
+// counts toward the duration of RunFor scenarios. Pausing an executor that
+// is not running holds the first iteration of its next run.
+func (e *Executor) Pause() {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+
+	if e.resumed == nil {
+		e.resumed = make(chan struct{})
+	}
+}
+
+// Resume lets a paused run continue
+func (e *Executor) Resume() {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+
+	if e.resumed != nil {
+		close(e.resumed)
+		e.resumed = nil
+	}
+}
+
+// Paused reports whether a pause is in effect
+func (e *Executor) Paused() bool {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+
+	return e.resumed != nil
+}
+
+// holdWhilePaused waits out a pause of run with its injectors stopped. It
+// returns the context error when the run ends while paused.
+func (e *Executor) holdWhilePaused(ctx context.Context, run *scenarioRun) error {
+	e.pauseMu.Lock()
+	resumed := e.resumed
+	e.pauseMu.Unlock()
+	if resumed == nil {
+		return nil
+	}
+
+	e.stopRunInjectors(ctx, run.scenario, run.injectors)
+	run.injectors = &runInjectors{}
+	if e.logger != nil {
+		e.logger.Info("scenario paused", slog.String("scenario", run.scenario.name))
+	}
+
+	select {
+	case <-resumed:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	run.resumes++
+	injectors, err := e.startInjectors(ctx, run.scenario, fmt.Sprintf("resume-%d-", run.resumes))
+	if err != nil {
+		return fmt.Errorf("restarting injectors after pause: %w", err)
+	}
+	run.injectors = injectors
+	if e.logger != nil {
+		e.logger.Info("scenario resumed", slog.String("scenario", run.scenario.name))
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package chaoskit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutor_PauseResume(t *testing.T) {
+	injector := &lifecycleInjector{name: "paused"}
+	executor := NewExecutor()
+
+	iteration := 0
+	scenario := NewScenario("pause").
+		WithTarget(&testTarget{}).
+		Inject("paused", injector).
+		Step("step", func(ctx context.Context, target Target) error {
+			if iteration++; iteration == 2 {
+				executor.Pause()
+			}
+
+			return nil
+		}).
+		Repeat(4).
+		Build()
+
+	done := make(chan error, 1)
+	go func() { done <- executor.Run(context.Background(), scenario) }()
+
+	require.Eventually(t, func() bool {
+		return injector.lifecycle.State() == InjectorStopped
+	}, 5*time.Second, time.Millisecond)
+	assert.True(t, executor.Paused())
+	assert.Len(t, executor.Reporter().Results(), 2)
+
+	executor.Resume()
+	require.NoError(t, <-done)
+	assert.False(t, executor.Paused())
+	assert.Len(t, executor.Reporter().Results(), 4)
+	assert.Equal(t, 2, injector.starts)
+}
+
+func TestExecutor_CancelWhilePaused(t *testing.T) {
+	executor := NewExecutor()
+	executor.Pause()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	scenario := NewScenario("pause").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(3).
+		Build()
+
+	require.ErrorIs(t, executor.Run(ctx, scenario), context.DeadlineExceeded)
+	assert.Empty(t, executor.Reporter().Results())
+}
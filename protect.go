@@ -0,0 +1,52 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ErrPanicRecovered is matched by errors.Is for panics recovered by Protect
+var ErrPanicRecovered = errors.New("recovered panic")
+
+// Protect runs fn, recovering from panics instead of the usual
+// defer/recover boilerplate:
+//
+//	err := chaoskit.Protect(ctx, func(ctx context.Context) error {
+//		chaoskit.MaybePanic(ctx)
+//		return s.process(ctx)
+//	})
+//
+// A recovered panic is recorded for validators (see RecordPanic) and
+// returned as an error matching ErrPanicRecovered. Panics raised by MaybePanic
+// keep their *ChaosError, so errors.Is(err, ErrInjected) tells them from bugs.
+// The duration of the call is logged at debug level, panics at warn level.
+func Protect(ctx context.Context, fn func(context.Context) error) (err error) {
+	start := time.Now()
+	defer func() {
+		duration := time.Since(start)
+		r := recover()
+		if r == nil {
+			GetLogger(ctx).Debug("protected call finished",
+				slog.Duration("duration", duration),
+				slog.Bool("failed", err != nil))
+
+			return
+		}
+
+		RecordPanic(ctx)
+		if injected, ok := r.(*ChaosError); ok {
+			err = fmt.Errorf("%w: %w", ErrPanicRecovered, injected)
+		} else {
+			err = fmt.Errorf("%w: %v", ErrPanicRecovered, r)
+		}
+		GetLogger(ctx).Warn("protected call panicked",
+			slog.Duration("duration", duration),
+			slog.String("panic", fmt.Sprint(r)),
+			slog.Bool("injected", IsInjected(err)))
+	}()
+
+	return fn(ctx)
+}
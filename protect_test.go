@@ -0,0 +1,44 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRecorder counts recorded panics
+type countingRecorder struct {
+	panics int
+}
+
+func (r *countingRecorder) RecordPanic(ctx context.Context) { r.panics++ }
+func (r *countingRecorder) RecordRecursionDepth(depth int)  {}
+func (r *countingRecorder) RecordError(ctx context.Context) {}
+
+func TestProtect(t *testing.T) {
+	recorder := &countingRecorder{}
+	ctx := AttachRecorder(context.Background(), recorder)
+
+	errFailed := errors.New("failed")
+	assert.NoError(t, Protect(ctx, func(ctx context.Context) error { return nil }))
+	assert.ErrorIs(t, Protect(ctx, func(ctx context.Context) error { return errFailed }), errFailed)
+	assert.Zero(t, recorder.panics)
+
+	err := Protect(ctx, func(ctx context.Context) error { panic("boom") })
+	require.ErrorIs(t, err, ErrPanicRecovered)
+	assert.False(t, IsInjected(err))
+	assert.EqualError(t, err, "recovered panic: boom")
+
+	err = Protect(ctx, func(ctx context.Context) error {
+		panic(NewChaosError(ctx, "panic-injector", InjectionTypePanic, errors.New(injectedPanicMessage)))
+	})
+	require.ErrorIs(t, err, ErrPanicRecovered)
+	var injected *ChaosError
+	require.ErrorAs(t, err, &injected)
+	assert.Equal(t, "panic-injector", injected.Injector)
+	assert.True(t, IsInjected(err))
+	assert.Equal(t, 2, recorder.panics)
+}
@@ -0,0 +1,167 @@
+package chaoskit
+
+import (
+	"regexp"
+	"strings"
+)
+
+// RedactedPlaceholder replaces redacted values
+const RedactedPlaceholder = "[REDACTED]"
+
+// Redactor removes sensitive values (credentials, tokens, customer identifiers)
+// from error messages, captured output and injection details before they are
+// written to reports, result sinks and artifacts. A nil *Redactor is a no-op.
+type Redactor struct {
+	rules  []redactionRule
+	fields map[string]struct{}
+}
+
+type redactionRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// RedactionOption configures a Redactor
+type RedactionOption func(*Redactor)
+
+// RedactRegexp replaces every match of re with replacement, which may refer
+// to capture groups ($1). An empty replacement uses RedactedPlaceholder.
+func RedactRegexp(re *regexp.Regexp, replacement string) RedactionOption {
+	return func(r *Redactor) {
+		if replacement == "" {
+			replacement = RedactedPlaceholder
+		}
+		r.rules = append(r.rules, redactionRule{re: re, replacement: replacement})
+	}
+}
+
+// RedactFields redacts values of the named fields: "name=value", "name: value"
+// and "\"name\":\"value\"" in messages, and map entries with these keys in
+// injection attributes and injector metrics. Names are case-insensitive.
+func RedactFields(names ...string) RedactionOption {
+	return func(r *Redactor) {
+		quoted := make([]string, 0, len(names))
+		for _, name := range names {
+			r.fields[strings.ToLower(name)] = struct{}{}
+			quoted = append(quoted, regexp.QuoteMeta(name))
+		}
+		if len(quoted) == 0 {
+			return
+		}
+
+		// An authorization scheme ("Bearer", "Basic") before the value is kept
+		re := regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") +
+			`)("?\s*[:=]\s*"?)((?:bearer|basic)\s+)?[^\s"'&,;]+`)
+		r.rules = append(r.rules, redactionRule{re: re, replacement: "${1}${2}${3}" + RedactedPlaceholder})
+	}
+}
+
+// NewRedactor creates a redactor with the given rules
+func NewRedactor(opts ...RedactionOption) *Redactor {
+	r := &Redactor{fields: make(map[string]struct{})}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// DefaultRedactor redacts common secrets: credential fields (password, token, ...),
+// bearer/basic credentials and passwords embedded in connection strings
+func DefaultRedactor() *Redactor {
+	return NewRedactor(
+		RedactFields("password", "passwd", "pwd", "secret", "token", "access_token",
+			"api_key", "apikey", "authorization"),
+		RedactRegexp(regexp.MustCompile(`(?i)\b((?:bearer|basic)\s+)[A-Za-z0-9._~+/-]+=*`), "${1}"+RedactedPlaceholder),
+		RedactRegexp(regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://[^:/@\s]+:)[^@\s]+@`), "${1}"+RedactedPlaceholder+"@"),
+	)
+}
+
+// RedactString applies all rules to s
+func (r *Redactor) RedactString(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+
+	for _, rule := range r.rules {
+		s = rule.re.ReplaceAllString(s, rule.replacement)
+	}
+
+	return s
+}
+
+// RedactError returns err with a redacted message.
+// The original error stays reachable through errors.Is/As.
+func (r *Redactor) RedactError(err error) error {
+	if r == nil || err == nil {
+		return err
+	}
+
+	msg := err.Error()
+	redacted := r.RedactString(msg)
+	if redacted == msg {
+		return err
+	}
+
+	return &redactedError{msg: redacted, err: err}
+}
+
+// RedactResult redacts the error, panic stack and output of a result
+func (r *Redactor) RedactResult(result ExecutionResult) ExecutionResult {
+	if r == nil {
+		return result
+	}
+
+	result.Error = r.RedactError(result.Error)
+	result.PanicStack = r.RedactString(result.PanicStack)
+	result.Output = r.RedactString(result.Output)
+
+	return result
+}
+
+// RedactEvent redacts the attributes of an injection event
+func (r *Redactor) RedactEvent(event InjectionEvent) InjectionEvent {
+	if r == nil {
+		return event
+	}
+
+	event.Attributes = r.RedactMap(event.Attributes)
+
+	return event
+}
+
+// RedactMap returns a copy of m with redacted fields and string values
+func (r *Redactor) RedactMap(m map[string]any) map[string]any {
+	if r == nil || m == nil {
+		return m
+	}
+
+	out := make(map[string]any, len(m))
+	for key, value := range m {
+		if _, ok := r.fields[strings.ToLower(key)]; ok {
+			out[key] = RedactedPlaceholder
+
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			out[key] = r.RedactString(v)
+		case error:
+			out[key] = r.RedactString(v.Error())
+		default:
+			out[key] = value
+		}
+	}
+
+	return out
+}
+
+// redactedError carries a redacted message while keeping the original error chain
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string { return e.msg }
+
+func (e *redactedError) Unwrap() error { return e.err }
@@ -0,0 +1,156 @@
+package chaoskit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRedactor(t *testing.T) {
+	redactor := DefaultRedactor()
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"dial postgres://app:s3cr3t@db:5432/orders failed", "dial postgres://app:[REDACTED]@db:5432/orders failed"},
+		{"auth failed: password=hunter2 user=bob", "auth failed: password=[REDACTED] user=bob"},
+		{`body {"token": "abc.def"}`, `body {"token": "[REDACTED]"}`},
+		{"header Authorization: Bearer eyJhbGciOi.x-y_z", "header Authorization: Bearer [REDACTED]"},
+		{"nothing to hide", "nothing to hide"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, redactor.RedactString(tt.in))
+		assert.Equal(t, tt.want, redactor.RedactString(redactor.RedactString(tt.in)), "redaction must be idempotent")
+	}
+}
+
+func TestRedactor_CustomRules(t *testing.T) {
+	redactor := NewRedactor(
+		RedactRegexp(regexp.MustCompile(`cust-\d+`), "cust-***"),
+		RedactFields("session"),
+	)
+
+	assert.Equal(t, "customer cust-*** session=[REDACTED]", redactor.RedactString("customer cust-42 session=xyz"))
+	assert.Equal(t, map[string]any{"Session": RedactedPlaceholder, "host": "cust-***", "port": 5432},
+		redactor.RedactMap(map[string]any{"Session": "xyz", "host": "cust-1", "port": 5432}))
+
+	var nilRedactor *Redactor
+	assert.Equal(t, "password=x", nilRedactor.RedactString("password=x"))
+}
+
+func TestRedactor_RedactErrorKeepsChain(t *testing.T) {
+	sentinel := errors.New("connection refused")
+	err := fmt.Errorf("dial redis://:pw@cache failed: %w", sentinel)
+
+	redacted := NewRedactor(RedactRegexp(regexp.MustCompile(`:pw@`), ":***@")).RedactError(err)
+	assert.EqualError(t, redacted, "dial redis://:***@cache failed: connection refused")
+	assert.ErrorIs(t, redacted, sentinel)
+}
+
+func TestExecutor_WithRedactor(t *testing.T) {
+	var sink bytes.Buffer
+	executor := NewExecutor(WithRedactor(DefaultRedactor()), WithResultSink(&sink))
+
+	scenario := NewScenario("redact").
+		WithTarget(&testTarget{}).
+		Step("connect", func(ctx context.Context, target Target) error {
+			RecordInjection(ctx, InjectionEvent{
+				Injector:   "proxy",
+				Type:       InjectionTypeError,
+				Attributes: map[string]any{"token": "abc", "error": "password=hunter2"},
+			})
+
+			return errors.New("dial postgres://app:s3cr3t@db/orders failed")
+		}).
+		Repeat(1).
+		Build()
+
+	require.Error(t, executor.Run(context.Background(), scenario))
+	assert.NotContains(t, sink.String(), "s3cr3t")
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	text := executor.Reporter().GenerateTextReport(report)
+	assert.NotContains(t, text, "s3cr3t")
+	assert.Contains(t, text, "postgres://app:[REDACTED]@db/orders")
+
+	require.Len(t, report.Timeline, 1)
+	assert.Equal(t, RedactedPlaceholder, report.Timeline[0].Attributes["token"])
+	assert.Equal(t, "password=[REDACTED]", report.Timeline[0].Attributes["error"])
+}
+
+// errorObserver records the errors observers receive
+type errorObserver struct {
+	mu        sync.Mutex
+	steps     []string
+	iteration []string
+}
+
+func (o *errorObserver) OnIterationStart(ctx context.Context, scenario string, iteration int) context.Context {
+	return ctx
+}
+
+func (o *errorObserver) OnIterationEnd(ctx context.Context, result ExecutionResult) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.iteration = append(o.iteration, result.Error.Error())
+}
+
+func (o *errorObserver) OnStepStart(ctx context.Context, step string) context.Context { return ctx }
+
+func (o *errorObserver) OnStepEnd(ctx context.Context, step string, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.steps = append(o.steps, err.Error())
+}
+
+func (o *errorObserver) OnInjection(ctx context.Context, event InjectionEvent) {}
+
+func TestExecutor_WithRedactorObservers(t *testing.T) {
+	observer := &errorObserver{}
+	redactor := NewRedactor(RedactRegexp(regexp.MustCompile(`hunter2`), "***"))
+	executor := NewExecutor(WithRedactor(redactor), WithObservers(observer))
+
+	scenario := NewScenario("redact-observers").
+		WithTarget(&testTarget{}).
+		Step("auth", func(ctx context.Context, target Target) error {
+			return errors.New("token hunter2 rejected")
+		}).
+		Repeat(1).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	assert.Equal(t, []string{"token *** rejected"}, observer.steps)
+	assert.Equal(t, []string{"step auth failed: token *** rejected"}, observer.iteration)
+}
+
+// dsnSetupTarget fails its setup with a DSN carrying a password
+type dsnSetupTarget struct{ testTarget }
+
+func (t *dsnSetupTarget) Setup(ctx context.Context) error {
+	return errors.New("dial postgres://u:hunter2@db")
+}
+
+func TestExecutor_WithRedactorRunEnd(t *testing.T) {
+	recorder := &runRecorder{}
+	executor := NewExecutor(WithRedactor(DefaultRedactor()), WithObservers(recorder))
+
+	scenario := NewScenario("broken").
+		WithTarget(&dsnSetupTarget{}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(1).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	require.Len(t, recorder.events, 2)
+	assert.Equal(t, "end broken setup failed: dial postgres://u:[REDACTED]@db", recorder.events[1])
+}
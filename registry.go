@@ -0,0 +1,164 @@
+package chaoskit
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// InjectorFactory builds an injector from its parameters, as decoded from a
+// scenario file (config.Params has typed accessors for them)
+type InjectorFactory func(params map[string]any) (Injector, error)
+
+// ValidatorFactory builds a validator from its parameters, as decoded from a
+// scenario file (config.Params has typed accessors for them)
+type ValidatorFactory func(params map[string]any) (Validator, error)
+
+// ComponentInfo describes a registered injector or validator type
+type ComponentInfo struct {
+	Type        string      `json:"type"`
+	Description string      `json:"description"`
+	Params      []ParamInfo `json:"params"`
+}
+
+// ParamInfo describes a parameter of a component type
+type ParamInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// FactoryOption describes a registered factory
+type FactoryOption func(*ComponentInfo)
+
+// WithFactoryDescription sets the description listed by `chaoskit list`
+func WithFactoryDescription(description string) FactoryOption {
+	return func(info *ComponentInfo) {
+		info.Description = description
+	}
+}
+
+// WithFactoryParam declares a parameter of the factory; scenario files
+// passing parameters not declared are rejected
+func WithFactoryParam(name, description string) FactoryOption {
+	return func(info *ComponentInfo) {
+		info.Params = append(info.Params, ParamInfo{Name: name, Description: description})
+	}
+}
+
+type registeredInjector struct {
+	info    ComponentInfo
+	factory InjectorFactory
+}
+
+type registeredValidator struct {
+	info    ComponentInfo
+	factory ValidatorFactory
+}
+
+// registry holds the injector and validator types of scenario files
+var registry = struct {
+	mu         sync.RWMutex
+	injectors  map[string]registeredInjector
+	validators map[string]registeredValidator
+}{
+	injectors:  make(map[string]registeredInjector),
+	validators: make(map[string]registeredValidator),
+}
+
+// RegisterInjectorFactory makes an injector type available in scenario files
+// (see package config) and the CLI. It is meant to be called from init and
+// panics when typ is empty, factory is nil or typ is already registered.
+func RegisterInjectorFactory(typ string, factory InjectorFactory, opts ...FactoryOption) {
+	info := newComponentInfo(typ, factory == nil, opts)
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, ok := registry.injectors[typ]; ok {
+		panic(fmt.Sprintf("chaoskit: injector type %q registered twice", typ))
+	}
+	registry.injectors[typ] = registeredInjector{info: info, factory: factory}
+}
+
+// RegisterValidatorFactory makes a validator type available in scenario files
+// (see package config) and the CLI. It is meant to be called from init and
+// panics when typ is empty, factory is nil or typ is already registered.
+func RegisterValidatorFactory(typ string, factory ValidatorFactory, opts ...FactoryOption) {
+	info := newComponentInfo(typ, factory == nil, opts)
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	if _, ok := registry.validators[typ]; ok {
+		panic(fmt.Sprintf("chaoskit: validator type %q registered twice", typ))
+	}
+	registry.validators[typ] = registeredValidator{info: info, factory: factory}
+}
+
+func newComponentInfo(typ string, nilFactory bool, opts []FactoryOption) ComponentInfo {
+	if typ == "" {
+		panic("chaoskit: factory registered without a type")
+	}
+	if nilFactory {
+		panic(fmt.Sprintf("chaoskit: nil factory registered for type %q", typ))
+	}
+
+	info := ComponentInfo{Type: typ}
+	for _, opt := range opts {
+		opt(&info)
+	}
+
+	return info
+}
+
+// LookupInjectorFactory returns the factory registered for an injector type
+func LookupInjectorFactory(typ string) (InjectorFactory, ComponentInfo, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	registered, ok := registry.injectors[typ]
+
+	return registered.factory, registered.info, ok
+}
+
+// LookupValidatorFactory returns the factory registered for a validator type
+func LookupValidatorFactory(typ string) (ValidatorFactory, ComponentInfo, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	registered, ok := registry.validators[typ]
+
+	return registered.factory, registered.info, ok
+}
+
+// RegisteredInjectors describes the registered injector types, sorted by type
+func RegisteredInjectors() []ComponentInfo {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	infos := make([]ComponentInfo, 0, len(registry.injectors))
+	for _, registered := range registry.injectors {
+		infos = append(infos, registered.info)
+	}
+	sortComponentInfos(infos)
+
+	return infos
+}
+
+// RegisteredValidators describes the registered validator types, sorted by type
+func RegisteredValidators() []ComponentInfo {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	infos := make([]ComponentInfo, 0, len(registry.validators))
+	for _, registered := range registry.validators {
+		infos = append(infos, registered.info)
+	}
+	sortComponentInfos(infos)
+
+	return infos
+}
+
+func sortComponentInfos(infos []ComponentInfo) {
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Type < infos[j].Type })
+}
@@ -0,0 +1,43 @@
+package chaoskit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Registered once per process, as tests may run more than once
+func init() {
+	RegisterInjectorFactory("registry-test", func(params map[string]any) (Injector, error) {
+		return &testMetricsInjector{}, nil
+	}, WithFactoryDescription("Test injector"), WithFactoryParam("rate", "test rate"))
+}
+
+func TestRegisterInjectorFactory(t *testing.T) {
+	factory, info, ok := LookupInjectorFactory("registry-test")
+	require.True(t, ok)
+	assert.Equal(t, ComponentInfo{
+		Type:        "registry-test",
+		Description: "Test injector",
+		Params:      []ParamInfo{{Name: "rate", Description: "test rate"}},
+	}, info)
+
+	injector, err := factory(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "test-injector", injector.Name())
+
+	assert.Contains(t, RegisteredInjectors(), info)
+	_, _, ok = LookupInjectorFactory("missing")
+	assert.False(t, ok)
+	_, _, ok = LookupValidatorFactory("registry-test")
+	assert.False(t, ok)
+
+	assert.Panics(t, func() {
+		RegisterInjectorFactory("registry-test", func(map[string]any) (Injector, error) { return nil, nil })
+	})
+	assert.Panics(t, func() {
+		RegisterInjectorFactory("", func(map[string]any) (Injector, error) { return nil, nil })
+	})
+	assert.Panics(t, func() { RegisterValidatorFactory("registry-nil", nil) })
+}
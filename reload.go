@@ -0,0 +1,179 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+// scenarioRun is the state of a Run call: the running scenario and its
+// started injectors, both replaced when a reload is applied
+type scenarioRun struct {
+	scenario  *Scenario
+	injectors *runInjectors
+	reloads   int
+	resumes   int
+
+	// healthCheckedAt is when injector health was last checked
+	healthCheckedAt time.Time
+}
+
+// runInjectors are the injectors started for a scenario
+type runInjectors struct {
+	active  []Injector
+	network []Injector
+}
+
+// Reload replaces the injectors, validators and chaos points of the scenario
+// being run with those of next, e.g. rebuilt from an edited scenario file. The
+// change is applied at the next iteration boundary: the current injectors are
+// stopped and those of next started, while the target, steps, run length,
+// seed and the results recorded so far are kept. When the injectors of next
+// fail to start, the previous ones are restarted. Only the latest pending
+// reload is applied; reloads pending when Run starts are dropped.
+func (e *Executor) Reload(next *Scenario) {
+	e.pendingReload.Store(next)
+}
+
+// applyReload applies a pending reload to run, if any
+func (e *Executor) applyReload(ctx context.Context, run *scenarioRun) error {
+	next := e.pendingReload.Swap(nil)
+	if next == nil {
+		return nil
+	}
+
+	reloaded := *run.scenario
+	reloaded.injectors = next.injectors
+	reloaded.scopes = next.scopes
+	reloaded.validators = next.validators
+	reloaded.points = next.points
+	reloaded.injectorLimits = next.injectorLimits
+
+	e.finalizeValidators(ctx, run.scenario, slices.DeleteFunc(slices.Clone(run.scenario.validators), func(val Validator) bool {
+		return slices.Contains(next.validators, val)
+	}))
+	e.stopRunInjectors(ctx, run.scenario, run.injectors)
+	run.reloads++
+	injectors, err := e.startInjectors(ctx, &reloaded, fmt.Sprintf("reload-%d-", run.reloads))
+	if err == nil {
+		run.scenario = &reloaded
+		run.injectors = injectors
+		if e.logger != nil {
+			e.logger.Info("scenario reloaded",
+				slog.String("scenario", reloaded.name),
+				slog.Int("injectors", len(injectors.active)),
+				slog.Int("validators", len(reloaded.validators)))
+		}
+
+		return nil
+	}
+
+	if e.logger != nil {
+		e.logger.Warn("scenario reload failed, restarting previous injectors",
+			slog.String("scenario", reloaded.name),
+			slog.String("error", err.Error()))
+	}
+	run.injectors, err = e.startInjectors(ctx, run.scenario, fmt.Sprintf("reload-%d-", run.reloads))
+	if err != nil {
+		run.injectors = &runInjectors{}
+
+		return fmt.Errorf("restarting injectors after failed reload: %w", err)
+	}
+
+	return nil
+}
+
+// startInjectors sets up the network injectors of scenario, then starts all
+// its injectors. Each injector draws from its own stream (keyed by keyPrefix
+// and its position), so its faults for a seed don't depend on how its draws
+// interleave with the other injectors. On failure everything started is undone.
+func (e *Executor) startInjectors(ctx context.Context, scenario *Scenario, keyPrefix string) (*runInjectors, error) {
+	allInjectors := e.getAllInjectors(scenario)
+	started := &runInjectors{}
+
+	// Setup network injectors first (if they need proxy setup)
+	for _, inj := range allInjectors {
+		if lifecycle, ok := inj.(NetworkInjectorLifecycle); ok {
+			if err := callSafely(func() error { return lifecycle.SetupNetwork(ctx) }); err != nil {
+				if errors.Is(err, ErrPanicRecovered) {
+					started.network = append(started.network, inj)
+				}
+				e.teardownNetwork(ctx, scenario, started.network)
+
+				err = fmt.Errorf("network setup failed for %s: %w", inj.Name(), err)
+				e.recordFrameworkFailure(scenario, err)
+
+				return nil, err
+			}
+			started.network = append(started.network, inj)
+			if e.logger != nil {
+				e.logger.Info("network injector setup completed",
+					slog.String("scenario", scenario.name),
+					slog.String("injector", inj.Name()))
+			}
+		}
+	}
+
+	if e.intensity != nil {
+		e.scaleIntensity(scenario, allInjectors, *e.intensity)
+	}
+
+	started.active = make([]Injector, 0, len(allInjectors))
+	for i, inj := range allInjectors {
+		injectCtx := ForkRand(ctx, fmt.Sprintf("%sinjector-%d-%s", keyPrefix, i, inj.Name()))
+		if err := callSafely(func() error { return inj.Inject(injectCtx) }); err != nil {
+			if e.logger != nil {
+				e.logger.Error("injector failed to start",
+					slog.String("scenario", scenario.name),
+					slog.String("injector", inj.Name()),
+					slog.String("error", err.Error()))
+			}
+			// Stop already started injectors, and a panicking one, which
+			// may have applied part of its faults
+			if errors.Is(err, ErrPanicRecovered) {
+				started.active = append(started.active, inj)
+			}
+			e.stopInjectors(ctx, started.active)
+			e.teardownNetwork(ctx, scenario, started.network)
+
+			err = fmt.Errorf("injector %s failed: %w", inj.Name(), err)
+			e.recordFrameworkFailure(scenario, err)
+
+			return nil, err
+		}
+		started.active = append(started.active, inj)
+	}
+
+	return started, nil
+}
+
+// stopRunInjectors snapshots the metrics of started injectors for the report,
+// stops them and tears down their network setup
+func (e *Executor) stopRunInjectors(ctx context.Context, scenario *Scenario, injectors *runInjectors) {
+	e.snapshotInjectorMetrics(injectors.active)
+	e.stopInjectors(ctx, injectors.active)
+	e.teardownNetwork(ctx, scenario, injectors.network)
+}
+
+// teardownNetwork tears down network injectors set up by startInjectors, in reverse order
+func (e *Executor) teardownNetwork(ctx context.Context, scenario *Scenario, injectors []Injector) {
+	for _, inj := range slices.Backward(injectors) {
+		lifecycle, ok := inj.(NetworkInjectorLifecycle)
+		if !ok {
+			continue
+		}
+		if err := callSafely(func() error { return lifecycle.TeardownNetwork(ctx) }); err != nil {
+			if e.logger != nil {
+				e.logger.Warn("network teardown error",
+					slog.String("scenario", scenario.name),
+					slog.String("injector", inj.Name()),
+					slog.String("error", err.Error()))
+			}
+			e.recordFrameworkFailure(scenario,
+				fmt.Errorf("network teardown failed for %s: %w", inj.Name(), err))
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lifecycleInjector records its Inject and Stop calls
+type lifecycleInjector struct {
+	name      string
+	failStart bool
+	lifecycle InjectorLifecycle
+	starts    int
+}
+
+func (i *lifecycleInjector) Name() string { return i.name }
+
+func (i *lifecycleInjector) Inject(ctx context.Context) error {
+	if i.failStart {
+		return errors.New("cannot start")
+	}
+	i.starts++
+
+	return i.lifecycle.Start()
+}
+
+func (i *lifecycleInjector) Stop(ctx context.Context) error {
+	i.lifecycle.Stop()
+
+	return nil
+}
+
+func TestExecutor_Reload(t *testing.T) {
+	before := &lifecycleInjector{name: "before"}
+	after := &lifecycleInjector{name: "after"}
+	executor := NewExecutor()
+
+	iteration := 0
+	scenario := NewScenario("reload").
+		WithTarget(&testTarget{}).
+		Inject("before", before).
+		Step("step", func(ctx context.Context, target Target) error {
+			if iteration++; iteration == 2 {
+				executor.Reload(NewScenario("reload").Inject("after", after).Build())
+			}
+
+			return nil
+		}).
+		Repeat(4).
+		Build()
+
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 4)
+	assert.Equal(t, []string{"before"}, results[1].Injectors)
+	assert.Equal(t, []string{"after"}, results[2].Injectors)
+	assert.Equal(t, InjectorStopped, before.lifecycle.State())
+	assert.Equal(t, InjectorStopped, after.lifecycle.State())
+	assert.Equal(t, 1, after.starts)
+}
+
+func TestExecutor_ReloadFailureRestartsPrevious(t *testing.T) {
+	before := &lifecycleInjector{name: "before"}
+	broken := &lifecycleInjector{name: "broken", failStart: true}
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+
+	iteration := 0
+	scenario := NewScenario("reload").
+		WithTarget(&testTarget{}).
+		Inject("before", before).
+		Step("step", func(ctx context.Context, target Target) error {
+			if iteration++; iteration == 1 {
+				executor.Reload(NewScenario("reload").Inject("broken", broken).Build())
+			}
+
+			return nil
+		}).
+		Repeat(3).
+		Build()
+
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	assert.Equal(t, 2, before.starts)
+	var injectors [][]string
+	for _, result := range executor.Reporter().Results() {
+		if result.FailureClass != FailureFramework {
+			injectors = append(injectors, result.Injectors)
+		}
+	}
+	assert.Equal(t, [][]string{{"before"}, {"before"}, {"before"}}, injectors)
+}
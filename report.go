@@ -4,6 +4,9 @@ import "time"
 
 // Report contains comprehensive test results with verdict
 type Report struct {
+	// SchemaVersion is the JSON format version (see ReportSchemaVersion)
+	SchemaVersion int `json:"schema_version"`
+
 	// Verdict is the overall test outcome
 	Verdict Verdict `json:"verdict"`
 
@@ -11,6 +14,7 @@ type Report struct {
 	Summary string `json:"summary"`
 
 	// ScenarioName is the name of tested scenario
+	// (comma-separated names when the reporter holds several scenarios)
 	ScenarioName string `json:"scenario_name"`
 
 	// ExecutionTime is when test was executed
@@ -26,6 +30,22 @@ type Report struct {
 	SuccessRate     float64       `json:"success_rate"`
 	AvgDuration     time.Duration `json:"avg_duration"`
 
+	// ToleratedFailures is the number of failed iterations caused by
+	// validators within their occurrence limits (SuccessThresholds.ValidatorLimits)
+	ToleratedFailures int `json:"tolerated_failures,omitempty"`
+
+	// Iteration duration percentiles
+	P50Duration time.Duration `json:"p50_duration"`
+	P90Duration time.Duration `json:"p90_duration"`
+	P99Duration time.Duration `json:"p99_duration"`
+
+	// Steps holds per-step duration statistics
+	Steps []StepStats `json:"steps,omitempty"`
+
+	// Scenarios breaks the results down per scenario when the reporter
+	// holds more than one scenario. A failing scenario fails the whole report.
+	Scenarios []ScenarioReport `json:"scenarios,omitempty"`
+
 	// Failures categorized by severity
 	CriticalFailures []ValidationFailure `json:"critical_failures"`
 	Warnings         []ValidationFailure `json:"warnings"`
@@ -34,8 +54,84 @@ type Report struct {
 	// Failure analysis
 	Analysis *FailureAnalysis `json:"analysis,omitempty"`
 
+	// Regressions lists regressions relative to a baseline report
+	// (see Reporter.GetVerdictAgainstBaseline)
+	Regressions []string `json:"regressions,omitempty"`
+
 	// Thresholds used for evaluation
 	Thresholds *SuccessThresholds `json:"thresholds,omitempty"`
+
+	// Injectors summarizes the chaos applied by each injector
+	Injectors []InjectorSummary `json:"injectors,omitempty"`
+
+	// Timeline lists injection events in recording order (up to MaxTimelineEvents)
+	Timeline []InjectionEvent `json:"timeline,omitempty"`
+
+	// TimelineDropped is the number of injection events not kept in Timeline
+	TimelineDropped int `json:"timeline_dropped,omitempty"`
+
+	// ChaosPoints lists the hit and registered named chaos points (see RegisterChaosPoints)
+	ChaosPoints []ChaosPointSummary `json:"chaos_points,omitempty"`
+
+	// Artifacts references post-mortem artifacts captured for failed iterations
+	Artifacts []FailureArtifacts `json:"artifacts,omitempty"`
+
+	// FailedIterations lists the faults injected in failed iterations (up to maxFailedIterations)
+	FailedIterations []FailedIteration `json:"failed_iterations,omitempty"`
+
+	// DroppedFailures is the number of failed results not kept by a streaming
+	// reporter (see WithBounded and SetResultRetention), so missing from
+	// Artifacts, FailedIterations and clusters
+	DroppedFailures int `json:"dropped_failures,omitempty"`
+}
+
+// ExitCode returns the exit code for the report verdict,
+// honoring the ExitCodes mapping of the thresholds used for evaluation
+func (r *Report) ExitCode() int {
+	if r.Thresholds != nil && r.Thresholds.ExitCodes != nil {
+		return r.Thresholds.ExitCodes.For(r.Verdict)
+	}
+
+	return r.Verdict.ExitCode()
+}
+
+// effectiveSuccessRate is the success rate counting tolerated failures as successes
+func (r *Report) effectiveSuccessRate() float64 {
+	if r.TotalIterations == 0 {
+		return r.SuccessRate
+	}
+
+	return float64(r.SuccessCount+r.ToleratedFailures) / float64(r.TotalIterations)
+}
+
+// failedScenarios returns the names of scenarios with a FAIL verdict
+func (r *Report) failedScenarios() []string {
+	var names []string
+	for _, scenario := range r.Scenarios {
+		if scenario.Verdict == VerdictFail {
+			names = append(names, scenario.ScenarioName)
+		}
+	}
+
+	return names
+}
+
+// ScenarioReport is the verdict and statistics of one scenario of a shared reporter
+type ScenarioReport struct {
+	ScenarioName      string              `json:"scenario_name"`
+	Verdict           Verdict             `json:"verdict"`
+	Summary           string              `json:"summary"`
+	TotalIterations   int                 `json:"total_iterations"`
+	SuccessCount      int                 `json:"success_count"`
+	FailureCount      int                 `json:"failure_count"`
+	SuccessRate       float64             `json:"success_rate"`
+	ToleratedFailures int                 `json:"tolerated_failures,omitempty"`
+	AvgDuration       time.Duration       `json:"avg_duration"`
+	P50Duration       time.Duration       `json:"p50_duration"`
+	P90Duration       time.Duration       `json:"p90_duration"`
+	P99Duration       time.Duration       `json:"p99_duration"`
+	CriticalFailures  []ValidationFailure `json:"critical_failures,omitempty"`
+	Warnings          []ValidationFailure `json:"warnings,omitempty"`
 }
 
 // ValidationFailure represents a validator failure
@@ -57,11 +153,21 @@ type FailureAnalysis struct {
 	// ByType groups failures by error type
 	ByType map[string]int `json:"by_type"`
 
+	// ByClass counts failures per FailureClass, separating framework
+	// problems and injected faults from genuine target failures
+	ByClass map[FailureClass]int `json:"by_class,omitempty"`
+
 	// TopErrors lists most common errors
 	TopErrors []ErrorSummary `json:"top_errors"`
 
 	// FailureRate over time (if duration-based test)
 	FailureRateOverTime []TimeWindow `json:"failure_rate_over_time,omitempty"`
+
+	// Clusters groups similar failures by normalized message or panic site
+	Clusters []ErrorCluster `json:"clusters,omitempty"`
+
+	// Correlations lists injectors most often active in failing iterations
+	Correlations []InjectionCorrelation `json:"correlations,omitempty"`
 }
 
 // ErrorSummary represents a common error pattern
@@ -0,0 +1,280 @@
+package chaoskit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// DurationRegressionThreshold is the relative average duration growth
+// (0.10 = 10%) above which a diff reports a duration regression
+const DurationRegressionThreshold = 0.10
+
+// ReportDiff is a structured comparison between two reports
+type ReportDiff struct {
+	ScenarioName string `json:"scenario_name"`
+
+	PreviousVerdict Verdict `json:"previous_verdict"`
+	CurrentVerdict  Verdict `json:"current_verdict"`
+
+	PreviousSuccessRate float64 `json:"previous_success_rate"`
+	CurrentSuccessRate  float64 `json:"current_success_rate"`
+	SuccessRateDelta    float64 `json:"success_rate_delta"`
+
+	PreviousAvgDuration time.Duration `json:"previous_avg_duration"`
+	CurrentAvgDuration  time.Duration `json:"current_avg_duration"`
+	AvgDurationDelta    time.Duration `json:"avg_duration_delta"`
+
+	// AvgDurationChange is the relative change of the average duration (0.25 = +25%)
+	AvgDurationChange float64 `json:"avg_duration_change"`
+
+	// DurationRegressed is set when the average duration grew above DurationRegressionThreshold
+	DurationRegressed bool `json:"duration_regressed"`
+
+	// NewFailures lists validators failing in the current run only
+	NewFailures []FailureChange `json:"new_failures,omitempty"`
+
+	// ResolvedFailures lists validators that failed previously but not anymore
+	ResolvedFailures []FailureChange `json:"resolved_failures,omitempty"`
+
+	// ChangedFailures lists validators failing in both runs with different occurrence counts
+	ChangedFailures []FailureChange `json:"changed_failures,omitempty"`
+
+	// NewErrorTypes and ResolvedErrorTypes compare the failure analysis error types
+	NewErrorTypes      []string `json:"new_error_types,omitempty"`
+	ResolvedErrorTypes []string `json:"resolved_error_types,omitempty"`
+}
+
+// FailureChange describes how failures of one validator changed between runs
+type FailureChange struct {
+	ValidatorName       string             `json:"validator_name"`
+	Severity            ValidationSeverity `json:"severity"`
+	PreviousOccurrences int                `json:"previous_occurrences"`
+	CurrentOccurrences  int                `json:"current_occurrences"`
+	Message             string             `json:"message,omitempty"`
+}
+
+// Diff compares the current results with a previous report.
+// The current report is evaluated with the thresholds of the previous one
+// (or DefaultThresholds if it has none), so both verdicts are comparable.
+func (r *Reporter) Diff(previous *Report) (*ReportDiff, error) {
+	if previous == nil {
+		return nil, fmt.Errorf("previous report is nil")
+	}
+
+	thresholds := previous.Thresholds
+	if thresholds == nil {
+		thresholds = DefaultThresholds()
+	}
+
+	current, err := r.calculateVerdict(thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffReports(previous, current), nil
+}
+
+// DiffReports compares two reports of the same scenario
+func DiffReports(previous, current *Report) *ReportDiff {
+	diff := &ReportDiff{
+		ScenarioName:        current.ScenarioName,
+		PreviousVerdict:     previous.Verdict,
+		CurrentVerdict:      current.Verdict,
+		PreviousSuccessRate: previous.SuccessRate,
+		CurrentSuccessRate:  current.SuccessRate,
+		SuccessRateDelta:    current.SuccessRate - previous.SuccessRate,
+		PreviousAvgDuration: previous.AvgDuration,
+		CurrentAvgDuration:  current.AvgDuration,
+		AvgDurationDelta:    current.AvgDuration - previous.AvgDuration,
+	}
+
+	if previous.AvgDuration > 0 {
+		diff.AvgDurationChange = float64(diff.AvgDurationDelta) / float64(previous.AvgDuration)
+		diff.DurationRegressed = diff.AvgDurationChange > DurationRegressionThreshold
+	}
+
+	previousFailures := reportFailures(previous)
+	currentFailures := reportFailures(current)
+
+	for name, cur := range currentFailures {
+		prev, ok := previousFailures[name]
+		switch {
+		case !ok:
+			diff.NewFailures = append(diff.NewFailures, FailureChange{
+				ValidatorName:      name,
+				Severity:           cur.Severity,
+				CurrentOccurrences: cur.Occurrences,
+				Message:            cur.Message,
+			})
+		case prev.Occurrences != cur.Occurrences:
+			diff.ChangedFailures = append(diff.ChangedFailures, FailureChange{
+				ValidatorName:       name,
+				Severity:            cur.Severity,
+				PreviousOccurrences: prev.Occurrences,
+				CurrentOccurrences:  cur.Occurrences,
+				Message:             cur.Message,
+			})
+		}
+	}
+
+	for name, prev := range previousFailures {
+		if _, ok := currentFailures[name]; !ok {
+			diff.ResolvedFailures = append(diff.ResolvedFailures, FailureChange{
+				ValidatorName:       name,
+				Severity:            prev.Severity,
+				PreviousOccurrences: prev.Occurrences,
+				Message:             prev.Message,
+			})
+		}
+	}
+
+	sortFailureChanges(diff.NewFailures)
+	sortFailureChanges(diff.ResolvedFailures)
+	sortFailureChanges(diff.ChangedFailures)
+
+	previousTypes := reportErrorTypes(previous)
+	currentTypes := reportErrorTypes(current)
+	for errorType := range currentTypes {
+		if _, ok := previousTypes[errorType]; !ok {
+			diff.NewErrorTypes = append(diff.NewErrorTypes, errorType)
+		}
+	}
+	for errorType := range previousTypes {
+		if _, ok := currentTypes[errorType]; !ok {
+			diff.ResolvedErrorTypes = append(diff.ResolvedErrorTypes, errorType)
+		}
+	}
+	sort.Strings(diff.NewErrorTypes)
+	sort.Strings(diff.ResolvedErrorTypes)
+
+	return diff
+}
+
+// HasRegressions reports whether the current run is worse than the previous one
+func (d *ReportDiff) HasRegressions() bool {
+	return d.CurrentVerdict > d.PreviousVerdict ||
+		d.SuccessRateDelta < 0 ||
+		d.DurationRegressed ||
+		len(d.NewFailures) > 0
+}
+
+// String returns a human-readable diff summary
+func (d *ReportDiff) String() string {
+	var buf bytes.Buffer
+
+	_, _ = fmt.Fprintf(&buf, "=== ChaosKit Report Diff ===\n")
+	_, _ = fmt.Fprintf(&buf, "Scenario: %s\n", d.ScenarioName)
+	_, _ = fmt.Fprintf(&buf, "Verdict: %s -> %s\n", d.PreviousVerdict, d.CurrentVerdict)
+	_, _ = fmt.Fprintf(&buf, "Success Rate: %.2f%% -> %.2f%% (%+.2f%%)\n",
+		d.PreviousSuccessRate*100, d.CurrentSuccessRate*100, d.SuccessRateDelta*100)
+	_, _ = fmt.Fprintf(&buf, "Avg Duration: %s -> %s (%+.1f%%)\n\n",
+		d.PreviousAvgDuration, d.CurrentAvgDuration, d.AvgDurationChange*100)
+
+	if len(d.NewFailures) > 0 {
+		_, _ = fmt.Fprintf(&buf, "New Failures:\n")
+		for _, failure := range d.NewFailures {
+			_, _ = fmt.Fprintf(&buf, "  + %s [%s]: %s (%d times)\n",
+				failure.ValidatorName, failure.Severity, failure.Message, failure.CurrentOccurrences)
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	if len(d.ResolvedFailures) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Resolved Failures:\n")
+		for _, failure := range d.ResolvedFailures {
+			_, _ = fmt.Fprintf(&buf, "  - %s [%s] (was %d times)\n",
+				failure.ValidatorName, failure.Severity, failure.PreviousOccurrences)
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	if len(d.ChangedFailures) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Changed Failures:\n")
+		for _, failure := range d.ChangedFailures {
+			_, _ = fmt.Fprintf(&buf, "  ~ %s [%s]: %d -> %d times\n",
+				failure.ValidatorName, failure.Severity,
+				failure.PreviousOccurrences, failure.CurrentOccurrences)
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	if len(d.NewErrorTypes) > 0 {
+		_, _ = fmt.Fprintf(&buf, "New Error Types: %v\n", d.NewErrorTypes)
+	}
+	if len(d.ResolvedErrorTypes) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Resolved Error Types: %v\n", d.ResolvedErrorTypes)
+	}
+
+	if d.HasRegressions() {
+		_, _ = fmt.Fprintf(&buf, "❌ REGRESSION DETECTED\n")
+	} else {
+		_, _ = fmt.Fprintf(&buf, "✅ No regressions\n")
+	}
+
+	return buf.String()
+}
+
+// SaveReport writes the report as JSON so it can be used as a baseline later
+func (r *Reporter) SaveReport(report *Report, path string) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0644)
+}
+
+// LoadReport reads a report previously written with SaveReport
+// (any supported schema version, see DecodeReport)
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := DecodeReport(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse report %s: %w", path, err)
+	}
+
+	return report, nil
+}
+
+func reportFailures(report *Report) map[string]ValidationFailure {
+	failures := make(map[string]ValidationFailure)
+	for _, group := range [][]ValidationFailure{report.CriticalFailures, report.Warnings, report.InfoMessages} {
+		for _, failure := range group {
+			failures[failure.ValidatorName] = failure
+		}
+	}
+
+	return failures
+}
+
+func reportErrorTypes(report *Report) map[string]struct{} {
+	types := make(map[string]struct{})
+	if report.Analysis == nil {
+		return types
+	}
+	for errorType, count := range report.Analysis.ByType {
+		if count > 0 {
+			types[errorType] = struct{}{}
+		}
+	}
+
+	return types
+}
+
+func sortFailureChanges(changes []FailureChange) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Severity != changes[j].Severity {
+			return changes[i].Severity < changes[j].Severity
+		}
+
+		return changes[i].ValidatorName < changes[j].ValidatorName
+	})
+}
@@ -0,0 +1,98 @@
+package chaoskit
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_Diff(t *testing.T) {
+	previousReporter := NewReporter()
+	for i := 0; i < 10; i++ {
+		previousReporter.AddResult(ExecutionResult{
+			ScenarioName: "diff",
+			Success:      i != 0,
+			Error:        errorIf(i == 0, "validator slow_iteration_1s failed: too slow"),
+			Duration:     10 * time.Millisecond,
+			Timestamp:    time.Now(),
+		})
+	}
+	previous, err := previousReporter.GetVerdict(RelaxedThresholds())
+	require.NoError(t, err)
+
+	currentReporter := NewReporter()
+	for i := 0; i < 10; i++ {
+		currentReporter.AddResult(ExecutionResult{
+			ScenarioName: "diff",
+			Success:      i > 1,
+			Error:        errorIf(i <= 1, "validator goroutine_limit_10 failed: leak"),
+			Duration:     20 * time.Millisecond,
+			Timestamp:    time.Now(),
+		})
+	}
+
+	diff, err := currentReporter.Diff(previous)
+	require.NoError(t, err)
+
+	assert.InDelta(t, -0.1, diff.SuccessRateDelta, 1e-9)
+	assert.Equal(t, 10*time.Millisecond, diff.AvgDurationDelta)
+	assert.True(t, diff.DurationRegressed)
+
+	require.Len(t, diff.NewFailures, 1)
+	assert.Equal(t, "goroutine_limit_10", diff.NewFailures[0].ValidatorName)
+	assert.Equal(t, 2, diff.NewFailures[0].CurrentOccurrences)
+
+	require.Len(t, diff.ResolvedFailures, 1)
+	assert.Equal(t, "slow_iteration_1s", diff.ResolvedFailures[0].ValidatorName)
+
+	assert.Equal(t, []string{ErrorTypeGoroutineLeak}, diff.NewErrorTypes)
+	assert.True(t, diff.HasRegressions())
+	assert.Contains(t, diff.String(), "REGRESSION DETECTED")
+}
+
+func TestDiffReports_NoRegression(t *testing.T) {
+	report := &Report{
+		ScenarioName: "same",
+		SuccessRate:  1.0,
+		AvgDuration:  10 * time.Millisecond,
+	}
+
+	diff := DiffReports(report, report)
+	assert.False(t, diff.HasRegressions())
+	assert.Empty(t, diff.NewFailures)
+	assert.Empty(t, diff.ResolvedFailures)
+}
+
+func TestReporter_SaveLoadReport(t *testing.T) {
+	reporter := NewReporter()
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "roundtrip",
+		Success:      true,
+		Duration:     15 * time.Millisecond,
+		Timestamp:    time.Now(),
+	})
+
+	report, err := reporter.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, reporter.SaveReport(report, path))
+
+	loaded, err := LoadReport(path)
+	require.NoError(t, err)
+	assert.Equal(t, report.ScenarioName, loaded.ScenarioName)
+	assert.Equal(t, report.Verdict, loaded.Verdict)
+	assert.Equal(t, report.AvgDuration, loaded.AvgDuration)
+}
+
+func errorIf(cond bool, msg string) error {
+	if !cond {
+		return nil
+	}
+
+	return fmt.Errorf("%s", msg)
+}
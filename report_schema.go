@@ -0,0 +1,403 @@
+package chaoskit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReportSchemaVersion is the version of the JSON report format written by this package.
+// The format is documented in docs/report-schema.json.
+//
+// Versions:
+//   - 1: initial format without schema_version; verdict and severity encoded as numbers
+//   - 2: schema_version field; verdict and severity encoded as names ("FAIL", "CRITICAL")
+const ReportSchemaVersion = 2
+
+// ErrNotReport is returned by DecodeReport for JSON documents that are not
+// reports, such as JSON results or truncated files
+var ErrNotReport = errors.New("not a chaoskit report")
+
+// reportFields are the fields every report has in all schema versions
+var reportFields = []string{"verdict", "scenario_name", "total_iterations"}
+
+// schemaHeader is the part of a document needed to pick a decoder
+type schemaHeader struct {
+	SchemaVersion int `json:"schema_version"`
+}
+
+// DecodeReport decodes a JSON report of any supported schema version.
+// Reports without schema_version are treated as version 1. The returned
+// report is migrated to the current ReportSchemaVersion. Documents without
+// the verdict, scenario_name and total_iterations fields fail with ErrNotReport.
+func DecodeReport(data []byte) (*Report, error) {
+	version, err := documentSchemaVersion(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode report (schema version %d): %w", version, err)
+	}
+	var missing []string
+	for _, field := range reportFields {
+		if _, ok := fields[field]; !ok {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing %s", ErrNotReport, strings.Join(missing, ", "))
+	}
+
+	// Version 1 differs only in numeric verdict and severity encoding,
+	// which Verdict and ValidationSeverity decode transparently
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to decode report (schema version %d): %w", version, err)
+	}
+	report.SchemaVersion = ReportSchemaVersion
+
+	return &report, nil
+}
+
+// DecodeResults reads execution results streamed with WithResultSink (NDJSON).
+// Result errors are restored as plain errors carrying the original message.
+func DecodeResults(r io.Reader) ([]ExecutionResult, error) {
+	var results []ExecutionResult
+	err := StreamResults(r, func(result ExecutionResult) error {
+		results = append(results, result)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// StreamResults is DecodeResults for streams read while they are written:
+// fn is called with each result as soon as its line is read. An error
+// returned by fn stops reading and is returned.
+func StreamResults(r io.Reader, fn func(ExecutionResult) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := scanner.Bytes()
+		if len(data) == 0 {
+			continue
+		}
+
+		if _, err := documentSchemaVersion(data); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		var jr jsonResult
+		if err := json.Unmarshal(data, &jr); err != nil {
+			return fmt.Errorf("line %d: failed to decode result: %w", line, err)
+		}
+		if err := fn(jr.executionResult()); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// reporterDocument is the document written by Reporter.GenerateJSON
+type reporterDocument struct {
+	Executions []jsonResult      `json:"executions"`
+	Dropped    int               `json:"dropped_failures"`
+	Steps      []StepStats       `json:"steps"`
+	Injectors  []InjectorSummary `json:"injectors"`
+	Timeline   []InjectionEvent  `json:"timeline"`
+
+	ChaosPoints []ChaosPointSummary `json:"chaos_points"`
+	Aggregates  *jsonAggregates     `json:"aggregates"`
+}
+
+// jsonAggregates are the statistics of a streaming reporter, which keeps
+// only some of its results (see WithBounded and SetResultRetention)
+type jsonAggregates struct {
+	Retained       int                   `json:"retained"`
+	FailedOnly     bool                  `json:"failed_only,omitempty"`
+	All            jsonResultAggregate   `json:"all"`
+	Scenarios      []jsonResultAggregate `json:"scenarios"`
+	Correlation    jsonCorrelationCounts `json:"correlation"`
+	LastIterations map[string]int        `json:"last_iterations,omitempty"`
+}
+
+// jsonResultAggregate is the JSON representation of a resultAggregate
+type jsonResultAggregate struct {
+	Scenario   string                  `json:"scenario,omitempty"`
+	Total      int                     `json:"total"`
+	Success    int                     `json:"success"`
+	Failure    int                     `json:"failure"`
+	Duration   time.Duration           `json:"duration"`
+	P50        time.Duration           `json:"p50"`
+	P90        time.Duration           `json:"p90"`
+	P99        time.Duration           `json:"p99"`
+	Validators []jsonValidatorFailures `json:"validators,omitempty"`
+	ByType     map[string]int          `json:"by_type,omitempty"`
+	ByClass    map[FailureClass]int    `json:"by_class,omitempty"`
+	Patterns   map[string]int          `json:"patterns,omitempty"`
+}
+
+// jsonValidatorFailures is the JSON representation of validatorFailures
+type jsonValidatorFailures struct {
+	Validator   string    `json:"validator"`
+	Occurrences int       `json:"occurrences"`
+	Failed      int       `json:"failed"`
+	Message     string    `json:"message"`
+	FirstSeen   time.Time `json:"first_seen"`
+	LastSeen    time.Time `json:"last_seen"`
+}
+
+// jsonCorrelationCounts is the JSON representation of correlationCounts
+type jsonCorrelationCounts struct {
+	Failures  int                    `json:"failures"`
+	Passes    int                    `json:"passes"`
+	Injectors []InjectionCorrelation `json:"injectors,omitempty"`
+}
+
+// jsonAggregates returns the statistics of a streaming reporter (caller must hold r.mu)
+func (r *Reporter) jsonAggregates() *jsonAggregates {
+	aggregates := &jsonAggregates{
+		Retained:       cap(r.retained.results),
+		FailedOnly:     r.retainFailed,
+		All:            newJSONResultAggregate("", r.aggregates.all),
+		Scenarios:      make([]jsonResultAggregate, 0, len(r.aggregates.scenarios)),
+		LastIterations: r.lastIteration,
+		Correlation: jsonCorrelationCounts{
+			Failures: r.correlation.failures,
+			Passes:   r.correlation.passes,
+		},
+	}
+	for _, scenario := range r.aggregates.scenarios {
+		aggregates.Scenarios = append(aggregates.Scenarios, newJSONResultAggregate(scenario.name, scenario.resultAggregate))
+	}
+	for _, stats := range r.correlation.stats {
+		aggregates.Correlation.Injectors = append(aggregates.Correlation.Injectors, InjectionCorrelation{
+			Injector:              stats.Injector,
+			FailuresWithInjection: stats.FailuresWithInjection,
+			PassesWithInjection:   stats.PassesWithInjection,
+		})
+	}
+	sort.Slice(aggregates.Correlation.Injectors, func(i, j int) bool {
+		return aggregates.Correlation.Injectors[i].Injector < aggregates.Correlation.Injectors[j].Injector
+	})
+
+	return aggregates
+}
+
+func newJSONResultAggregate(scenario string, agg *resultAggregate) jsonResultAggregate {
+	ja := jsonResultAggregate{
+		Scenario: scenario,
+		Total:    agg.total,
+		Success:  agg.success,
+		Failure:  agg.failure,
+		Duration: agg.totalDuration,
+		P50:      agg.durations.percentile(50),
+		P90:      agg.durations.percentile(90),
+		P99:      agg.durations.percentile(99),
+		ByType:   agg.failures.byType,
+		ByClass:  agg.failures.byClass,
+		Patterns: agg.failures.patterns,
+	}
+	for name, vf := range agg.failures.validators {
+		ja.Validators = append(ja.Validators, jsonValidatorFailures{
+			Validator:   name,
+			Occurrences: vf.occurrences,
+			Failed:      vf.failed,
+			Message:     vf.message,
+			FirstSeen:   vf.firstSeen,
+			LastSeen:    vf.lastSeen,
+		})
+	}
+	sort.Slice(ja.Validators, func(i, j int) bool { return ja.Validators[i].Validator < ja.Validators[j].Validator })
+
+	return ja
+}
+
+// resultAggregate converts the JSON representation back to a resultAggregate
+func (ja jsonResultAggregate) resultAggregate() *resultAggregate {
+	agg := &resultAggregate{
+		total:         ja.Total,
+		success:       ja.Success,
+		failure:       ja.Failure,
+		totalDuration: ja.Duration,
+		durations:     decodedPercentiles{50: ja.P50, 90: ja.P90, 99: ja.P99},
+		failures:      newFailureAggregate(),
+	}
+	for _, vf := range ja.Validators {
+		agg.failures.validators[vf.Validator] = &validatorFailures{
+			occurrences: vf.Occurrences,
+			failed:      vf.Failed,
+			message:     vf.Message,
+			firstSeen:   vf.FirstSeen,
+			lastSeen:    vf.LastSeen,
+		}
+	}
+	maps.Copy(agg.failures.byType, ja.ByType)
+	maps.Copy(agg.failures.byClass, ja.ByClass)
+	maps.Copy(agg.failures.patterns, ja.Patterns)
+
+	return agg
+}
+
+// restoreAggregates makes a decoded reporter streaming with the statistics
+// of the document, so its verdicts cover the results that were not kept
+func (r *Reporter) restoreAggregates(doc *reporterDocument) {
+	r.stream(doc.Aggregates.Retained, doc.Aggregates.FailedOnly)
+
+	r.aggregates.all = doc.Aggregates.All.resultAggregate()
+	for _, ja := range doc.Aggregates.Scenarios {
+		r.aggregates.index[ja.Scenario] = len(r.aggregates.scenarios)
+		r.aggregates.scenarios = append(r.aggregates.scenarios, scenarioAggregate{
+			name:            ja.Scenario,
+			resultAggregate: ja.resultAggregate(),
+		})
+	}
+
+	for _, stats := range doc.Steps {
+		r.steps.index[stats.Name] = len(r.steps.steps)
+		r.steps.steps = append(r.steps.steps, &stepAggregate{
+			name:      stats.Name,
+			count:     stats.Count,
+			min:       stats.Min,
+			max:       stats.Max,
+			total:     stats.Avg * time.Duration(stats.Count),
+			durations: decodedPercentiles{50: stats.P50, 90: stats.P90, 99: stats.P99},
+		})
+	}
+
+	r.correlation.failures = doc.Aggregates.Correlation.Failures
+	r.correlation.passes = doc.Aggregates.Correlation.Passes
+	for _, stats := range doc.Aggregates.Correlation.Injectors {
+		if r.correlation.stats == nil {
+			r.correlation.stats = make(map[string]*InjectionCorrelation)
+		}
+		r.correlation.stats[stats.Injector] = &InjectionCorrelation{
+			Injector:              stats.Injector,
+			FailuresWithInjection: stats.FailuresWithInjection,
+			PassesWithInjection:   stats.PassesWithInjection,
+		}
+	}
+	maps.Copy(r.lastIteration, doc.Aggregates.LastIterations)
+
+	// The executions are already part of the statistics
+	for _, jr := range doc.Executions {
+		r.retain(jr.executionResult())
+	}
+	r.droppedFailures = doc.Dropped
+}
+
+// DecodeReporter rebuilds a Reporter from the output of Reporter.GenerateJSON,
+// so verdicts, analysis and other reports can be calculated offline. The
+// reporter of a streaming reporter's output keeps the same results and
+// statistics, so its verdicts cover every result, not only the kept ones.
+func DecodeReporter(data []byte) (*Reporter, error) {
+	version, err := documentSchemaVersion(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc reporterDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode results (schema version %d): %w", version, err)
+	}
+
+	reporter := NewReporter()
+	if doc.Aggregates != nil {
+		reporter.restoreAggregates(&doc)
+	} else {
+		for _, jr := range doc.Executions {
+			reporter.AddResult(jr.executionResult())
+		}
+	}
+	for _, event := range doc.Timeline {
+		reporter.AddInjection(event)
+	}
+
+	// Hits are not part of the timeline; the timeline may be truncated as well
+	for _, point := range doc.ChaosPoints {
+		stats := reporter.chaosPoint(point.Name)
+		stats.Hits = point.Hits
+		stats.Triggered = point.Triggered
+		for t, count := range point.ByType {
+			stats.ByType[t] = count
+		}
+	}
+
+	// The timeline may be truncated: injector totals come from the summaries
+	for _, summary := range doc.Injectors {
+		if reporter.injectorStats == nil {
+			reporter.injectorStats = make(map[string]*InjectorSummary)
+		}
+		stats := summary
+		stats.Metrics = nil
+		reporter.injectorStats[summary.Name] = &stats
+		if summary.Metrics != nil {
+			reporter.SetInjectorMetrics(summary.Name, summary.Metrics)
+		}
+		if summary.Disabled != "" {
+			reporter.SetInjectorDisabled(summary.Name, summary.Disabled)
+		}
+	}
+
+	return reporter, nil
+}
+
+// documentSchemaVersion returns the schema version of a JSON document (1 if absent)
+func documentSchemaVersion(data []byte) (int, error) {
+	var header schemaHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return 0, fmt.Errorf("failed to decode schema version: %w", err)
+	}
+
+	switch {
+	case header.SchemaVersion == 0:
+		return 1, nil
+	case header.SchemaVersion < 0 || header.SchemaVersion > ReportSchemaVersion:
+		return 0, fmt.Errorf("unsupported schema version %d (max %d)", header.SchemaVersion, ReportSchemaVersion)
+	default:
+		return header.SchemaVersion, nil
+	}
+}
+
+// executionResult converts the JSON representation back to an ExecutionResult
+func (jr jsonResult) executionResult() ExecutionResult {
+	result := ExecutionResult{
+		ScenarioName:  jr.Scenario,
+		Iteration:     jr.Iteration,
+		Success:       jr.Success,
+		Duration:      time.Duration(jr.DurationMs) * time.Millisecond,
+		StepsExecuted: jr.Steps,
+		Timestamp:     jr.Timestamp,
+		Injectors:     jr.Injectors,
+		Artifacts:     jr.Artifacts,
+		Output:        jr.Output,
+		FailureClass:  FailureClass(jr.Class),
+		Injections:    jr.Injections,
+	}
+	if jr.Error != "" {
+		result.Error = errors.New(jr.Error)
+	}
+	for _, sd := range jr.StepDurations {
+		result.StepDurations = append(result.StepDurations, StepDuration{
+			Step:     sd.Step,
+			Duration: time.Duration(sd.DurationMs * float64(time.Millisecond)),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,146 @@
+package chaoskit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeReport_CurrentVersion(t *testing.T) {
+	reporter := NewReporter()
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "schema",
+		Error:        errors.New("validator goroutine_limit_10 failed: leak"),
+		Duration:     time.Millisecond,
+		Timestamp:    time.Now(),
+	})
+	report, err := reporter.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"schema_version":2`)
+	assert.Contains(t, string(data), `"verdict":"FAIL"`)
+	assert.Contains(t, string(data), `"severity":"CRITICAL"`)
+
+	decoded, err := DecodeReport(data)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictFail, decoded.Verdict)
+	require.Len(t, decoded.CriticalFailures, 1)
+	assert.Equal(t, SeverityCritical, decoded.CriticalFailures[0].Severity)
+}
+
+func TestDecodeReport_Version1(t *testing.T) {
+	data := []byte(`{
+		"verdict": 1,
+		"scenario_name": "legacy",
+		"total_iterations": 10,
+		"success_rate": 0.9,
+		"warnings": [{"validator_name": "execution-time", "severity": 1, "occurrences": 2}]
+	}`)
+
+	report, err := DecodeReport(data)
+	require.NoError(t, err)
+	assert.Equal(t, ReportSchemaVersion, report.SchemaVersion)
+	assert.Equal(t, VerdictUnstable, report.Verdict)
+	require.Len(t, report.Warnings, 1)
+	assert.Equal(t, SeverityWarning, report.Warnings[0].Severity)
+}
+
+func TestDecodeReport_UnsupportedVersion(t *testing.T) {
+	_, err := DecodeReport([]byte(`{"schema_version": 99, "verdict": "PASS"}`))
+	assert.Error(t, err)
+
+	_, err = DecodeReport([]byte(`{"verdict": "MAYBE", "scenario_name": "s", "total_iterations": 1}`))
+	assert.Error(t, err)
+}
+
+func TestDecodeReport_NotReport(t *testing.T) {
+	for _, data := range []string{
+		`{}`,
+		`{"schema_version": 2}`,
+		`{"verdict": "PASS", "scenario_name": "truncated"}`,
+		`{"schema_version": 2, "executions": [], "summary": {"total": 0}}`,
+	} {
+		_, err := DecodeReport([]byte(data))
+		assert.ErrorIs(t, err, ErrNotReport, data)
+	}
+}
+
+func TestDecodeResults(t *testing.T) {
+	var buf bytes.Buffer
+	executor := NewExecutor(WithResultSink(&buf), WithFailurePolicy(ContinueOnFailure))
+
+	iteration := 0
+	scenario := NewScenario("decode").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			if iteration == 2 {
+				return errors.New("boom")
+			}
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+	assert.Contains(t, buf.String(), `"schema_version":2`)
+
+	// Version 1 lines have no schema_version
+	buf.WriteString(`{"scenario":"decode","iteration":3,"success":true,"duration_ms":5}` + "\n")
+
+	results, err := DecodeResults(&buf)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.True(t, results[0].Success)
+	assert.EqualError(t, results[1].Error, "step step failed: boom")
+	require.Len(t, results[1].StepDurations, 1)
+	assert.Equal(t, 5*time.Millisecond, results[2].Duration)
+}
+
+func TestDecodeReporter(t *testing.T) {
+	reporter := NewReporter()
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "offline",
+		Iteration:    1,
+		Success:      true,
+		Duration:     time.Millisecond,
+		Timestamp:    time.Now(),
+	})
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "offline",
+		Iteration:    2,
+		Error:        errors.New("validator goroutine_limit_10 failed: leak"),
+		Duration:     2 * time.Millisecond,
+		Timestamp:    time.Now(),
+		FailureClass: FailureValidatorViolation,
+	})
+	reporter.AddInjection(InjectionEvent{Injector: "delay", Type: InjectionTypeDelay, Scenario: "offline", Iteration: 2})
+	reporter.SetInjectorMetrics("delay", map[string]any{"delays": 1})
+
+	data, err := reporter.GenerateJSON()
+	require.NoError(t, err)
+
+	decoded, err := DecodeReporter([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, decoded.Results(), 2)
+	assert.Len(t, decoded.Timeline(), 1)
+
+	report, err := decoded.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, VerdictFail, report.Verdict)
+	assert.Equal(t, 1, report.Analysis.ByClass[FailureValidatorViolation])
+	require.Len(t, report.Injectors, 1)
+	assert.Equal(t, 1, report.Injectors[0].Injections)
+	assert.Equal(t, float64(1), report.Injectors[0].Metrics["delays"])
+
+	_, err = DecodeReporter([]byte(`{"schema_version": 99}`))
+	assert.Error(t, err)
+}
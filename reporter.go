@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -14,30 +15,186 @@ import (
 
 // Reporter generates execution reports
 type Reporter struct {
-	mu      sync.Mutex
-	results []ExecutionResult
+	mu        sync.Mutex
+	results   []ExecutionResult
+	listeners []VerdictListener
+
+	// Applied chaos (see reporter_injections.go)
+	injections        []InjectionEvent
+	droppedInjections int
+	injectorStats     map[string]*InjectorSummary
+	injectorMetrics   map[string]map[string]any
+	disabledInjectors map[string]string
+	activeInjectors   map[iterationKey]map[string]struct{}
+
+	// Named chaos points (see chaos_points.go)
+	pointStats map[string]*ChaosPointSummary
+
+	redactor   *Redactor
+	lastReport *Report
+
+	// Streaming aggregation (see WithBounded and SetResultRetention):
+	// results are aggregated as they are added and only the last ones kept
+	retained        *resultRing
+	retainFailed    bool
+	droppedFailures int
+	aggregates      *resultAggregates
+	steps           *stepAggregates
+	correlation     *correlationCounts
+	lastIteration   map[string]int
+}
+
+// ReporterOption configures a Reporter
+type ReporterOption func(*Reporter)
+
+// WithBounded makes the reporter aggregate results as they are added instead
+// of keeping them, so memory stays bounded in long campaigns: counters and
+// failure breakdowns are exact, duration percentiles are t-digest estimates,
+// and only the n most recent failed results are kept (for Results, clusters,
+// artifacts, failed iterations and the executions of the JSON report)
+func WithBounded(n int) ReporterOption {
+	return func(r *Reporter) {
+		r.stream(n, true)
+	}
+}
+
+// SetResultRetention makes the reporter keep only the last n results, of
+// any outcome, for Results, the report details and the executions of the
+// JSON report, so always-on chaos services don't grow memory without bound.
+// Aggregate statistics still cover every result, as with WithBounded.
+func (r *Reporter) SetResultRetention(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stream(n, false)
+}
+
+// stream switches to streaming aggregation keeping n results, folding in the
+// results added so far (caller must hold r.mu)
+func (r *Reporter) stream(n int, failedOnly bool) {
+	retained := r.retainedResults()
+
+	r.retained = newResultRing(max(n, 1))
+	r.retainFailed = failedOnly
+	if r.aggregates == nil {
+		r.aggregates = newResultAggregates(true)
+		r.steps = newStepAggregates(true)
+		r.correlation = &correlationCounts{}
+		r.lastIteration = make(map[string]int)
+		r.results = nil
+		for _, result := range retained {
+			r.addStreaming(result)
+		}
+
+		return
+	}
+
+	for _, result := range retained {
+		r.retain(result)
+	}
+}
+
+// VerdictListener is notified every time a verdict is calculated,
+// e.g. to post the outcome of a scheduled chaos campaign
+type VerdictListener interface {
+	OnVerdict(report *Report)
 }
 
 // NewReporter creates a new reporter
-func NewReporter() *Reporter {
-	return &Reporter{
+func NewReporter(opts ...ReporterOption) *Reporter {
+	r := &Reporter{
 		results: make([]ExecutionResult, 0),
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
 }
 
 // AddResult adds an execution result
 func (r *Reporter) AddResult(result ExecutionResult) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.results = append(r.results, result)
+
+	result = r.redactor.RedactResult(result)
+	if r.aggregates == nil {
+		r.results = append(r.results, result)
+
+		return
+	}
+
+	r.addStreaming(result)
+}
+
+// addStreaming aggregates a result and retains it (caller must hold r.mu)
+func (r *Reporter) addStreaming(result ExecutionResult) {
+	r.aggregates.add(result)
+	r.steps.add(result)
+	if result.Iteration > 0 {
+		// Injection events of the iteration were recorded before its result
+		key := iterationKey{scenario: result.ScenarioName, iteration: result.Iteration}
+		r.correlation.add(result, r.activeInjectors[key])
+		delete(r.activeInjectors, key)
+		r.lastIteration[result.ScenarioName] = max(r.lastIteration[result.ScenarioName], result.Iteration)
+	}
+
+	r.retain(result)
+}
+
+// retain keeps result in place of the oldest retained one (caller must hold r.mu)
+func (r *Reporter) retain(result ExecutionResult) {
+	if result.Success && r.retainFailed {
+		return
+	}
+	if evicted, ok := r.retained.push(result); ok && !evicted.Success {
+		r.droppedFailures++
+	}
+}
+
+// retainedResults returns the kept results in order (caller must hold r.mu)
+func (r *Reporter) retainedResults() []ExecutionResult {
+	if r.retained != nil {
+		return r.retained.ordered()
+	}
+
+	return r.results
+}
+
+// aggregate summarizes the results (caller must hold r.mu)
+func (r *Reporter) aggregate() *resultAggregates {
+	if r.aggregates != nil {
+		return r.aggregates
+	}
+
+	return aggregateResults(r.results)
+}
+
+// stepStats summarizes the step durations (caller must hold r.mu)
+func (r *Reporter) stepStats() []StepStats {
+	if r.aggregates != nil {
+		return r.steps.stats()
+	}
+
+	return computeStepStats(r.results)
+}
+
+// SetRedactor sets the redactor applied to results, injection events and
+// injector metrics as they are added, so reports never contain the redacted values
+func (r *Reporter) SetRedactor(redactor *Redactor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.redactor = redactor
 }
 
-// Results returns a copy of accumulated results
+// Results returns a copy of accumulated results; a streaming reporter only
+// keeps the last ones (see WithBounded and SetResultRetention)
 func (r *Reporter) Results() []ExecutionResult {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	out := make([]ExecutionResult, len(r.results))
-	copy(out, r.results)
+	results := r.retainedResults()
+	out := make([]ExecutionResult, len(results))
+	copy(out, results)
 
 	return out
 }
@@ -47,26 +204,12 @@ func (r *Reporter) GenerateReport() string {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if len(r.results) == 0 {
+	agg := r.aggregate()
+	if agg.all.total == 0 {
 		return "No executions recorded"
 	}
 
-	success := 0
-	failed := 0
-	var totalDuration time.Duration
-
-	for _, result := range r.results {
-		if result.Success {
-			success++
-		} else {
-			failed++
-		}
-		totalDuration += result.Duration
-	}
-
-	avgDuration := totalDuration / time.Duration(len(r.results))
-
-	return fmt.Sprintf(
+	report := fmt.Sprintf(
 		"ChaosKit Execution Report\n"+
 			"========================\n"+
 			"Total Executions: %d\n"+
@@ -74,61 +217,124 @@ func (r *Reporter) GenerateReport() string {
 			"Failed: %d\n"+
 			"Success Rate: %.2f%%\n"+
 			"Average Duration: %v\n",
-		len(r.results),
-		success,
-		failed,
-		float64(success)/float64(len(r.results))*100,
-		avgDuration,
+		agg.all.total,
+		agg.all.success,
+		agg.all.failure,
+		float64(agg.all.success)/float64(agg.all.total)*100,
+		agg.all.totalDuration/time.Duration(agg.all.total),
 	)
+
+	if len(agg.scenarios) > 1 {
+		for _, scenario := range agg.scenarios {
+			report += fmt.Sprintf("Scenario %s: %d/%d succeeded (%.2f%%)\n",
+				scenario.name, scenario.success, scenario.total,
+				float64(scenario.success)/float64(scenario.total)*100)
+		}
+	}
+
+	for _, summary := range r.injectorSummaries() {
+		report += fmt.Sprintf("Injector %s\n", formatInjectorSummary(summary))
+	}
+
+	return report
+}
+
+// jsonResult is the JSON representation of an ExecutionResult
+type jsonResult struct {
+	// SchemaVersion is set on standalone (NDJSON) result lines
+	SchemaVersion int `json:"schema_version,omitempty"`
+
+	Scenario   string    `json:"scenario"`
+	Iteration  int       `json:"iteration,omitempty"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	Steps      int       `json:"steps_executed"`
+	Timestamp  time.Time `json:"timestamp"`
+	Injectors  []string  `json:"injectors,omitempty"`
+	Artifacts  []string  `json:"artifacts,omitempty"`
+	Output     string    `json:"output,omitempty"`
+	Class      string    `json:"failure_class,omitempty"`
+
+	StepDurations []jsonStepDuration `json:"step_durations,omitempty"`
+	Injections    []InjectionEvent   `json:"injections,omitempty"`
+}
+
+// jsonStepDuration is the JSON representation of a StepDuration
+type jsonStepDuration struct {
+	Step       string  `json:"step"`
+	DurationMs float64 `json:"duration_ms"`
 }
 
-// GenerateJSON returns a JSON report with aggregate stats and executions
+func newJSONResult(res ExecutionResult) jsonResult {
+	jr := jsonResult{
+		Scenario:   res.ScenarioName,
+		Iteration:  res.Iteration,
+		Success:    res.Success,
+		DurationMs: res.Duration.Milliseconds(),
+		Steps:      res.StepsExecuted,
+		Timestamp:  res.Timestamp,
+		Injectors:  res.Injectors,
+		Artifacts:  res.Artifacts,
+		Output:     res.Output,
+		Class:      string(ClassifyFailure(res)),
+		Injections: res.Injections,
+	}
+	if res.Error != nil {
+		jr.Error = res.Error.Error()
+	}
+	for _, sd := range res.StepDurations {
+		jr.StepDurations = append(jr.StepDurations, jsonStepDuration{
+			Step:       sd.Step,
+			DurationMs: float64(sd.Duration) / float64(time.Millisecond),
+		})
+	}
+
+	return jr
+}
+
+// GenerateJSON returns a JSON report with aggregate stats and executions.
+// A streaming reporter writes only the retained executions, with the
+// aggregates DecodeReporter needs to restore verdicts covering every result.
 func (r *Reporter) GenerateJSON() (string, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	type jsonResult struct {
-		Scenario   string    `json:"scenario"`
-		Success    bool      `json:"success"`
-		Error      string    `json:"error,omitempty"`
-		DurationMs int64     `json:"duration_ms"`
-		Steps      int       `json:"steps_executed"`
-		Timestamp  time.Time `json:"timestamp"`
-	}
-
+	agg := r.aggregate()
 	stats := struct {
-		Total       int          `json:"total_executions"`
-		Success     int          `json:"success_count"`
-		Failed      int          `json:"failure_count"`
-		AvgDuration int64        `json:"avg_duration_ms"`
-		Executions  []jsonResult `json:"executions"`
+		Schema      int                 `json:"schema_version"`
+		Total       int                 `json:"total_executions"`
+		Success     int                 `json:"success_count"`
+		Failed      int                 `json:"failure_count"`
+		AvgDuration int64               `json:"avg_duration_ms"`
+		Executions  []jsonResult        `json:"executions"`
+		Dropped     int                 `json:"dropped_failures,omitempty"`
+		Steps       []StepStats         `json:"steps,omitempty"`
+		Injectors   []InjectorSummary   `json:"injectors,omitempty"`
+		Timeline    []InjectionEvent    `json:"timeline,omitempty"`
+		ChaosPoints []ChaosPointSummary `json:"chaos_points,omitempty"`
+		Aggregates  *jsonAggregates     `json:"aggregates,omitempty"`
 	}{
-		Executions: make([]jsonResult, 0, len(r.results)),
+		Schema:      ReportSchemaVersion,
+		Total:       agg.all.total,
+		Success:     agg.all.success,
+		Failed:      agg.all.failure,
+		Executions:  make([]jsonResult, 0, len(r.retainedResults())),
+		Dropped:     r.droppedFailures,
+		Steps:       r.stepStats(),
+		Injectors:   r.injectorSummaries(),
+		Timeline:    r.timelineCopy(),
+		ChaosPoints: r.chaosPointSummaries(),
+	}
+	if r.aggregates != nil {
+		stats.Aggregates = r.jsonAggregates()
 	}
 
-	var totalDuration time.Duration
-	for _, res := range r.results {
-		jr := jsonResult{
-			Scenario:   res.ScenarioName,
-			Success:    res.Success,
-			DurationMs: res.Duration.Milliseconds(),
-			Steps:      res.StepsExecuted,
-			Timestamp:  res.Timestamp,
-		}
-		if res.Error != nil {
-			jr.Error = res.Error.Error()
-		}
-		stats.Executions = append(stats.Executions, jr)
-		if res.Success {
-			stats.Success++
-		} else {
-			stats.Failed++
-		}
-		totalDuration += res.Duration
+	for _, res := range r.retainedResults() {
+		stats.Executions = append(stats.Executions, newJSONResult(res))
 	}
-	stats.Total = len(r.results)
 	if stats.Total > 0 {
-		stats.AvgDuration = (totalDuration / time.Duration(stats.Total)).Milliseconds()
+		stats.AvgDuration = (agg.all.totalDuration / time.Duration(stats.Total)).Milliseconds()
 	}
 
 	b, err := json.MarshalIndent(stats, "", "  ")
@@ -149,12 +355,46 @@ func (r *Reporter) SaveJSON(path string) error {
 	return os.WriteFile(path, []byte(jsonStr), 0644)
 }
 
+// AddVerdictListener registers a listener called after each GetVerdict
+func (r *Reporter) AddVerdictListener(listener VerdictListener) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listeners = append(r.listeners, listener)
+}
+
 // GetVerdict calculates verdict based on thresholds
+// and notifies registered verdict listeners
 func (r *Reporter) GetVerdict(thresholds *SuccessThresholds) (*Report, error) {
+	report, err := r.calculateVerdict(thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	r.notifyVerdict(report)
+
+	return report, nil
+}
+
+// notifyVerdict remembers the report for SaveSummary and calls registered verdict listeners
+func (r *Reporter) notifyVerdict(report *Report) {
+	r.mu.Lock()
+	r.lastReport = report
+	listeners := make([]VerdictListener, len(r.listeners))
+	copy(listeners, r.listeners)
+	r.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener.OnVerdict(report)
+	}
+}
+
+// calculateVerdict builds the report without notifying listeners
+func (r *Reporter) calculateVerdict(thresholds *SuccessThresholds) (*Report, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if len(r.results) == 0 {
+	agg := r.aggregate()
+	if agg.all.total == 0 {
 		return nil, fmt.Errorf("no execution results available")
 	}
 
@@ -162,39 +402,35 @@ func (r *Reporter) GetVerdict(thresholds *SuccessThresholds) (*Report, error) {
 		return nil, fmt.Errorf("invalid thresholds: %w", err)
 	}
 
-	report := &Report{
-		ExecutionTime:   time.Now(),
-		TotalIterations: len(r.results),
-		Thresholds:      thresholds,
-	}
+	report := r.evaluateResults(agg.all, thresholds)
+	report.SchemaVersion = ReportSchemaVersion
+	report.ExecutionTime = time.Now()
 
-	// Extract scenario name from the first result
-	if len(r.results) > 0 {
-		report.ScenarioName = r.results[0].ScenarioName
+	// A shared reporter may hold several scenarios: each gets its own verdict
+	names := make([]string, 0, len(agg.scenarios))
+	for _, scenario := range agg.scenarios {
+		names = append(names, scenario.name)
 	}
-
-	// Calculate statistics
-	var totalDuration time.Duration
-	for _, result := range r.results {
-		if result.Success {
-			report.SuccessCount++
-		} else {
-			report.FailureCount++
+	report.ScenarioName = strings.Join(names, ", ")
+	if len(agg.scenarios) > 1 {
+		for _, scenario := range agg.scenarios {
+			report.Scenarios = append(report.Scenarios, r.scenarioReport(scenario.name, scenario.resultAggregate, thresholds))
 		}
-		totalDuration += result.Duration
 	}
 
-	report.SuccessRate = float64(report.SuccessCount) / float64(report.TotalIterations)
-	if report.TotalIterations > 0 {
-		report.AvgDuration = totalDuration / time.Duration(report.TotalIterations)
-	}
-	report.Duration = totalDuration
+	report.Steps = r.stepStats()
 
-	// Analyze failures and categorize by severity
-	report.Analysis = r.analyzeFailures()
-	report.CriticalFailures = r.categorizeFailures(SeverityCritical, thresholds)
-	report.Warnings = r.categorizeFailures(SeverityWarning, thresholds)
-	report.InfoMessages = r.categorizeFailures(SeverityInfo, thresholds)
+	// Analyze failures
+	report.Analysis = r.analyzeFailures(agg.all.failures)
+
+	// Applied chaos
+	report.Injectors = r.injectorSummaries()
+	report.Timeline = r.timelineCopy()
+	report.TimelineDropped = r.droppedInjections
+	report.ChaosPoints = r.chaosPointSummaries()
+	report.Artifacts = failureArtifacts(r.retainedResults())
+	report.FailedIterations = failedIterations(r.retainedResults())
+	report.DroppedFailures = r.droppedFailures
 
 	// Determine verdict
 	report.Verdict = r.determineVerdict(report, thresholds)
@@ -203,6 +439,62 @@ func (r *Reporter) GetVerdict(thresholds *SuccessThresholds) (*Report, error) {
 	return report, nil
 }
 
+// evaluateResults calculates statistics of results and categorizes their failures by severity
+func (r *Reporter) evaluateResults(agg *resultAggregate, thresholds *SuccessThresholds) *Report {
+	report := &Report{
+		TotalIterations: agg.total,
+		SuccessCount:    agg.success,
+		FailureCount:    agg.failure,
+		Duration:        agg.totalDuration,
+		Thresholds:      thresholds,
+	}
+
+	if report.TotalIterations > 0 {
+		report.SuccessRate = float64(report.SuccessCount) / float64(report.TotalIterations)
+		report.AvgDuration = agg.totalDuration / time.Duration(report.TotalIterations)
+	}
+
+	// Duration percentiles
+	report.P50Duration = agg.durations.percentile(50)
+	report.P90Duration = agg.durations.percentile(90)
+	report.P99Duration = agg.durations.percentile(99)
+
+	// Categorize failures by severity
+	validators := agg.failures.validators
+	limitCounts := validatorLimitCounts(validators, thresholds)
+	report.CriticalFailures = r.categorizeFailures(validators, SeverityCritical, thresholds, limitCounts)
+	report.Warnings = r.categorizeFailures(validators, SeverityWarning, thresholds, limitCounts)
+	report.InfoMessages = r.categorizeFailures(validators, SeverityInfo, thresholds, limitCounts)
+	report.ToleratedFailures = toleratedFailures(validators, thresholds, limitCounts)
+
+	return report
+}
+
+// scenarioReport evaluates the results of one scenario of a shared reporter
+func (r *Reporter) scenarioReport(name string, agg *resultAggregate, thresholds *SuccessThresholds) ScenarioReport {
+	report := r.evaluateResults(agg, thresholds)
+	report.ScenarioName = name
+	report.Verdict = r.determineVerdict(report, thresholds)
+	report.Summary = r.generateSummary(report)
+
+	return ScenarioReport{
+		ScenarioName:      name,
+		Verdict:           report.Verdict,
+		Summary:           report.Summary,
+		TotalIterations:   report.TotalIterations,
+		SuccessCount:      report.SuccessCount,
+		FailureCount:      report.FailureCount,
+		SuccessRate:       report.SuccessRate,
+		ToleratedFailures: report.ToleratedFailures,
+		AvgDuration:       report.AvgDuration,
+		P50Duration:       report.P50Duration,
+		P90Duration:       report.P90Duration,
+		P99Duration:       report.P99Duration,
+		CriticalFailures:  report.CriticalFailures,
+		Warnings:          report.Warnings,
+	}
+}
+
 // determineVerdict applies thresholds to determine verdict
 func (r *Reporter) determineVerdict(report *Report, thresholds *SuccessThresholds) Verdict {
 	// Check critical failures
@@ -210,18 +502,26 @@ func (r *Reporter) determineVerdict(report *Report, thresholds *SuccessThreshold
 		return VerdictFail
 	}
 
+	// A failing scenario fails the whole run even if the aggregate is within thresholds
+	if len(report.failedScenarios()) > 0 {
+		return VerdictFail
+	}
+
+	// Failures tolerated by validator limits don't count against global budgets
+	failed := report.FailureCount - report.ToleratedFailures
+
 	// Check the success rate
-	if report.SuccessRate < thresholds.MinSuccessRate {
+	if report.effectiveSuccessRate() < thresholds.MinSuccessRate {
 		return VerdictFail
 	}
 
 	// Check max failed iterations (if set)
-	if thresholds.MaxFailedIterations > 0 && report.FailureCount > thresholds.MaxFailedIterations {
+	if thresholds.MaxFailedIterations > 0 && failed > thresholds.MaxFailedIterations {
 		return VerdictFail
 	}
 
 	// Check if all validators must pass
-	if thresholds.RequireAllValidatorsPassing && report.FailureCount > 0 {
+	if thresholds.RequireAllValidatorsPassing && failed > 0 {
 		return VerdictFail
 	}
 
@@ -229,6 +529,11 @@ func (r *Reporter) determineVerdict(report *Report, thresholds *SuccessThreshold
 	if len(report.Warnings) > 0 {
 		return VerdictUnstable
 	}
+	for _, scenario := range report.Scenarios {
+		if scenario.Verdict == VerdictUnstable {
+			return VerdictUnstable
+		}
+	}
 
 	return VerdictPass
 }
@@ -249,10 +554,17 @@ func (r *Reporter) generateSummary(report *Report) string {
 		if len(report.CriticalFailures) > 0 {
 			reasons = append(reasons, fmt.Sprintf("%d critical validator(s) failed", len(report.CriticalFailures)))
 		}
-		if report.SuccessRate < report.Thresholds.MinSuccessRate {
+		if report.effectiveSuccessRate() < report.Thresholds.MinSuccessRate {
 			reasons = append(reasons, fmt.Sprintf("success rate %.2f%% below threshold %.2f%%",
 				report.SuccessRate*100, report.Thresholds.MinSuccessRate*100))
 		}
+		if failed := report.failedScenarios(); len(failed) > 0 {
+			reasons = append(reasons, fmt.Sprintf("scenario(s) failed: %s", strings.Join(failed, ", ")))
+		}
+		if report.Analysis != nil && report.Analysis.ByClass[FailureFramework] > 0 {
+			reasons = append(reasons, fmt.Sprintf("%d framework error(s)", report.Analysis.ByClass[FailureFramework]))
+		}
+		reasons = append(reasons, report.Regressions...)
 
 		return fmt.Sprintf("Tests failed: %s", strings.Join(reasons, ", "))
 
@@ -262,30 +574,29 @@ func (r *Reporter) generateSummary(report *Report) string {
 }
 
 // analyzeFailures performs detailed failure analysis
-func (r *Reporter) analyzeFailures() *FailureAnalysis {
+func (r *Reporter) analyzeFailures(failures failureAggregate) *FailureAnalysis {
 	analysis := &FailureAnalysis{
-		ByValidator: make(map[string]int),
-		ByType:      make(map[string]int),
+		ByValidator: make(map[string]int, len(failures.validators)),
+		ByType:      make(map[string]int, len(failures.byType)),
+		ByClass:     make(map[FailureClass]int, len(failures.byClass)),
 		TopErrors:   make([]ErrorSummary, 0),
 	}
 
-	errorCounts := make(map[string]int)
-
-	for _, result := range r.results {
-		if result.Error != nil {
-			// Extract validator name from error
-			validatorName := extractValidatorName(result.Error)
-			analysis.ByValidator[validatorName]++
+	for name, validator := range failures.validators {
+		analysis.ByValidator[name] = validator.occurrences
+	}
+	for errorType, count := range failures.byType {
+		analysis.ByType[errorType] = count
+	}
+	for class, count := range failures.byClass {
+		analysis.ByClass[class] = count
+	}
 
-			// Extract error type
-			errorType := classifyError(result.Error)
-			analysis.ByType[errorType]++
+	// Cluster similar failures
+	analysis.Clusters = clusterFailures(r.retainedResults())
 
-			// Count error patterns
-			errorPattern := normalizeError(result.Error)
-			errorCounts[errorPattern]++
-		}
-	}
+	// Correlate failures with injectors active in the same iterations
+	analysis.Correlations = r.correlateInjections()
 
 	// Build top errors list
 	type errorCount struct {
@@ -293,7 +604,7 @@ func (r *Reporter) analyzeFailures() *FailureAnalysis {
 		count   int
 	}
 	var errors []errorCount
-	for pattern, count := range errorCounts {
+	for pattern, count := range failures.patterns {
 		errors = append(errors, errorCount{pattern, count})
 	}
 	sort.Slice(errors, func(i, j int) bool {
@@ -312,47 +623,29 @@ func (r *Reporter) analyzeFailures() *FailureAnalysis {
 }
 
 // categorizeFailures groups failures by severity
-func (r *Reporter) categorizeFailures(severity ValidationSeverity, thresholds *SuccessThresholds) []ValidationFailure {
-	failures := make(map[string]*ValidationFailure)
-
-	for _, result := range r.results {
-		if result.Error == nil {
-			continue
-		}
-
-		validatorName := extractValidatorName(result.Error)
-
+func (r *Reporter) categorizeFailures(
+	validators map[string]*validatorFailures,
+	severity ValidationSeverity,
+	thresholds *SuccessThresholds,
+	limitCounts map[string]int,
+) []ValidationFailure {
+	var result []ValidationFailure
+	for validatorName, failures := range validators {
 		// Determine severity based on thresholds
-		failureSeverity := r.getValidatorSeverity(validatorName, thresholds)
+		failureSeverity := r.getValidatorSeverity(validatorName, thresholds, limitCounts)
 
 		if failureSeverity != severity {
 			continue
 		}
 
-		if existing, ok := failures[validatorName]; ok {
-			existing.Occurrences++
-			if result.Timestamp.After(existing.LastSeen) {
-				existing.LastSeen = result.Timestamp
-			}
-			if result.Timestamp.Before(existing.FirstSeen) {
-				existing.FirstSeen = result.Timestamp
-			}
-		} else {
-			failures[validatorName] = &ValidationFailure{
-				ValidatorName: validatorName,
-				Severity:      failureSeverity,
-				Message:       result.Error.Error(),
-				Occurrences:   1,
-				FirstSeen:     result.Timestamp,
-				LastSeen:      result.Timestamp,
-			}
-		}
-	}
-
-	// Convert map to slice
-	var result []ValidationFailure
-	for _, failure := range failures {
-		result = append(result, *failure)
+		result = append(result, ValidationFailure{
+			ValidatorName: validatorName,
+			Severity:      failureSeverity,
+			Message:       failures.message,
+			Occurrences:   failures.occurrences,
+			FirstSeen:     failures.firstSeen,
+			LastSeen:      failures.lastSeen,
+		})
 	}
 
 	// Sort by occurrences (most common first)
@@ -363,11 +656,26 @@ func (r *Reporter) categorizeFailures(severity ValidationSeverity, thresholds *S
 	return result
 }
 
-// getValidatorSeverity determines validator severity from thresholds
-func (r *Reporter) getValidatorSeverity(validatorName string, thresholds *SuccessThresholds) ValidationSeverity {
+// getValidatorSeverity determines validator severity from thresholds.
+// A validator with an occurrence limit is info while within the limit
+// and critical once the limit is exceeded.
+func (r *Reporter) getValidatorSeverity(
+	validatorName string,
+	thresholds *SuccessThresholds,
+	limitCounts map[string]int,
+) ValidationSeverity {
 	// Normalize validator name for matching (e.g., "goroutine_limit_100" -> ValidatorGoroutineLimit)
 	normalizedName := normalizeValidatorName(validatorName)
 
+	// Check occurrence limit
+	if key, limit, ok := thresholds.validatorLimit(validatorName); ok {
+		if limitCounts[key] > limit.Max {
+			return SeverityCritical
+		}
+
+		return SeverityInfo
+	}
+
 	// Check if critical
 	for _, critical := range thresholds.CriticalValidators {
 		if normalizedName == critical || validatorName == critical {
@@ -386,6 +694,39 @@ func (r *Reporter) getValidatorSeverity(validatorName string, thresholds *Succes
 	return SeverityInfo
 }
 
+// validatorLimitCounts counts failures per validator limit key
+func validatorLimitCounts(validators map[string]*validatorFailures, thresholds *SuccessThresholds) map[string]int {
+	counts := make(map[string]int)
+	if len(thresholds.ValidatorLimits) == 0 {
+		return counts
+	}
+
+	for name, failures := range validators {
+		if key, _, ok := thresholds.validatorLimit(name); ok {
+			counts[key] += failures.occurrences
+		}
+	}
+
+	return counts
+}
+
+// toleratedFailures counts failed iterations caused by validators within their limits
+func toleratedFailures(
+	validators map[string]*validatorFailures,
+	thresholds *SuccessThresholds,
+	limitCounts map[string]int,
+) int {
+	tolerated := 0
+	for name, failures := range validators {
+		key, limit, ok := thresholds.validatorLimit(name)
+		if ok && limitCounts[key] <= limit.Max {
+			tolerated += failures.failed
+		}
+	}
+
+	return tolerated
+}
+
 // normalizeValidatorName converts validator names to canonical form for matching
 // Examples:
 //   - "goroutine_limit_100" -> ValidatorGoroutineLimit
@@ -479,6 +820,17 @@ func extractValidatorName(err error) string {
 }
 
 func classifyError(err error) string {
+	switch FaultKindOf(err) {
+	case FaultTimeout:
+		return ErrorTypeTimeout
+	case FaultUnavailable:
+		return ErrorTypeUnavailable
+	case FaultThrottled:
+		return ErrorTypeThrottled
+	case FaultCorruption:
+		return ErrorTypeCorruption
+	}
+
 	msg := strings.ToLower(err.Error())
 	switch {
 	case strings.Contains(msg, "goroutine"):
@@ -509,6 +861,9 @@ func normalizeError(err error) string {
 	return re.ReplaceAllString(msg, "N")
 }
 
+// maxTextTimelineEvents limits the injection timeline printed in text reports
+const maxTextTimelineEvents = 20
+
 // GenerateTextReport generates enhanced human-readable report
 func (r *Reporter) GenerateTextReport(report *Report) string {
 	var buf bytes.Buffer
@@ -534,7 +889,79 @@ func (r *Reporter) GenerateTextReport(report *Report) string {
 	_, _ = fmt.Fprintf(&buf, "  Total Iterations: %d\n", report.TotalIterations)
 	_, _ = fmt.Fprintf(&buf, "  Success: %d (%.2f%%)\n", report.SuccessCount, report.SuccessRate*100)
 	_, _ = fmt.Fprintf(&buf, "  Failures: %d\n", report.FailureCount)
-	_, _ = fmt.Fprintf(&buf, "  Avg Duration: %s\n\n", report.AvgDuration)
+	_, _ = fmt.Fprintf(&buf, "  Avg Duration: %s\n", report.AvgDuration)
+	_, _ = fmt.Fprintf(&buf, "  P50/P90/P99: %s / %s / %s\n\n",
+		report.P50Duration, report.P90Duration, report.P99Duration)
+
+	// Per-scenario breakdown
+	if len(report.Scenarios) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Scenarios:\n")
+		for _, scenario := range report.Scenarios {
+			_, _ = fmt.Fprintf(&buf, "  - %s: %s, %d/%d iterations (%.2f%%), p99 %s\n",
+				scenario.ScenarioName, scenario.Verdict, scenario.SuccessCount, scenario.TotalIterations,
+				scenario.SuccessRate*100, scenario.P99Duration)
+			if scenario.Verdict != VerdictPass {
+				_, _ = fmt.Fprintf(&buf, "    %s\n", scenario.Summary)
+			}
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	// Baseline regressions
+	if len(report.Regressions) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Baseline Regressions:\n")
+		for _, regression := range report.Regressions {
+			_, _ = fmt.Fprintf(&buf, "  - %s\n", regression)
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	// Step durations
+	if len(report.Steps) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Step Durations:\n")
+		for _, step := range report.Steps {
+			_, _ = fmt.Fprintf(&buf, "  - %s: min %s / avg %s / max %s, p50 %s / p90 %s / p99 %s (%d runs)\n",
+				step.Name, step.Min, step.Avg, step.Max, step.P50, step.P90, step.P99, step.Count)
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	// Applied chaos
+	if len(report.Injectors) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Chaos Applied:\n")
+		for _, summary := range report.Injectors {
+			_, _ = fmt.Fprintf(&buf, "  - %s\n", formatInjectorSummary(summary))
+			if len(summary.Metrics) > 0 {
+				_, _ = fmt.Fprintf(&buf, "    metrics: %s\n", formatInjectorMetrics(summary.Metrics))
+			}
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	if len(report.ChaosPoints) > 0 {
+		hit, triggered, total := report.ChaosPointCoverage()
+		_, _ = fmt.Fprintf(&buf, "Chaos Points (%d/%d hit, %d/%d triggered):\n", hit, total, triggered, total)
+		for _, point := range report.ChaosPoints {
+			_, _ = fmt.Fprintf(&buf, "  - %s\n", formatChaosPoint(point))
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	if len(report.Timeline) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Injection Timeline (%d events", len(report.Timeline)+report.TimelineDropped)
+		if len(report.Timeline) > maxTextTimelineEvents {
+			_, _ = fmt.Fprintf(&buf, ", first %d shown", maxTextTimelineEvents)
+		}
+		_, _ = fmt.Fprintf(&buf, "):\n")
+		for i, event := range report.Timeline {
+			if i == maxTextTimelineEvents {
+				break
+			}
+			_, _ = fmt.Fprintf(&buf, "  %s iteration %d: %s\n", event.Timestamp.Format("15:04:05.000"),
+				event.Iteration, formatInjectionEvent(event))
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
 
 	// Critical failures
 	if len(report.CriticalFailures) > 0 {
@@ -556,6 +983,17 @@ func (r *Reporter) GenerateTextReport(report *Report) string {
 		_, _ = fmt.Fprintf(&buf, "\n")
 	}
 
+	// Failure classes
+	if report.Analysis != nil && len(report.Analysis.ByClass) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Failure Classes:\n")
+		for _, class := range failureClasses {
+			if count := report.Analysis.ByClass[class]; count > 0 {
+				_, _ = fmt.Fprintf(&buf, "  - %s: %d\n", class, count)
+			}
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
 	// Failure analysis
 	if report.Analysis != nil && len(report.Analysis.TopErrors) > 0 {
 		_, _ = fmt.Fprintf(&buf, "Top Errors:\n")
@@ -565,6 +1003,58 @@ func (r *Reporter) GenerateTextReport(report *Report) string {
 		_, _ = fmt.Fprintf(&buf, "\n")
 	}
 
+	// Faults of failed iterations
+	if len(report.FailedIterations) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Failed Iterations:\n")
+		for _, failed := range report.FailedIterations {
+			_, _ = fmt.Fprintf(&buf, "  - %s #%d: %s\n", failed.Scenario, failed.Iteration, failed.Error)
+			for i, event := range failed.Injections {
+				if i == maxTextTimelineEvents {
+					_, _ = fmt.Fprintf(&buf, "      ... %d more\n", len(failed.Injections)-i)
+
+					break
+				}
+				_, _ = fmt.Fprintf(&buf, "      %d. %s\n", i+1, formatInjectionEvent(event))
+			}
+			if len(failed.Injections) == 0 {
+				_, _ = fmt.Fprintf(&buf, "      no faults injected\n")
+			}
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	// Failure artifacts
+	if len(report.Artifacts) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Failure Artifacts:\n")
+		for _, artifacts := range report.Artifacts {
+			_, _ = fmt.Fprintf(&buf, "  - %s #%d: %s\n",
+				artifacts.Scenario, artifacts.Iteration, filepath.Dir(artifacts.Files[0]))
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	// Failure clusters
+	if report.Analysis != nil && len(report.Analysis.Clusters) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Failure Clusters:\n")
+		for i, cluster := range report.Analysis.Clusters {
+			_, _ = fmt.Fprintf(&buf, "  %d. %s (%d occurrences, %d variants)\n",
+				i+1, cluster.Pattern, cluster.Count, cluster.Variants)
+			if cluster.StackSignature != "" {
+				_, _ = fmt.Fprintf(&buf, "     at %s\n", cluster.StackSignature)
+			}
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
+	// Injection correlations
+	if report.Analysis != nil && len(report.Analysis.Correlations) > 0 {
+		_, _ = fmt.Fprintf(&buf, "Injection Correlations:\n")
+		for _, c := range report.Analysis.Correlations {
+			_, _ = fmt.Fprintf(&buf, "  - %s\n", c)
+		}
+		_, _ = fmt.Fprintf(&buf, "\n")
+	}
+
 	// Action items
 	switch report.Verdict {
 	case VerdictFail:
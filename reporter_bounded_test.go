@@ -0,0 +1,261 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTDigest_Percentiles(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	digest := newTDigest()
+	exact := &exactDurations{}
+	for i := 0; i < 100000; i++ {
+		// Long-tailed like real latencies
+		d := time.Duration(rng.ExpFloat64() * float64(10*time.Millisecond))
+		digest.add(d)
+		exact.add(d)
+	}
+
+	assert.LessOrEqual(t, len(digest.centroids), 2*tDigestCompression)
+	for _, p := range []float64{50, 90, 99} {
+		want := exact.percentile(p)
+		assert.InEpsilon(t, float64(want), float64(digest.percentile(p)), 0.02, "p%v", p)
+	}
+	assert.Equal(t, exact.percentile(0), digest.percentile(0))
+	assert.Equal(t, exact.percentile(100), digest.percentile(100))
+}
+
+func TestTDigest_Small(t *testing.T) {
+	digest := newTDigest()
+	assert.Zero(t, digest.percentile(50))
+
+	digest.add(5 * time.Millisecond)
+	assert.Equal(t, 5*time.Millisecond, digest.percentile(50))
+	assert.Equal(t, 5*time.Millisecond, digest.percentile(99))
+}
+
+func TestReporter_WithBounded(t *testing.T) {
+	exact := NewReporter()
+	bounded := NewReporter(WithBounded(5))
+
+	for i := 1; i <= 1000; i++ {
+		result := ExecutionResult{
+			ScenarioName:  "bounded",
+			Iteration:     i,
+			Success:       i%10 != 0,
+			Duration:      time.Duration(i) * time.Microsecond,
+			Timestamp:     time.Unix(int64(i), 0),
+			StepDurations: []StepDuration{{Step: "call", Duration: time.Duration(i) * time.Microsecond}},
+		}
+		if !result.Success {
+			result.Error = errors.New("validator no_panics failed: panic in iteration " + time.Duration(i).String())
+		}
+
+		for _, reporter := range []*Reporter{exact, bounded} {
+			if !result.Success {
+				reporter.AddInjection(InjectionEvent{Injector: "panic", Type: InjectionTypePanic,
+					Scenario: "bounded", Iteration: i})
+			}
+			reporter.AddResult(result)
+		}
+	}
+
+	thresholds := DefaultThresholds()
+	thresholds.MinSuccessRate = 0.8
+	want, err := exact.GetVerdict(thresholds)
+	require.NoError(t, err)
+	got, err := bounded.GetVerdict(thresholds)
+	require.NoError(t, err)
+
+	assert.Equal(t, want.Verdict, got.Verdict)
+	assert.Equal(t, 1000, got.TotalIterations)
+	assert.Equal(t, want.SuccessCount, got.SuccessCount)
+	assert.Equal(t, 100, got.FailureCount)
+	assert.Equal(t, want.AvgDuration, got.AvgDuration)
+	assert.InEpsilon(t, float64(want.P99Duration), float64(got.P99Duration), 0.02)
+	assert.Equal(t, want.Warnings, got.Warnings)
+	assert.Equal(t, want.InfoMessages, got.InfoMessages)
+	assert.Equal(t, want.Analysis.ByValidator, got.Analysis.ByValidator)
+	assert.Equal(t, want.Analysis.TopErrors, got.Analysis.TopErrors)
+	assert.Equal(t, want.Analysis.Correlations, got.Analysis.Correlations)
+	require.Len(t, got.Steps, 1)
+	assert.Equal(t, 1000, got.Steps[0].Count)
+	assert.Equal(t, want.Steps[0].Max, got.Steps[0].Max)
+
+	// Only the most recent failures are kept
+	assert.Equal(t, 95, got.DroppedFailures)
+	assert.Zero(t, want.DroppedFailures)
+	results := bounded.Results()
+	require.Len(t, results, 5)
+	assert.Equal(t, 960, results[0].Iteration)
+	assert.Equal(t, 1000, results[4].Iteration)
+	assert.Empty(t, bounded.activeInjectors)
+
+	assert.Contains(t, bounded.GenerateReport(), "Total Executions: 1000")
+}
+
+func TestReporter_SetResultRetention(t *testing.T) {
+	reporter := NewReporter()
+
+	add := func(from, to int) {
+		for i := from; i <= to; i++ {
+			result := ExecutionResult{ScenarioName: "retention", Iteration: i, Success: i%3 != 0,
+				Duration: time.Millisecond}
+			if !result.Success {
+				result.Error = errors.New("step failed")
+			}
+			reporter.AddResult(result)
+		}
+	}
+
+	// Results added before are folded into the statistics
+	add(1, 4)
+	reporter.SetResultRetention(4)
+	add(5, 10)
+
+	results := reporter.Results()
+	require.Len(t, results, 4)
+	for i, result := range results {
+		assert.Equal(t, 7+i, result.Iteration)
+	}
+
+	report, err := reporter.GetVerdict(&SuccessThresholds{MinSuccessRate: 0.5})
+	require.NoError(t, err)
+	assert.Equal(t, 10, report.TotalIterations)
+	assert.Equal(t, 3, report.FailureCount)
+	assert.Equal(t, time.Millisecond, report.AvgDuration)
+	// Failed iterations 3 and 6 were evicted, 9 is retained
+	assert.Equal(t, 2, report.DroppedFailures)
+	require.Len(t, report.FailedIterations, 1)
+	assert.Equal(t, 9, report.FailedIterations[0].Iteration)
+}
+
+func TestExecutor_WithResultRetention(t *testing.T) {
+	scenario := NewScenario("retention").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(20).
+		Build()
+
+	executor := NewExecutor(WithResultRetention(5))
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 5)
+	assert.Equal(t, 20, results[4].Iteration)
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, 20, report.TotalIterations)
+	assert.Equal(t, VerdictPass, report.Verdict)
+}
+
+// addCampaign adds 100 results of two scenarios at 98% success; the first
+// failure is a critical goroutine leak, the second an ordinary error
+func addCampaign(reporter *Reporter) {
+	for i := 1; i <= 100; i++ {
+		scenario := "orders"
+		if i%2 == 0 {
+			scenario = "payments"
+		}
+		result := ExecutionResult{
+			ScenarioName:  scenario,
+			Iteration:     i,
+			Success:       true,
+			Duration:      time.Duration(i) * time.Millisecond,
+			Timestamp:     time.Unix(int64(i), 0).UTC(),
+			StepDurations: []StepDuration{{Step: "call", Duration: time.Duration(i) * time.Millisecond}},
+		}
+		switch i {
+		case 10:
+			result.Success = false
+			result.Error = errors.New("validator goroutine_limit_100 failed: 150 goroutines")
+		case 20:
+			result.Success = false
+			result.Error = errors.New("step failed: connection refused")
+		}
+		if !result.Success {
+			reporter.AddInjection(InjectionEvent{Injector: "delay", Type: InjectionTypeDelay,
+				Scenario: scenario, Iteration: i})
+		}
+		reporter.AddResult(result)
+	}
+}
+
+// assertRoundTrip checks that the reporter decoded from the JSON report of
+// a streaming reporter gives the same verdict and statistics
+func assertRoundTrip(t *testing.T, reporter *Reporter, retained int) {
+	t.Helper()
+
+	data, err := reporter.GenerateJSON()
+	require.NoError(t, err)
+	decoded, err := DecodeReporter([]byte(data))
+	require.NoError(t, err)
+	results := decoded.Results()
+	require.Len(t, results, retained)
+	for i, result := range reporter.Results() {
+		assert.Equal(t, result.Iteration, results[i].Iteration)
+	}
+
+	thresholds := DefaultThresholds()
+	thresholds.CriticalValidators = nil
+	for _, thresholds := range []*SuccessThresholds{thresholds, DefaultThresholds()} {
+		want, err := reporter.GetVerdict(thresholds)
+		require.NoError(t, err)
+		got, err := decoded.GetVerdict(thresholds)
+		require.NoError(t, err)
+
+		assert.Equal(t, want.Verdict, got.Verdict)
+		assert.Equal(t, want.Summary, got.Summary)
+		assert.Equal(t, 100, got.TotalIterations)
+		assert.Equal(t, 98, got.SuccessCount)
+		assert.Equal(t, want.AvgDuration, got.AvgDuration)
+		assert.Equal(t, want.P99Duration, got.P99Duration)
+		assert.Equal(t, want.CriticalFailures, got.CriticalFailures)
+		assert.Equal(t, want.Scenarios, got.Scenarios)
+		assert.Equal(t, want.Steps, got.Steps)
+		assert.Equal(t, want.Analysis.ByValidator, got.Analysis.ByValidator)
+		assert.ElementsMatch(t, want.Analysis.TopErrors, got.Analysis.TopErrors)
+		assert.Equal(t, want.Analysis.Correlations, got.Analysis.Correlations)
+		assert.Equal(t, want.DroppedFailures, got.DroppedFailures)
+		assert.Equal(t, want.FailedIterations, got.FailedIterations)
+	}
+}
+
+func TestDecodeReporter_Bounded(t *testing.T) {
+	reporter := NewReporter(WithBounded(1))
+	addCampaign(reporter)
+
+	// The critical failure of iteration 10 was evicted by the one of iteration 20
+	assertRoundTrip(t, reporter, 1)
+
+	thresholds := DefaultThresholds()
+	thresholds.CriticalValidators = nil
+	data, err := reporter.GenerateJSON()
+	require.NoError(t, err)
+	decoded, err := DecodeReporter([]byte(data))
+	require.NoError(t, err)
+	report, err := decoded.GetVerdict(thresholds)
+	require.NoError(t, err)
+	assert.Equal(t, VerdictPass, report.Verdict)
+	assert.Equal(t, 0.98, report.SuccessRate)
+	assert.Equal(t, 1, report.DroppedFailures)
+
+	report, err = decoded.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, VerdictFail, report.Verdict)
+}
+
+func TestDecodeReporter_ResultRetention(t *testing.T) {
+	reporter := NewReporter()
+	reporter.SetResultRetention(10)
+	addCampaign(reporter)
+
+	assertRoundTrip(t, reporter, 10)
+}
@@ -0,0 +1,70 @@
+package chaoskit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GenerateGitHubAnnotations converts report to GitHub Actions workflow commands.
+// Critical failures become ::error, warnings become ::warning and info messages
+// and applied chaos per injector become ::notice annotations, followed by one
+// annotation with the overall verdict.
+func (r *Reporter) GenerateGitHubAnnotations(report *Report) string {
+	var buf bytes.Buffer
+
+	for _, failure := range report.CriticalFailures {
+		writeGitHubAnnotation(&buf, "error", report.ScenarioName, failure)
+	}
+	for _, failure := range report.Warnings {
+		writeGitHubAnnotation(&buf, "warning", report.ScenarioName, failure)
+	}
+	for _, failure := range report.InfoMessages {
+		writeGitHubAnnotation(&buf, "notice", report.ScenarioName, failure)
+	}
+
+	for _, summary := range report.Injectors {
+		_, _ = fmt.Fprintf(&buf, "::notice title=%s::%s\n",
+			escapeGitHubProperty(fmt.Sprintf("ChaosKit %s: injector %s", report.ScenarioName, summary.Name)),
+			escapeGitHubData(formatInjectorSummary(summary)))
+	}
+
+	command := "notice"
+	switch report.Verdict {
+	case VerdictFail:
+		command = "error"
+	case VerdictUnstable:
+		command = "warning"
+	}
+	_, _ = fmt.Fprintf(&buf, "::%s title=%s::%s\n", command,
+		escapeGitHubProperty(fmt.Sprintf("ChaosKit %s: %s", report.ScenarioName, report.Verdict)),
+		escapeGitHubData(report.Summary))
+
+	return buf.String()
+}
+
+// WriteGitHubAnnotations writes GitHub Actions annotations to w (usually os.Stdout)
+func (r *Reporter) WriteGitHubAnnotations(w io.Writer, report *Report) error {
+	_, err := io.WriteString(w, r.GenerateGitHubAnnotations(report))
+
+	return err
+}
+
+func writeGitHubAnnotation(buf *bytes.Buffer, command, scenario string, failure ValidationFailure) {
+	title := fmt.Sprintf("ChaosKit %s: validator %s", scenario, failure.ValidatorName)
+	message := fmt.Sprintf("%s (%d occurrences)", failure.Message, failure.Occurrences)
+
+	_, _ = fmt.Fprintf(buf, "::%s title=%s::%s\n", command,
+		escapeGitHubProperty(title), escapeGitHubData(message))
+}
+
+// escapeGitHubData escapes a workflow command message
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeGitHubProperty escapes a workflow command property value
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
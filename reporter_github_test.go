@@ -0,0 +1,35 @@
+package chaoskit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_GenerateGitHubAnnotations(t *testing.T) {
+	reporter := NewReporter()
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "gh",
+		Error:        fmt.Errorf("validator goroutine_limit_100 failed: exceeded limit\nstack"),
+	})
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "gh",
+		Error:        fmt.Errorf("validator execution_time_10ms failed: too slow"),
+	})
+
+	thresholds := DefaultThresholds()
+	thresholds.WarningValidators = []string{ValidatorExecutionTime}
+	report, err := reporter.GetVerdict(thresholds)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(reporter.GenerateGitHubAnnotations(report)), "\n")
+	require.Len(t, lines, 3)
+
+	assert.True(t, strings.HasPrefix(lines[0], "::error title=ChaosKit gh%3A validator goroutine_limit_100::"))
+	assert.Contains(t, lines[0], "exceeded limit%0Astack")
+	assert.True(t, strings.HasPrefix(lines[1], "::warning title=ChaosKit gh%3A validator execution_time_10ms::"))
+	assert.True(t, strings.HasPrefix(lines[2], "::error title=ChaosKit gh%3A FAIL::"))
+}
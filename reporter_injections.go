@@ -0,0 +1,260 @@
+package chaoskit
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MaxTimelineEvents limits how many injection events a reporter keeps for the timeline.
+// Events above the limit are still counted in the injector summaries.
+const MaxTimelineEvents = 10000
+
+// InjectorSummary describes how much chaos an injector actually applied
+type InjectorSummary struct {
+	// Name is the injector name
+	Name string `json:"name"`
+
+	// Injections is the number of recorded injection events
+	Injections int `json:"injections"`
+
+	// ByType counts injection events per type (see InjectionType* constants)
+	ByType map[string]int `json:"by_type,omitempty"`
+
+	// TotalDelay is the sum of injected latency
+	TotalDelay time.Duration `json:"total_delay,omitempty"`
+
+	// Metrics is the last GetMetrics snapshot of the injector
+	Metrics map[string]any `json:"metrics,omitempty"`
+
+	// Disabled is why the injector was disabled during the run after a
+	// failed health check (see HealthChecker); empty if it stayed enabled
+	Disabled string `json:"disabled,omitempty"`
+}
+
+// AddInjection records an applied fault for the report timeline
+func (r *Reporter) AddInjection(event InjectionEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event = r.redactor.RedactEvent(event)
+
+	if r.injectorStats == nil {
+		r.injectorStats = make(map[string]*InjectorSummary)
+	}
+
+	stats, ok := r.injectorStats[event.Injector]
+	if !ok {
+		stats = &InjectorSummary{Name: event.Injector, ByType: make(map[string]int)}
+		r.injectorStats[event.Injector] = stats
+	}
+	stats.Injections++
+	stats.ByType[event.Type]++
+	stats.TotalDelay += event.Delay
+
+	if event.Point != "" {
+		point := r.chaosPoint(event.Point)
+		point.Triggered++
+		point.ByType[event.Type]++
+	}
+
+	// A streaming reporter correlates iterations when their result is added,
+	// so events of iterations already reported are not kept
+	if event.Iteration > 0 && (r.aggregates == nil || event.Iteration > r.lastIteration[event.Scenario]) {
+		if r.activeInjectors == nil {
+			r.activeInjectors = make(map[iterationKey]map[string]struct{})
+		}
+		key := iterationKey{scenario: event.Scenario, iteration: event.Iteration}
+		if r.activeInjectors[key] == nil {
+			r.activeInjectors[key] = make(map[string]struct{})
+		}
+		r.activeInjectors[key][event.Injector] = struct{}{}
+	}
+
+	if len(r.injections) >= MaxTimelineEvents {
+		r.droppedInjections++
+
+		return
+	}
+	r.injections = append(r.injections, event)
+}
+
+// SetInjectorMetrics stores the latest metrics snapshot of an injector
+func (r *Reporter) SetInjectorMetrics(injector string, metrics map[string]any) {
+	snapshot := make(map[string]any, len(metrics))
+	for k, v := range metrics {
+		snapshot[k] = v
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot = r.redactor.RedactMap(snapshot)
+
+	if r.injectorMetrics == nil {
+		r.injectorMetrics = make(map[string]map[string]any)
+	}
+	r.injectorMetrics[injector] = snapshot
+}
+
+// SetInjectorDisabled flags an injector as disabled during the run, with the reason
+func (r *Reporter) SetInjectorDisabled(injector, reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.disabledInjectors == nil {
+		r.disabledInjectors = make(map[string]string)
+	}
+	r.disabledInjectors[injector] = r.redactor.RedactString(reason)
+}
+
+// Timeline returns a copy of recorded injection events in recording order
+func (r *Reporter) Timeline() []InjectionEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	out := make([]InjectionEvent, len(r.injections))
+	copy(out, r.injections)
+
+	return out
+}
+
+// injectorSummaries builds per-injector summaries sorted by name (caller must hold r.mu)
+func (r *Reporter) injectorSummaries() []InjectorSummary {
+	names := make(map[string]struct{}, len(r.injectorStats)+len(r.injectorMetrics))
+	for name := range r.injectorStats {
+		names[name] = struct{}{}
+	}
+	for name := range r.injectorMetrics {
+		names[name] = struct{}{}
+	}
+	for name := range r.disabledInjectors {
+		names[name] = struct{}{}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	summaries := make([]InjectorSummary, 0, len(names))
+	for _, name := range sortedKeys(names) {
+		summary := InjectorSummary{Name: name}
+		if stats, ok := r.injectorStats[name]; ok {
+			summary.Injections = stats.Injections
+			summary.TotalDelay = stats.TotalDelay
+			summary.ByType = make(map[string]int, len(stats.ByType))
+			for t, count := range stats.ByType {
+				summary.ByType[t] = count
+			}
+		}
+		summary.Metrics = r.injectorMetrics[name]
+		summary.Disabled = r.disabledInjectors[name]
+		summaries = append(summaries, summary)
+	}
+
+	return summaries
+}
+
+// timelineCopy returns a copy of the timeline (caller must hold r.mu)
+func (r *Reporter) timelineCopy() []InjectionEvent {
+	if len(r.injections) == 0 {
+		return nil
+	}
+
+	out := make([]InjectionEvent, len(r.injections))
+	copy(out, r.injections)
+
+	return out
+}
+
+// maxFailedIterations limits how many failed iterations a report lists with their faults
+const maxFailedIterations = 10
+
+// FailedIteration lists the faults injected during a failed iteration, in injection order
+type FailedIteration struct {
+	Scenario   string           `json:"scenario"`
+	Iteration  int              `json:"iteration"`
+	Error      string           `json:"error,omitempty"`
+	Injections []InjectionEvent `json:"injections"`
+}
+
+// failedIterations returns the first failed iterations with their injected faults
+func failedIterations(results []ExecutionResult) []FailedIteration {
+	var failed []FailedIteration
+	for _, result := range results {
+		if result.Success || len(failed) == maxFailedIterations {
+			continue
+		}
+
+		entry := FailedIteration{
+			Scenario:   result.ScenarioName,
+			Iteration:  result.Iteration,
+			Injections: result.Injections,
+		}
+		if result.Error != nil {
+			entry.Error = result.Error.Error()
+		}
+		failed = append(failed, entry)
+	}
+
+	return failed
+}
+
+// formatInjectionEvent returns a one-line description of an injected fault
+func formatInjectionEvent(event InjectionEvent) string {
+	var buf bytes.Buffer
+	_, _ = fmt.Fprintf(&buf, "%s %s", event.Injector, event.Type)
+	if event.Point != "" {
+		_, _ = fmt.Fprintf(&buf, " at %s", event.Point)
+	}
+	if event.Delay > 0 {
+		_, _ = fmt.Fprintf(&buf, " (%s)", event.Delay)
+	}
+
+	return buf.String()
+}
+
+// formatInjectorSummary returns a one-line description of applied chaos
+func formatInjectorSummary(summary InjectorSummary) string {
+	var buf bytes.Buffer
+	_, _ = fmt.Fprintf(&buf, "%s: %d injections", summary.Name, summary.Injections)
+
+	if len(summary.ByType) > 0 {
+		types := make([]string, 0, len(summary.ByType))
+		for t := range summary.ByType {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+
+		buf.WriteString(" (")
+		for i, t := range types {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			_, _ = fmt.Fprintf(&buf, "%s: %d", t, summary.ByType[t])
+		}
+		buf.WriteString(")")
+	}
+
+	if summary.TotalDelay > 0 {
+		_, _ = fmt.Fprintf(&buf, ", total delay %s", summary.TotalDelay)
+	}
+	if summary.Disabled != "" {
+		_, _ = fmt.Fprintf(&buf, " [DISABLED: %s]", summary.Disabled)
+	}
+
+	return buf.String()
+}
+
+// formatInjectorMetrics returns injector metrics as sorted key=value pairs
+func formatInjectorMetrics(metrics map[string]any) string {
+	var buf bytes.Buffer
+	for i, k := range sortedMetricKeys(metrics) {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		_, _ = fmt.Fprintf(&buf, "%s=%v", k, metrics[k])
+	}
+
+	return buf.String()
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/xml"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -21,11 +24,24 @@ type JUnitTestSuite struct {
 
 // JUnitTestCase represents a single test case
 type JUnitTestCase struct {
-	Name      string        `xml:"name,attr"`
-	Classname string        `xml:"classname,attr"`
-	Time      float64       `xml:"time,attr"`
-	Failure   *JUnitFailure `xml:"failure,omitempty"`
-	Error     *JUnitError   `xml:"error,omitempty"`
+	Name       string           `xml:"name,attr"`
+	Classname  string           `xml:"classname,attr"`
+	Time       float64          `xml:"time,attr"`
+	Properties *JUnitProperties `xml:"properties,omitempty"`
+	Failure    *JUnitFailure    `xml:"failure,omitempty"`
+	Error      *JUnitError      `xml:"error,omitempty"`
+	SystemOut  string           `xml:"system-out,omitempty"`
+}
+
+// JUnitProperties holds additional key/value metadata of a test case
+type JUnitProperties struct {
+	Properties []JUnitProperty `xml:"property"`
+}
+
+// JUnitProperty represents a single test case property
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
 }
 
 // JUnitFailure represents a test failure
@@ -42,13 +58,32 @@ type JUnitError struct {
 	Content string `xml:",chardata"`
 }
 
-// GenerateJUnitXML converts report to JUnit XML format
-func (r *Reporter) GenerateJUnitXML(report *Report) (string, error) {
+// JUnitOption configures JUnit XML generation
+type JUnitOption func(*junitConfig)
+
+type junitConfig struct {
+	perIteration bool
+}
+
+// WithJUnitIterations adds one test case per executed iteration
+// in addition to the verdict and per-validator test cases
+func WithJUnitIterations() JUnitOption {
+	return func(c *junitConfig) {
+		c.perIteration = true
+	}
+}
+
+// GenerateJUnitXML converts report to JUnit XML format.
+// The suite contains the overall verdict, one test case per failed validator
+// per scenario and, with WithJUnitIterations, one test case per iteration.
+func (r *Reporter) GenerateJUnitXML(report *Report, opts ...JUnitOption) (string, error) {
+	config := &junitConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+
 	suite := JUnitTestSuite{
 		Name:      report.ScenarioName,
-		Tests:     report.TotalIterations,
-		Failures:  len(report.CriticalFailures),
-		Errors:    len(report.Warnings),
 		Time:      report.Duration.Seconds(),
 		Timestamp: report.ExecutionTime.Format(time.RFC3339),
 		TestCases: make([]JUnitTestCase, 0),
@@ -60,6 +95,7 @@ func (r *Reporter) GenerateJUnitXML(report *Report) (string, error) {
 		Classname: "chaoskit." + report.ScenarioName,
 		Time:      report.Duration.Seconds(),
 	}
+	verdictCase.Properties = verdictProperties(report)
 
 	switch report.Verdict {
 	case VerdictFail:
@@ -78,37 +114,27 @@ func (r *Reporter) GenerateJUnitXML(report *Report) (string, error) {
 
 	suite.TestCases = append(suite.TestCases, verdictCase)
 
+	results := r.Results()
+	thresholds := report.Thresholds
+	if thresholds == nil {
+		thresholds = DefaultThresholds()
+	}
+
 	// Add individual validator results as test cases
-	for _, failure := range report.CriticalFailures {
-		testCase := JUnitTestCase{
-			Name:      failure.ValidatorName,
-			Classname: "chaoskit.validator",
-			Time:      0, // Validators don't have individual duration
-			Failure: &JUnitFailure{
-				Message: failure.Message,
-				Type:    "CriticalValidatorFailure",
-				Content: fmt.Sprintf("Validator %s failed %d times\nFirst seen: %s\nLast seen: %s",
-					failure.ValidatorName, failure.Occurrences,
-					failure.FirstSeen.Format(time.RFC3339),
-					failure.LastSeen.Format(time.RFC3339)),
-			},
-		}
-		suite.TestCases = append(suite.TestCases, testCase)
+	suite.TestCases = append(suite.TestCases, r.validatorTestCases(results, thresholds)...)
+
+	if config.perIteration {
+		suite.TestCases = append(suite.TestCases, iterationTestCases(results)...)
 	}
 
-	for _, warning := range report.Warnings {
-		testCase := JUnitTestCase{
-			Name:      warning.ValidatorName,
-			Classname: "chaoskit.validator",
-			Time:      0,
-			Error: &JUnitError{
-				Message: warning.Message,
-				Type:    "ValidatorWarning",
-				Content: fmt.Sprintf("Validator %s produced %d warnings",
-					warning.ValidatorName, warning.Occurrences),
-			},
+	suite.Tests = len(suite.TestCases)
+	for _, testCase := range suite.TestCases {
+		if testCase.Failure != nil {
+			suite.Failures++
+		}
+		if testCase.Error != nil {
+			suite.Errors++
 		}
-		suite.TestCases = append(suite.TestCases, testCase)
 	}
 
 	// Marshal to XML
@@ -121,8 +147,8 @@ func (r *Reporter) GenerateJUnitXML(report *Report) (string, error) {
 }
 
 // SaveJUnitXML writes JUnit XML report to file
-func (r *Reporter) SaveJUnitXML(report *Report, path string) error {
-	xmlStr, err := r.GenerateJUnitXML(report)
+func (r *Reporter) SaveJUnitXML(report *Report, path string, opts ...JUnitOption) error {
+	xmlStr, err := r.GenerateJUnitXML(report, opts...)
 	if err != nil {
 		return err
 	}
@@ -130,6 +156,228 @@ func (r *Reporter) SaveJUnitXML(report *Report, path string) error {
 	return os.WriteFile(path, []byte(xmlStr), 0644)
 }
 
+// junitValidatorFailure aggregates failures of one validator within one scenario
+type junitValidatorFailure struct {
+	scenario    string
+	validator   string
+	message     string
+	occurrences int
+	firstSeen   time.Time
+	lastSeen    time.Time
+	injectors   map[string]struct{}
+	output      string
+	outputSeen  time.Time
+}
+
+// validatorTestCases builds one test case per failed validator per scenario.
+// Occurrences and timestamps cover all results, including those a streaming
+// reporter dropped; injectors and output come from the retained results.
+func (r *Reporter) validatorTestCases(results []ExecutionResult, thresholds *SuccessThresholds) []JUnitTestCase {
+	failures := make(map[string]*junitValidatorFailure)
+
+	r.mu.Lock()
+	aggregates := r.aggregate()
+	limitCounts := validatorLimitCounts(aggregates.all.failures.validators, thresholds)
+	for _, scenario := range aggregates.scenarios {
+		for name, vf := range scenario.failures.validators {
+			failures[scenario.name+"\x00"+name] = &junitValidatorFailure{
+				scenario:    scenario.name,
+				validator:   name,
+				message:     vf.message,
+				occurrences: vf.occurrences,
+				firstSeen:   vf.firstSeen,
+				lastSeen:    vf.lastSeen,
+				injectors:   make(map[string]struct{}),
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	for _, result := range results {
+		if result.Error == nil {
+			continue
+		}
+
+		failure, ok := failures[result.ScenarioName+"\x00"+extractValidatorName(result.Error)]
+		if !ok {
+			continue
+		}
+		if result.Output != "" && !result.Timestamp.Before(failure.outputSeen) {
+			failure.output = result.Output
+			failure.outputSeen = result.Timestamp
+		}
+		for _, name := range result.Injectors {
+			failure.injectors[name] = struct{}{}
+		}
+	}
+
+	sorted := make([]*junitValidatorFailure, 0, len(failures))
+	for _, failure := range failures {
+		sorted = append(sorted, failure)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].scenario != sorted[j].scenario {
+			return sorted[i].scenario < sorted[j].scenario
+		}
+		if sorted[i].occurrences != sorted[j].occurrences {
+			return sorted[i].occurrences > sorted[j].occurrences
+		}
+
+		return sorted[i].validator < sorted[j].validator
+	})
+
+	testCases := make([]JUnitTestCase, 0, len(sorted))
+	for _, failure := range sorted {
+		severity := r.getValidatorSeverity(failure.validator, thresholds, limitCounts)
+		injectors := sortedKeys(failure.injectors)
+
+		testCase := JUnitTestCase{
+			Name:      failure.validator,
+			Classname: "chaoskit." + failure.scenario + ".validator",
+			Time:      0, // Validators don't have individual duration
+			Properties: &JUnitProperties{Properties: []JUnitProperty{
+				{Name: "scenario", Value: failure.scenario},
+				{Name: "severity", Value: severity.String()},
+				{Name: "occurrences", Value: strconv.Itoa(failure.occurrences)},
+				{Name: "first_seen", Value: failure.firstSeen.Format(time.RFC3339Nano)},
+				{Name: "last_seen", Value: failure.lastSeen.Format(time.RFC3339Nano)},
+				{Name: "injectors", Value: strings.Join(injectors, ",")},
+			}},
+			SystemOut: failure.output,
+		}
+
+		content := fmt.Sprintf("Validator %s failed %d times in scenario %s\nFirst seen: %s\nLast seen: %s",
+			failure.validator, failure.occurrences, failure.scenario,
+			failure.firstSeen.Format(time.RFC3339),
+			failure.lastSeen.Format(time.RFC3339))
+		if len(injectors) > 0 {
+			content += fmt.Sprintf("\nActive injectors: %s", strings.Join(injectors, ", "))
+		}
+
+		switch severity {
+		case SeverityCritical:
+			testCase.Failure = &JUnitFailure{
+				Message: failure.message,
+				Type:    "CriticalValidatorFailure",
+				Content: content,
+			}
+		case SeverityWarning:
+			testCase.Error = &JUnitError{
+				Message: failure.message,
+				Type:    "ValidatorWarning",
+				Content: content,
+			}
+		}
+
+		testCases = append(testCases, testCase)
+	}
+
+	return testCases
+}
+
+// iterationTestCases builds one test case per execution result
+func iterationTestCases(results []ExecutionResult) []JUnitTestCase {
+	testCases := make([]JUnitTestCase, 0, len(results))
+	iterations := make(map[string]int)
+
+	for _, result := range results {
+		iterations[result.ScenarioName]++
+		iteration := iterations[result.ScenarioName]
+
+		testCase := JUnitTestCase{
+			Name:      fmt.Sprintf("iteration-%d", iteration),
+			Classname: "chaoskit." + result.ScenarioName + ".iteration",
+			Time:      result.Duration.Seconds(),
+			Properties: &JUnitProperties{Properties: []JUnitProperty{
+				{Name: "scenario", Value: result.ScenarioName},
+				{Name: "iteration", Value: strconv.Itoa(iteration)},
+				{Name: "steps_executed", Value: strconv.Itoa(result.StepsExecuted)},
+				{Name: "timestamp", Value: result.Timestamp.Format(time.RFC3339Nano)},
+				{Name: "injectors", Value: strings.Join(result.Injectors, ",")},
+			}},
+		}
+
+		if result.Error != nil {
+			validatorName := extractValidatorName(result.Error)
+			testCase.Properties.Properties = append(testCase.Properties.Properties,
+				JUnitProperty{Name: "validator", Value: validatorName},
+				JUnitProperty{Name: "failure_class", Value: string(ClassifyFailure(result))})
+			testCase.Failure = &JUnitFailure{
+				Message: result.Error.Error(),
+				Type:    "IterationFailure",
+				Content: result.Error.Error(),
+			}
+			testCase.SystemOut = result.Output
+		}
+
+		testCases = append(testCases, testCase)
+	}
+
+	return testCases
+}
+
+// verdictProperties describes step durations and applied chaos as verdict test case properties
+func verdictProperties(report *Report) *JUnitProperties {
+	if len(report.Steps) == 0 && len(report.Injectors) == 0 {
+		return nil
+	}
+
+	props := &JUnitProperties{}
+	for _, step := range report.Steps {
+		prefix := "step." + step.Name + "."
+		props.Properties = append(props.Properties,
+			JUnitProperty{Name: prefix + "count", Value: strconv.Itoa(step.Count)},
+			JUnitProperty{Name: prefix + "min", Value: step.Min.String()},
+			JUnitProperty{Name: prefix + "avg", Value: step.Avg.String()},
+			JUnitProperty{Name: prefix + "max", Value: step.Max.String()},
+			JUnitProperty{Name: prefix + "p50", Value: step.P50.String()},
+			JUnitProperty{Name: prefix + "p90", Value: step.P90.String()},
+			JUnitProperty{Name: prefix + "p99", Value: step.P99.String()},
+		)
+	}
+	for _, summary := range report.Injectors {
+		prefix := "injector." + summary.Name + "."
+		props.Properties = append(props.Properties, JUnitProperty{
+			Name:  prefix + "injections",
+			Value: strconv.Itoa(summary.Injections),
+		})
+		if summary.TotalDelay > 0 {
+			props.Properties = append(props.Properties, JUnitProperty{
+				Name:  prefix + "total_delay",
+				Value: summary.TotalDelay.String(),
+			})
+		}
+		for _, key := range sortedMetricKeys(summary.Metrics) {
+			props.Properties = append(props.Properties, JUnitProperty{
+				Name:  prefix + key,
+				Value: fmt.Sprint(summary.Metrics[key]),
+			})
+		}
+	}
+
+	return props
+}
+
+func sortedMetricKeys(metrics map[string]any) []string {
+	keys := make([]string, 0, len(metrics))
+	for key := range metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
 func formatFailuresForJUnit(report *Report) string {
 	var content string
 	content += fmt.Sprintf("Verdict: %s\n", report.Verdict)
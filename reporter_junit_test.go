@@ -0,0 +1,170 @@
+package chaoskit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_GenerateJUnitXML_PerValidator(t *testing.T) {
+	reporter := NewReporter()
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		reporter.AddResult(ExecutionResult{
+			ScenarioName: "scenario-a",
+			Success:      false,
+			Error:        fmt.Errorf("validator goroutine_limit_100 failed: exceeded limit"),
+			Timestamp:    now.Add(time.Duration(i) * time.Second),
+			Injectors:    []string{"db-delay", "panic"},
+		})
+	}
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "scenario-b",
+		Success:      false,
+		Error:        fmt.Errorf("validator execution_time_10ms failed: too slow"),
+		Timestamp:    now,
+		Injectors:    []string{"cpu"},
+	})
+	reporter.AddResult(ExecutionResult{
+		ScenarioName: "scenario-b",
+		Success:      true,
+		Timestamp:    now,
+	})
+
+	thresholds := DefaultThresholds()
+	thresholds.WarningValidators = []string{ValidatorExecutionTime}
+	report, err := reporter.GetVerdict(thresholds)
+	require.NoError(t, err)
+
+	xmlStr, err := reporter.GenerateJUnitXML(report)
+	require.NoError(t, err)
+
+	var suite JUnitTestSuite
+	require.NoError(t, xml.Unmarshal([]byte(xmlStr), &suite))
+
+	// verdict + goroutine (scenario-a) + execution time (scenario-b)
+	require.Len(t, suite.TestCases, 3)
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 2, suite.Failures)
+	assert.Equal(t, 1, suite.Errors)
+
+	goroutineCase := suite.TestCases[1]
+	assert.Equal(t, "goroutine_limit_100", goroutineCase.Name)
+	assert.Equal(t, "chaoskit.scenario-a.validator", goroutineCase.Classname)
+	require.NotNil(t, goroutineCase.Failure)
+	assert.Equal(t, "CriticalValidatorFailure", goroutineCase.Failure.Type)
+
+	props := junitProperties(goroutineCase)
+	assert.Equal(t, "3", props["occurrences"])
+	assert.Equal(t, "CRITICAL", props["severity"])
+	assert.Equal(t, "db-delay,panic", props["injectors"])
+	assert.Equal(t, now.Format(time.RFC3339Nano), props["first_seen"])
+	assert.Equal(t, now.Add(2*time.Second).Format(time.RFC3339Nano), props["last_seen"])
+
+	slowCase := suite.TestCases[2]
+	assert.Equal(t, "chaoskit.scenario-b.validator", slowCase.Classname)
+	require.NotNil(t, slowCase.Error)
+	assert.Equal(t, "ValidatorWarning", slowCase.Error.Type)
+}
+
+func TestReporter_GenerateJUnitXML_Bounded(t *testing.T) {
+	reporter := NewReporter(WithBounded(1))
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		reporter.AddResult(ExecutionResult{
+			ScenarioName: "scenario-a",
+			Success:      false,
+			Error:        fmt.Errorf("validator goroutine_limit_100 failed: exceeded limit"),
+			Timestamp:    now.Add(time.Duration(i) * time.Second),
+			Injectors:    []string{fmt.Sprintf("injector-%d", i)},
+		})
+	}
+
+	report, err := reporter.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	require.Len(t, report.CriticalFailures, 1)
+	assert.Equal(t, 2, report.DroppedFailures)
+
+	xmlStr, err := reporter.GenerateJUnitXML(report)
+	require.NoError(t, err)
+	var suite JUnitTestSuite
+	require.NoError(t, xml.Unmarshal([]byte(xmlStr), &suite))
+	require.Len(t, suite.TestCases, 2)
+
+	// Counts and timestamps include dropped failures, injectors the retained one
+	props := junitProperties(suite.TestCases[1])
+	assert.Equal(t, fmt.Sprint(report.CriticalFailures[0].Occurrences), props["occurrences"])
+	assert.Equal(t, "3", props["occurrences"])
+	assert.Equal(t, now.Format(time.RFC3339Nano), props["first_seen"])
+	assert.Equal(t, now.Add(2*time.Second).Format(time.RFC3339Nano), props["last_seen"])
+	assert.Equal(t, "injector-2", props["injectors"])
+}
+
+func TestReporter_GenerateJUnitXML_PerIteration(t *testing.T) {
+	reporter := NewReporter()
+
+	reporter.AddResult(ExecutionResult{
+		ScenarioName:  "test-scenario",
+		Success:       true,
+		Duration:      10 * time.Millisecond,
+		StepsExecuted: 2,
+		Timestamp:     time.Now(),
+	})
+	reporter.AddResult(ExecutionResult{
+		ScenarioName:  "test-scenario",
+		Success:       false,
+		Error:         fmt.Errorf("step process failed: boom"),
+		Duration:      5 * time.Millisecond,
+		StepsExecuted: 1,
+		Timestamp:     time.Now(),
+		Injectors:     []string{"delay"},
+	})
+
+	report, err := reporter.GetVerdict(RelaxedThresholds())
+	require.NoError(t, err)
+
+	withoutIterations, err := reporter.GenerateJUnitXML(report)
+	require.NoError(t, err)
+	assert.NotContains(t, withoutIterations, "iteration-1")
+
+	xmlStr, err := reporter.GenerateJUnitXML(report, WithJUnitIterations())
+	require.NoError(t, err)
+
+	var suite JUnitTestSuite
+	require.NoError(t, xml.Unmarshal([]byte(xmlStr), &suite))
+
+	var iterations []JUnitTestCase
+	for _, testCase := range suite.TestCases {
+		if strings.HasSuffix(testCase.Classname, ".iteration") {
+			iterations = append(iterations, testCase)
+		}
+	}
+	require.Len(t, iterations, 2)
+
+	assert.Equal(t, "iteration-1", iterations[0].Name)
+	assert.Nil(t, iterations[0].Failure)
+	assert.Equal(t, "2", junitProperties(iterations[0])["steps_executed"])
+
+	assert.Equal(t, "iteration-2", iterations[1].Name)
+	require.NotNil(t, iterations[1].Failure)
+	assert.Equal(t, "delay", junitProperties(iterations[1])["injectors"])
+}
+
+func junitProperties(testCase JUnitTestCase) map[string]string {
+	props := make(map[string]string)
+	if testCase.Properties == nil {
+		return props
+	}
+	for _, property := range testCase.Properties.Properties {
+		props[property.Name] = property.Value
+	}
+
+	return props
+}
@@ -165,3 +165,114 @@ func TestReporter_GenerateJUnitXML(t *testing.T) {
 	assert.Contains(t, xmlStr, "<testsuite")
 	assert.Contains(t, xmlStr, "test-scenario")
 }
+
+func TestReporter_GetVerdict_ValidatorLimits(t *testing.T) {
+	newReporter := func(slowFailures int) *Reporter {
+		reporter := NewReporter()
+		for i := 0; i < 20; i++ {
+			reporter.AddResult(ExecutionResult{
+				Success:      true,
+				Timestamp:    time.Now(),
+				ScenarioName: "test-scenario",
+			})
+		}
+		for i := 0; i < slowFailures; i++ {
+			reporter.AddResult(ExecutionResult{
+				Success:      false,
+				Error:        fmt.Errorf("validator slow_iteration_5s failed: iteration too slow"),
+				Timestamp:    time.Now(),
+				ScenarioName: "test-scenario",
+			})
+		}
+
+		return reporter
+	}
+
+	thresholds := DefaultThresholds()
+	thresholds.MaxFailedIterations = 1
+	thresholds.ValidatorLimits = map[string]ValidatorLimit{
+		ValidatorSlowIteration: {Max: 3},
+	}
+
+	// Within the limit: tolerated despite the success rate and failed-iteration budget
+	report, err := newReporter(3).GetVerdict(thresholds)
+	assert.NoError(t, err)
+	assert.Equal(t, VerdictPass, report.Verdict)
+	assert.Equal(t, 3, report.ToleratedFailures)
+	assert.Empty(t, report.CriticalFailures)
+	assert.Len(t, report.InfoMessages, 1)
+
+	// Exceeding the limit is critical
+	report, err = newReporter(4).GetVerdict(thresholds)
+	assert.NoError(t, err)
+	assert.Equal(t, VerdictFail, report.Verdict)
+	assert.Equal(t, 0, report.ToleratedFailures)
+	assert.Len(t, report.CriticalFailures, 1)
+
+	// Negative limits are invalid
+	thresholds.ValidatorLimits[ValidatorSlowIteration] = ValidatorLimit{Max: -1}
+	_, err = newReporter(1).GetVerdict(thresholds)
+	assert.Error(t, err)
+}
+
+func TestReporter_GetVerdict_PerScenario(t *testing.T) {
+	reporter := NewReporter()
+
+	for i := 0; i < 97; i++ {
+		reporter.AddResult(ExecutionResult{
+			Success:      true,
+			Duration:     10 * time.Millisecond,
+			Timestamp:    time.Now(),
+			ScenarioName: "healthy",
+		})
+	}
+	reporter.AddResult(ExecutionResult{
+		Success:      true,
+		Duration:     20 * time.Millisecond,
+		Timestamp:    time.Now(),
+		ScenarioName: "flaky",
+	})
+	for i := 0; i < 2; i++ {
+		reporter.AddResult(ExecutionResult{
+			Success:      false,
+			Error:        fmt.Errorf("timeout"),
+			Duration:     20 * time.Millisecond,
+			Timestamp:    time.Now(),
+			ScenarioName: "flaky",
+		})
+	}
+
+	report, err := reporter.GetVerdict(DefaultThresholds())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "healthy, flaky", report.ScenarioName)
+	assert.Equal(t, 0.98, report.SuccessRate)
+	if assert.Len(t, report.Scenarios, 2) {
+		assert.Equal(t, "healthy", report.Scenarios[0].ScenarioName)
+		assert.Equal(t, VerdictPass, report.Scenarios[0].Verdict)
+		assert.Equal(t, 97, report.Scenarios[0].TotalIterations)
+		assert.Equal(t, "flaky", report.Scenarios[1].ScenarioName)
+		assert.Equal(t, VerdictFail, report.Scenarios[1].Verdict)
+		assert.Equal(t, 2, report.Scenarios[1].FailureCount)
+	}
+
+	// The aggregate success rate is within thresholds, but one scenario fails
+	assert.Equal(t, VerdictFail, report.Verdict)
+	assert.Contains(t, report.Summary, "scenario(s) failed: flaky")
+
+	text := reporter.GenerateTextReport(report)
+	assert.Contains(t, text, "Scenarios:")
+	assert.Contains(t, text, "flaky: FAIL, 1/3 iterations")
+	assert.Contains(t, reporter.GenerateReport(), "Scenario healthy: 97/97 succeeded")
+}
+
+func TestReporter_GetVerdict_SingleScenarioHasNoBreakdown(t *testing.T) {
+	reporter := NewReporter()
+	reporter.AddResult(ExecutionResult{Success: true, Timestamp: time.Now(), ScenarioName: "only"})
+
+	report, err := reporter.GetVerdict(DefaultThresholds())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "only", report.ScenarioName)
+	assert.Empty(t, report.Scenarios)
+}
@@ -0,0 +1,289 @@
+package chaoskit
+
+import (
+	"sort"
+	"time"
+)
+
+// durationPercentiles collects durations for percentile queries
+type durationPercentiles interface {
+	add(d time.Duration)
+	percentile(p float64) time.Duration
+}
+
+// exactDurations keeps every duration for exact nearest-rank percentiles
+type exactDurations struct {
+	durations []time.Duration
+	sorted    bool
+}
+
+func (e *exactDurations) add(d time.Duration) {
+	e.durations = append(e.durations, d)
+	e.sorted = false
+}
+
+func (e *exactDurations) percentile(p float64) time.Duration {
+	if !e.sorted {
+		sort.Slice(e.durations, func(i, j int) bool { return e.durations[i] < e.durations[j] })
+		e.sorted = true
+	}
+
+	return percentile(e.durations, p)
+}
+
+// newDurationPercentiles returns a t-digest when bounded, exact durations otherwise
+func newDurationPercentiles(bounded bool) durationPercentiles {
+	if bounded {
+		return newTDigest()
+	}
+
+	return &exactDurations{}
+}
+
+// validatorFailures counts the failures caused by one validator
+type validatorFailures struct {
+	// occurrences counts results with an error of the validator
+	occurrences int
+	// failed counts those of failed iterations
+	failed int
+	// message is the first error message
+	message   string
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// failureAggregate counts failures by validator, error type, class and pattern
+type failureAggregate struct {
+	validators map[string]*validatorFailures
+	byType     map[string]int
+	byClass    map[FailureClass]int
+	patterns   map[string]int
+}
+
+func newFailureAggregate() failureAggregate {
+	return failureAggregate{
+		validators: make(map[string]*validatorFailures),
+		byType:     make(map[string]int),
+		byClass:    make(map[FailureClass]int),
+		patterns:   make(map[string]int),
+	}
+}
+
+// add counts the error of result, if any
+func (f *failureAggregate) add(result ExecutionResult) {
+	if result.Error == nil {
+		return
+	}
+
+	name := extractValidatorName(result.Error)
+	vf, ok := f.validators[name]
+	if !ok {
+		vf = &validatorFailures{
+			message:   result.Error.Error(),
+			firstSeen: result.Timestamp,
+			lastSeen:  result.Timestamp,
+		}
+		f.validators[name] = vf
+	}
+	vf.occurrences++
+	if !result.Success {
+		vf.failed++
+	}
+	if result.Timestamp.After(vf.lastSeen) {
+		vf.lastSeen = result.Timestamp
+	}
+	if result.Timestamp.Before(vf.firstSeen) {
+		vf.firstSeen = result.Timestamp
+	}
+
+	f.byType[classifyError(result.Error)]++
+	f.byClass[ClassifyFailure(result)]++
+	f.patterns[normalizeError(result.Error)]++
+}
+
+// resultAggregate summarizes execution results
+type resultAggregate struct {
+	total         int
+	success       int
+	failure       int
+	totalDuration time.Duration
+	durations     durationPercentiles
+	failures      failureAggregate
+}
+
+func newResultAggregate(bounded bool) *resultAggregate {
+	return &resultAggregate{
+		durations: newDurationPercentiles(bounded),
+		failures:  newFailureAggregate(),
+	}
+}
+
+// add adds a result to the summary
+func (a *resultAggregate) add(result ExecutionResult) {
+	a.total++
+	if result.Success {
+		a.success++
+	} else {
+		a.failure++
+	}
+	a.totalDuration += result.Duration
+	a.durations.add(result.Duration)
+	a.failures.add(result)
+}
+
+// scenarioAggregate is the summary of the results of one scenario
+type scenarioAggregate struct {
+	name string
+	*resultAggregate
+}
+
+// resultAggregates summarizes results overall and per scenario, in order of first appearance
+type resultAggregates struct {
+	bounded   bool
+	all       *resultAggregate
+	scenarios []scenarioAggregate
+	index     map[string]int
+}
+
+func newResultAggregates(bounded bool) *resultAggregates {
+	return &resultAggregates{
+		bounded: bounded,
+		all:     newResultAggregate(bounded),
+		index:   make(map[string]int),
+	}
+}
+
+// aggregateResults summarizes results exactly
+func aggregateResults(results []ExecutionResult) *resultAggregates {
+	aggregates := newResultAggregates(false)
+	for _, result := range results {
+		aggregates.add(result)
+	}
+
+	return aggregates
+}
+
+func (a *resultAggregates) add(result ExecutionResult) {
+	a.all.add(result)
+
+	i, ok := a.index[result.ScenarioName]
+	if !ok {
+		i = len(a.scenarios)
+		a.index[result.ScenarioName] = i
+		a.scenarios = append(a.scenarios, scenarioAggregate{
+			name:            result.ScenarioName,
+			resultAggregate: newResultAggregate(a.bounded),
+		})
+	}
+	a.scenarios[i].add(result)
+}
+
+// stepAggregate summarizes the durations of one step
+type stepAggregate struct {
+	name      string
+	count     int
+	min       time.Duration
+	max       time.Duration
+	total     time.Duration
+	durations durationPercentiles
+}
+
+// stepAggregates summarizes step durations in order of first execution
+type stepAggregates struct {
+	bounded bool
+	steps   []*stepAggregate
+	index   map[string]int
+}
+
+func newStepAggregates(bounded bool) *stepAggregates {
+	return &stepAggregates{bounded: bounded, index: make(map[string]int)}
+}
+
+func (s *stepAggregates) add(result ExecutionResult) {
+	for _, sd := range result.StepDurations {
+		i, ok := s.index[sd.Step]
+		if !ok {
+			i = len(s.steps)
+			s.index[sd.Step] = i
+			s.steps = append(s.steps, &stepAggregate{
+				name:      sd.Step,
+				min:       sd.Duration,
+				max:       sd.Duration,
+				durations: newDurationPercentiles(s.bounded),
+			})
+		}
+
+		step := s.steps[i]
+		step.count++
+		step.min = min(step.min, sd.Duration)
+		step.max = max(step.max, sd.Duration)
+		step.total += sd.Duration
+		step.durations.add(sd.Duration)
+	}
+}
+
+// stats returns the step statistics, nil without steps
+func (s *stepAggregates) stats() []StepStats {
+	if len(s.steps) == 0 {
+		return nil
+	}
+
+	stats := make([]StepStats, 0, len(s.steps))
+	for _, step := range s.steps {
+		stats = append(stats, StepStats{
+			Name:  step.name,
+			Count: step.count,
+			Min:   step.min,
+			Avg:   step.total / time.Duration(step.count),
+			Max:   step.max,
+			P50:   step.durations.percentile(50),
+			P90:   step.durations.percentile(90),
+			P99:   step.durations.percentile(99),
+		})
+	}
+
+	return stats
+}
+
+// resultRing keeps the last results added, up to its capacity
+type resultRing struct {
+	results []ExecutionResult
+	oldest  int
+}
+
+func newResultRing(capacity int) *resultRing {
+	return &resultRing{results: make([]ExecutionResult, 0, capacity)}
+}
+
+// push adds result, returning the result it replaced once the ring is full
+func (r *resultRing) push(result ExecutionResult) (ExecutionResult, bool) {
+	if len(r.results) < cap(r.results) {
+		r.results = append(r.results, result)
+
+		return ExecutionResult{}, false
+	}
+
+	evicted := r.results[r.oldest]
+	r.results[r.oldest] = result
+	r.oldest = (r.oldest + 1) % len(r.results)
+
+	return evicted, true
+}
+
+// ordered returns the results from the oldest to the newest
+func (r *resultRing) ordered() []ExecutionResult {
+	out := make([]ExecutionResult, 0, len(r.results))
+	out = append(out, r.results[r.oldest:]...)
+
+	return append(out, r.results[:r.oldest]...)
+}
+
+// decodedPercentiles are the p50, p90 and p99 durations of a decoded report
+// (see DecodeReporter); durations added later don't change them
+type decodedPercentiles map[float64]time.Duration
+
+func (d decodedPercentiles) add(time.Duration) {}
+
+func (d decodedPercentiles) percentile(p float64) time.Duration {
+	return d[p]
+}
@@ -31,6 +31,9 @@ type Scenario struct {
 	repeat     int
 	duration   time.Duration
 	seed       *int64 // Optional seed for deterministic randomness (nil = random)
+	points     map[string]*ChaosPointConfig
+
+	injectorLimits map[string]InjectionLimit
 }
 
 // Scope groups injectors logically (e.g., "db", "api", "cache")
@@ -142,6 +145,83 @@ func (b *ScenarioBuilder) Build() *Scenario {
 	return b.scenario
 }
 
+// Name returns the scenario name
+func (s *Scenario) Name() string {
+	return s.name
+}
+
+// InjectorNames returns the names of all injectors, scoped ones included
+func (s *Scenario) InjectorNames() []string {
+	var names []string
+	for _, injector := range s.injectors {
+		names = append(names, injector.Name())
+	}
+	for _, scope := range s.scopes {
+		for _, injector := range scope.injectors {
+			names = append(names, injector.Name())
+		}
+	}
+
+	return names
+}
+
+// ValidatorNames returns the names of the validators in evaluation order
+func (s *Scenario) ValidatorNames() []string {
+	names := make([]string, 0, len(s.validators))
+	for _, validator := range s.validators {
+		names = append(names, validator.Name())
+	}
+
+	return names
+}
+
+// WithoutInjectors returns a copy of the scenario without injectors and
+// chaos point faults, e.g. as the baseline of a chaos comparison.
+// Run it within WithChaosDisabled to turn off ShouldFail as well.
+func (s *Scenario) WithoutInjectors() *Scenario {
+	baseline := *s
+	baseline.injectors = nil
+	baseline.scopes = nil
+	baseline.points = nil
+
+	return &baseline
+}
+
+// Seed returns the seed set with WithSeed, if any
+func (s *Scenario) Seed() (int64, bool) {
+	if s.seed == nil {
+		return 0, false
+	}
+
+	return *s.seed, true
+}
+
+// FilterInjectors returns a copy of the scenario keeping the injectors for
+// which keep returns true. Injectors are indexed in InjectorNames order.
+func (s *Scenario) FilterInjectors(keep func(index int, injector Injector) bool) *Scenario {
+	filtered := *s
+	index := 0
+	filter := func(injectors []Injector) []Injector {
+		var kept []Injector
+		for _, injector := range injectors {
+			if keep(index, injector) {
+				kept = append(kept, injector)
+			}
+			index++
+		}
+
+		return kept
+	}
+
+	filtered.injectors = filter(s.injectors)
+	filtered.scopes = make([]*Scope, 0, len(s.scopes))
+	for _, scope := range s.scopes {
+		filtered.scopes = append(filtered.scopes, &Scope{name: scope.name, injectors: filter(scope.injectors)})
+	}
+
+	return &filtered
+}
+
 // funcStep implements Step interface
 type funcStep struct {
 	name string
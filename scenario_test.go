@@ -0,0 +1,56 @@
+package chaoskit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScenario_Names(t *testing.T) {
+	scenario := NewScenario("names").
+		Inject("plain", &testMetricsInjector{}).
+		Scope("db", func(s *ScopeBuilder) {
+			s.Inject("scoped", &alwaysErrorInjector{})
+		}).
+		Assert("fails", &failingValidator{}).
+		Build()
+
+	assert.Equal(t, "names", scenario.Name())
+	assert.Equal(t, []string{"test-injector", "always-error"}, scenario.InjectorNames())
+	assert.Equal(t, []string{"always-fails"}, scenario.ValidatorNames())
+}
+
+func TestScenario_WithoutInjectors(t *testing.T) {
+	scenario := NewScenario("baseline").
+		Inject("plain", &testMetricsInjector{}).
+		Scope("db", func(s *ScopeBuilder) {
+			s.Inject("scoped", &alwaysErrorInjector{})
+		}).
+		Assert("fails", &failingValidator{}).
+		Build()
+
+	baseline := scenario.WithoutInjectors()
+
+	assert.Empty(t, baseline.InjectorNames())
+	assert.Equal(t, []string{"always-fails"}, baseline.ValidatorNames())
+	assert.Len(t, scenario.InjectorNames(), 2)
+}
+
+func TestScenario_FilterInjectors(t *testing.T) {
+	scenario := NewScenario("filter").
+		WithSeed(42).
+		Inject("plain", &testMetricsInjector{}).
+		Scope("db", func(s *ScopeBuilder) {
+			s.Inject("scoped", &alwaysErrorInjector{})
+			s.Inject("delay", &alwaysDelayInjector{})
+		}).
+		Build()
+
+	filtered := scenario.FilterInjectors(func(index int, injector Injector) bool { return index != 1 })
+
+	assert.Equal(t, []string{"test-injector", "always-delay"}, filtered.InjectorNames())
+	assert.Len(t, scenario.InjectorNames(), 3)
+	seed, ok := filtered.Seed()
+	assert.True(t, ok)
+	assert.Equal(t, int64(42), seed)
+}
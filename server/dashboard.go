@@ -0,0 +1,16 @@
+package server
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+//go:embed dashboard/index.html
+var dashboardHTML []byte
+
+// handleDashboard serves the embedded web UI; it polls the REST API
+// for scenarios, runs, live charts and injector activity
+func (s *Server) handleDashboard(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(dashboardHTML)
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/rom8726/chaoskit/config"
+)
+
+// maxScenarioSize limits the size of a registered scenario document
+const maxScenarioSize = 1 << 20
+
+// errUnauthorized is returned for API requests without the token of the server
+var errUnauthorized = errors.New("missing or invalid token")
+
+// Handler returns the REST API handler
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleDashboard)
+	mux.HandleFunc("GET /api/scenarios", s.handleListScenarios)
+	mux.HandleFunc("POST /api/scenarios", s.handleRegisterScenario)
+	mux.HandleFunc("GET /api/scenarios/{name}", s.handleGetScenario)
+	mux.HandleFunc("DELETE /api/scenarios/{name}", s.handleDeleteScenario)
+	mux.HandleFunc("POST /api/scenarios/{name}/runs", s.handleStartRun)
+	mux.HandleFunc("GET /api/runs", s.handleListRuns)
+	mux.HandleFunc("GET /api/runs/{id}", s.handleGetRun)
+	mux.HandleFunc("POST /api/runs/{id}/stop", s.handleStopRun)
+	mux.HandleFunc("GET /api/runs/{id}/metrics", s.handleMetrics)
+	mux.HandleFunc("GET /api/runs/{id}/report", s.handleReport)
+	if s.token == "" {
+		return mux
+	}
+
+	// The dashboard page holds no data; it sends the token with its API calls
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" && !s.authorized(r.Header.Get("Authorization")) {
+			writeError(w, http.StatusUnauthorized, errUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// authorized reports whether the Authorization header value carries the
+// token of the server
+func (s *Server) authorized(authorization string) bool {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+func (s *Server) handleListScenarios(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.Scenarios())
+}
+
+func (s *Server) handleRegisterScenario(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxScenarioSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	scenario, err := config.Parse(data)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := s.Register(scenario); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, scenario)
+}
+
+func (s *Server) handleGetScenario(w http.ResponseWriter, r *http.Request) {
+	scenario, err := s.Scenario(r.PathValue("name"))
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, scenario)
+}
+
+func (s *Server) handleDeleteScenario(w http.ResponseWriter, r *http.Request) {
+	if err := s.Unregister(r.PathValue("name")); err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleStartRun(w http.ResponseWriter, r *http.Request) {
+	info, err := s.StartRun(r.PathValue("name"))
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, info)
+}
+
+func (s *Server) handleListRuns(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.Runs())
+}
+
+func (s *Server) handleGetRun(w http.ResponseWriter, r *http.Request) {
+	info, err := s.Run(r.PathValue("id"))
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+func (s *Server) handleStopRun(w http.ResponseWriter, r *http.Request) {
+	info, err := s.StopRun(r.PathValue("id"))
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	metrics, err := s.Metrics(r.PathValue("id"))
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, metrics)
+}
+
+// handleReport writes the run report; ?download=1 makes browsers save it as a file
+func (s *Server) handleReport(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	report, reporter, err := s.Report(id)
+	if err != nil {
+		writeError(w, statusFor(err), err)
+		return
+	}
+
+	download := func(ext string) {
+		if r.URL.Query().Get("download") != "" {
+			w.Header().Set("Content-Disposition", `attachment; filename="chaoskit-`+id+"."+ext+`"`)
+		}
+	}
+
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "json":
+		download("json")
+		writeJSON(w, http.StatusOK, report)
+	case "text":
+		download("txt")
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = io.WriteString(w, reporter.GenerateTextReport(report))
+	case "junit":
+		xml, err := reporter.GenerateJUnitXML(report)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		download("xml")
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = io.WriteString(w, xml)
+	default:
+		writeError(w, http.StatusBadRequest, errors.New("unsupported format "+format+" (json, text, junit)"))
+	}
+}
+
+// statusFor maps server errors to HTTP status codes
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, ErrScenarioNotFound), errors.Is(err, ErrRunNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrRunActive), errors.Is(err, ErrNoResults):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// MaxSeriesPoints is the maximum number of points in RunMetrics.Series;
+// iterations are grouped into equally sized windows beyond that
+const MaxSeriesPoints = 100
+
+// RunMetrics is the data behind the dashboard charts of a run
+type RunMetrics struct {
+	// Series holds success rate and latency per window of consecutive iterations
+	Series []SeriesPoint `json:"series"`
+
+	// Injectors summarizes injector activity so far, sorted by name
+	Injectors []InjectorActivity `json:"injectors"`
+}
+
+// SeriesPoint aggregates a window of consecutive iterations
+type SeriesPoint struct {
+	Time        time.Time     `json:"time"`
+	Iterations  int           `json:"iterations"`
+	SuccessRate float64       `json:"success_rate"`
+	AvgDuration time.Duration `json:"avg_duration"`
+	P99Duration time.Duration `json:"p99_duration"`
+}
+
+// InjectorActivity is the activity of one injector
+type InjectorActivity struct {
+	Name string `json:"name"`
+
+	// Injections is the number of recorded injection events
+	// (bounded by chaoskit.MaxTimelineEvents)
+	Injections int `json:"injections"`
+
+	// ActiveIterations is the number of iterations the injector was active in
+	ActiveIterations int `json:"active_iterations"`
+
+	// FailedIterations is the number of failed iterations the injector was active in
+	FailedIterations int `json:"failed_iterations"`
+}
+
+// Metrics returns the chart data of a run (live while the run is active)
+func (s *Server) Metrics(id string) (RunMetrics, error) {
+	r, err := s.run(id)
+	if err != nil {
+		return RunMetrics{}, err
+	}
+
+	reporter := r.executor.Reporter()
+
+	return buildRunMetrics(reporter.Results(), reporter.Timeline()), nil
+}
+
+// buildRunMetrics aggregates results into series windows and injector activity
+func buildRunMetrics(results []chaoskit.ExecutionResult, timeline []chaoskit.InjectionEvent) RunMetrics {
+	metrics := RunMetrics{
+		Series:    []SeriesPoint{},
+		Injectors: []InjectorActivity{},
+	}
+
+	window := (len(results) + MaxSeriesPoints - 1) / MaxSeriesPoints
+	for start := 0; start < len(results); start += window {
+		end := min(start+window, len(results))
+		metrics.Series = append(metrics.Series, seriesPoint(results[start:end]))
+	}
+
+	activity := make(map[string]*InjectorActivity)
+	get := func(name string) *InjectorActivity {
+		a, ok := activity[name]
+		if !ok {
+			a = &InjectorActivity{Name: name}
+			activity[name] = a
+		}
+
+		return a
+	}
+	for _, event := range timeline {
+		get(event.Injector).Injections++
+	}
+	for _, result := range results {
+		for _, name := range result.Injectors {
+			a := get(name)
+			a.ActiveIterations++
+			if !result.Success {
+				a.FailedIterations++
+			}
+		}
+	}
+	for _, a := range activity {
+		metrics.Injectors = append(metrics.Injectors, *a)
+	}
+	sort.Slice(metrics.Injectors, func(i, j int) bool {
+		return metrics.Injectors[i].Name < metrics.Injectors[j].Name
+	})
+
+	return metrics
+}
+
+// seriesPoint aggregates a non-empty window of results
+func seriesPoint(results []chaoskit.ExecutionResult) SeriesPoint {
+	durations := make([]time.Duration, len(results))
+	var total time.Duration
+	var successes int
+	for i, result := range results {
+		durations[i] = result.Duration
+		total += result.Duration
+		if result.Success {
+			successes++
+		}
+	}
+	slices.Sort(durations)
+
+	return SeriesPoint{
+		Time:        results[len(results)-1].Timestamp,
+		Iterations:  len(results),
+		SuccessRate: float64(successes) / float64(len(results)),
+		AvgDuration: total / time.Duration(len(results)),
+		P99Duration: durations[(len(durations)*99-1)/100],
+	}
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/chaoskit"
+)
+
+func TestBuildRunMetrics(t *testing.T) {
+	results := make([]chaoskit.ExecutionResult, 250)
+	for i := range results {
+		results[i] = chaoskit.ExecutionResult{
+			Success:   i%2 == 0,
+			Duration:  time.Duration(i+1) * time.Millisecond,
+			Injectors: []string{"delay"},
+		}
+		if !results[i].Success {
+			results[i].Error = errors.New("boom")
+		}
+	}
+	timeline := []chaoskit.InjectionEvent{{Injector: "delay"}, {Injector: "delay"}, {Injector: "error"}}
+
+	metrics := buildRunMetrics(results, timeline)
+
+	// 250 results in windows of 3
+	require.Len(t, metrics.Series, 84)
+	assert.Equal(t, 3, metrics.Series[0].Iterations)
+	assert.Equal(t, 1, metrics.Series[83].Iterations)
+	assert.Equal(t, 2*time.Millisecond, metrics.Series[0].AvgDuration)
+	assert.Equal(t, 3*time.Millisecond, metrics.Series[0].P99Duration)
+
+	assert.Equal(t, []InjectorActivity{
+		{Name: "delay", Injections: 2, ActiveIterations: 250, FailedIterations: 125},
+		{Name: "error", Injections: 1},
+	}, metrics.Injectors)
+}
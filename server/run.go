@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+// RunInfo is the status of a run
+type RunInfo struct {
+	ID         string     `json:"id"`
+	Scenario   string     `json:"scenario"`
+	State      RunState   `json:"state"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	Progress   Progress   `json:"progress"`
+
+	// Reloads counts scenario updates applied to the running run
+	Reloads int `json:"reloads,omitempty"`
+
+	// Verdict is set once the run has finished
+	Verdict *chaoskit.Verdict `json:"verdict,omitempty"`
+}
+
+// Progress summarizes the results recorded so far
+type Progress struct {
+	Iterations  int           `json:"iterations"`
+	Successes   int           `json:"successes"`
+	Failures    int           `json:"failures"`
+	SuccessRate float64       `json:"success_rate"`
+	AvgDuration time.Duration `json:"avg_duration"`
+}
+
+// run is a single execution of a scenario
+type run struct {
+	id        string
+	scenario  string
+	executor  *chaoskit.Executor
+	cancel    context.CancelFunc
+	startedAt time.Time
+	done      chan struct{}
+
+	mu         sync.Mutex
+	thresholds *chaoskit.SuccessThresholds
+	reloads    int
+	current    RunState
+	stopped    bool
+	finishedAt time.Time
+	err        error
+	verdict    *chaoskit.Verdict
+}
+
+// execute runs the scenario and records the outcome
+func (r *run) execute(ctx context.Context, scenario *chaoskit.Scenario) {
+	defer close(r.done)
+
+	err := r.executor.Run(ctx, scenario)
+
+	var verdict *chaoskit.Verdict
+	if report, verdictErr := r.executor.Reporter().GetVerdict(r.currentThresholds()); verdictErr == nil {
+		verdict = &report.Verdict
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.finishedAt = time.Now()
+	if r.stopped && errors.Is(err, context.Canceled) {
+		err = nil
+	}
+	r.err = err
+	r.verdict = verdict
+	r.current = RunCompleted
+	if r.stopped {
+		r.current = RunStopped
+	}
+}
+
+// reload applies an updated scenario to the run at its next iteration
+// boundary; the thresholds apply to the verdict from now on
+func (r *run) reload(cfg *config.Scenario) error {
+	scenario, err := cfg.Build()
+	if err != nil {
+		return err
+	}
+	r.executor.Reload(scenario)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.thresholds = cfg.SuccessThresholds()
+	r.reloads++
+
+	return nil
+}
+
+func (r *run) currentThresholds() *chaoskit.SuccessThresholds {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.thresholds
+}
+
+// stop cancels the run context
+func (r *run) stop() {
+	r.mu.Lock()
+	if r.current == RunRunning {
+		r.stopped = true
+	}
+	r.mu.Unlock()
+
+	r.cancel()
+}
+
+func (r *run) state() RunState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.current
+}
+
+// info builds the run status with progress from the reporter
+func (r *run) info() RunInfo {
+	r.mu.Lock()
+	info := RunInfo{
+		ID:        r.id,
+		Scenario:  r.scenario,
+		State:     r.current,
+		StartedAt: r.startedAt,
+		Verdict:   r.verdict,
+		Reloads:   r.reloads,
+	}
+	if !r.finishedAt.IsZero() {
+		finishedAt := r.finishedAt
+		info.FinishedAt = &finishedAt
+	}
+	if r.err != nil {
+		info.Error = r.err.Error()
+	}
+	r.mu.Unlock()
+
+	var total time.Duration
+	for _, result := range r.executor.Reporter().Results() {
+		info.Progress.Iterations++
+		if result.Success {
+			info.Progress.Successes++
+		} else {
+			info.Progress.Failures++
+		}
+		total += result.Duration
+	}
+	if info.Progress.Iterations > 0 {
+		info.Progress.SuccessRate = float64(info.Progress.Successes) / float64(info.Progress.Iterations)
+		info.Progress.AvgDuration = total / time.Duration(info.Progress.Iterations)
+	}
+
+	return info
+}
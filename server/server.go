@@ -0,0 +1,322 @@
+// Package server runs declarative chaos scenarios (see package config) behind
+// a REST API, so campaigns can be triggered by CD pipelines or schedulers.
+//
+// Endpoints:
+//
+//	GET    /                              web dashboard
+//	GET    /api/scenarios                 list registered scenarios
+//	POST   /api/scenarios                 register a scenario (YAML or JSON body)
+//	GET    /api/scenarios/{name}          get a scenario
+//	DELETE /api/scenarios/{name}          remove a scenario
+//	POST   /api/scenarios/{name}/runs     start a run
+//	GET    /api/runs                      list runs
+//	GET    /api/runs/{id}                 run status and live progress
+//	POST   /api/runs/{id}/stop            stop a run
+//	GET    /api/runs/{id}/metrics         success rate, latency and injector activity
+//	GET    /api/runs/{id}/report          report (?format=json|text|junit, &download=1)
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+	"github.com/rom8726/chaoskit/config"
+)
+
+// Errors returned by Server methods
+var (
+	ErrScenarioNotFound = errors.New("scenario not found")
+	ErrRunNotFound      = errors.New("run not found")
+	ErrRunActive        = errors.New("scenario already has an active run")
+	ErrNoResults        = errors.New("run has no results yet")
+)
+
+// RunState is the lifecycle state of a run
+type RunState string
+
+// Run states
+const (
+	RunRunning   RunState = "running"
+	RunCompleted RunState = "completed"
+	RunStopped   RunState = "stopped"
+)
+
+// Server manages registered scenarios and their runs
+type Server struct {
+	logger       *slog.Logger
+	executorOpts []chaoskit.ExecutorOption
+	token        string
+
+	mu        sync.Mutex
+	scenarios map[string]*config.Scenario
+	runs      map[string]*run
+	runOrder  []string
+	nextRunID int
+	wg        sync.WaitGroup
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithLogger sets the logger of the server and its runs
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithExecutorOptions adds executor options (exporters, redactor, artifacts)
+// applied to every run
+func WithExecutorOptions(opts ...chaoskit.ExecutorOption) Option {
+	return func(s *Server) {
+		s.executorOpts = append(s.executorOpts, opts...)
+	}
+}
+
+// WithToken makes the REST API reject requests without the bearer token
+// token. The dashboard takes it from its URL fragment: /#token=<token>.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// New creates a server without scenarios
+func New(opts ...Option) *Server {
+	s := &Server{
+		logger:    slog.Default(),
+		scenarios: make(map[string]*config.Scenario),
+		runs:      make(map[string]*run),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Register adds or replaces a scenario. The scenario is validated first.
+// A replaced scenario with an active run is hot reloaded: the run switches
+// to its injectors, validators, chaos points and thresholds at the next
+// iteration, keeping the results recorded so far.
+func (s *Server) Register(scenario *config.Scenario) error {
+	if err := scenario.Validate(); err != nil {
+		return err
+	}
+	if scenario.Target == nil {
+		return fmt.Errorf("scenario %s has no target", scenario.Name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scenarios[scenario.Name] = scenario
+	s.logger.Info("scenario registered", slog.String("scenario", scenario.Name))
+
+	for _, r := range s.runs {
+		if r.scenario != scenario.Name || r.state() != RunRunning {
+			continue
+		}
+		if err := r.reload(scenario); err != nil {
+			return fmt.Errorf("reloading run %s: %w", r.id, err)
+		}
+		s.logger.Info("scenario reloaded", slog.String("run", r.id), slog.String("scenario", scenario.Name))
+	}
+
+	return nil
+}
+
+// Unregister removes a scenario; its runs are kept
+func (s *Server) Unregister(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.scenarios[name]; !ok {
+		return ErrScenarioNotFound
+	}
+	delete(s.scenarios, name)
+
+	return nil
+}
+
+// Scenario returns a registered scenario
+func (s *Server) Scenario(name string) (*config.Scenario, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scenario, ok := s.scenarios[name]
+	if !ok {
+		return nil, ErrScenarioNotFound
+	}
+
+	return scenario, nil
+}
+
+// Scenarios returns registered scenarios sorted by name
+func (s *Server) Scenarios() []*config.Scenario {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]*config.Scenario, 0, len(s.scenarios))
+	for _, scenario := range s.scenarios {
+		out = append(out, scenario)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+
+	return out
+}
+
+// StartRun starts a run of a registered scenario in the background.
+// A scenario runs at most once at a time.
+func (s *Server) StartRun(name string) (RunInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cfg, ok := s.scenarios[name]
+	if !ok {
+		return RunInfo{}, ErrScenarioNotFound
+	}
+	for _, r := range s.runs {
+		if r.scenario == name && r.state() == RunRunning {
+			return RunInfo{}, ErrRunActive
+		}
+	}
+
+	scenario, err := cfg.Build()
+	if err != nil {
+		return RunInfo{}, err
+	}
+
+	s.nextRunID++
+	id := fmt.Sprintf("run-%d", s.nextRunID)
+
+	opts := append([]chaoskit.ExecutorOption{
+		chaoskit.WithSlogLogger(s.logger.With(slog.String("run", id))),
+		chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure),
+	}, s.executorOpts...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &run{
+		id:         id,
+		scenario:   name,
+		thresholds: cfg.SuccessThresholds(),
+		executor:   chaoskit.NewExecutor(opts...),
+		cancel:     cancel,
+		startedAt:  time.Now(),
+		current:    RunRunning,
+		done:       make(chan struct{}),
+	}
+	s.runs[id] = r
+	s.runOrder = append(s.runOrder, id)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		r.execute(ctx, scenario)
+		s.logger.Info("run finished",
+			slog.String("run", id),
+			slog.String("scenario", name),
+			slog.String("state", string(r.state())))
+	}()
+	s.logger.Info("run started", slog.String("run", id), slog.String("scenario", name))
+
+	return r.info(), nil
+}
+
+// StopRun stops a running run; stopping a finished run is a no-op
+func (s *Server) StopRun(id string) (RunInfo, error) {
+	r, err := s.run(id)
+	if err != nil {
+		return RunInfo{}, err
+	}
+
+	r.stop()
+	<-r.done
+
+	return r.info(), nil
+}
+
+// Run returns the status of a run
+func (s *Server) Run(id string) (RunInfo, error) {
+	r, err := s.run(id)
+	if err != nil {
+		return RunInfo{}, err
+	}
+
+	return r.info(), nil
+}
+
+// Runs returns the status of all runs, oldest first
+func (s *Server) Runs() []RunInfo {
+	s.mu.Lock()
+	runs := make([]*run, 0, len(s.runOrder))
+	for _, id := range s.runOrder {
+		runs = append(runs, s.runs[id])
+	}
+	s.mu.Unlock()
+
+	out := make([]RunInfo, 0, len(runs))
+	for _, r := range runs {
+		out = append(out, r.info())
+	}
+
+	return out
+}
+
+// Report calculates the report of a run (partial while the run is active)
+func (s *Server) Report(id string) (*chaoskit.Report, *chaoskit.Reporter, error) {
+	r, err := s.run(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reporter := r.executor.Reporter()
+	if len(reporter.Results()) == 0 {
+		return nil, nil, ErrNoResults
+	}
+	report, err := reporter.GetVerdict(r.currentThresholds())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return report, reporter, nil
+}
+
+// Shutdown stops all runs and waits for them to finish
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	for _, r := range s.runs {
+		r.stop()
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Server) run(id string) (*run, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.runs[id]
+	if !ok {
+		return nil, ErrRunNotFound
+	}
+
+	return r, nil
+}
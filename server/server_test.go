@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/chaoskit"
+)
+
+const shortScenario = `
+name: short
+target: {type: noop}
+injectors:
+  - type: delay
+    params: {min: 1ms, max: 2ms, probability: 1}
+repeat: 5
+`
+
+const longScenario = `
+name: long
+target: {type: noop}
+injectors:
+  - type: delay
+    params: {min: 5ms, max: 10ms, probability: 1}
+duration: 1m
+`
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	s := New(WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))))
+	ts := httptest.NewServer(s.Handler())
+	t.Cleanup(func() {
+		ts.Close()
+		require.NoError(t, s.Shutdown(context.Background()))
+	})
+
+	return ts
+}
+
+func doRequest(t *testing.T, method, url, body string, out any) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	if out != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+	}
+
+	return resp.StatusCode
+}
+
+func TestServer_RunLifecycle(t *testing.T) {
+	ts := newTestServer(t)
+
+	assert.Equal(t, http.StatusCreated, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", shortScenario, nil))
+
+	var info RunInfo
+	require.Equal(t, http.StatusAccepted, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios/short/runs", "", &info))
+	assert.Equal(t, "short", info.Scenario)
+
+	require.Eventually(t, func() bool {
+		doRequest(t, http.MethodGet, ts.URL+"/api/runs/"+info.ID, "", &info)
+
+		return info.State == RunCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 5, info.Progress.Iterations)
+	require.NotNil(t, info.Verdict)
+	assert.Equal(t, chaoskit.VerdictPass, *info.Verdict)
+
+	var report chaoskit.Report
+	require.Equal(t, http.StatusOK, doRequest(t, http.MethodGet, ts.URL+"/api/runs/"+info.ID+"/report", "", &report))
+	assert.Equal(t, 5, report.TotalIterations)
+
+	resp, err := http.Get(ts.URL + "/api/runs/" + info.ID + "/report?format=junit")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "<testsuite")
+
+	var metrics RunMetrics
+	require.Equal(t, http.StatusOK, doRequest(t, http.MethodGet, ts.URL+"/api/runs/"+info.ID+"/metrics", "", &metrics))
+	require.Len(t, metrics.Series, 5)
+	assert.Equal(t, 1.0, metrics.Series[0].SuccessRate)
+	require.Len(t, metrics.Injectors, 1)
+	assert.Equal(t, 5, metrics.Injectors[0].ActiveIterations)
+
+	var runs []RunInfo
+	require.Equal(t, http.StatusOK, doRequest(t, http.MethodGet, ts.URL+"/api/runs", "", &runs))
+	assert.Len(t, runs, 1)
+}
+
+func TestServer_StopRun(t *testing.T) {
+	ts := newTestServer(t)
+
+	require.Equal(t, http.StatusCreated, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", longScenario, nil))
+
+	var info RunInfo
+	require.Equal(t, http.StatusAccepted, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios/long/runs", "", &info))
+	assert.Equal(t, http.StatusConflict, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios/long/runs", "", nil))
+
+	require.Equal(t, http.StatusOK, doRequest(t, http.MethodPost, ts.URL+"/api/runs/"+info.ID+"/stop", "", &info))
+	assert.Equal(t, RunStopped, info.State)
+	assert.Empty(t, info.Error)
+	assert.NotNil(t, info.FinishedAt)
+}
+
+func TestServer_ReloadRunningScenario(t *testing.T) {
+	ts := newTestServer(t)
+
+	require.Equal(t, http.StatusCreated, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", longScenario, nil))
+
+	var info RunInfo
+	require.Equal(t, http.StatusAccepted, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios/long/runs", "", &info))
+
+	reloaded := strings.Replace(longScenario, "probability: 1", "probability: 0.5", 1)
+	require.Equal(t, http.StatusCreated, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", reloaded, nil))
+
+	require.Equal(t, http.StatusOK, doRequest(t, http.MethodGet, ts.URL+"/api/runs/"+info.ID, "", &info))
+	assert.Equal(t, RunRunning, info.State)
+	assert.Equal(t, 1, info.Reloads)
+
+	require.Equal(t, http.StatusOK, doRequest(t, http.MethodPost, ts.URL+"/api/runs/"+info.ID+"/stop", "", &info))
+	assert.Equal(t, RunStopped, info.State)
+}
+
+func TestServer_Errors(t *testing.T) {
+	ts := newTestServer(t)
+
+	assert.Equal(t, http.StatusBadRequest, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", "name: broken\nrepeat: -1\n", nil))
+	assert.Equal(t, http.StatusBadRequest, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", "name: no-target\nrepeat: 1\n", nil))
+	assert.Equal(t, http.StatusNotFound, doRequest(t, http.MethodGet, ts.URL+"/api/scenarios/missing", "", nil))
+	assert.Equal(t, http.StatusNotFound, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios/missing/runs", "", nil))
+	assert.Equal(t, http.StatusNotFound, doRequest(t, http.MethodGet, ts.URL+"/api/runs/run-1", "", nil))
+
+	require.Equal(t, http.StatusCreated, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", shortScenario, nil))
+	assert.Equal(t, http.StatusNoContent, doRequest(t, http.MethodDelete, ts.URL+"/api/scenarios/short", "", nil))
+	assert.Equal(t, http.StatusNotFound, doRequest(t, http.MethodDelete, ts.URL+"/api/scenarios/short", "", nil))
+}
+
+func TestServer_Dashboard(t *testing.T) {
+	ts := newTestServer(t)
+
+	resp, err := http.Get(ts.URL + "/")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/html")
+	assert.Contains(t, string(body), "/api/runs")
+}
+
+func TestServer_Token(t *testing.T) {
+	s := New(WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), WithToken("secret"))
+	ts := httptest.NewServer(s.Handler())
+	defer ts.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, doRequest(t, http.MethodPost, ts.URL+"/api/scenarios", shortScenario, nil))
+	assert.Equal(t, http.StatusUnauthorized, doRequest(t, http.MethodGet, ts.URL+"/api/scenarios", "", nil))
+
+	// The dashboard page is public, its API calls carry the token
+	assert.Equal(t, http.StatusOK, doRequest(t, http.MethodGet, ts.URL+"/", "", nil))
+
+	for authorization, want := range map[string]int{
+		"Bearer wrong":  http.StatusUnauthorized,
+		"secret":        http.StatusUnauthorized,
+		"Bearer secret": http.StatusCreated,
+	} {
+		req, err := http.NewRequest(http.MethodPost, ts.URL+"/api/scenarios", strings.NewReader(shortScenario))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", authorization)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, want, resp.StatusCode, authorization)
+	}
+}
@@ -0,0 +1,119 @@
+package chaoskit
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ChaosDB is a *sql.DB whose queries consult the active injectors: every
+// query, exec, prepare, begin and ping is delayed by the ChaosDelayProvider
+// (see MaybeDelay) and may fail with a *ChaosError of the ChaosErrorProvider
+// (see MaybeError). QueryRow and QueryRowContext are only delayed, as a
+// *sql.Row cannot carry an injected error. Other methods are those of the
+// wrapped *sql.DB: statements of a returned *sql.Tx or *sql.Stmt get no chaos,
+// only beginning or preparing them does.
+type ChaosDB struct {
+	*sql.DB
+	ctx context.Context
+}
+
+// WrapDB returns a chaos-aware handle of db. As with WrapHTTPClient, calls use
+// the chaos of their context and fall back to ctx. The context of
+// Target.Setup carries no chaos: a handle wrapped there only faults when its
+// Context methods are called with the context of a step, while Query, Exec,
+// Begin and the other methods without a context never do. Wrap a handle
+// inside a step to fault them too. pgx pools are wrapped through pgx's
+// database/sql adapter (stdlib.OpenDBFromPool).
+func WrapDB(ctx context.Context, db *sql.DB) *ChaosDB {
+	return &ChaosDB{DB: db, ctx: ctx}
+}
+
+// maybeFail applies the delay and error chaos of a call
+func (db *ChaosDB) maybeFail(ctx context.Context) error {
+	ctx = chaosCallContext(ctx, db.ctx)
+	MaybeDelay(ctx)
+
+	return MaybeError(ctx)
+}
+
+// QueryContext is sql.DB.QueryContext with chaos
+func (db *ChaosDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if err := db.maybeFail(ctx); err != nil {
+		return nil, err
+	}
+
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+// Query is sql.DB.Query with chaos
+func (db *ChaosDB) Query(query string, args ...any) (*sql.Rows, error) {
+	return db.QueryContext(context.Background(), query, args...)
+}
+
+// QueryRowContext is sql.DB.QueryRowContext with delay chaos
+func (db *ChaosDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	MaybeDelay(chaosCallContext(ctx, db.ctx))
+
+	return db.DB.QueryRowContext(ctx, query, args...)
+}
+
+// QueryRow is sql.DB.QueryRow with delay chaos
+func (db *ChaosDB) QueryRow(query string, args ...any) *sql.Row {
+	return db.QueryRowContext(context.Background(), query, args...)
+}
+
+// ExecContext is sql.DB.ExecContext with chaos
+func (db *ChaosDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if err := db.maybeFail(ctx); err != nil {
+		return nil, err
+	}
+
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// Exec is sql.DB.Exec with chaos
+func (db *ChaosDB) Exec(query string, args ...any) (sql.Result, error) {
+	return db.ExecContext(context.Background(), query, args...)
+}
+
+// PrepareContext is sql.DB.PrepareContext with chaos
+func (db *ChaosDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	if err := db.maybeFail(ctx); err != nil {
+		return nil, err
+	}
+
+	return db.DB.PrepareContext(ctx, query)
+}
+
+// Prepare is sql.DB.Prepare with chaos
+func (db *ChaosDB) Prepare(query string) (*sql.Stmt, error) {
+	return db.PrepareContext(context.Background(), query)
+}
+
+// BeginTx is sql.DB.BeginTx with chaos
+func (db *ChaosDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	if err := db.maybeFail(ctx); err != nil {
+		return nil, err
+	}
+
+	return db.DB.BeginTx(ctx, opts)
+}
+
+// Begin is sql.DB.Begin with chaos
+func (db *ChaosDB) Begin() (*sql.Tx, error) {
+	return db.BeginTx(context.Background(), nil)
+}
+
+// PingContext is sql.DB.PingContext with chaos
+func (db *ChaosDB) PingContext(ctx context.Context) error {
+	if err := db.maybeFail(ctx); err != nil {
+		return err
+	}
+
+	return db.DB.PingContext(ctx)
+}
+
+// Ping is sql.DB.Ping with chaos
+func (db *ChaosDB) Ping() error {
+	return db.PingContext(context.Background())
+}
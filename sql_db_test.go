@@ -0,0 +1,50 @@
+package chaoskit
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapDB(t *testing.T) {
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	db := WrapDB(context.Background(), sqlDB)
+	_, err = db.Exec("CREATE TABLE orders (id INTEGER)")
+	require.NoError(t, err, "no chaos outside of scenario runs")
+
+	var execErr, queryErr, disabledErr, plainErr, stepErr error
+	var count int
+	executor := NewExecutor()
+	scenario := NewScenario("sql").
+		WithTarget(&testTarget{}).
+		Inject("errors", &alwaysErrorInjector{}).
+		Inject("delay", &alwaysDelayInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			_, execErr = db.ExecContext(ctx, "INSERT INTO orders VALUES (1)")
+			_, queryErr = db.QueryContext(ctx, "SELECT id FROM orders")
+			_, disabledErr = db.ExecContext(WithChaosDisabled(ctx), "INSERT INTO orders VALUES (2)")
+			// Without a call context, only a handle wrapped in the step gets chaos
+			_, plainErr = db.Exec("INSERT INTO orders VALUES (3)")
+			_, stepErr = WrapDB(ctx, sqlDB).Exec("INSERT INTO orders VALUES (4)")
+
+			return db.QueryRowContext(ctx, "SELECT COUNT(*) FROM orders").Scan(&count)
+		}).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	assert.True(t, IsInjected(execErr))
+	assert.True(t, IsInjected(queryErr))
+	assert.NoError(t, disabledErr)
+	assert.NoError(t, plainErr)
+	assert.True(t, IsInjected(stepErr))
+	assert.Equal(t, 2, count)
+	assert.Equal(t, map[int]map[string]int{1: {"always-error": 3, "always-delay": 4}},
+		countInjections(executor.Reporter()))
+}
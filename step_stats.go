@@ -0,0 +1,49 @@
+package chaoskit
+
+import (
+	"math"
+	"time"
+)
+
+// StepStats summarizes durations of one step across iterations
+type StepStats struct {
+	// Name is the step name
+	Name string `json:"name"`
+
+	// Count is the number of times the step was executed
+	Count int `json:"count"`
+
+	Min time.Duration `json:"min"`
+	Avg time.Duration `json:"avg"`
+	Max time.Duration `json:"max"`
+	P50 time.Duration `json:"p50"`
+	P90 time.Duration `json:"p90"`
+	P99 time.Duration `json:"p99"`
+}
+
+// computeStepStats aggregates step durations in order of first execution
+func computeStepStats(results []ExecutionResult) []StepStats {
+	steps := newStepAggregates(false)
+	for _, result := range results {
+		steps.add(result)
+	}
+
+	return steps.stats()
+}
+
+// percentile returns the nearest-rank percentile p (0-100) of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,44 @@
+package chaoskit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_DurationPercentiles(t *testing.T) {
+	reporter := NewReporter()
+
+	// 1ms..100ms: p50 = 50ms, p90 = 90ms, p99 = 99ms
+	for i := 1; i <= 100; i++ {
+		d := time.Duration(i) * time.Millisecond
+		reporter.AddResult(ExecutionResult{
+			ScenarioName:  "percentiles",
+			Success:       true,
+			Duration:      d,
+			StepDurations: []StepDuration{{Step: "call", Duration: d}},
+		})
+	}
+
+	report, err := reporter.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+
+	assert.Equal(t, 50*time.Millisecond, report.P50Duration)
+	assert.Equal(t, 90*time.Millisecond, report.P90Duration)
+	assert.Equal(t, 99*time.Millisecond, report.P99Duration)
+
+	require.Len(t, report.Steps, 1)
+	assert.Equal(t, time.Millisecond, report.Steps[0].Min)
+	assert.Equal(t, 100*time.Millisecond, report.Steps[0].Max)
+	assert.Equal(t, 90*time.Millisecond, report.Steps[0].P90)
+
+	assert.Contains(t, reporter.GenerateTextReport(report), "P50/P90/P99: 50ms / 90ms / 99ms")
+}
+
+func TestPercentile_Small(t *testing.T) {
+	sorted := []time.Duration{time.Second}
+	assert.Equal(t, time.Second, percentile(sorted, 99))
+	assert.Equal(t, time.Duration(0), percentile(nil, 50))
+}
@@ -0,0 +1,59 @@
+package chaoskit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// VerdictSummary is a minimal machine-readable verdict for CI scripts
+type VerdictSummary struct {
+	Verdict     Verdict `json:"verdict"`
+	Scenario    string  `json:"scenario"`
+	SuccessRate float64 `json:"success_rate"`
+	Iterations  int     `json:"iterations"`
+	Failures    int     `json:"failures"`
+	Critical    int     `json:"critical"`
+	Warnings    int     `json:"warnings"`
+	Regressions int     `json:"regressions,omitempty"`
+	ExitCode    int     `json:"exit_code"`
+}
+
+// Summarize returns the minimal verdict summary of the report.
+// Critical and Warnings count distinct failing validators.
+func (r *Report) Summarize() VerdictSummary {
+	return VerdictSummary{
+		Verdict:     r.Verdict,
+		Scenario:    r.ScenarioName,
+		SuccessRate: r.SuccessRate,
+		Iterations:  r.TotalIterations,
+		Failures:    r.FailureCount,
+		Critical:    len(r.CriticalFailures),
+		Warnings:    len(r.Warnings),
+		Regressions: len(r.Regressions),
+		ExitCode:    r.ExitCode(),
+	}
+}
+
+// SaveSummary writes the summary of the latest verdict (GetVerdict or
+// GetVerdictAgainstBaseline) as a single-line JSON object, e.g.
+//
+//	{"verdict":"FAIL","scenario":"api","success_rate":0.82,"iterations":100,"failures":18,"critical":2,"warnings":0,"exit_code":1}
+//
+// so CI scripts can gate on it with jq: jq -e '.verdict != "FAIL"' summary.json
+func (r *Reporter) SaveSummary(path string) error {
+	r.mu.Lock()
+	report := r.lastReport
+	r.mu.Unlock()
+
+	if report == nil {
+		return fmt.Errorf("no verdict calculated, call GetVerdict first")
+	}
+
+	b, err := json.Marshal(report.Summarize())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
@@ -0,0 +1,60 @@
+package chaoskit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReporter_SaveSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.json")
+	reporter := NewReporter()
+
+	for i := 0; i < 10; i++ {
+		reporter.AddResult(ExecutionResult{
+			ScenarioName: "summary",
+			Success:      i >= 2,
+			Error:        errorIf(i < 2, "validator goroutine_limit_10 failed: leak"),
+			Duration:     time.Millisecond,
+			Timestamp:    time.Now(),
+		})
+	}
+
+	assert.Error(t, reporter.SaveSummary(path), "no verdict yet")
+
+	_, err := reporter.GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	require.NoError(t, reporter.SaveSummary(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"verdict": "FAIL",
+		"scenario": "summary",
+		"success_rate": 0.8,
+		"iterations": 10,
+		"failures": 2,
+		"critical": 1,
+		"warnings": 0,
+		"exit_code": 1
+	}`, string(data))
+}
+
+func TestReport_Summarize(t *testing.T) {
+	report := &Report{
+		Verdict:     VerdictUnstable,
+		SuccessRate: 1,
+		Warnings:    []ValidationFailure{{ValidatorName: "execution-time"}},
+		Regressions: []string{"p99 grew"},
+	}
+
+	summary := report.Summarize()
+	assert.Equal(t, VerdictUnstable, summary.Verdict)
+	assert.Equal(t, 1, summary.Warnings)
+	assert.Equal(t, 1, summary.Regressions)
+	assert.Equal(t, 0, summary.ExitCode)
+}
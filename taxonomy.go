@@ -0,0 +1,133 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// FailureClass tells where a failure came from
+type FailureClass string
+
+// Failure classes
+const (
+	// FailureInjectedFault is a fault injected by chaos (MaybeError, MaybePanic)
+	// which the target did not absorb
+	FailureInjectedFault FailureClass = "injected-fault"
+
+	// FailureTargetError is an error or panic of the target itself
+	FailureTargetError FailureClass = "target-error"
+
+	// FailureValidatorViolation is an invariant violated after the steps completed
+	FailureValidatorViolation FailureClass = "validator-violation"
+
+	// FailureFramework is a problem of the test setup rather than of the target:
+	// target setup/teardown, injector start or network setup, injector step hooks
+	FailureFramework FailureClass = "framework-error"
+)
+
+// failureClasses lists failure classes in report order
+var failureClasses = []FailureClass{
+	FailureTargetError,
+	FailureValidatorViolation,
+	FailureInjectedFault,
+	FailureFramework,
+}
+
+// injectedPanicMessage is the message of panics raised by MaybePanic
+const injectedPanicMessage = "chaos: injected panic"
+
+// ErrInjected is matched by errors.Is for every error produced by chaos injection
+var ErrInjected = errors.New("chaos: injected fault")
+
+// ChaosError is an error produced by chaos injection rather than by the target:
+// errors returned by MaybeError and by functions patched by the monkey patch
+// error and timeout injectors, and panic values raised by MaybePanic.
+// Its message is the message of the injected error. Target code and validators
+// tell injected faults from real bugs with errors.Is(err, ErrInjected) or errors.As.
+type ChaosError struct {
+	// Injector is the name of the injector that produced the error
+	Injector string
+
+	// Kind is the kind of fault (see InjectionType* constants)
+	Kind string
+
+	// Iteration is the 1-based iteration the fault was injected in (0 when unknown)
+	Iteration int
+
+	// FaultID identifies the injected fault (see NewFaultID); the injection
+	// event of the fault carries the same ID
+	FaultID string
+
+	Err error
+}
+
+// InjectedError is the former name of ChaosError.
+//
+// Deprecated: use ChaosError.
+type InjectedError = ChaosError
+
+// NewChaosError wraps err as a fault of kind injected by injector, with a new fault ID.
+// The iteration is taken from ctx when it belongs to a running scenario.
+func NewChaosError(ctx context.Context, injector, kind string, err error) *ChaosError {
+	chaosErr := &ChaosError{Injector: injector, Kind: kind, FaultID: NewFaultID(), Err: err}
+	if ctx != nil {
+		if r, ok := ctx.Value(injectionRecorderKey{}).(*injectionRecorder); ok {
+			chaosErr.Iteration = r.iteration
+		}
+	}
+
+	return chaosErr
+}
+
+func (e *ChaosError) Error() string { return e.Err.Error() }
+
+func (e *ChaosError) Unwrap() error { return e.Err }
+
+// Is makes errors.Is(err, ErrInjected) match
+func (e *ChaosError) Is(target error) bool { return target == ErrInjected }
+
+// IsInjected reports whether err is or wraps a fault injected by chaos
+func IsInjected(err error) bool {
+	return errors.Is(err, ErrInjected)
+}
+
+// FailedValidator returns the name of the validator that failed result, or ""
+// when result did not fail by a validator violation
+func FailedValidator(result ExecutionResult) string {
+	if ClassifyFailure(result) != FailureValidatorViolation || result.Error == nil {
+		return ""
+	}
+
+	return extractValidatorName(result.Error)
+}
+
+// ClassifyFailure returns the failure class of a failed result
+// (empty for successful results). Results produced by the Executor carry
+// their class; for other results it is derived from the error.
+func ClassifyFailure(result ExecutionResult) FailureClass {
+	if result.Success && result.Error == nil {
+		return ""
+	}
+	if result.FailureClass != "" {
+		return result.FailureClass
+	}
+
+	switch {
+	case IsInjected(result.Error):
+		return FailureInjectedFault
+	case result.Error != nil && strings.HasPrefix(result.Error.Error(), "validator "):
+		return FailureValidatorViolation
+	default:
+		return FailureTargetError
+	}
+}
+
+// classifyStepError returns the class of a failed step
+func classifyStepError(err error) FailureClass {
+	if IsInjected(err) {
+		return FailureInjectedFault
+	}
+
+	return FailureTargetError
+}
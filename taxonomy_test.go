@@ -0,0 +1,199 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type alwaysErrorInjector struct{ testMetricsInjector }
+
+func (i *alwaysErrorInjector) Name() string             { return "always-error" }
+func (i *alwaysErrorInjector) ShouldReturnError() error { return errors.New("injected") }
+
+type failingSetupTarget struct{ testTarget }
+
+func (t *failingSetupTarget) Setup(ctx context.Context) error { return errors.New("port in use") }
+
+type failingValidator struct{}
+
+func (v *failingValidator) Name() string { return "always-fails" }
+func (v *failingValidator) Validate(ctx context.Context, target Target) error {
+	return errors.New("broken")
+}
+func (v *failingValidator) Severity() ValidationSeverity { return SeverityCritical }
+
+func TestExecutor_FailureClasses(t *testing.T) {
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+
+	iteration := 0
+	scenario := NewScenario("taxonomy").
+		WithTarget(&testTarget{}).
+		Inject("errors", &alwaysErrorInjector{}).
+		Step("step", func(ctx context.Context, target Target) error {
+			iteration++
+			switch iteration {
+			case 1:
+				return MaybeError(ctx)
+			case 2:
+				return errors.New("target bug")
+			}
+
+			return nil
+		}).
+		Assert("fails", &failingValidator{}).
+		Repeat(3).
+		Build()
+
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	setupFailure := NewScenario("taxonomy").
+		WithTarget(&failingSetupTarget{}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(1).
+		Build()
+	require.Error(t, executor.Run(context.Background(), setupFailure))
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 4)
+	assert.Equal(t, FailureInjectedFault, results[0].FailureClass)
+	assert.True(t, IsInjected(results[0].Error))
+	assert.Equal(t, FailureTargetError, results[1].FailureClass)
+	assert.Equal(t, FailureValidatorViolation, results[2].FailureClass)
+	assert.Equal(t, FailureFramework, results[3].FailureClass)
+	assert.Equal(t, "always-fails", FailedValidator(results[2]))
+	assert.Empty(t, FailedValidator(results[1]))
+
+	report, err := executor.Reporter().GetVerdict(DefaultThresholds())
+	require.NoError(t, err)
+	assert.Equal(t, map[FailureClass]int{
+		FailureInjectedFault:      1,
+		FailureTargetError:        1,
+		FailureValidatorViolation: 1,
+		FailureFramework:          1,
+	}, report.Analysis.ByClass)
+	assert.Contains(t, report.Summary, "1 framework error(s)")
+	assert.Contains(t, executor.Reporter().GenerateTextReport(report), "framework-error: 1")
+}
+
+func TestClassifyFailure(t *testing.T) {
+	assert.Equal(t, FailureClass(""), ClassifyFailure(ExecutionResult{Success: true}))
+	assert.Equal(t, FailureValidatorViolation, ClassifyFailure(ExecutionResult{
+		Error: errors.New("validator goroutine_limit_10 failed: leak"),
+	}))
+	assert.Equal(t, FailureInjectedFault, ClassifyFailure(ExecutionResult{
+		Error: &ChaosError{Err: errors.New("boom")},
+	}))
+	assert.Equal(t, FailureTargetError, ClassifyFailure(ExecutionResult{Error: errors.New("boom")}))
+}
+
+type alwaysPanicInjector struct{ testMetricsInjector }
+
+func (i *alwaysPanicInjector) Name() string                 { return "always-panic" }
+func (i *alwaysPanicInjector) ShouldChaosPanic() bool       { return true }
+func (i *alwaysPanicInjector) GetPanicProbability() float64 { return 1 }
+
+func TestChaosError(t *testing.T) {
+	executor := NewExecutor(WithFailurePolicy(ContinueOnFailure))
+
+	var stepErr error
+	scenario := NewScenario("chaos-error").
+		WithTarget(&testTarget{}).
+		Inject("errors", &alwaysErrorInjector{}).
+		Inject("panics", &alwaysPanicInjector{}).
+		Step("error", func(ctx context.Context, target Target) error {
+			stepErr = fmt.Errorf("charge: %w", MaybeError(ctx))
+
+			return nil
+		}).
+		Step("panic", func(ctx context.Context, target Target) error {
+			MaybePanic(ctx)
+
+			return nil
+		}).
+		Repeat(2).
+		Build()
+	require.Error(t, executor.Run(context.Background(), scenario))
+
+	// Target code tells injected faults from real bugs
+	assert.ErrorIs(t, stepErr, ErrInjected)
+	var chaosErr *ChaosError
+	require.ErrorAs(t, stepErr, &chaosErr)
+	assert.Equal(t, ChaosError{Injector: "always-error", Kind: InjectionTypeError, Iteration: 2, FaultID: chaosErr.FaultID, Err: chaosErr.Err}, *chaosErr)
+	assert.Equal(t, "charge: injected", stepErr.Error())
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 2)
+	require.ErrorAs(t, results[0].Error, &chaosErr)
+	assert.Equal(t, "always-panic", chaosErr.Injector)
+	assert.Equal(t, InjectionTypePanic, chaosErr.Kind)
+	assert.Equal(t, 1, chaosErr.Iteration)
+	assert.Equal(t, FailureInjectedFault, results[0].FailureClass)
+	assert.Contains(t, results[0].Error.Error(), "panic in step panic: chaos: injected panic")
+
+	assert.False(t, errors.Is(errors.New("chaos: injected fault"), ErrInjected))
+}
+
+type runRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *runRecorder) add(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *runRecorder) OnRunStart(ctx context.Context, scenario string) {
+	r.add("start " + scenario + " " + fmt.Sprint(ExecutionID(ctx) != ""))
+}
+
+func (r *runRecorder) OnRunEnd(ctx context.Context, scenario string, err error) {
+	r.add(fmt.Sprintf("end %s %v", scenario, err))
+}
+
+func (r *runRecorder) OnIterationStart(ctx context.Context, scenario string, iteration int) context.Context {
+	r.add("iteration")
+	return ctx
+}
+
+func (r *runRecorder) OnIterationEnd(ctx context.Context, result ExecutionResult) {}
+
+func (r *runRecorder) OnStepStart(ctx context.Context, step string) context.Context { return ctx }
+
+func (r *runRecorder) OnStepEnd(ctx context.Context, step string, err error) {}
+
+func (r *runRecorder) OnInjection(ctx context.Context, event InjectionEvent) {}
+
+func TestExecutor_RunObserver(t *testing.T) {
+	recorder := &runRecorder{}
+	executor := NewExecutor(WithObservers(recorder))
+
+	scenario := NewScenario("observed").
+		WithTarget(&testTarget{}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(1).
+		Build()
+	require.NoError(t, executor.Run(context.Background(), scenario))
+
+	setupFailure := NewScenario("broken").
+		WithTarget(&failingSetupTarget{}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(1).
+		Build()
+	require.Error(t, executor.Run(context.Background(), setupFailure))
+
+	assert.Equal(t, []string{
+		"start observed true",
+		"iteration",
+		"end observed <nil>",
+		"start broken true",
+		"end broken setup failed: port in use",
+	}, recorder.events)
+}
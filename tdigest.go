@@ -0,0 +1,130 @@
+package chaoskit
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// tDigestCompression bounds the number of centroids of a t-digest,
+// trading memory for percentile accuracy
+const tDigestCompression = 100
+
+// centroid is a cluster of values of a t-digest
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// tDigest estimates percentiles of a stream in bounded memory (a merging
+// t-digest): values are buffered, then merged into centroids that are
+// small near the tails and large in the middle, so extreme percentiles
+// like p99 stay accurate
+type tDigest struct {
+	centroids []centroid
+	buffer    []float64
+	count     float64
+	min       float64
+	max       float64
+}
+
+// newTDigest creates an empty t-digest
+func newTDigest() *tDigest {
+	return &tDigest{min: math.Inf(1), max: math.Inf(-1)}
+}
+
+// add adds a duration
+func (d *tDigest) add(value time.Duration) {
+	x := float64(value)
+	d.buffer = append(d.buffer, x)
+	d.count++
+	d.min = math.Min(d.min, x)
+	d.max = math.Max(d.max, x)
+
+	if len(d.buffer) >= 5*tDigestCompression {
+		d.compress()
+	}
+}
+
+// compress merges the buffered values into the centroids
+func (d *tDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+
+	all := make([]centroid, 0, len(d.centroids)+len(d.buffer))
+	all = append(all, d.centroids...)
+	for _, x := range d.buffer {
+		all = append(all, centroid{mean: x, weight: 1})
+	}
+	d.buffer = d.buffer[:0]
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	// A centroid spans at most one unit of the scale function k
+	merged := all[:1]
+	weightSoFar := 0.0
+	weightLimit := d.count * tDigestQuantile(tDigestScale(0)+1)
+	for _, next := range all[1:] {
+		current := &merged[len(merged)-1]
+		if weightSoFar+current.weight+next.weight <= weightLimit {
+			current.mean += (next.mean - current.mean) * next.weight / (current.weight + next.weight)
+			current.weight += next.weight
+
+			continue
+		}
+		weightSoFar += current.weight
+		weightLimit = d.count * tDigestQuantile(tDigestScale(weightSoFar/d.count)+1)
+		merged = append(merged, next)
+	}
+	d.centroids = append(d.centroids[:0], merged...)
+}
+
+// tDigestScale is the scale function k(q) of the t-digest: steep near the
+// tails, so centroids are small there, and bounded by the compression in total
+func tDigestScale(q float64) float64 {
+	return tDigestCompression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// tDigestQuantile is the inverse of tDigestScale
+func tDigestQuantile(k float64) float64 {
+	return (math.Sin(math.Min(k*2*math.Pi/tDigestCompression, math.Pi/2)) + 1) / 2
+}
+
+// percentile estimates the percentile p (0-100), interpolating between centroid centers
+func (d *tDigest) percentile(p float64) time.Duration {
+	d.compress()
+
+	switch {
+	case len(d.centroids) == 0:
+		return 0
+	case p <= 0:
+		return time.Duration(d.min)
+	case p >= 100:
+		return time.Duration(d.max)
+	case len(d.centroids) == 1:
+		return time.Duration(d.centroids[0].mean)
+	}
+
+	target := p / 100 * d.count
+	prevCenter, prevMean := 0.0, d.min
+	cumulative := 0.0
+	for _, c := range d.centroids {
+		center := cumulative + c.weight/2
+		if target < center {
+			return time.Duration(interpolate(target, prevCenter, center, prevMean, c.mean))
+		}
+		prevCenter, prevMean = center, c.mean
+		cumulative += c.weight
+	}
+
+	return time.Duration(interpolate(target, prevCenter, d.count, prevMean, d.max))
+}
+
+// interpolate maps x from [x0, x1] onto [y0, y1] linearly
+func interpolate(x, x0, x1, y0, y1 float64) float64 {
+	if x1 <= x0 {
+		return y1
+	}
+
+	return y0 + (x-x0)/(x1-x0)*(y1-y0)
+}
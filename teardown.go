@@ -0,0 +1,62 @@
+package chaoskit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// Teardown order of a run (see Executor.Run), the reverse of setup:
+//
+//  1. validators implementing Finalizer are finalized
+//  2. injectors are stopped in reverse start order
+//  3. network injectors are torn down in reverse setup order
+//  4. the target is torn down
+//
+// Every step runs even when an earlier one fails or panics, and a panic while
+// starting an injector stops the injectors started before it and the
+// panicking one, so patches applied so far don't outlive the run.
+
+// callSafely calls fn, returning a panic as an error matching ErrPanicRecovered
+func callSafely(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrPanicRecovered, r)
+		}
+	}()
+
+	return fn()
+}
+
+// finalizeValidators finalizes validators in reverse order, recording
+// failures as framework failures
+func (e *Executor) finalizeValidators(ctx context.Context, scenario *Scenario, validators []Validator) {
+	for _, val := range slices.Backward(validators) {
+		finalizer, ok := val.(Finalizer)
+		if !ok {
+			continue
+		}
+		if err := callSafely(func() error { return finalizer.Finalize(ctx) }); err != nil {
+			if e.logger != nil {
+				e.logger.Warn("validator failed to finalize",
+					slog.String("scenario", scenario.name),
+					slog.String("validator", val.Name()),
+					slog.String("error", err.Error()))
+			}
+			e.recordFrameworkFailure(scenario, fmt.Errorf("validator %s finalize failed: %w", val.Name(), err))
+		}
+	}
+}
+
+// teardownTarget tears down the target of scenario, recording a failure as a framework failure
+func (e *Executor) teardownTarget(ctx context.Context, scenario *Scenario) {
+	if err := callSafely(func() error { return scenario.target.Teardown(ctx) }); err != nil {
+		if e.logger != nil {
+			e.logger.Warn("teardown error",
+				slog.String("scenario", scenario.name),
+				slog.String("error", err.Error()))
+		}
+		e.recordFrameworkFailure(scenario, fmt.Errorf("teardown failed: %w", err))
+	}
+}
@@ -0,0 +1,119 @@
+package chaoskit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// teardownLog records setup and teardown calls in order
+type teardownLog struct{ calls []string }
+
+func (l *teardownLog) add(call string) { l.calls = append(l.calls, call) }
+
+type recordingTarget struct{ log *teardownLog }
+
+func (t *recordingTarget) Name() string { return "target" }
+func (t *recordingTarget) Setup(ctx context.Context) error {
+	t.log.add("setup target")
+	return nil
+}
+func (t *recordingTarget) Teardown(ctx context.Context) error {
+	t.log.add("teardown target")
+	return nil
+}
+
+type recordingInjector struct {
+	name        string
+	log         *teardownLog
+	panicInject bool
+}
+
+func (i *recordingInjector) Name() string { return i.name }
+func (i *recordingInjector) Inject(ctx context.Context) error {
+	i.log.add("inject " + i.name)
+	if i.panicInject {
+		panic("inject " + i.name)
+	}
+
+	return nil
+}
+func (i *recordingInjector) Stop(ctx context.Context) error {
+	i.log.add("stop " + i.name)
+	return nil
+}
+
+type recordingNetworkInjector struct{ recordingInjector }
+
+func (i *recordingNetworkInjector) SetupNetwork(ctx context.Context) error {
+	i.log.add("setup network " + i.name)
+	return nil
+}
+func (i *recordingNetworkInjector) TeardownNetwork(ctx context.Context) error {
+	i.log.add("teardown network " + i.name)
+	return nil
+}
+
+type finalizingValidator struct {
+	log *teardownLog
+}
+
+func (v *finalizingValidator) Name() string                                      { return "finalizing" }
+func (v *finalizingValidator) Validate(ctx context.Context, target Target) error { return nil }
+func (v *finalizingValidator) Severity() ValidationSeverity                      { return SeverityWarning }
+func (v *finalizingValidator) Finalize(ctx context.Context) error {
+	v.log.add("finalize validator")
+	return nil
+}
+
+func TestExecutor_TeardownOrder(t *testing.T) {
+	log := &teardownLog{}
+	scenario := NewScenario("teardown").
+		WithTarget(&recordingTarget{log: log}).
+		Inject("net", &recordingNetworkInjector{recordingInjector{name: "net", log: log}}).
+		Inject("a", &recordingInjector{name: "a", log: log}).
+		Inject("b", &recordingInjector{name: "b", log: log}).
+		Assert("finalizing", &finalizingValidator{log: log}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(1).
+		Build()
+
+	require.NoError(t, NewExecutor().Run(context.Background(), scenario))
+	assert.Equal(t, []string{
+		"setup target",
+		"setup network net",
+		"inject net", "inject a", "inject b",
+		"finalize validator",
+		"stop b", "stop a", "stop net",
+		"teardown network net",
+		"teardown target",
+	}, log.calls)
+}
+
+func TestExecutor_InjectPanicCleansUp(t *testing.T) {
+	log := &teardownLog{}
+	scenario := NewScenario("teardown").
+		WithTarget(&recordingTarget{log: log}).
+		Inject("a", &recordingInjector{name: "a", log: log}).
+		Inject("b", &recordingInjector{name: "b", log: log, panicInject: true}).
+		Inject("c", &recordingInjector{name: "c", log: log}).
+		Step("step", func(ctx context.Context, target Target) error { return nil }).
+		Repeat(1).
+		Build()
+
+	executor := NewExecutor()
+	err := executor.Run(context.Background(), scenario)
+	require.ErrorIs(t, err, ErrPanicRecovered)
+	assert.Equal(t, []string{
+		"setup target",
+		"inject a", "inject b",
+		"stop b", "stop a",
+		"teardown target",
+	}, log.calls)
+
+	results := executor.Reporter().Results()
+	require.Len(t, results, 1)
+	assert.Equal(t, FailureFramework, results[0].FailureClass)
+}
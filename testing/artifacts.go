@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// Files written into a chaos test artifact directory, next to the iteration
+// artifacts of chaoskit.WithArtifacts (<dir>/<scenario>/iteration-<n>/)
+const (
+	ArtifactReport    = "report.json"
+	ArtifactJUnit     = "junit.xml"
+	ArtifactDecisions = "decisions.json"
+)
+
+var unsafeArtifactChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// WithArtifacts writes the report, JUnit XML, decision trace and the dumps of
+// failed iterations of the test into a per-test artifact directory: a
+// t.TempDir() (removed with the test), or <root>/<test name>/<scenario> when a
+// root is set with WithArtifactRoot, -chaos.artifacts or CHAOSKIT_ARTIFACTS,
+// e.g. for CI to upload. The directory is logged.
+func WithArtifacts() ChaosTestOption {
+	return func(c *chaosTestConfig) {
+		c.artifacts = true
+	}
+}
+
+// WithArtifactRoot enables WithArtifacts with per-test directories under root
+func WithArtifactRoot(root string) ChaosTestOption {
+	return func(c *chaosTestConfig) {
+		c.artifacts = true
+		c.artifactRoot = root
+	}
+}
+
+// artifactDir returns the artifact directory of a chaos test, "" when artifacts are disabled
+func artifactDir(t TestingT, name string, config *chaosTestConfig, settings *chaosSettings) (string, error) {
+	root := config.artifactRoot
+	if root == "" {
+		root = settings.artifactRoot
+	}
+	if !config.artifacts && root == "" {
+		return "", nil
+	}
+
+	if root == "" {
+		tempDirer, ok := t.(interface{ TempDir() string })
+		if !ok {
+			return "", errors.New("artifacts need a root directory when the test has no TempDir")
+		}
+
+		return tempDirer.TempDir(), nil
+	}
+
+	dir := root
+	if namer, ok := t.(interface{ Name() string }); ok {
+		dir = filepath.Join(dir, unsafeArtifactChars.ReplaceAllString(namer.Name(), "_"))
+	}
+	dir = filepath.Join(dir, unsafeArtifactChars.ReplaceAllString(name, "_"))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// artifactOptions are the executor options capturing artifacts into dir
+func artifactOptions(dir string) []chaoskit.ExecutorOption {
+	if dir == "" {
+		return nil
+	}
+
+	return []chaoskit.ExecutorOption{
+		chaoskit.WithArtifacts(chaoskit.DefaultArtifactConfig(dir)),
+		chaoskit.WithDecisionTrace(),
+	}
+}
+
+// saveArtifacts writes the report, JUnit XML and decision trace of a run into dir
+func saveArtifacts(
+	t TestingT,
+	executor *chaoskit.Executor,
+	report *chaoskit.Report,
+	config *chaosTestConfig,
+	dir string,
+) {
+	t.Helper()
+
+	if dir == "" {
+		return
+	}
+
+	var errs []error
+	if report == nil {
+		var err error
+		if report, err = executor.Reporter().GetVerdict(config.thresholds); err != nil {
+			errs = append(errs, fmt.Errorf("verdict: %w", err))
+		}
+	}
+	if report != nil {
+		errs = append(errs,
+			executor.Reporter().SaveReport(report, filepath.Join(dir, ArtifactReport)),
+			executor.Reporter().SaveJUnitXML(report, filepath.Join(dir, ArtifactJUnit)))
+	}
+	if trace := executor.DecisionTrace(); trace != nil {
+		errs = append(errs, trace.Save(filepath.Join(dir, ArtifactDecisions)))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		t.Errorf("failed to save chaos artifacts to %s: %v", dir, err)
+
+		return
+	}
+	if logger, ok := t.(interface{ Logf(string, ...interface{}) }); ok {
+		logger.Logf("chaos artifacts: %s", dir)
+	}
+}
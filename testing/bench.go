@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"context"
+	"sync/atomic"
+	stdtesting "testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// RunChaosBench benchmarks a scenario without chaos and with it, as the
+// sub-benchmarks "baseline" and "chaos", so benchstat can compare them:
+//
+//	func BenchmarkCheckout(b *testing.B) {
+//	    chaostest.RunChaosBench(b, "checkout", &MyTarget{}, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+//	        return s.
+//	            Step("checkout", checkout).
+//	            Inject("delay", injectors.RandomDelay(time.Millisecond, 5*time.Millisecond))
+//	    })
+//	}
+//
+// Each benchmark op is one scenario iteration; ns/op and allocs/op cover the
+// iterations only (not target setup and teardown). The chaos sub-benchmark
+// also reports injections/op, failures/op and chaos-overhead-% (its ns/op
+// relative to the baseline). builderFn is called for every benchmark run,
+// so injectors must be created inside it. Iteration failures don't fail the
+// benchmark. The chaos test flags of RunChaos apply to the chaos sub-benchmark.
+func RunChaosBench(
+	b *stdtesting.B,
+	name string,
+	target chaoskit.Target,
+	builderFn func(*chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder,
+	opts ...ChaosTestOption,
+) {
+	b.Helper()
+
+	config := &chaosTestConfig{}
+	for _, opt := range append(mainDefaults(), opts...) {
+		opt(config)
+	}
+	settings, err := loadChaosSettings()
+	if err != nil {
+		b.Fatalf("chaos benchmark %q: %v", name, err)
+	}
+	config.executorOpts = append(config.executorOpts, settings.executorOptions()...)
+	build := func(n int) *chaoskit.Scenario {
+		return settings.applyBuilder(builderFn(chaoskit.NewScenario(name).WithTarget(target)).Repeat(n)).Build()
+	}
+
+	var baselineNsPerOp float64
+	b.Run("baseline", func(b *stdtesting.B) {
+		ctx := chaoskit.WithChaosDisabled(context.Background())
+		baselineNsPerOp = runBench(b, ctx, build(b.N).WithoutInjectors(), config).nsPerOp()
+	})
+	b.Run("chaos", func(b *stdtesting.B) {
+		ctx, scenario := settings.applyScenario(context.Background(), build(b.N))
+		timer := runBench(b, ctx, scenario, config)
+
+		b.ReportMetric(float64(timer.injections.Load())/float64(b.N), "injections/op")
+		b.ReportMetric(float64(timer.failures)/float64(b.N), "failures/op")
+		if baselineNsPerOp > 0 {
+			b.ReportMetric((timer.nsPerOp()-baselineNsPerOp)/baselineNsPerOp*100, "chaos-overhead-%")
+		}
+	})
+}
+
+// runBench runs the b.N iterations of scenario with the benchmark timer
+// running during iterations only
+func runBench(
+	b *stdtesting.B,
+	ctx context.Context,
+	scenario *chaoskit.Scenario,
+	config *chaosTestConfig,
+) *benchTimer {
+	b.Helper()
+	b.ReportAllocs()
+	b.StopTimer()
+
+	timer := &benchTimer{b: b}
+	executorOpts := append([]chaoskit.ExecutorOption{
+		chaoskit.WithFailurePolicy(chaoskit.ContinueOnFailure),
+		chaoskit.WithObservers(timer),
+	}, config.executorOpts...)
+	executor := chaoskit.NewExecutor(executorOpts...)
+
+	if err := executor.Run(ctx, scenario); err != nil && len(executor.Reporter().Results()) == 0 {
+		b.Fatalf("chaos benchmark failed: %v", err)
+	}
+
+	return timer
+}
+
+// benchTimer runs the benchmark timer during iterations and counts their
+// failures and injections
+type benchTimer struct {
+	b          *stdtesting.B
+	start      time.Time
+	elapsed    time.Duration
+	failures   int
+	injections atomic.Int64
+}
+
+// nsPerOp returns the average iteration duration in nanoseconds
+func (t *benchTimer) nsPerOp() float64 {
+	return float64(t.elapsed) / float64(t.b.N)
+}
+
+func (t *benchTimer) OnIterationStart(ctx context.Context, scenario string, iteration int) context.Context {
+	t.b.StartTimer()
+	t.start = time.Now()
+
+	return ctx
+}
+
+func (t *benchTimer) OnIterationEnd(ctx context.Context, result chaoskit.ExecutionResult) {
+	t.elapsed += time.Since(t.start)
+	t.b.StopTimer()
+	if !result.Success {
+		t.failures++
+	}
+}
+
+func (t *benchTimer) OnStepStart(ctx context.Context, step string) context.Context { return ctx }
+
+func (t *benchTimer) OnStepEnd(ctx context.Context, step string, err error) {}
+
+func (t *benchTimer) OnInjection(ctx context.Context, event chaoskit.InjectionEvent) {
+	t.injections.Add(1)
+}
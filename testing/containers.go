@@ -0,0 +1,218 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// ContainerStartFunc provisions a dependency, e.g. with testcontainers-go, and
+// returns its host:port address and a function stopping it. A non-nil stop is
+// called even when err is set, so a half-started container is not leaked.
+type ContainerStartFunc func(ctx context.Context) (addr string, stop func(context.Context) error, err error)
+
+// containerDependency is a dependency of a ContainerTarget
+type containerDependency struct {
+	name    string
+	start   ContainerStartFunc
+	proxied bool
+}
+
+// dependencyProxies routes proxied dependencies through a network proxy
+type dependencyProxies interface {
+	// create creates the proxy of a dependency on listenHost and returns its address
+	create(name, listenHost, upstream string) (string, error)
+	// cleanupAll removes the proxies created
+	cleanupAll() error
+}
+
+// ContainerTargetOption configures a ContainerTarget
+type ContainerTargetOption func(*ContainerTarget)
+
+// WithContainer adds a dependency reached directly at its address
+func WithContainer(name string, start ContainerStartFunc) ContainerTargetOption {
+	return func(c *ContainerTarget) {
+		c.deps = append(c.deps, containerDependency{name: name, start: start})
+	}
+}
+
+// WithProxiedContainer adds a dependency reached through a ToxiProxy proxy
+// named after it, so ToxiProxy injectors can degrade its network
+func WithProxiedContainer(name string, start ContainerStartFunc) ContainerTargetOption {
+	return func(c *ContainerTarget) {
+		c.deps = append(c.deps, containerDependency{name: name, start: start, proxied: true})
+	}
+}
+
+// WithContainerSetup runs fn once all dependencies are up, e.g. to start the
+// system under test against ContainerTarget.Addr
+func WithContainerSetup(fn func(ctx context.Context, target *ContainerTarget) error) ContainerTargetOption {
+	return func(c *ContainerTarget) {
+		c.setup = fn
+	}
+}
+
+// ContainerTarget is a chaoskit.Target provisioning dependencies (databases,
+// caches, ToxiProxy) in Setup and tearing them down in Teardown, in reverse
+// order. Steps reach a dependency at Addr(name), which is the address of its
+// ToxiProxy proxy for proxied dependencies:
+//
+//	target := chaostest.NewContainerTarget("orders",
+//	    chaostest.WithToxiproxy("localhost:8474", ""),
+//	    chaostest.WithProxiedContainer("postgres", func(ctx context.Context) (string, func(context.Context) error, error) {
+//	        c, err := postgres.Run(ctx, "postgres:16-alpine")
+//	        if err != nil {
+//	            return "", nil, err
+//	        }
+//	        addr, err := c.Endpoint(ctx, "")
+//	        return addr, func(ctx context.Context) error { return c.Terminate(ctx) }, err
+//	    }),
+//	)
+//	chaostest.RunChaos(t, "orders", target, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+//	    return s.
+//	        Step("query", queryOrders).
+//	        Inject("pg-latency", injectors.ToxiProxyLatency(target.ToxiProxyClient(), "postgres", 50*time.Millisecond, 10*time.Millisecond))
+//	})
+type ContainerTarget struct {
+	name       string
+	deps       []containerDependency
+	listenHost string
+	setup      func(ctx context.Context, target *ContainerTarget) error
+
+	// proxies is set by WithToxiproxy
+	proxies dependencyProxies
+
+	mu    sync.RWMutex
+	addrs map[string]string
+	stops []func(context.Context) error
+}
+
+// NewContainerTarget creates a ContainerTarget
+func NewContainerTarget(name string, opts ...ContainerTargetOption) *ContainerTarget {
+	c := &ContainerTarget{name: name, listenHost: "127.0.0.1"}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.listenHost == "" {
+		c.listenHost = "127.0.0.1"
+	}
+
+	return c
+}
+
+// Name implements chaoskit.Target
+func (c *ContainerTarget) Name() string {
+	return c.name
+}
+
+// Setup starts the dependencies and their proxies, then the setup hook.
+// Whatever was started is torn down again when starting fails.
+func (c *ContainerTarget) Setup(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.start(ctx); err != nil {
+		return errors.Join(err, c.stop(ctx))
+	}
+
+	if c.setup != nil {
+		c.mu.Unlock()
+		err := c.setup(ctx, c)
+		c.mu.Lock()
+		if err != nil {
+			return errors.Join(fmt.Errorf("container target %s setup: %w", c.name, err), c.stop(ctx))
+		}
+	}
+
+	return nil
+}
+
+// start provisions the dependencies; c.mu is held
+func (c *ContainerTarget) start(ctx context.Context) error {
+	c.addrs = make(map[string]string, len(c.deps))
+	if c.proxies != nil {
+		c.stops = append(c.stops, func(context.Context) error {
+			return c.proxies.cleanupAll()
+		})
+	}
+
+	for _, dep := range c.deps {
+		addr, err := c.startDependency(ctx, dep.name, dep.start)
+		if err != nil {
+			return err
+		}
+		if dep.proxied {
+			if addr, err = c.proxy(dep.name, addr); err != nil {
+				return err
+			}
+		}
+		c.addrs[dep.name] = addr
+	}
+
+	return nil
+}
+
+// startDependency starts a dependency and records its stop function
+func (c *ContainerTarget) startDependency(ctx context.Context, name string, start ContainerStartFunc) (string, error) {
+	addr, stop, err := start(ctx)
+	if stop != nil {
+		c.stops = append(c.stops, stop)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to start container %s: %w", name, err)
+	}
+	chaoskit.GetLogger(ctx).Info("container started",
+		slog.String("target", c.name),
+		slog.String("container", name),
+		slog.String("addr", addr))
+
+	return addr, nil
+}
+
+// proxy creates the ToxiProxy proxy of a dependency and returns its listen address
+func (c *ContainerTarget) proxy(name, upstream string) (string, error) {
+	if c.proxies == nil {
+		return "", fmt.Errorf("container %s is proxied but no ToxiProxy is configured", name)
+	}
+
+	return c.proxies.create(name, c.listenHost, upstream)
+}
+
+// Teardown removes the proxies and stops the dependencies in reverse order
+func (c *ContainerTarget) Teardown(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stop(ctx)
+}
+
+// stop stops everything started, last first; c.mu is held
+func (c *ContainerTarget) stop(ctx context.Context) error {
+	var errs []error
+	for _, stop := range slices.Backward(c.stops) {
+		if err := stop(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	c.stops = nil
+	c.addrs = nil
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("container target %s teardown: %w", c.name, err)
+	}
+
+	return nil
+}
+
+// Addr returns the address steps use to reach a dependency, "" when it is not running
+func (c *ContainerTarget) Addr(name string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.addrs[name]
+}
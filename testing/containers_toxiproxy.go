@@ -0,0 +1,54 @@
+//go:build !no_toxiproxy
+
+package testing
+
+import "github.com/rom8726/chaoskit/injectors"
+
+// WithToxiproxy routes proxied dependencies through the ToxiProxy server at apiAddr
+// (e.g. "localhost:8474"); proxies listen on random ports of listenHost (default
+// "127.0.0.1"). A ToxiProxy container must expose these ports, e.g. with host networking.
+func WithToxiproxy(apiAddr, listenHost string) ContainerTargetOption {
+	return func(c *ContainerTarget) {
+		client := injectors.NewToxiProxyClient(apiAddr)
+		c.proxies = &toxiproxyDependencies{client: client, manager: injectors.NewToxiProxyManager(client)}
+		c.listenHost = listenHost
+	}
+}
+
+// ToxiProxyClient returns the client of the ToxiProxy server for ToxiProxy
+// injectors, whose proxy names are the dependency names. It is nil without WithToxiproxy.
+func (c *ContainerTarget) ToxiProxyClient() *injectors.ToxiProxyClient {
+	if proxies, ok := c.proxies.(*toxiproxyDependencies); ok {
+		return proxies.client
+	}
+
+	return nil
+}
+
+// toxiproxyDependencies proxies dependencies with ToxiProxy
+type toxiproxyDependencies struct {
+	client  *injectors.ToxiProxyClient
+	manager *injectors.ToxiProxyManager
+}
+
+func (t *toxiproxyDependencies) create(name, listenHost, upstream string) (string, error) {
+	err := t.manager.CreateProxy(injectors.ProxyConfig{
+		Name:     name,
+		Listen:   listenHost + ":0",
+		Upstream: upstream,
+		Enabled:  true,
+	})
+	if err != nil {
+		return "", err
+	}
+	proxy, err := t.manager.GetProxy(name)
+	if err != nil {
+		return "", err
+	}
+
+	return proxy.Listen, nil
+}
+
+func (t *toxiproxyDependencies) cleanupAll() error {
+	return t.manager.CleanupAll()
+}
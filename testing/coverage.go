@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// WithChaosPointCoverage fails the test when less than minHit (0 to 1) of its
+// chaos points were hit, where the points are the registered ones (see
+// chaoskit.RegisterChaosPoints) and the ones hit. The coverage line is logged
+// for every chaos test with chaos points, like -cover output:
+//
+//	chaos points: 75.0% hit (3/4), 50.0% triggered (2/4); never hit: payment.refund
+func WithChaosPointCoverage(minHit float64) ChaosTestOption {
+	return func(c *chaosTestConfig) {
+		c.minPointCoverage = minHit
+	}
+}
+
+// checkChaosPointCoverage logs the chaos point coverage of a run and enforces its minimum
+func checkChaosPointCoverage(
+	t TestingT,
+	name string,
+	executor *chaoskit.Executor,
+	report *chaoskit.Report,
+	config *chaosTestConfig,
+) {
+	t.Helper()
+
+	if report == nil {
+		if config.minPointCoverage <= 0 {
+			return
+		}
+		var err error
+		if report, err = executor.Reporter().GetVerdict(config.thresholds); err != nil {
+			t.Errorf("chaos test %q: chaos point coverage: %v", name, err)
+
+			return
+		}
+	}
+
+	hit, triggered, total := report.ChaosPointCoverage()
+	if total == 0 {
+		if config.minPointCoverage > 0 {
+			t.Errorf("chaos test %q: no chaos points were registered or hit", name)
+		}
+
+		return
+	}
+
+	if logger, ok := t.(interface{ Logf(string, ...interface{}) }); ok {
+		logger.Logf("%s", formatChaosPointCoverage(report))
+	}
+	if ratio := float64(hit) / float64(total); ratio < config.minPointCoverage {
+		t.Errorf("chaos test %q: %.1f%% of chaos points hit (%d/%d, %d triggered), want at least %.1f%%",
+			name, ratio*100, hit, total, triggered, config.minPointCoverage*100)
+	}
+}
+
+// formatChaosPointCoverage renders the chaos point coverage line of a report
+func formatChaosPointCoverage(report *chaoskit.Report) string {
+	hit, triggered, total := report.ChaosPointCoverage()
+
+	line := fmt.Sprintf("chaos points: %.1f%% hit (%d/%d), %.1f%% triggered (%d/%d)",
+		percent(hit, total), hit, total, percent(triggered, total), triggered, total)
+
+	var neverHit []string
+	for _, point := range report.ChaosPoints {
+		if point.Hits == 0 {
+			neverHit = append(neverHit, point.Name)
+		}
+	}
+	if len(neverHit) > 0 {
+		line += "; never hit: " + strings.Join(neverHit, ", ")
+	}
+
+	return line
+}
+
+// percent returns n of total in percent
+func percent(n, total int) float64 {
+	return float64(n) / float64(total) * 100
+}
@@ -0,0 +1,127 @@
+package testing
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// Environment variables equivalent to the chaos test flags; flags take precedence
+const (
+	EnvSeed      = "CHAOSKIT_SEED"
+	EnvOff       = "CHAOSKIT_OFF"
+	EnvIntensity = "CHAOSKIT_INTENSITY"
+	EnvArtifacts = "CHAOSKIT_ARTIFACTS"
+)
+
+// Chaos test flags, registered in every test binary using this package
+var (
+	flagSeed      = flag.String("chaos.seed", "", "fixed scenario seed of chaos tests, to reproduce a run (env "+EnvSeed+")")
+	flagOff       = flag.Bool("chaos.off", false, "disable all chaos injectors, e.g. to quarantine chaos tests (env "+EnvOff+")")
+	flagIntensity = flag.String("chaos.intensity", "", "multiply injector fault probabilities by this factor (env "+EnvIntensity+")")
+	flagArtifacts = flag.String("chaos.artifacts", "", "write chaos test artifacts into per-test directories under this root (env "+EnvArtifacts+")")
+)
+
+// chaosSettings are the chaos test flags and environment variables honored
+// by RunChaos, RunMatrix, FuzzChaos and RunChaosBench
+type chaosSettings struct {
+	seed         *int64
+	off          bool
+	intensity    float64
+	artifactRoot string
+}
+
+// loadChaosSettings reads the chaos test flags, falling back to the environment
+func loadChaosSettings() (*chaosSettings, error) {
+	settings := &chaosSettings{intensity: 1}
+
+	if value := settingValue(*flagSeed, EnvSeed); value != "" {
+		seed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chaos seed %q: %w", value, err)
+		}
+		settings.seed = &seed
+	}
+	if *flagOff {
+		settings.off = true
+	} else if value := os.Getenv(EnvOff); value != "" {
+		off, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chaos off switch %q: %w", value, err)
+		}
+		settings.off = off
+	}
+	if value := settingValue(*flagIntensity, EnvIntensity); value != "" {
+		intensity, err := strconv.ParseFloat(value, 64)
+		if err != nil || intensity < 0 {
+			return nil, fmt.Errorf("invalid chaos intensity %q: must be a non-negative number", value)
+		}
+		settings.intensity = intensity
+	}
+
+	settings.artifactRoot = settingValue(*flagArtifacts, EnvArtifacts)
+
+	return settings, nil
+}
+
+// settingValue returns the flag value, or the environment variable when the flag is empty
+func settingValue(flagValue, env string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+
+	return os.Getenv(env)
+}
+
+// applyBuilder fixes the scenario seed
+func (s *chaosSettings) applyBuilder(builder *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+	if s.seed != nil {
+		builder = builder.WithSeed(*s.seed)
+	}
+
+	return builder
+}
+
+// applyScenario removes the chaos of the scenario when chaos is off
+func (s *chaosSettings) applyScenario(
+	ctx context.Context,
+	scenario *chaoskit.Scenario,
+) (context.Context, *chaoskit.Scenario) {
+	if !s.off {
+		return ctx, scenario
+	}
+
+	return chaoskit.WithChaosDisabled(ctx), scenario.WithoutInjectors()
+}
+
+// executorOptions scales the injectors by the intensity
+func (s *chaosSettings) executorOptions() []chaoskit.ExecutorOption {
+	if s.intensity == 1 {
+		return nil
+	}
+
+	return []chaoskit.ExecutorOption{chaoskit.WithIntensity(s.intensity)}
+}
+
+// String describes the non-default settings for test logs
+func (s *chaosSettings) String() string {
+	var desc string
+	if s.seed != nil {
+		desc += fmt.Sprintf(" seed=%d", *s.seed)
+	}
+	if s.off {
+		desc += " off"
+	}
+	if s.intensity != 1 {
+		desc += fmt.Sprintf(" intensity=%g", s.intensity)
+	}
+	if s.artifactRoot != "" {
+		desc += " artifacts=" + s.artifactRoot
+	}
+
+	return desc
+}
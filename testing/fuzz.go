@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"encoding/binary"
+	stdtesting "testing"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// ChaosParams derives injector parameters from a fuzz input. Every call
+// consumes the next bytes of the input; an exhausted input yields zero
+// values (no probability, minimum durations), so inputs minimized by
+// go test -fuzz describe the least chaos that still fails.
+type ChaosParams struct {
+	Seed int64
+
+	data []byte
+}
+
+// NewChaosParams returns the parameters of a fuzz input
+func NewChaosParams(seed int64, data []byte) *ChaosParams {
+	return &ChaosParams{Seed: seed, data: data}
+}
+
+// Uint64 returns the next 8 bytes of the input as an integer
+func (p *ChaosParams) Uint64() uint64 {
+	return p.next(8)
+}
+
+// Intn returns an integer in [0, n)
+func (p *ChaosParams) Intn(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	return int(p.next(4) % uint64(n))
+}
+
+// Bool returns the lowest bit of the next input byte
+func (p *ChaosParams) Bool() bool {
+	return p.next(1)&1 == 1
+}
+
+// Float64 returns a number in [0, 1)
+func (p *ChaosParams) Float64() float64 {
+	return float64(p.next(2)) / (1 << 16)
+}
+
+// Probability returns a probability in [0, maxP)
+func (p *ChaosParams) Probability(maxP float64) float64 {
+	return p.Float64() * maxP
+}
+
+// Duration returns a duration in [minD, maxD]
+func (p *ChaosParams) Duration(minD, maxD time.Duration) time.Duration {
+	if maxD <= minD {
+		return minD
+	}
+
+	return minD + time.Duration(p.Uint64()%uint64(maxD-minD+1))
+}
+
+// next consumes up to n bytes as a big-endian integer, padding an exhausted input with zeros
+func (p *ChaosParams) next(n int) uint64 {
+	var buf [8]byte
+	consumed := copy(buf[8-n:], p.data)
+	p.data = p.data[consumed:]
+
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// FuzzChaos runs a chaos scenario for every fuzz input. The input is a
+// scenario seed and bytes from which builderFn derives injector parameters
+// with ChaosParams, so go test -fuzz explores the chaos parameter space and
+// minimizes failing inputs:
+//
+//	func FuzzCheckout(f *testing.F) {
+//	    chaostest.FuzzChaos(f, "checkout", &MyTarget{}, func(s *chaoskit.ScenarioBuilder, p *chaostest.ChaosParams) *chaoskit.ScenarioBuilder {
+//	        return s.
+//	            Step("checkout", checkout).
+//	            Inject("delay", injectors.RandomDelayWithProbability(
+//	                time.Millisecond, p.Duration(time.Millisecond, 50*time.Millisecond), p.Probability(1)))
+//	    }, chaostest.WithRepeat(5))
+//	}
+//
+// The corpus starts with seed 1 and empty bytes; call f.Add(int64, []byte)
+// beforehand for more entries. Options are those of RunChaos.
+func FuzzChaos(
+	f *stdtesting.F,
+	name string,
+	target chaoskit.Target,
+	builderFn func(*chaoskit.ScenarioBuilder, *ChaosParams) *chaoskit.ScenarioBuilder,
+	opts ...ChaosTestOption,
+) {
+	f.Helper()
+
+	f.Add(int64(1), []byte{})
+	f.Fuzz(func(t *stdtesting.T, seed int64, data []byte) {
+		params := NewChaosParams(seed, data)
+
+		RunChaos(t, name, target, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+			return builderFn(s.WithSeed(seed), params)
+		}, opts...)
+	})
+}
@@ -0,0 +1,239 @@
+package testing
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// updateGolden rewrites golden reports instead of comparing against them.
+// A boolean -update flag of the test binary, common in golden file tests, works too.
+var updateGolden = flag.Bool("chaos.update", false, "rewrite chaoskit golden report files")
+
+// ReportTolerances defines how far a report may deviate from its golden file
+type ReportTolerances struct {
+	// SuccessRate is the maximum absolute success rate difference
+	// Example: 0.05 = 5 percentage points either way
+	SuccessRate float64
+
+	// FailureCount is the maximum difference in failed iterations
+	FailureCount int
+
+	// Injections is the maximum relative difference of per-injector injection counts
+	// Example: 0.25 = ±25%
+	Injections float64
+
+	// Duration is the maximum relative difference of the p50 and p99 iteration
+	// durations. 0 skips durations, which rarely reproduce across machines.
+	Duration float64
+}
+
+// goldenReport holds the key report fields locked in by AssertReportMatches
+type goldenReport struct {
+	Verdict          chaoskit.Verdict `json:"verdict"`
+	TotalIterations  int              `json:"total_iterations"`
+	FailureCount     int              `json:"failure_count"`
+	SuccessRate      float64          `json:"success_rate"`
+	P50Duration      time.Duration    `json:"p50_duration"`
+	P99Duration      time.Duration    `json:"p99_duration"`
+	Injections       map[string]int   `json:"injections,omitempty"`
+	CriticalFailures []string         `json:"critical_failures,omitempty"`
+}
+
+// newGoldenReport extracts the key fields of report
+func newGoldenReport(report *chaoskit.Report) goldenReport {
+	golden := goldenReport{
+		Verdict:         report.Verdict,
+		TotalIterations: report.TotalIterations,
+		FailureCount:    report.FailureCount,
+		SuccessRate:     report.SuccessRate,
+		P50Duration:     report.P50Duration,
+		P99Duration:     report.P99Duration,
+	}
+	for _, injector := range report.Injectors {
+		if golden.Injections == nil {
+			golden.Injections = make(map[string]int)
+		}
+		golden.Injections[injector.Name] = injector.Injections
+	}
+	for _, failure := range report.CriticalFailures {
+		golden.CriticalFailures = append(golden.CriticalFailures, failure.ValidatorName)
+	}
+	slices.Sort(golden.CriticalFailures)
+
+	return golden
+}
+
+// AssertReportMatches compares the key fields of a report (verdict, iterations,
+// failures, success rate, p50/p99 durations, injections per injector and
+// failed critical validators) to a golden JSON file within tolerances, locking
+// resilience characteristics in as a test:
+//
+//	report, _ := executor.Reporter().GetVerdict(chaoskit.DefaultThresholds())
+//	chaostest.AssertReportMatches(t, report, "testdata/checkout.golden.json",
+//	    chaostest.ReportTolerances{SuccessRate: 0.05, FailureCount: 2, Injections: 0.25})
+//
+// Run the test with -chaos.update (or the -update flag of the test binary,
+// if it defines one) to write the golden file from the current report.
+// Use a fixed scenario seed, so runs are comparable.
+func AssertReportMatches(t TestingT, report *chaoskit.Report, goldenPath string, tolerances ReportTolerances) {
+	t.Helper()
+
+	if report == nil {
+		t.Errorf("golden report %s: report is nil", goldenPath)
+		t.FailNow()
+		return
+	}
+	current := newGoldenReport(report)
+
+	if shouldUpdateGolden() {
+		if err := writeGoldenReport(goldenPath, current); err != nil {
+			t.Errorf("golden report %s: %v", goldenPath, err)
+			t.FailNow()
+			return
+		}
+		if logger, ok := t.(interface{ Logf(string, ...interface{}) }); ok {
+			logger.Logf("updated golden report %s", goldenPath)
+		}
+
+		return
+	}
+
+	data, err := os.ReadFile(goldenPath)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Errorf("golden report %s does not exist; run the test with -chaos.update to create it", goldenPath)
+		t.FailNow()
+		return
+	}
+	if err != nil {
+		t.Errorf("golden report %s: %v", goldenPath, err)
+		t.FailNow()
+		return
+	}
+	var golden goldenReport
+	if err := json.Unmarshal(data, &golden); err != nil {
+		t.Errorf("golden report %s: invalid JSON: %v", goldenPath, err)
+		t.FailNow()
+		return
+	}
+
+	for _, mismatch := range goldenMismatches(golden, current, tolerances) {
+		t.Errorf("golden report %s: %s", goldenPath, mismatch)
+	}
+}
+
+// shouldUpdateGolden reports whether -chaos.update or a boolean -update flag is set
+func shouldUpdateGolden() bool {
+	if *updateGolden {
+		return true
+	}
+	if f := flag.Lookup("update"); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			update, _ := getter.Get().(bool)
+
+			return update
+		}
+	}
+
+	return false
+}
+
+// writeGoldenReport writes golden as indented JSON, creating its directory
+func writeGoldenReport(path string, golden goldenReport) error {
+	data, err := json.MarshalIndent(golden, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// goldenMismatches lists the differences of current from golden beyond tolerances
+func goldenMismatches(golden, current goldenReport, tolerances ReportTolerances) []string {
+	var mismatches []string
+
+	if current.Verdict != golden.Verdict {
+		mismatches = append(mismatches, fmt.Sprintf("verdict is %s, golden %s", current.Verdict, golden.Verdict))
+	}
+	if current.TotalIterations != golden.TotalIterations {
+		mismatches = append(mismatches, fmt.Sprintf("total iterations are %d, golden %d",
+			current.TotalIterations, golden.TotalIterations))
+	}
+	if diff := current.FailureCount - golden.FailureCount; abs(diff) > tolerances.FailureCount {
+		mismatches = append(mismatches, fmt.Sprintf("failure count is %d, golden %d (tolerance ±%d)",
+			current.FailureCount, golden.FailureCount, tolerances.FailureCount))
+	}
+	if diff := math.Abs(current.SuccessRate - golden.SuccessRate); diff > tolerances.SuccessRate+1e-9 {
+		mismatches = append(mismatches, fmt.Sprintf("success rate is %.2f%%, golden %.2f%% (tolerance ±%.2f%%)",
+			current.SuccessRate*100, golden.SuccessRate*100, tolerances.SuccessRate*100))
+	}
+
+	if tolerances.Duration > 0 {
+		for _, d := range []struct {
+			name            string
+			current, golden time.Duration
+		}{
+			{"p50 duration", current.P50Duration, golden.P50Duration},
+			{"p99 duration", current.P99Duration, golden.P99Duration},
+		} {
+			if !withinRatio(float64(d.current), float64(d.golden), tolerances.Duration) {
+				mismatches = append(mismatches, fmt.Sprintf("%s is %s, golden %s (tolerance ±%.0f%%)",
+					d.name, d.current, d.golden, tolerances.Duration*100))
+			}
+		}
+	}
+
+	for _, name := range sortedUnion(golden.Injections, current.Injections) {
+		if !withinRatio(float64(current.Injections[name]), float64(golden.Injections[name]), tolerances.Injections) {
+			mismatches = append(mismatches, fmt.Sprintf("injector %s made %d injections, golden %d (tolerance ±%.0f%%)",
+				name, current.Injections[name], golden.Injections[name], tolerances.Injections*100))
+		}
+	}
+
+	if !slices.Equal(current.CriticalFailures, golden.CriticalFailures) {
+		mismatches = append(mismatches, fmt.Sprintf("failed critical validators are %v, golden %v",
+			current.CriticalFailures, golden.CriticalFailures))
+	}
+
+	return mismatches
+}
+
+// withinRatio reports whether current is within ±ratio of golden
+func withinRatio(current, golden, ratio float64) bool {
+	return math.Abs(current-golden) <= math.Abs(golden)*ratio+1e-9
+}
+
+// sortedUnion returns the sorted keys of both maps
+func sortedUnion(a, b map[string]int) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,173 @@
+package testing
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	stdtesting "testing"
+	"text/tabwriter"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// ArtifactSummary is the file Main writes the verdicts of all chaos tests to,
+// in the artifact root
+const ArtifactSummary = "summary.json"
+
+// mainState holds the defaults set by Main and the verdicts it collects
+var mainState struct {
+	mu       sync.Mutex
+	active   bool
+	defaults []ChaosTestOption
+	verdicts []MainVerdict
+}
+
+// MainVerdict is the verdict of one chaos test collected by Main
+type MainVerdict struct {
+	Test string `json:"test"`
+	chaoskit.VerdictSummary
+}
+
+// Main runs the tests of a package with shared chaos configuration:
+//
+//	func TestMain(m *testing.M) {
+//	    chaostest.Main(m,
+//	        chaostest.WithArtifactRoot("chaos-artifacts"),
+//	        chaostest.WithExecutorOptions(chaoskit.WithExporters(exporter)),
+//	    )
+//	}
+//
+// opts are applied to every RunChaos, RunMatrix, FuzzChaos and RunChaosBench
+// call before the options of the call. Main validates the chaos test flags
+// and environment variables, runs the tests, then logs the verdicts of all
+// chaos tests and writes them to <artifact root>/summary.json when an
+// artifact root is set. It exits with the exit code of the tests or, when
+// they passed, the highest exit code of the chaos verdicts, so e.g.
+// thresholds with chaoskit.StrictExitCodes fail CI on UNSTABLE verdicts.
+// Verdicts of RunChaos calls with a TestingT other than *testing.T are not collected.
+func Main(m *stdtesting.M, opts ...ChaosTestOption) {
+	os.Exit(runMain(m, opts))
+}
+
+// runMain implements Main and returns the exit code
+func runMain(m *stdtesting.M, opts []ChaosTestOption) int {
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+	settings, err := loadChaosSettings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "chaos tests: %v\n", err)
+
+		return 2
+	}
+	if desc := settings.String(); desc != "" {
+		fmt.Printf("chaos settings:%s\n", desc)
+	}
+
+	mainState.mu.Lock()
+	mainState.active = true
+	mainState.defaults = opts
+	mainState.verdicts = nil
+	mainState.mu.Unlock()
+	defer func() {
+		mainState.mu.Lock()
+		mainState.active = false
+		mainState.defaults = nil
+		mainState.mu.Unlock()
+	}()
+
+	code := m.Run()
+
+	mainState.mu.Lock()
+	verdicts := mainState.verdicts
+	mainState.mu.Unlock()
+	if len(verdicts) == 0 {
+		return code
+	}
+
+	fmt.Printf("\n%s", formatMainSummary(verdicts))
+
+	// The artifact root of the defaults, or of -chaos.artifacts
+	root := newChaosTestConfig(nil).artifactRoot
+	if root == "" {
+		root = settings.artifactRoot
+	}
+	if root != "" {
+		if err := saveMainSummary(filepath.Join(root, ArtifactSummary), verdicts); err != nil {
+			fmt.Fprintf(os.Stderr, "chaos tests: failed to save summary: %v\n", err)
+			if code == 0 {
+				code = 1
+			}
+		}
+	}
+
+	if code == 0 {
+		for _, verdict := range verdicts {
+			code = max(code, verdict.ExitCode)
+		}
+	}
+
+	return code
+}
+
+// mainDefaults returns the chaos test options set by Main
+func mainDefaults() []ChaosTestOption {
+	mainState.mu.Lock()
+	defer mainState.mu.Unlock()
+
+	return mainState.defaults
+}
+
+// recordMainVerdict collects the verdict of a chaos test when running under Main
+func recordMainVerdict(t TestingT, report *chaoskit.Report) {
+	test, ok := t.(*stdtesting.T)
+	if !ok || report == nil {
+		return
+	}
+
+	mainState.mu.Lock()
+	defer mainState.mu.Unlock()
+
+	if mainState.active {
+		mainState.verdicts = append(mainState.verdicts, MainVerdict{Test: test.Name(), VerdictSummary: report.Summarize()})
+	}
+}
+
+// saveMainSummary writes the collected verdicts as indented JSON
+func saveMainSummary(path string, verdicts []MainVerdict) error {
+	data, err := json.MarshalIndent(verdicts, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// formatMainSummary renders the collected verdicts as a table
+func formatMainSummary(verdicts []MainVerdict) string {
+	counts := make(map[chaoskit.Verdict]int)
+	for _, verdict := range verdicts {
+		counts[verdict.Verdict]++
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Chaos tests: %d passed, %d unstable, %d failed\n",
+		counts[chaoskit.VerdictPass], counts[chaoskit.VerdictUnstable], counts[chaoskit.VerdictFail])
+
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TEST\tSCENARIO\tVERDICT\tSUCCESS\tITERATIONS")
+	for _, verdict := range verdicts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.1f%%\t%d\n",
+			verdict.Test, verdict.Scenario, verdict.Verdict, verdict.SuccessRate*100, verdict.Iterations)
+	}
+	_ = w.Flush()
+
+	return sb.String()
+}
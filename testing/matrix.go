@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	stdtesting "testing"
+	"text/tabwriter"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// ChaosMatrix describes the chaos tests of RunMatrix: every fault set runs
+// at every intensity with every seed
+type ChaosMatrix struct {
+	// Name prefixes the scenario names (default "matrix")
+	Name string
+
+	// Scenario adds the steps and validators shared by all cells
+	Scenario func(*chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder
+
+	// Faults are the injector sets to combine
+	Faults []MatrixFaults
+
+	// Intensities are passed to the injector sets, e.g. as probabilities (default 1)
+	Intensities []float64
+
+	// Seeds are the scenario seeds (default 1)
+	Seeds []int64
+}
+
+// MatrixFaults is a named injector set of a ChaosMatrix
+type MatrixFaults struct {
+	Name string
+
+	// Injectors returns new injectors for a cell at the given intensity
+	Injectors func(intensity float64) []chaoskit.Injector
+}
+
+// MatrixResult is the outcome of one ChaosMatrix cell
+type MatrixResult struct {
+	Faults    string
+	Intensity float64
+	Seed      int64
+	Verdict   chaoskit.Verdict
+
+	// SuccessRate and Iterations are 0 when the cell failed to run or skipped its verdict
+	SuccessRate float64
+	Iterations  int
+}
+
+// RunMatrix runs a chaos test per combination of fault set, intensity and
+// seed as the subtest "<faults>/intensity=<i>/seed=<s>", each with its own
+// verdict, then logs a summary table of all cells and returns their results:
+//
+//	func TestCheckoutResilience(t *testing.T) {
+//	    chaostest.RunMatrix(t, &MyTarget{}, chaostest.ChaosMatrix{
+//	        Scenario: func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+//	            return s.Step("checkout", checkout)
+//	        },
+//	        Faults: []chaostest.MatrixFaults{
+//	            {Name: "delay", Injectors: func(p float64) []chaoskit.Injector {
+//	                return []chaoskit.Injector{injectors.RandomDelayWithProbability(time.Millisecond, 10*time.Millisecond, p)}
+//	            }},
+//	            {Name: "errors", Injectors: func(p float64) []chaoskit.Injector {
+//	                return []chaoskit.Injector{injectors.FaultWithProbability(chaoskit.FaultUnavailable, p)}
+//	            }},
+//	        },
+//	        Intensities: []float64{0.1, 0.5},
+//	        Seeds:       []int64{1, 2, 3},
+//	    }, chaostest.WithRepeat(20))
+//	}
+//
+// Options are those of RunChaos and apply to every cell.
+func RunMatrix(
+	t *stdtesting.T,
+	target chaoskit.Target,
+	matrix ChaosMatrix,
+	opts ...ChaosTestOption,
+) []MatrixResult {
+	t.Helper()
+
+	name := matrix.Name
+	if name == "" {
+		name = "matrix"
+	}
+	intensities := matrix.Intensities
+	if len(intensities) == 0 {
+		intensities = []float64{1}
+	}
+	seeds := matrix.Seeds
+	if len(seeds) == 0 {
+		seeds = []int64{1}
+	}
+
+	results := make([]MatrixResult, 0, len(matrix.Faults)*len(intensities)*len(seeds))
+	for _, faults := range matrix.Faults {
+		for _, intensity := range intensities {
+			for _, seed := range seeds {
+				intensityName := strconv.FormatFloat(intensity, 'g', -1, 64)
+				cell := fmt.Sprintf("%s/intensity=%s/seed=%d", faults.Name, intensityName, seed)
+
+				t.Run(cell, func(t *stdtesting.T) {
+					// FailNow ends the subtest early, so the result is recorded on exit
+					result := MatrixResult{Faults: faults.Name, Intensity: intensity, Seed: seed, Verdict: chaoskit.VerdictFail}
+					defer func() { results = append(results, result) }()
+
+					report := runChaos(t, name+"/"+cell, target, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+						s = s.WithSeed(seed)
+						if matrix.Scenario != nil {
+							s = matrix.Scenario(s)
+						}
+						for i, inj := range faults.Injectors(intensity) {
+							s = s.Inject(fmt.Sprintf("%s-%d", faults.Name, i+1), inj)
+						}
+
+						return s
+					}, newChaosTestConfig(opts))
+
+					result.Verdict = chaoskit.VerdictPass
+					if report != nil {
+						result.Verdict = report.Verdict
+						result.SuccessRate = report.SuccessRate
+						result.Iterations = report.TotalIterations
+					}
+				})
+			}
+		}
+	}
+
+	t.Logf("\n%s", formatMatrixSummary(results))
+
+	return results
+}
+
+// formatMatrixSummary renders the results of RunMatrix as a table
+func formatMatrixSummary(results []MatrixResult) string {
+	passed := 0
+	for _, result := range results {
+		if result.Verdict == chaoskit.VerdictPass {
+			passed++
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Chaos matrix: %d/%d cells passed\n", passed, len(results))
+
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FAULTS\tINTENSITY\tSEED\tVERDICT\tSUCCESS\tITERATIONS")
+	for _, result := range results {
+		fmt.Fprintf(w, "%s\t%g\t%d\t%s\t%.1f%%\t%d\n",
+			result.Faults, result.Intensity, result.Seed, result.Verdict, result.SuccessRate*100, result.Iterations)
+	}
+	_ = w.Flush()
+
+	return sb.String()
+}
@@ -0,0 +1,230 @@
+// Package mocks provides scriptable fakes of chaoskit injectors, validators
+// and targets, recording their calls, to unit-test custom injectors,
+// validators and scenario wrappers without real chaos.
+package mocks
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// Injector and target method names recorded by the fakes
+const (
+	CallInject     = "Inject"
+	CallStop       = "Stop"
+	CallBeforeStep = "BeforeStep"
+	CallAfterStep  = "AfterStep"
+	CallSetup      = "Setup"
+	CallTeardown   = "Teardown"
+	CallValidate   = "Validate"
+	CallReset      = "Reset"
+)
+
+// calls records method calls; its methods are safe for concurrent use
+type calls struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (c *calls) record(method string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, method)
+}
+
+// Calls returns the recorded method calls in call order
+func (c *calls) Calls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.calls)
+}
+
+// CallCount returns how often method was called
+func (c *calls) CallCount(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count := 0
+	for _, call := range c.calls {
+		if call == method {
+			count++
+		}
+	}
+
+	return count
+}
+
+// FakeInjector is a chaoskit.StepInjector, MetricsProvider and IntensityScaler
+// doing nothing but recording its calls and returning the programmed errors
+type FakeInjector struct {
+	calls
+
+	name         string
+	injectorType chaoskit.InjectorType
+
+	mu             sync.Mutex
+	injectErr      error
+	stopErr        error
+	beforeStepErr  error
+	afterStepErr   error
+	injectFunc     func(ctx context.Context) error
+	stepErrs       []error
+	intensityScale []float64
+}
+
+// NewFakeInjector creates a FakeInjector of type chaoskit.InjectorTypeHybrid
+func NewFakeInjector(name string) *FakeInjector {
+	return &FakeInjector{name: name, injectorType: chaoskit.InjectorTypeHybrid}
+}
+
+// WithType sets the injector type returned by Type
+func (f *FakeInjector) WithType(injectorType chaoskit.InjectorType) *FakeInjector {
+	f.injectorType = injectorType
+
+	return f
+}
+
+// FailInject makes Inject return err
+func (f *FakeInjector) FailInject(err error) *FakeInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.injectErr = err
+
+	return f
+}
+
+// FailStop makes Stop return err
+func (f *FakeInjector) FailStop(err error) *FakeInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.stopErr = err
+
+	return f
+}
+
+// FailBeforeStep makes BeforeStep return err, failing the step
+func (f *FakeInjector) FailBeforeStep(err error) *FakeInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.beforeStepErr = err
+
+	return f
+}
+
+// FailAfterStep makes AfterStep return err
+func (f *FakeInjector) FailAfterStep(err error) *FakeInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.afterStepErr = err
+
+	return f
+}
+
+// OnInject runs fn in Inject, e.g. to start a custom effect; its error is returned
+func (f *FakeInjector) OnInject(fn func(ctx context.Context) error) *FakeInjector {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.injectFunc = fn
+
+	return f
+}
+
+func (f *FakeInjector) Name() string {
+	return f.name
+}
+
+func (f *FakeInjector) Type() chaoskit.InjectorType {
+	return f.injectorType
+}
+
+func (f *FakeInjector) Inject(ctx context.Context) error {
+	f.record(CallInject)
+
+	f.mu.Lock()
+	err, fn := f.injectErr, f.injectFunc
+	f.mu.Unlock()
+	if err == nil && fn != nil {
+		err = fn(ctx)
+	}
+
+	return err
+}
+
+func (f *FakeInjector) Stop(ctx context.Context) error {
+	f.record(CallStop)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.stopErr
+}
+
+func (f *FakeInjector) BeforeStep(ctx context.Context) error {
+	f.record(CallBeforeStep)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.beforeStepErr
+}
+
+func (f *FakeInjector) AfterStep(ctx context.Context, err error) error {
+	f.record(CallAfterStep)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.stepErrs = append(f.stepErrs, err)
+
+	return f.afterStepErr
+}
+
+// StepErrors returns the step errors passed to AfterStep, nil for passed steps
+func (f *FakeInjector) StepErrors() []error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.stepErrs)
+}
+
+func (f *FakeInjector) ScaleIntensity(factor float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.intensityScale = append(f.intensityScale, factor)
+}
+
+// IntensityFactors returns the factors passed to ScaleIntensity
+func (f *FakeInjector) IntensityFactors() []float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.intensityScale)
+}
+
+// GetMetrics reports the number of calls per method
+func (f *FakeInjector) GetMetrics() map[string]interface{} {
+	return map[string]interface{}{
+		"injects":      f.CallCount(CallInject),
+		"stops":        f.CallCount(CallStop),
+		"before_steps": f.CallCount(CallBeforeStep),
+		"after_steps":  f.CallCount(CallAfterStep),
+	}
+}
+
+var (
+	_ chaoskit.StepInjector        = (*FakeInjector)(nil)
+	_ chaoskit.CategorizedInjector = (*FakeInjector)(nil)
+	_ chaoskit.MetricsProvider     = (*FakeInjector)(nil)
+	_ chaoskit.IntensityScaler     = (*FakeInjector)(nil)
+)
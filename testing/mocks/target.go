@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rom8726/chaoskit"
+)
+
+var _ chaoskit.Target = (*FakeTarget)(nil)
+
+// FakeTarget is a chaoskit.Target recording Setup and Teardown and
+// returning the programmed errors
+type FakeTarget struct {
+	calls
+
+	name string
+
+	mu          sync.Mutex
+	setupErr    error
+	teardownErr error
+}
+
+// NewFakeTarget creates a FakeTarget
+func NewFakeTarget(name string) *FakeTarget {
+	return &FakeTarget{name: name}
+}
+
+// FailSetup makes Setup return err
+func (f *FakeTarget) FailSetup(err error) *FakeTarget {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.setupErr = err
+
+	return f
+}
+
+// FailTeardown makes Teardown return err
+func (f *FakeTarget) FailTeardown(err error) *FakeTarget {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.teardownErr = err
+
+	return f
+}
+
+func (f *FakeTarget) Name() string {
+	return f.name
+}
+
+func (f *FakeTarget) Setup(ctx context.Context) error {
+	f.record(CallSetup)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.setupErr
+}
+
+func (f *FakeTarget) Teardown(ctx context.Context) error {
+	f.record(CallTeardown)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.teardownErr
+}
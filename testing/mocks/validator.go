@@ -0,0 +1,113 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rom8726/chaoskit"
+)
+
+var (
+	_ chaoskit.Validator  = (*FakeValidator)(nil)
+	_ chaoskit.Resettable = (*FakeValidator)(nil)
+)
+
+// FakeValidator is a chaoskit.Validator and Resettable returning scripted
+// results: the errors given to Script in order, then the error of FailWith
+type FakeValidator struct {
+	calls
+
+	name     string
+	severity chaoskit.ValidationSeverity
+
+	mu       sync.Mutex
+	script   []error
+	err      error
+	validate func(ctx context.Context, target chaoskit.Target) error
+	targets  []chaoskit.Target
+}
+
+// NewFakeValidator creates a passing FakeValidator of chaoskit.SeverityCritical
+func NewFakeValidator(name string) *FakeValidator {
+	return &FakeValidator{name: name, severity: chaoskit.SeverityCritical}
+}
+
+// WithSeverity sets the severity returned by Severity
+func (f *FakeValidator) WithSeverity(severity chaoskit.ValidationSeverity) *FakeValidator {
+	f.severity = severity
+
+	return f
+}
+
+// Script makes the next Validate calls return errs in order (nil passes)
+func (f *FakeValidator) Script(errs ...error) *FakeValidator {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.script = append(f.script, errs...)
+
+	return f
+}
+
+// FailWith makes Validate return err once the script is exhausted
+func (f *FakeValidator) FailWith(err error) *FakeValidator {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+
+	return f
+}
+
+// OnValidate makes Validate call fn once the script is exhausted, instead of returning the FailWith error
+func (f *FakeValidator) OnValidate(fn func(ctx context.Context, target chaoskit.Target) error) *FakeValidator {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.validate = fn
+
+	return f
+}
+
+func (f *FakeValidator) Name() string {
+	return f.name
+}
+
+func (f *FakeValidator) Severity() chaoskit.ValidationSeverity {
+	return f.severity
+}
+
+func (f *FakeValidator) Validate(ctx context.Context, target chaoskit.Target) error {
+	f.record(CallValidate)
+
+	f.mu.Lock()
+	f.targets = append(f.targets, target)
+	if len(f.script) > 0 {
+		err := f.script[0]
+		f.script = f.script[1:]
+		f.mu.Unlock()
+
+		return err
+	}
+	err, fn := f.err, f.validate
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, target)
+	}
+
+	return err
+}
+
+// Reset records the reset before each iteration; it keeps the script
+func (f *FakeValidator) Reset() {
+	f.record(CallReset)
+}
+
+// Targets returns the targets passed to Validate in call order
+func (f *FakeValidator) Targets() []chaoskit.Target {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]chaoskit.Target(nil), f.targets...)
+}
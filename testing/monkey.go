@@ -0,0 +1,35 @@
+package testing
+
+import (
+	"github.com/rom8726/chaoskit/injectors"
+)
+
+// monkeyPatchingHelp tells how to enable monkey patching
+const monkeyPatchingHelp = "monkey patch injectors need inlining disabled, " +
+	"or patched calls may silently run the original function: run go test -gcflags=all=-l"
+
+// RequireMonkeyPatching skips the test, or fails it when t cannot skip,
+// unless the binary was built with inlining disabled (-gcflags=all=-l), so
+// monkey patch tests do not pass without their patches applying. Patches
+// replace function variables with reflection and work on every OS and
+// architecture; inlining is the only build requirement.
+//
+//	func TestPaymentRetries(t *testing.T) {
+//	    chaostest.RequireMonkeyPatching(t)
+//	    ...
+//	}
+func RequireMonkeyPatching(t TestingT) {
+	t.Helper()
+
+	if injectors.InliningDisabled() {
+		return
+	}
+
+	if skipper, ok := t.(interface{ Skip(args ...interface{}) }); ok {
+		skipper.Skip(monkeyPatchingHelp)
+
+		return
+	}
+	t.Errorf("%s", monkeyPatchingHelp)
+	t.FailNow()
+}
@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// defaultShrinkRuns is the re-run budget of WithShrinking(0)
+const defaultShrinkRuns = 20
+
+// minShrinkIntensity is the lowest intensity tried while shrinking
+const minShrinkIntensity = 0.125
+
+// WithShrinking re-runs a failing chaos test to find a minimal configuration
+// still failing: injectors are dropped one at a time, then the intensity of the
+// remaining ones is halved, within maxRuns re-runs (0 = 20). The scenario gets a
+// fixed seed unless builderFn or -chaos.seed sets one. The minimal configuration
+// is logged with the flags reproducing it.
+func WithShrinking(maxRuns int) ChaosTestOption {
+	return func(c *chaosTestConfig) {
+		if maxRuns <= 0 {
+			maxRuns = defaultShrinkRuns
+		}
+		c.shrinkRuns = maxRuns
+	}
+}
+
+// shrinker re-runs a failing chaos test with subsets of its injectors
+type shrinker struct {
+	name      string
+	target    chaoskit.Target
+	builderFn func(*chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder
+	config    *chaosTestConfig
+	settings  *chaosSettings
+	seed      int64
+	runs      int
+}
+
+// newShrinker returns a shrinker with a random default seed
+func newShrinker(
+	name string,
+	target chaoskit.Target,
+	builderFn func(*chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder,
+	config *chaosTestConfig,
+	settings *chaosSettings,
+) *shrinker {
+	return &shrinker{
+		name:      name,
+		target:    target,
+		builderFn: builderFn,
+		config:    config,
+		settings:  settings,
+		seed:      rand.Int63(),
+	}
+}
+
+// build builds a new scenario the way runChaos does
+func (s *shrinker) build() *chaoskit.Scenario {
+	builder := s.builderFn(chaoskit.NewScenario(s.name).WithTarget(s.target).WithSeed(s.seed))
+
+	return s.settings.applyBuilder(builder.Repeat(s.config.repeat)).Build()
+}
+
+// fails re-runs the scenario with the kept injectors at intensity and reports whether it still fails
+func (s *shrinker) fails(keep []bool, intensity float64) bool {
+	s.runs++
+
+	scenario := s.build().FilterInjectors(func(index int, injector chaoskit.Injector) bool {
+		return index < len(keep) && keep[index]
+	})
+	ctx, scenario := s.settings.applyScenario(context.Background(), scenario)
+
+	executorOpts := append(
+		[]chaoskit.ExecutorOption{chaoskit.WithFailurePolicy(s.config.failurePolicy)},
+		s.config.executorOpts...,
+	)
+	if factor := s.settings.intensity * intensity; factor != 1 {
+		executorOpts = append(executorOpts, chaoskit.WithIntensity(factor))
+	}
+	if s.config.subtests {
+		executorOpts = append(executorOpts, chaoskit.WithIterationRunner(runAllIterations))
+	}
+	executor := chaoskit.NewExecutor(executorOpts...)
+
+	if err := executor.Run(ctx, scenario); err != nil {
+		return true
+	}
+	if s.config.skipVerdict {
+		return false
+	}
+	report, err := executor.Reporter().GetVerdict(s.config.thresholds)
+
+	return err != nil || report.Verdict == chaoskit.VerdictFail
+}
+
+// shrink searches a minimal failing configuration and logs it
+func (s *shrinker) shrink(t TestingT) {
+	t.Helper()
+
+	logf := func(format string, args ...interface{}) {
+		if logger, ok := t.(interface{ Logf(string, ...interface{}) }); ok {
+			logger.Logf(format, args...)
+		}
+	}
+
+	probe := s.build()
+	names := probe.InjectorNames()
+	seed, _ := probe.Seed()
+	keep := make([]bool, len(names))
+	for i := range keep {
+		keep[i] = true
+	}
+
+	if !s.fails(keep, 1) {
+		logf("chaos test %q: failure did not reproduce with seed %d; nothing to shrink", s.name, seed)
+
+		return
+	}
+
+	// Drop injectors one at a time while the failure persists
+	for i := range keep {
+		if s.runs >= s.config.shrinkRuns {
+			break
+		}
+		keep[i] = false
+		if !s.fails(keep, 1) {
+			keep[i] = true
+		}
+	}
+
+	// Then lower the intensity of the remaining injectors
+	intensity := 1.0
+	for next := 0.5; next >= minShrinkIntensity && s.runs < s.config.shrinkRuns; next /= 2 {
+		if !s.fails(keep, next) {
+			break
+		}
+		intensity = next
+	}
+
+	var kept []string
+	for i, name := range names {
+		if keep[i] {
+			kept = append(kept, name)
+		}
+	}
+	flags := fmt.Sprintf("-chaos.seed=%d", seed)
+	if factor := s.settings.intensity * intensity; factor != 1 {
+		flags += fmt.Sprintf(" -chaos.intensity=%g", factor)
+	}
+	logf("chaos test %q: shrunk the failure in %d runs to %d of %d injectors [%s] at intensity %g\n"+
+		"reproduce with %s, keeping only these injectors",
+		s.name, s.runs, len(kept), len(names), strings.Join(kept, ", "), intensity, flags)
+}
@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/rom8726/chaoskit"
+)
+
+// WithSubtests runs every iteration as the subtest "iter-<n>", showing which
+// iterations passed and failed in go test output; a failing iteration fails
+// its subtest, and so the test, whatever the thresholds. Iterations get their own
+// seeds (chaoskit.WithIterationRunner), so a failing iteration reruns alone
+// with the -run pattern and -chaos.seed flag it logs. The scenario gets a
+// fixed seed unless builderFn or -chaos.seed sets one. Needs a *testing.T.
+func WithSubtests() ChaosTestOption {
+	return func(c *chaosTestConfig) {
+		c.subtests = true
+	}
+}
+
+// subtestRunner is the part of *testing.T running subtests
+type subtestRunner interface {
+	Run(name string, f func(t *stdtesting.T)) bool
+}
+
+// iterationSubtests returns the executor option running the iterations of scenario as subtests of t
+func iterationSubtests(t TestingT, scenario *chaoskit.Scenario) (chaoskit.ExecutorOption, error) {
+	runner, ok := t.(subtestRunner)
+	if !ok {
+		return nil, errors.New("WithSubtests needs a *testing.T")
+	}
+	seed, _ := scenario.Seed()
+
+	return chaoskit.WithIterationRunner(func(
+		ctx context.Context,
+		iteration int,
+		_ int64,
+		run func(context.Context) chaoskit.ExecutionResult,
+	) {
+		runner.Run(fmt.Sprintf("iter-%d", iteration), func(t *stdtesting.T) {
+			if result := run(ctx); result.Error != nil {
+				t.Errorf("iteration %d failed: %v\nreproduce with -run '%s' -chaos.seed=%d",
+					iteration, result.Error, runPattern(t.Name()), seed)
+			}
+		})
+	}), nil
+}
+
+// runAllIterations is an iteration runner running every iteration in place,
+// keeping the iteration seeds of WithSubtests without creating subtests
+func runAllIterations(ctx context.Context, _ int, _ int64, run func(context.Context) chaoskit.ExecutionResult) {
+	run(ctx)
+}
+
+// runPattern returns the -run pattern matching exactly the test named name
+func runPattern(name string) string {
+	parts := strings.Split(name, "/")
+	for i, part := range parts {
+		parts[i] = "^" + regexp.QuoteMeta(part) + "$"
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -3,6 +3,9 @@ package testing
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
 
 	"github.com/rom8726/chaoskit"
 )
@@ -18,13 +21,18 @@ type TestingT interface {
 type ChaosTestOption func(*chaosTestConfig)
 
 type chaosTestConfig struct {
-	repeat         int
-	failurePolicy  chaoskit.FailurePolicy
-	executorOpts   []chaoskit.ExecutorOption
-	skipReport     bool
-	reportToStderr bool
-	thresholds     *chaoskit.SuccessThresholds
-	skipVerdict    bool
+	repeat           int
+	failurePolicy    chaoskit.FailurePolicy
+	executorOpts     []chaoskit.ExecutorOption
+	skipReport       bool
+	reportToStderr   bool
+	thresholds       *chaoskit.SuccessThresholds
+	skipVerdict      bool
+	shrinkRuns       int
+	artifacts        bool
+	artifactRoot     string
+	subtests         bool
+	minPointCoverage float64
 }
 
 // WithRepeat sets the number of times to repeat the test scenario
@@ -121,6 +129,18 @@ func WithoutVerdict() ChaosTestOption {
 //	        WithDefaultThresholds(), // Enables verdict with 95% success rate
 //	    )
 //	}
+//
+// The -chaos.seed, -chaos.off and -chaos.intensity test flags (or the
+// CHAOSKIT_SEED, CHAOSKIT_OFF and CHAOSKIT_INTENSITY environment variables)
+// fix the scenario seed, disable all injectors and scale injector probabilities.
+// -chaos.artifacts (CHAOSKIT_ARTIFACTS) writes per-test artifacts under a root
+// directory, see WithArtifacts.
+//
+// RunChaos is safe in parallel tests (t.Parallel): every call has its own
+// executor and reporter, monkey patch injectors refuse functions patched by
+// another test (injectors.ErrFunctionPatched), and a target pointer may only
+// be used by one RunChaos call at a time, which fails the test otherwise.
+// Targets passed by value can't be told apart and must not share state.
 func RunChaos(
 	t TestingT,
 	name string,
@@ -130,37 +150,102 @@ func RunChaos(
 ) {
 	t.Helper()
 
-	// Apply options
+	runChaos(t, name, target, builderFn, newChaosTestConfig(opts))
+}
+
+// newChaosTestConfig applies opts to the default configuration
+func newChaosTestConfig(opts []ChaosTestOption) *chaosTestConfig {
 	config := &chaosTestConfig{
 		repeat:        1,
 		failurePolicy: chaoskit.FailFast,
 		thresholds:    chaoskit.DefaultThresholds(), // Use default thresholds
 	}
-	for _, opt := range opts {
+	for _, opt := range append(mainDefaults(), opts...) {
 		opt(config)
 	}
 
+	return config
+}
+
+// runChaos runs a chaos test and returns its verdict report,
+// nil when execution failed or the verdict was skipped
+func runChaos(
+	t TestingT,
+	name string,
+	target chaoskit.Target,
+	builderFn func(*chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder,
+	config *chaosTestConfig,
+) *chaoskit.Report {
+	t.Helper()
+
+	settings, err := loadChaosSettings()
+	if err != nil {
+		t.Errorf("chaos test %q: %v", name, err)
+		t.FailNow()
+		return nil
+	}
+	if desc := settings.String(); desc != "" {
+		if logger, ok := t.(interface{ Logf(string, ...interface{}) }); ok {
+			logger.Logf("chaos test %q: chaos settings:%s", name, desc)
+		}
+	}
+
+	// Setup and teardown of a target shared by parallel tests would interleave
+	release, ok := claimTarget(target)
+	if !ok {
+		t.Errorf("chaos test %q: target %q is used by a parallel chaos test; give each test its own target",
+			name, target.Name())
+		t.FailNow()
+		return nil
+	}
+	defer release()
+
+	dir, err := artifactDir(t, name, config, settings)
+	if err != nil {
+		t.Errorf("chaos test %q: %v", name, err)
+		t.FailNow()
+		return nil
+	}
+
 	// Create scenario builder
 	builder := chaoskit.NewScenario(name).WithTarget(target)
 
+	// Shrinking re-runs the scenario with the same seed
+	var shrink *shrinker
+	if config.shrinkRuns > 0 && !settings.off {
+		shrink = newShrinker(name, target, builderFn, config, settings)
+		builder = builder.WithSeed(shrink.seed)
+	} else if config.subtests {
+		builder = builder.WithSeed(rand.Int63())
+	}
+
 	// Let user configure the scenario
 	builder = builderFn(builder)
 
 	// Set repeat count
-	builder = builder.Repeat(config.repeat)
+	builder = settings.applyBuilder(builder.Repeat(config.repeat))
 
 	// Build scenario
-	scenario := builder.Build()
+	ctx, scenario := settings.applyScenario(context.Background(), builder.Build())
 
 	// Create executor with options
 	executorOpts := append(
 		[]chaoskit.ExecutorOption{chaoskit.WithFailurePolicy(config.failurePolicy)},
 		config.executorOpts...,
 	)
-	executor := chaoskit.NewExecutor(executorOpts...)
+	executorOpts = append(executorOpts, settings.executorOptions()...)
+	if config.subtests {
+		subtests, err := iterationSubtests(t, scenario)
+		if err != nil {
+			t.Errorf("chaos test %q: %v", name, err)
+			t.FailNow()
+			return nil
+		}
+		executorOpts = append(executorOpts, subtests)
+	}
+	executor := chaoskit.NewExecutor(append(executorOpts, artifactOptions(dir)...)...)
 
 	// Run scenario
-	ctx := context.Background()
 	if err := executor.Run(ctx, scenario); err != nil {
 		t.Errorf("chaos test execution failed: %v", err)
 
@@ -168,21 +253,67 @@ func RunChaos(
 		if !config.skipReport {
 			printReport(t, executor, config)
 		}
+		saveArtifacts(t, executor, nil, config, dir)
+		if shrink != nil {
+			shrink.shrink(t)
+		}
 
 		t.FailNow()
-		return
+		return nil
 	}
 
 	// Calculate verdict and print report
+	var report *chaoskit.Report
+	verdict := chaoskit.VerdictPass
 	if !config.skipReport || !config.skipVerdict {
-		verdict := evaluateVerdict(t, executor, config)
-
-		// Fail test if verdict is FAIL
-		if verdict == chaoskit.VerdictFail {
-			t.Errorf("chaos test verdict: FAIL")
-			t.FailNow()
+		verdict, report = evaluateVerdict(t, executor, config)
+	}
+	recordMainVerdict(t, report)
+	saveArtifacts(t, executor, report, config, dir)
+	checkChaosPointCoverage(t, name, executor, report, config)
+
+	// Fail test if verdict is FAIL
+	if verdict == chaoskit.VerdictFail {
+		t.Errorf("chaos test verdict: FAIL")
+		if shrink != nil {
+			shrink.shrink(t)
 		}
+		t.FailNow()
 	}
+
+	return report
+}
+
+// activeTargets holds the targets of running RunChaos calls
+var activeTargets = struct {
+	mu      sync.Mutex
+	targets map[chaoskit.Target]struct{}
+}{targets: make(map[chaoskit.Target]struct{})}
+
+// claimTarget reserves target for one RunChaos call. Only pointers to
+// non-zero-size values identify a shared target: equal values of other
+// types (e.g. two emptyTarget{}) and pointers to zero-size values, which
+// may share an address, are different targets and always accepted.
+func claimTarget(target chaoskit.Target) (release func(), ok bool) {
+	v := reflect.ValueOf(target)
+	if !v.IsValid() || v.Kind() != reflect.Pointer || v.IsNil() || v.Type().Elem().Size() == 0 {
+		return func() {}, true
+	}
+
+	activeTargets.mu.Lock()
+	defer activeTargets.mu.Unlock()
+
+	if _, busy := activeTargets.targets[target]; busy {
+		return nil, false
+	}
+	activeTargets.targets[target] = struct{}{}
+
+	return func() {
+		activeTargets.mu.Lock()
+		defer activeTargets.mu.Unlock()
+
+		delete(activeTargets.targets, target)
+	}, true
 }
 
 // printReport prints the test report
@@ -211,17 +342,21 @@ func printReport(t TestingT, executor *chaoskit.Executor, config *chaosTestConfi
 	}
 }
 
-// evaluateVerdict evaluates the verdict and returns it
-func evaluateVerdict(t TestingT, executor *chaoskit.Executor, config *chaosTestConfig) chaoskit.Verdict {
+// evaluateVerdict evaluates the verdict and returns it with its report (nil when skipped or failed)
+func evaluateVerdict(
+	t TestingT,
+	executor *chaoskit.Executor,
+	config *chaosTestConfig,
+) (chaoskit.Verdict, *chaoskit.Report) {
 	if config.skipVerdict {
-		return chaoskit.VerdictPass
+		return chaoskit.VerdictPass, nil
 	}
 
 	// Get verdict
 	report, err := executor.Reporter().GetVerdict(config.thresholds)
 	if err != nil {
 		t.Errorf("failed to generate verdict: %v", err)
-		return chaoskit.VerdictFail
+		return chaoskit.VerdictFail, nil
 	}
 
 	// Print report
@@ -232,7 +367,7 @@ func evaluateVerdict(t TestingT, executor *chaoskit.Executor, config *chaosTestC
 		}
 	}
 
-	return report.Verdict
+	return report.Verdict, report
 }
 
 // RunChaosSimple is a simplified version that takes steps, injectors, and validators directly.
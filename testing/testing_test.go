@@ -0,0 +1,127 @@
+package testing_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rom8726/chaoskit"
+	chaostest "github.com/rom8726/chaoskit/testing"
+)
+
+// emptyTarget is a zero-size target: equal values and pointers to them are
+// not shared state
+type emptyTarget struct{}
+
+func (emptyTarget) Name() string                       { return "empty" }
+func (emptyTarget) Setup(ctx context.Context) error    { return nil }
+func (emptyTarget) Teardown(ctx context.Context) error { return nil }
+
+// counterTarget counts its setups
+type counterTarget struct {
+	mu     sync.Mutex
+	setups int
+}
+
+func (c *counterTarget) Name() string { return "counter" }
+
+func (c *counterTarget) Setup(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setups++
+
+	return nil
+}
+
+func (c *counterTarget) Teardown(ctx context.Context) error { return nil }
+
+// recordingT records the errors of a chaos test
+type recordingT struct {
+	mu     sync.Mutex
+	errors []string
+	failed bool
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) FailNow() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed = true
+}
+
+func (r *recordingT) Helper() {}
+
+// sleepStep keeps parallel chaos tests running at the same time
+func sleepStep(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+	return s.Step("sleep", func(ctx context.Context, target chaoskit.Target) error {
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+}
+
+func TestRunChaos_ParallelDistinctTargets(t *testing.T) {
+	targets := map[string]chaoskit.Target{
+		"value-1":   emptyTarget{},
+		"value-2":   emptyTarget{},
+		"zero-1":    &emptyTarget{},
+		"zero-2":    &emptyTarget{},
+		"pointer-1": &counterTarget{},
+		"pointer-2": &counterTarget{},
+	}
+	for name, target := range targets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			chaostest.RunChaos(t, name, target, sleepStep, chaostest.WithRepeat(3), chaostest.WithoutReport())
+		})
+	}
+}
+
+func TestRunChaos_ParallelSharedTarget(t *testing.T) {
+	shared := &counterTarget{}
+	entered := make(chan struct{})
+	proceed := make(chan struct{})
+
+	first := &recordingT{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		chaostest.RunChaos(first, "first", shared, func(s *chaoskit.ScenarioBuilder) *chaoskit.ScenarioBuilder {
+			return s.Step("block", func(ctx context.Context, target chaoskit.Target) error {
+				close(entered)
+				<-proceed
+				return nil
+			})
+		}, chaostest.WithoutReport())
+	}()
+
+	// The second test runs while the first one holds the target
+	<-entered
+	second := &recordingT{}
+	chaostest.RunChaos(second, "second", shared, sleepStep, chaostest.WithoutReport())
+	close(proceed)
+	wg.Wait()
+
+	assert.False(t, first.failed, "first test errors: %v", first.errors)
+	require.True(t, second.failed)
+	require.Len(t, second.errors, 1)
+	assert.True(t, strings.Contains(second.errors[0], "used by a parallel chaos test"), second.errors[0])
+	assert.Equal(t, 1, shared.setups)
+
+	// The target is released when the first test ends
+	third := &recordingT{}
+	chaostest.RunChaos(third, "third", shared, sleepStep, chaostest.WithoutReport())
+	assert.False(t, third.failed, "third test errors: %v", third.errors)
+}
@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"github.com/rom8726/chaoskit"
+)
+
+// RequirePass fetches the verdict of an executor that has run, logs the text
+// report and fails the test unless the verdict is PASS. Nil thresholds mean
+// chaoskit.DefaultThresholds(). It returns the report, nil when the verdict
+// could not be calculated.
+//
+//	if err := executor.Run(ctx, scenario); err != nil {
+//	    t.Fatal(err)
+//	}
+//	chaostest.RequirePass(t, executor, chaoskit.DefaultThresholds())
+func RequirePass(t TestingT, executor *chaoskit.Executor, thresholds *chaoskit.SuccessThresholds) *chaoskit.Report {
+	t.Helper()
+
+	return requireVerdict(t, executor, thresholds, chaoskit.VerdictPass)
+}
+
+// RequireAtLeastUnstable is RequirePass tolerating an UNSTABLE verdict:
+// only a FAIL verdict fails the test
+func RequireAtLeastUnstable(
+	t TestingT,
+	executor *chaoskit.Executor,
+	thresholds *chaoskit.SuccessThresholds,
+) *chaoskit.Report {
+	t.Helper()
+
+	return requireVerdict(t, executor, thresholds, chaoskit.VerdictUnstable)
+}
+
+// requireVerdict fails the test when the verdict is worse than minimum
+func requireVerdict(
+	t TestingT,
+	executor *chaoskit.Executor,
+	thresholds *chaoskit.SuccessThresholds,
+	minimum chaoskit.Verdict,
+) *chaoskit.Report {
+	t.Helper()
+
+	if thresholds == nil {
+		thresholds = chaoskit.DefaultThresholds()
+	}
+	report, err := executor.Reporter().GetVerdict(thresholds)
+	if err != nil {
+		t.Errorf("failed to generate verdict: %v", err)
+		t.FailNow()
+		return nil
+	}
+
+	if logger, ok := t.(interface{ Logf(string, ...interface{}) }); ok {
+		logger.Logf("\n%s", executor.Reporter().GenerateTextReport(report))
+	}
+
+	if report.Verdict > minimum {
+		t.Errorf("chaos verdict %s, want %s or better: %s (success rate %.1f%%, %d/%d iterations failed)",
+			report.Verdict, minimum, report.Summary,
+			report.SuccessRate*100, report.FailureCount, report.TotalIterations)
+		t.FailNow()
+	}
+
+	return report
+}
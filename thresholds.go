@@ -1,8 +1,13 @@
 package chaoskit
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"os"
 	"time"
+
+	"go.yaml.in/yaml/v3"
 )
 
 // Validator identifiers
@@ -27,6 +32,9 @@ const (
 	ErrorTypeRecursion     = "recursion"
 	ErrorTypeTimeout       = "timeout"
 	ErrorTypeMemory        = "memory"
+	ErrorTypeUnavailable   = "unavailable"
+	ErrorTypeThrottled     = "throttled"
+	ErrorTypeCorruption    = "corruption"
 	ErrorTypeOther         = "other"
 	ErrorTypeUnknown       = "unknown"
 )
@@ -57,6 +65,23 @@ type SuccessThresholds struct {
 	// If true, any validator failure = FAIL
 	//nolint:lll
 	RequireAllValidatorsPassing bool `json:"require_all_validators_passing,omitempty" yaml:"require_all_validators_passing,omitempty"`
+
+	// ValidatorLimits sets per-validator occurrence limits keyed by validator name.
+	// Failures of a validator within its limit are reported as info and don't count
+	// against MinSuccessRate, MaxFailedIterations or RequireAllValidatorsPassing;
+	// exceeding the limit is a critical failure.
+	// Example: {ValidatorGoroutineLimit: {Max: 0}, ValidatorSlowIteration: {Max: 5}}
+	ValidatorLimits map[string]ValidatorLimit `json:"validator_limits,omitempty" yaml:"validator_limits,omitempty"`
+
+	// ExitCodes overrides the verdict to exit code mapping used by Report.ExitCode
+	// If nil, DefaultExitCodes is used
+	ExitCodes *ExitCodes `json:"exit_codes,omitempty" yaml:"exit_codes,omitempty"`
+}
+
+// ValidatorLimit is the occurrence limit for a single validator
+type ValidatorLimit struct {
+	// Max is the maximum number of failures tolerated
+	Max int `json:"max" yaml:"max"`
 }
 
 // DefaultThresholds returns sensible defaults for most systems
@@ -107,6 +132,73 @@ func (t *SuccessThresholds) Validate() error {
 	if t.MaxFailedIterations < 0 {
 		return fmt.Errorf("max_failed_iterations must be >= 0")
 	}
+	for name, limit := range t.ValidatorLimits {
+		if limit.Max < 0 {
+			return fmt.Errorf("validator_limits.%s.max must be >= 0", name)
+		}
+	}
 
 	return nil
 }
+
+// LoadThresholds reads thresholds from a YAML or JSON file.
+// Durations may be given as strings ("250ms") or nanoseconds. Fields which are
+// not set keep their zero values; unknown fields are rejected.
+//
+// Example thresholds.yaml:
+//
+//	min_success_rate: 0.95
+//	max_failed_iterations: 10
+//	critical_validators: [goroutine-limit, infinite-loop]
+//	warning_validators: [execution-time]
+//	max_avg_duration: 250ms
+//	exit_codes: {pass: 0, unstable: 2, fail: 1}
+//	validator_limits:
+//	  slow-iteration: {max: 5}
+func LoadThresholds(path string) (*SuccessThresholds, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is converted to YAML so both formats share decoding rules
+	// (duration strings, unknown field checks); it may be tab-indented, which YAML forbids
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var doc map[string]any
+		if err := json.Unmarshal(trimmed, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse thresholds %s: %w", path, err)
+		}
+		if data, err = yaml.Marshal(doc); err != nil {
+			return nil, fmt.Errorf("failed to parse thresholds %s: %w", path, err)
+		}
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	thresholds := &SuccessThresholds{}
+	if err := decoder.Decode(thresholds); err != nil {
+		return nil, fmt.Errorf("failed to parse thresholds %s: %w", path, err)
+	}
+
+	if err := thresholds.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid thresholds %s: %w", path, err)
+	}
+
+	return thresholds, nil
+}
+
+// validatorLimit finds the occurrence limit for a validator by its raw or
+// normalized name and returns the matching key
+func (t *SuccessThresholds) validatorLimit(validatorName string) (string, ValidatorLimit, bool) {
+	if limit, ok := t.ValidatorLimits[validatorName]; ok {
+		return validatorName, limit, true
+	}
+
+	normalizedName := normalizeValidatorName(validatorName)
+	if limit, ok := t.ValidatorLimits[normalizedName]; ok {
+		return normalizedName, limit, true
+	}
+
+	return "", ValidatorLimit{}, false
+}
@@ -1,9 +1,13 @@
 package chaoskit
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSuccessThresholds_Validate(t *testing.T) {
@@ -85,3 +89,49 @@ func TestRelaxedThresholds(t *testing.T) {
 	assert.Equal(t, 200, thresholds.MaxFailedIterations)
 	assert.Contains(t, thresholds.CriticalValidators, "goroutine-limit")
 }
+
+func TestLoadThresholds(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlPath := filepath.Join(dir, "thresholds.yaml")
+	require.NoError(t, os.WriteFile(yamlPath, []byte(`
+min_success_rate: 0.9
+max_failed_iterations: 5
+critical_validators: [goroutine-limit, infinite-loop]
+warning_validators:
+  - execution-time
+max_avg_duration: 250ms
+exit_codes: {pass: 0, unstable: 2, fail: 1}
+validator_limits:
+  slow-iteration: {max: 5}
+`), 0644))
+
+	thresholds, err := LoadThresholds(yamlPath)
+	require.NoError(t, err)
+	assert.Equal(t, 0.9, thresholds.MinSuccessRate)
+	assert.Equal(t, 5, thresholds.MaxFailedIterations)
+	assert.Equal(t, []string{"goroutine-limit", "infinite-loop"}, thresholds.CriticalValidators)
+	assert.Equal(t, []string{"execution-time"}, thresholds.WarningValidators)
+	assert.Equal(t, 250*time.Millisecond, thresholds.MaxAvgDuration)
+	require.NotNil(t, thresholds.ExitCodes)
+	assert.Equal(t, 2, thresholds.ExitCodes.Unstable)
+	assert.Equal(t, map[string]ValidatorLimit{"slow-iteration": {Max: 5}}, thresholds.ValidatorLimits)
+
+	jsonPath := filepath.Join(dir, "thresholds.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte("{\n\t\"min_success_rate\": 1,\n\t\"max_avg_duration\": \"1s\"\n}"), 0644))
+
+	thresholds, err = LoadThresholds(jsonPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, thresholds.MinSuccessRate)
+	assert.Equal(t, time.Second, thresholds.MaxAvgDuration)
+
+	badPath := filepath.Join(dir, "bad.yaml")
+	require.NoError(t, os.WriteFile(badPath, []byte("min_sucess_rate: 0.9\n"), 0644))
+	_, err = LoadThresholds(badPath)
+	assert.Error(t, err, "unknown fields must be rejected")
+
+	invalidPath := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalidPath, []byte("min_success_rate: 1.5\n"), 0644))
+	_, err = LoadThresholds(invalidPath)
+	assert.Error(t, err)
+}
@@ -0,0 +1,264 @@
+package chaoskit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DecisionTraceVersion is the version of the decision trace file format
+const DecisionTraceVersion = 1
+
+// Chaos helpers whose decisions are traced
+const (
+	traceDelay     = "delay"
+	traceError     = "error"
+	tracePanic     = "panic"
+	traceNetwork   = "network"
+	traceCorrupt   = "corrupt"
+	traceContext   = "context_value"
+	traceMessaging = "messaging"
+)
+
+// DecisionTrace is a recorded sequence of chaos decisions of one Run
+// (see WithDecisionTrace). Replaying it (see WithReplay) makes MaybeDelay,
+// MaybeError, MaybePanic, MaybeCorrupt, MaybeCorruptContextValue,
+// MaybeNetworkChaos and the messaging helpers (MaybePublishError,
+// MaybeDeliveryFault) repeat the recorded faults call by call, and seeds the run
+// with the recorded seed, so a failure observed once can be reproduced
+// deterministically.
+//
+// Decisions are matched by iteration and by the order of helper calls within
+// the iteration, so steps must call the helpers in a deterministic order.
+// ShouldFail decisions and faults applied outside the context helpers (ToxiProxy,
+// CPU and memory stress, failpoints, monkey patching, context cancellation)
+// follow the seed only.
+type DecisionTrace struct {
+	Version  int    `json:"version"`
+	Scenario string `json:"scenario"`
+	Seed     int64  `json:"seed"`
+
+	// Iterations is the number of executed iterations
+	Iterations int `json:"iterations"`
+
+	// FailedIterations lists the iterations which failed during recording
+	FailedIterations []int `json:"failed_iterations,omitempty"`
+
+	// Decisions lists the injected faults in iteration and call order;
+	// helper calls without a decision injected nothing
+	Decisions []Decision `json:"decisions"`
+}
+
+// Decision is one fault injected through a chaos context helper
+type Decision struct {
+	Iteration int `json:"iteration"`
+
+	// Helper is the context helper: delay, error, panic, network, corrupt,
+	// context_value or messaging
+	Helper string `json:"helper"`
+
+	// Call is the 1-based index of the helper call within the iteration
+	Call int `json:"call"`
+
+	Injector string        `json:"injector"`
+	Delay    time.Duration `json:"delay,omitempty"`
+	Error    string        `json:"error,omitempty"`
+
+	// Drop is set for dropped network connections
+	Drop bool `json:"drop,omitempty"`
+
+	// Fault is the kind of a messaging fault; Delay holds the duration of a
+	// consumer disconnect
+	Fault string `json:"fault,omitempty"`
+}
+
+// WithDecisionTrace records the chaos decisions of every Run; read them with
+// Executor.DecisionTrace and save them with DecisionTrace.Save
+func WithDecisionTrace() ExecutorOption {
+	return func(e *Executor) {
+		e.trace = &traceRecorder{}
+	}
+}
+
+// WithReplay replays a recorded decision trace instead of asking injectors
+// for decisions made through the context helpers
+func WithReplay(trace *DecisionTrace) ExecutorOption {
+	return func(e *Executor) {
+		e.replay = newTraceReplay(trace)
+	}
+}
+
+// DecisionTrace returns the decisions recorded by the last Run,
+// or nil without WithDecisionTrace
+func (e *Executor) DecisionTrace() *DecisionTrace {
+	if e.trace == nil {
+		return nil
+	}
+
+	return e.trace.snapshot()
+}
+
+// Save writes the trace as JSON
+func (t *DecisionTrace) Save(path string) error {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadDecisionTrace reads a trace written by DecisionTrace.Save
+func LoadDecisionTrace(path string) (*DecisionTrace, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	trace := &DecisionTrace{}
+	if err := json.Unmarshal(data, trace); err != nil {
+		return nil, fmt.Errorf("failed to parse decision trace: %w", err)
+	}
+	if trace.Version > DecisionTraceVersion {
+		return nil, fmt.Errorf("unsupported decision trace version %d (max %d)", trace.Version, DecisionTraceVersion)
+	}
+
+	return trace, nil
+}
+
+// traceRecorder collects decisions while a scenario runs
+type traceRecorder struct {
+	mu    sync.Mutex
+	trace DecisionTrace
+}
+
+// begin starts the trace of a new run
+func (r *traceRecorder) begin(scenario string, seed int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.trace = DecisionTrace{Version: DecisionTraceVersion, Scenario: scenario, Seed: seed}
+}
+
+func (r *traceRecorder) record(decision Decision) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.trace.Decisions = append(r.trace.Decisions, decision)
+}
+
+// finishIteration records the outcome of an iteration
+func (r *traceRecorder) finishIteration(result ExecutionResult) {
+	if result.Iteration == 0 {
+		// Framework failures outside of iterations
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.trace.Iterations = max(r.trace.Iterations, result.Iteration)
+	if !result.Success {
+		r.trace.FailedIterations = append(r.trace.FailedIterations, result.Iteration)
+	}
+}
+
+func (r *traceRecorder) snapshot() *DecisionTrace {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	trace := r.trace
+	trace.FailedIterations = append([]int(nil), r.trace.FailedIterations...)
+	trace.Decisions = append([]Decision(nil), r.trace.Decisions...)
+	sort.SliceStable(trace.Decisions, func(i, j int) bool {
+		return trace.Decisions[i].Iteration < trace.Decisions[j].Iteration
+	})
+
+	return &trace
+}
+
+// decisionKey identifies a helper call
+type decisionKey struct {
+	iteration int
+	helper    string
+	call      int
+}
+
+// traceReplay serves recorded decisions
+type traceReplay struct {
+	seed      int64
+	decisions map[decisionKey]Decision
+}
+
+func newTraceReplay(trace *DecisionTrace) *traceReplay {
+	r := &traceReplay{
+		seed:      trace.Seed,
+		decisions: make(map[decisionKey]Decision, len(trace.Decisions)),
+	}
+	for _, d := range trace.Decisions {
+		r.decisions[decisionKey{iteration: d.Iteration, helper: d.Helper, call: d.Call}] = d
+	}
+
+	return r
+}
+
+// iterationDecisions counts helper calls of one iteration and records
+// or replays their decisions. A nil value neither records nor replays.
+type iterationDecisions struct {
+	iteration int
+	recorder  *traceRecorder
+	replay    *traceReplay
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func newIterationDecisions(iteration int, recorder *traceRecorder, replay *traceReplay) *iterationDecisions {
+	if recorder == nil && replay == nil {
+		return nil
+	}
+
+	return &iterationDecisions{
+		iteration: iteration,
+		recorder:  recorder,
+		replay:    replay,
+		calls:     make(map[string]int),
+	}
+}
+
+// next returns the 1-based index of a helper call
+func (d *iterationDecisions) next(helper string) int {
+	if d == nil {
+		return 0
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls[helper]++
+
+	return d.calls[helper]
+}
+
+// replayed returns the recorded decision of a call; ok is false when not replaying.
+// A zero Decision with ok set means the call injected nothing.
+func (d *iterationDecisions) replayed(helper string, call int) (decision Decision, ok bool) {
+	if d == nil || d.replay == nil {
+		return Decision{}, false
+	}
+
+	return d.replay.decisions[decisionKey{iteration: d.iteration, helper: helper, call: call}], true
+}
+
+// record stores an injected fault of a call
+func (d *iterationDecisions) record(decision Decision) {
+	if d == nil || d.recorder == nil {
+		return
+	}
+
+	decision.Iteration = d.iteration
+	d.recorder.record(decision)
+}
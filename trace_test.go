@@ -0,0 +1,89 @@
+package chaoskit
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// traceTestInjector fails the listed error calls and delays every call
+type traceTestInjector struct {
+	calls  int
+	failOn map[int]bool
+}
+
+func (i *traceTestInjector) Name() string                     { return "trace-injector" }
+func (i *traceTestInjector) Inject(ctx context.Context) error { return nil }
+func (i *traceTestInjector) Stop(ctx context.Context) error   { return nil }
+
+func (i *traceTestInjector) ShouldReturnError() error {
+	i.calls++
+	if i.failOn[i.calls] {
+		return errors.New("injected failure")
+	}
+
+	return nil
+}
+
+func (i *traceTestInjector) GetChaosDelay(ctx context.Context) (time.Duration, bool) {
+	return time.Millisecond, true
+}
+
+func traceScenario(injector Injector) *Scenario {
+	return NewScenario("trace").
+		WithTarget(&testTarget{}).
+		Inject("chaos", injector).
+		Step("step", func(ctx context.Context, target Target) error {
+			MaybeDelay(ctx)
+
+			return MaybeError(ctx)
+		}).
+		Repeat(5).
+		Build()
+}
+
+func TestExecutor_DecisionTraceReplay(t *testing.T) {
+	recorder := NewExecutor(WithDecisionTrace(), WithFailurePolicy(ContinueOnFailure))
+	require.Error(t, recorder.Run(context.Background(), traceScenario(&traceTestInjector{failOn: map[int]bool{2: true, 4: true}})))
+
+	trace := recorder.DecisionTrace()
+	require.NotNil(t, trace)
+	assert.Equal(t, "trace", trace.Scenario)
+	assert.Equal(t, 5, trace.Iterations)
+	assert.Equal(t, []int{2, 4}, trace.FailedIterations)
+	assert.Len(t, trace.Decisions, 7)
+	assert.Contains(t, trace.Decisions, Decision{Iteration: 2, Helper: traceError, Call: 1, Injector: "trace-injector", Error: "injected failure"})
+
+	path := filepath.Join(t.TempDir(), "run.trace")
+	require.NoError(t, trace.Save(path))
+	loaded, err := LoadDecisionTrace(path)
+	require.NoError(t, err)
+	assert.Equal(t, trace, loaded)
+
+	// The replaying injector would never fail on its own
+	replayer := NewExecutor(WithReplay(loaded), WithDecisionTrace(), WithFailurePolicy(ContinueOnFailure))
+	require.Error(t, replayer.Run(context.Background(), traceScenario(&traceTestInjector{})))
+
+	replayed := replayer.DecisionTrace()
+	assert.Equal(t, trace.Seed, replayed.Seed)
+	assert.Equal(t, trace.FailedIterations, replayed.FailedIterations)
+	assert.Equal(t, trace.Decisions, replayed.Decisions)
+
+	var injected *ChaosError
+	results := replayer.Reporter().Results()
+	require.Len(t, results, 5)
+	require.ErrorAs(t, results[1].Error, &injected)
+	assert.Equal(t, "trace-injector", injected.Injector)
+}
+
+func TestExecutor_DecisionTraceDisabled(t *testing.T) {
+	executor := NewExecutor()
+	require.NoError(t, executor.Run(context.Background(), traceScenario(&traceTestInjector{})))
+
+	assert.Nil(t, executor.DecisionTrace())
+}
@@ -1,5 +1,11 @@
 package chaoskit
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 // Verdict represents the overall test outcome
 type Verdict int
 
@@ -28,16 +34,79 @@ func (v Verdict) String() string {
 	}
 }
 
+// ParseVerdict parses a verdict name ("PASS", "UNSTABLE", "FAIL"), case-insensitively
+func ParseVerdict(s string) (Verdict, error) {
+	switch strings.ToUpper(s) {
+	case "PASS":
+		return VerdictPass, nil
+	case "UNSTABLE":
+		return VerdictUnstable, nil
+	case "FAIL":
+		return VerdictFail, nil
+	default:
+		return 0, fmt.Errorf("unknown verdict %q", s)
+	}
+}
+
+// MarshalJSON encodes the verdict as its name
+func (v Verdict) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON decodes a verdict name or, for schema version 1 reports, a number
+func (v *Verdict) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var n int
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("invalid verdict %s", data)
+		}
+		*v = Verdict(n)
+
+		return nil
+	}
+
+	parsed, err := ParseVerdict(name)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+
+	return nil
+}
+
 // ExitCode returns appropriate exit code for CI/CD
 // Pass=0, Unstable=0, Fail=1
 func (v Verdict) ExitCode() int {
+	return DefaultExitCodes().For(v)
+}
+
+// ExitCodes maps verdicts to process exit codes
+type ExitCodes struct {
+	Pass     int `json:"pass" yaml:"pass"`
+	Unstable int `json:"unstable" yaml:"unstable"`
+	Fail     int `json:"fail" yaml:"fail"`
+}
+
+// DefaultExitCodes returns the default mapping: Pass=0, Unstable=0, Fail=1
+func DefaultExitCodes() ExitCodes {
+	return ExitCodes{Pass: 0, Unstable: 0, Fail: 1}
+}
+
+// StrictExitCodes returns a mapping which also fails on warnings: Pass=0, Unstable=2, Fail=1
+func StrictExitCodes() ExitCodes {
+	return ExitCodes{Pass: 0, Unstable: 2, Fail: 1}
+}
+
+// For returns the exit code of a verdict (unknown verdicts use the Fail code)
+func (c ExitCodes) For(v Verdict) int {
 	switch v {
-	case VerdictPass, VerdictUnstable:
-		return 0
-	case VerdictFail:
-		return 1
+	case VerdictPass:
+		return c.Pass
+	case VerdictUnstable:
+		return c.Unstable
 	default:
-		return 1
+		return c.Fail
 	}
 }
 
@@ -68,3 +137,44 @@ func (s ValidationSeverity) String() string {
 		return "UNKNOWN"
 	}
 }
+
+// ParseSeverity parses a severity name ("CRITICAL", "WARNING", "INFO"), case-insensitively
+func ParseSeverity(s string) (ValidationSeverity, error) {
+	switch strings.ToUpper(s) {
+	case "CRITICAL":
+		return SeverityCritical, nil
+	case "WARNING":
+		return SeverityWarning, nil
+	case "INFO":
+		return SeverityInfo, nil
+	default:
+		return 0, fmt.Errorf("unknown severity %q", s)
+	}
+}
+
+// MarshalJSON encodes the severity as its name
+func (s ValidationSeverity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes a severity name or, for schema version 1 reports, a number
+func (s *ValidationSeverity) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var n int
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("invalid severity %s", data)
+		}
+		*s = ValidationSeverity(n)
+
+		return nil
+	}
+
+	parsed, err := ParseSeverity(name)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+
+	return nil
+}
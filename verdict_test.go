@@ -32,6 +32,22 @@ func TestVerdict_ExitCode(t *testing.T) {
 	assert.Equal(t, 1, Verdict(999).ExitCode())
 }
 
+func TestReport_ExitCode(t *testing.T) {
+	report := &Report{Verdict: VerdictUnstable}
+	assert.Equal(t, 0, report.ExitCode())
+
+	strict := StrictExitCodes()
+	report.Thresholds = &SuccessThresholds{ExitCodes: &strict}
+	assert.Equal(t, 2, report.ExitCode())
+
+	report.Verdict = VerdictFail
+	assert.Equal(t, 1, report.ExitCode())
+
+	report.Thresholds.ExitCodes = &ExitCodes{Pass: 0, Unstable: 0, Fail: 3}
+	assert.Equal(t, 3, report.ExitCode())
+	assert.Equal(t, 3, report.Thresholds.ExitCodes.For(Verdict(999)))
+}
+
 func TestValidationSeverity_String(t *testing.T) {
 	tests := []struct {
 		name     string